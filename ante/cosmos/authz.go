@@ -28,12 +28,22 @@ func NewAuthzLimiterDecorator(disabledMsgTypes ...string) AuthzLimiterDecorator
 }
 
 func (ald AuthzLimiterDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
-	if err := ald.checkDisabledMsgs(tx.GetMsgs(), false, 1); err != nil {
-		return ctx, errorsmod.Wrapf(errortypes.ErrUnauthorized, "%s", err.Error())
+	if err := ald.CheckDisabledMsgs(tx.GetMsgs()...); err != nil {
+		return ctx, err
 	}
 	return next(ctx, tx, simulate)
 }
 
+// CheckDisabledMsgs returns an unauthorized error if the authz msgs grant or execute
+// any of the disabled msg types. It is also used for the authz msgs that are not
+// included in a Cosmos transaction, like the ones of the authz precompile.
+func (ald AuthzLimiterDecorator) CheckDisabledMsgs(msgs ...sdk.Msg) error {
+	if err := ald.checkDisabledMsgs(msgs, false, 1); err != nil {
+		return errorsmod.Wrapf(errortypes.ErrUnauthorized, "%s", err.Error())
+	}
+	return nil
+}
+
 // checkDisabledMsgs iterates through the msgs and returns an error if it finds any unauthorized msgs.
 //
 // When searchOnlyInAuthzMsgs is enabled, only authz MsgGrant and MsgExec are blocked, if they contain unauthorized msg types.
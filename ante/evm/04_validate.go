@@ -54,9 +54,10 @@ func checkDisabledCreateCall(
 }
 
 // ValidateTx validates an Ethereum specific transaction type and returns an error if invalid.
+// The fee granter of the transaction must be empty unless allowFeeGranter is true.
 //
 // FIXME: this shouldn't be required if the tx was an Ethereum transaction type.
-func ValidateTx(tx sdktypes.Tx) (*tx.Fee, error) {
+func ValidateTx(tx sdktypes.Tx, allowFeeGranter bool) (*tx.Fee, error) {
 	if t, ok := tx.(sdktypes.HasValidateBasic); ok {
 		err := t.ValidateBasic()
 		// ErrNoSignatures is fine with eth tx
@@ -88,8 +89,12 @@ func ValidateTx(tx sdktypes.Tx) (*tx.Fee, error) {
 		return nil, errorsmod.Wrap(errortypes.ErrInvalidRequest, "for eth tx AuthInfo SignerInfos should be empty")
 	}
 
-	if authInfo.Fee.Payer != "" || authInfo.Fee.Granter != "" {
-		return nil, errorsmod.Wrap(errortypes.ErrInvalidRequest, "for eth tx AuthInfo Fee payer and granter should be empty")
+	if authInfo.Fee.Payer != "" {
+		return nil, errorsmod.Wrap(errortypes.ErrInvalidRequest, "for eth tx AuthInfo Fee payer should be empty")
+	}
+
+	if authInfo.Fee.Granter != "" && !allowFeeGranter {
+		return nil, errorsmod.Wrap(errortypes.ErrInvalidRequest, "for eth tx AuthInfo Fee granter should be empty")
 	}
 
 	sigs := protoTx.Signatures
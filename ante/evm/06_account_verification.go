@@ -27,9 +27,69 @@ func VerifyAccountBalance(
 	from common.Address,
 	txData evmtypes.TxData,
 ) error {
+	account, err := verifyAccount(ctx, accountKeeper, account, from)
+	if err != nil {
+		return err
+	}
+
+	if err := keeper.CheckSenderBalance(sdkmath.NewIntFromBigInt(account.Balance.ToBig()), txData); err != nil {
+		return errorsmod.Wrap(err, "failed to check sender balance")
+	}
+
+	return nil
+}
+
+// VerifyGrantedAccountBalance checks that the account balance is greater than the value of a
+// transaction whose fees are paid by a fee granter.
+// The account will be set to store if it doesn't exist, i.e. cannot be found on store.
+// This method will fail if:
+// - from address is NOT an EOA
+// - account balance is lower than the transaction value
+func VerifyGrantedAccountBalance(
+	ctx sdk.Context,
+	accountKeeper anteinterfaces.AccountKeeper,
+	account *statedb.Account,
+	from common.Address,
+	txData evmtypes.TxData,
+) error {
+	account, err := verifyAccount(ctx, accountKeeper, account, from)
+	if err != nil {
+		return err
+	}
+
+	value := txData.GetValue()
+	if value == nil {
+		return nil
+	}
+
+	if value.Sign() < 0 {
+		return errorsmod.Wrapf(
+			errortypes.ErrInvalidCoins,
+			"tx value (%s) is negative and invalid", value,
+		)
+	}
+
+	if account.Balance.ToBig().Cmp(value) < 0 {
+		return errorsmod.Wrapf(
+			errortypes.ErrInsufficientFunds,
+			"failed to check sender balance: sender balance < tx value (%s < %s)", account.Balance, value,
+		)
+	}
+
+	return nil
+}
+
+// verifyAccount checks that the sender is an EOA and returns its account,
+// which is set to store if it doesn't exist.
+func verifyAccount(
+	ctx sdk.Context,
+	accountKeeper anteinterfaces.AccountKeeper,
+	account *statedb.Account,
+	from common.Address,
+) (*statedb.Account, error) {
 	// Only EOA are allowed to send transactions.
 	if account != nil && account.IsContract() {
-		return errorsmod.Wrapf(
+		return nil, errorsmod.Wrapf(
 			errortypes.ErrInvalidType,
 			"the sender is not EOA: address %s", from,
 		)
@@ -41,9 +101,5 @@ func VerifyAccountBalance(
 		account = statedb.NewEmptyAccount()
 	}
 
-	if err := keeper.CheckSenderBalance(sdkmath.NewIntFromBigInt(account.Balance.ToBig()), txData); err != nil {
-		return errorsmod.Wrap(err, "failed to check sender balance")
-	}
-
-	return nil
+	return account, nil
 }
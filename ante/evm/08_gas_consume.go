@@ -2,6 +2,7 @@ package evm
 
 import (
 	"math/big"
+	"slices"
 
 	"github.com/ethereum/go-ethereum/common"
 
@@ -10,6 +11,8 @@ import (
 	evmtypes "github.com/cosmos/evm/x/vm/types"
 
 	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/x/feegrant"
 
 	sdktypes "github.com/cosmos/cosmos-sdk/types"
 	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
@@ -60,6 +63,70 @@ func ConsumeFeesAndEmitEvent(
 	return nil
 }
 
+// ConsumeGrantedFeesAndEmitEvent deduces the fees of the sender from the fee
+// allowance granted to it and from the balance of the granter, and emits the
+// event. The allowance is spent in the extended denom of the EVM coin.
+//
+// The granter must have opted in to pay the fees of the EVM transactions of
+// the sender with an allowance restricted to messages including
+// MsgEthereumTx: the fee granter is not covered by the Ethereum signature, so
+// anyone relaying the transactions of the sender can choose it.
+func ConsumeGrantedFeesAndEmitEvent(
+	ctx sdktypes.Context,
+	feegrantKeeper anteinterfaces.FeegrantKeeper,
+	evmKeeper anteinterfaces.EVMKeeper,
+	fees sdktypes.Coins,
+	granter, from sdktypes.AccAddress,
+	msgs []sdktypes.Msg,
+) error {
+	allowance, err := feegrantKeeper.GetAllowance(ctx, granter, from)
+	if err != nil {
+		return errorsmod.Wrapf(err, "%s does not allow to pay fees for %s", granter, from)
+	}
+	if !allowsEVMFees(allowance) {
+		return errorsmod.Wrapf(
+			errortypes.ErrUnauthorized,
+			"%s does not allow to pay fees for the EVM transactions of %s: the allowance must be restricted to messages including %s",
+			granter, from, sdktypes.MsgTypeURL(&evmtypes.MsgEthereumTx{}),
+		)
+	}
+
+	if err := feegrantKeeper.UseGrantedFees(
+		ctx,
+		granter,
+		from,
+		evmtypes.ConvertCoinsDenomToExtendedDenom(fees),
+		msgs,
+	); err != nil {
+		return errorsmod.Wrapf(err, "%s does not allow to pay fees for %s", granter, from)
+	}
+
+	if err := deductFees(
+		ctx,
+		evmKeeper,
+		fees,
+		granter,
+	); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdktypes.NewEvent(
+			sdktypes.EventTypeTx,
+			sdktypes.NewAttribute(sdktypes.AttributeKeyFee, fees.String()),
+			sdktypes.NewAttribute(sdktypes.AttributeKeyFeePayer, granter.String()),
+		),
+	)
+	return nil
+}
+
+// allowsEVMFees returns true if the fee allowance is restricted to messages
+// including MsgEthereumTx.
+func allowsEVMFees(allowance feegrant.FeeAllowanceI) bool {
+	allowedMsgs, ok := allowance.(*feegrant.AllowedMsgAllowance)
+	return ok && slices.Contains(allowedMsgs.AllowedMessages, sdktypes.MsgTypeURL(&evmtypes.MsgEthereumTx{}))
+}
+
 // deductFee checks if the fee payer has enough funds to pay for the fees and deducts them.
 func deductFees(
 	ctx sdktypes.Context,
@@ -82,6 +149,65 @@ func deductFees(
 	return nil
 }
 
+// VerifyBlobFee checks the blob gas of an EIP-4844 blob transaction against the
+// blob gas limits and the blob base fee, and returns the blob fee to deduct
+// from the sender. The blob gas used by the transaction is added to the block
+// blob gas used, that updates the blob base fee at the end of the block.
+// Non blob transactions have no blob fee.
+func VerifyBlobFee(
+	ctx sdktypes.Context,
+	feeMarketKeeper anteinterfaces.FeeMarketKeeper,
+	txData evmtypes.TxData,
+	denom string,
+	blobBaseFee *big.Int,
+) (sdktypes.Coins, error) {
+	blobTx, ok := txData.(*evmtypes.BlobTx)
+	if !ok {
+		return sdktypes.Coins{}, nil
+	}
+
+	blobGas := blobTx.GetBlobGas()
+	maxBlobGas := feeMarketKeeper.GetParams(ctx).MaxBlobGasPerBlock
+	if blobGas > maxBlobGas {
+		return nil, errorsmod.Wrapf(
+			evmtypes.ErrInvalidBlobTx,
+			"tx blob gas (%d) exceeds block blob gas limit (%d)",
+			blobGas,
+			maxBlobGas,
+		)
+	}
+
+	if blobTx.GetBlobFeeCap().Cmp(blobBaseFee) < 0 {
+		return nil, errorsmod.Wrapf(
+			errortypes.ErrInsufficientFee,
+			"max fee per blob gas less than block blob base fee (%s < %s)",
+			blobTx.GetBlobFeeCap(), blobBaseFee,
+		)
+	}
+
+	// the block blob gas limit is only enforced when the transaction is included
+	if !ctx.IsCheckTx() {
+		blockBlobGas, err := feeMarketKeeper.AddTransientBlobGasUsed(ctx, blobGas)
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "failed to add blob gas used to transient store")
+		}
+		if blockBlobGas > maxBlobGas {
+			return nil, errorsmod.Wrapf(
+				evmtypes.ErrInvalidBlobTx,
+				"block blob gas (%d) exceeds block blob gas limit (%d)",
+				blockBlobGas,
+				maxBlobGas,
+			)
+		}
+	}
+
+	blobFee := blobTx.BlobFee(blobBaseFee)
+	if blobFee.Sign() == 0 {
+		return sdktypes.Coins{}, nil
+	}
+	return sdktypes.Coins{{Denom: denom, Amount: sdkmath.NewIntFromBigInt(blobFee)}}, nil
+}
+
 // GetMsgPriority returns the priority of an Eth Tx capped by the minimum priority
 func GetMsgPriority(
 	txData evmtypes.TxData,
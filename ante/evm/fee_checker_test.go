@@ -43,6 +43,10 @@ func (m MockFeemarketKeeper) AddTransientGasWanted(_ sdk.Context, _ uint64) (uin
 	return 0, nil
 }
 
+func (m MockFeemarketKeeper) AddTransientBlobGasUsed(_ sdk.Context, _ uint64) (uint64, error) {
+	return 0, nil
+}
+
 func (m MockFeemarketKeeper) GetParams(_ sdk.Context) (params feemarkettypes.Params) {
 	return feemarkettypes.DefaultParams()
 }
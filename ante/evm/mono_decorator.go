@@ -25,6 +25,15 @@ type MonoDecorator struct {
 	feeMarketKeeper anteinterfaces.FeeMarketKeeper
 	evmKeeper       anteinterfaces.EVMKeeper
 	maxGasWanted    uint64
+	// mempoolNonceCheck defers the validation of nonces that are ahead of the
+	// account sequence in CheckTx to the app-side EVM mempool.
+	mempoolNonceCheck bool
+	// mempool accepts in CheckTx the replacements of its pending transactions,
+	// whose nonces are already used in the check state.
+	mempool anteinterfaces.EVMMempool
+	// feegrantKeeper pays the fees of the transactions with a fee granter from
+	// its allowance. Fee granters are rejected when it is nil.
+	feegrantKeeper anteinterfaces.FeegrantKeeper
 }
 
 // NewEVMMonoDecorator creates the 'mono' decorator, that is used to run the ante handle logic
@@ -47,6 +56,48 @@ func NewEVMMonoDecorator(
 	}
 }
 
+// WithMempoolNonceCheck returns a copy of the decorator that, when enabled,
+// accepts in CheckTx transactions whose nonce is ahead of the account sequence,
+// without incrementing it. The app-side EVM mempool is then responsible for
+// queueing these nonce-gapped transactions, which can be replaced by others
+// with the same nonce. Transactions with a nonce lower than the account
+// sequence are rejected, unless they replace a pending transaction (see
+// WithPendingTxReplacement), and so are nonce-gapped ones on ReCheckTx. It
+// must only be enabled when the application uses the EVM mempool.
+func (md MonoDecorator) WithMempoolNonceCheck(enabled bool) MonoDecorator {
+	md.mempoolNonceCheck = enabled
+	return md
+}
+
+// WithPendingTxReplacement returns a copy of the decorator that accepts in
+// CheckTx the transactions with the sender and nonce of a pending transaction
+// of the given EVM mempool, without incrementing the account sequence or
+// paying the fees again. The mempool then validates them as replacements of the
+// pending ones. Only queued transactions can be replaced otherwise, since the
+// nonces of the pending ones are lower than the account sequence of the check
+// state. The replaced transactions are rejected on ReCheckTx, so that they are
+// evicted from the CometBFT mempool before their replacements are rechecked.
+func (md MonoDecorator) WithPendingTxReplacement(mempool anteinterfaces.EVMMempool) MonoDecorator {
+	md.mempool = mempool
+	return md
+}
+
+// WithFeegrantKeeper returns a copy of the decorator that accepts a fee granter
+// on the Cosmos transaction wrapping the Ethereum transaction. The fees are
+// then paid by the granter within the allowance granted to the sender, which
+// only needs to cover the transaction value, and the leftover gas is refunded
+// to the granter.
+//
+// NOTE: the fee granter is not covered by the signature of the Ethereum
+// transaction, so the transactions with a fee granter must be broadcast as
+// Cosmos transactions, and anyone relaying them can choose it. The granters
+// opt in with allowances restricted to messages including MsgEthereumTx, see
+// ConsumeGrantedFeesAndEmitEvent.
+func (md MonoDecorator) WithFeegrantKeeper(feegrantKeeper anteinterfaces.FeegrantKeeper) MonoDecorator {
+	md.feegrantKeeper = feegrantKeeper
+	return md
+}
+
 // AnteHandle handles the entire decorator chain using a mono decorator.
 func (md MonoDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
 	// 0. Basic validation of the transaction
@@ -55,7 +106,7 @@ func (md MonoDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, ne
 		// NOTE: txFeeInfo is associated with the Cosmos stack, not the EVM. For
 		// this reason, the fee is represented in the original decimals and
 		// should be converted later when used.
-		txFeeInfo, err = ValidateTx(tx)
+		txFeeInfo, err = ValidateTx(tx, md.feegrantKeeper != nil)
 		if err != nil {
 			return ctx, err
 		}
@@ -104,7 +155,10 @@ func (md MonoDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, ne
 		}
 	}
 
-	if txData.TxType() == ethtypes.DynamicFeeTxType && decUtils.BaseFee != nil {
+	isDynamicFeeTx := txData.TxType() == ethtypes.DynamicFeeTxType ||
+		txData.TxType() == ethtypes.BlobTxType ||
+		txData.TxType() == ethtypes.SetCodeTxType
+	if isDynamicFeeTx && decUtils.BaseFee != nil {
 		// If the base fee is not empty, we compute the effective gas price
 		// according to current base fee price. The gas limit is specified
 		// by the user, while the price is given by the minimum between the
@@ -139,13 +193,39 @@ func (md MonoDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, ne
 
 	from := ethMsg.GetFrom()
 	fromAddr := common.BytesToAddress(from)
+	feeGranter := md.getFeeGranter(tx)
+
+	// a replaced transaction is evicted on ReCheckTx, so that it doesn't use
+	// the nonce of the transaction replacing it
+	if md.mempool != nil && ctx.IsReCheckTx() {
+		if txHash := ethMsg.AsTransaction().Hash(); md.mempool.IsReplaced(txHash) {
+			return ctx, errorsmod.Wrapf(errortypes.ErrConflict, "transaction %s was replaced in the mempool", txHash)
+		}
+	}
+	// the nonce and the fees of a pending transaction replacement are already
+	// used in the check state by the transaction it replaces
+	isReplacement := md.isPendingTxReplacement(ctx, from, txData.GetNonce())
 
 	// 6. account balance verification
 	// We get the account with the balance from the EVM keeper because it is
 	// using a wrapper of the bank keeper as a dependency to scale all
 	// balances to 18 decimals.
 	account := md.evmKeeper.GetAccount(ctx, fromAddr)
-	if err := VerifyAccountBalance(
+	if account != nil && account.IsContract() {
+		code := md.evmKeeper.GetCode(ctx, common.BytesToHash(account.CodeHash))
+		if _, delegated := ethtypes.ParseDelegation(code); delegated {
+			// EIP-7702 delegated accounts are still EOAs, their delegation
+			// designator is not contract code
+			eoa := *account
+			eoa.CodeHash = evmtypes.EmptyCodeHash
+			account = &eoa
+		}
+	}
+	verifyBalance := VerifyAccountBalance
+	if feeGranter != nil {
+		verifyBalance = VerifyGrantedAccountBalance
+	}
+	if err := verifyBalance(
 		ctx,
 		md.accountKeeper,
 		account,
@@ -189,15 +269,43 @@ func (md MonoDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, ne
 		return ctx, err
 	}
 
-	err = ConsumeFeesAndEmitEvent(
+	blobFees, err := VerifyBlobFee(
 		ctx,
-		md.evmKeeper,
-		msgFees,
-		from,
+		md.feeMarketKeeper,
+		txData,
+		evmDenom,
+		decUtils.BlobBaseFee,
 	)
 	if err != nil {
 		return ctx, err
 	}
+	msgFees = msgFees.Add(blobFees...)
+
+	switch {
+	case isReplacement:
+		// the fees are paid on ReCheckTx, once the replaced transaction is
+		// evicted
+	case feeGranter != nil:
+		err = ConsumeGrantedFeesAndEmitEvent(
+			ctx,
+			md.feegrantKeeper,
+			md.evmKeeper,
+			msgFees,
+			feeGranter,
+			from,
+			msgs,
+		)
+	default:
+		err = ConsumeFeesAndEmitEvent(
+			ctx,
+			md.evmKeeper,
+			msgFees,
+			from,
+		)
+	}
+	if err != nil {
+		return ctx, err
+	}
 
 	gasWanted := UpdateCumulativeGasWanted(
 		ctx,
@@ -233,8 +341,19 @@ func (md MonoDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, ne
 		)
 	}
 
-	if err := IncrementNonce(ctx, md.accountKeeper, acc, txData.GetNonce()); err != nil {
-		return ctx, err
+	// NOTE: when the nonce check is deferred to the EVM mempool, transactions
+	// that are not executable yet are validated by the mempool on insertion
+	// instead. They are not deferred on ReCheckTx, so that they are evicted
+	// if their nonce gap isn't filled by then.
+	ctx = evmtypes.ContextWithAccountSequence(ctx, acc.GetSequence())
+	deferNonceCheck := md.mempoolNonceCheck &&
+		ctx.IsCheckTx() &&
+		!ctx.IsReCheckTx() &&
+		txData.GetNonce() > acc.GetSequence()
+	if !deferNonceCheck && !isReplacement {
+		if err := IncrementNonce(ctx, md.accountKeeper, acc, txData.GetNonce()); err != nil {
+			return ctx, err
+		}
 	}
 
 	// 10. gas wanted
@@ -255,5 +374,43 @@ func (md MonoDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, ne
 		return ctx, err
 	}
 
+	if feeGranter != nil {
+		// refund the leftover gas to the granter that paid the fees
+		ctx = evmtypes.ContextWithFeePayer(ctx, feeGranter)
+	}
+
 	return next(ctx, tx, simulate)
 }
+
+// getFeeGranter returns the fee granter of the transaction, nil if it has
+// none or if fee granters are not accepted.
+func (md MonoDecorator) getFeeGranter(tx sdk.Tx) sdk.AccAddress {
+	if md.feegrantKeeper == nil {
+		return nil
+	}
+
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return nil
+	}
+
+	feeGranter := feeTx.FeeGranter()
+	if len(feeGranter) == 0 {
+		return nil
+	}
+	return feeGranter
+}
+
+// isPendingTxReplacement returns true if the transaction replaces a pending
+// transaction of the EVM mempool in CheckTx, whose nonce is lower than the
+// account sequence of the check state.
+func (md MonoDecorator) isPendingTxReplacement(ctx sdk.Context, from sdk.AccAddress, nonce uint64) bool {
+	if md.mempool == nil || !ctx.IsCheckTx() || ctx.IsReCheckTx() {
+		return false
+	}
+	sequence, err := md.accountKeeper.GetSequence(ctx, from)
+	if err != nil || nonce >= sequence {
+		return false
+	}
+	return md.mempool.HasPendingTx(common.BytesToAddress(from), nonce)
+}
@@ -23,6 +23,7 @@ type DecoratorUtils struct {
 	Rules              params.Rules
 	Signer             ethtypes.Signer
 	BaseFee            *big.Int
+	BlobBaseFee        *big.Int
 	MempoolMinGasPrice sdkmath.LegacyDec
 	GlobalMinGasPrice  sdkmath.LegacyDec
 	BlockTxIndex       uint64
@@ -69,6 +70,7 @@ func NewMonoDecoratorUtils(
 		Rules:              rules,
 		Signer:             ethtypes.MakeSigner(ethCfg, blockHeight, uint64(ctx.BlockTime().Unix())), //#nosec G115 -- int overflow is not a concern here
 		BaseFee:            baseFee,
+		BlobBaseFee:        ek.GetBlobBaseFee(ctx),
 		MempoolMinGasPrice: mempoolMinGasPrice,
 		GlobalMinGasPrice:  globalMinGasPrice,
 		BlockTxIndex:       ek.GetTxIndexTransient(ctx),
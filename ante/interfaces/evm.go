@@ -33,6 +33,9 @@ type EVMKeeper interface {
 	// GetBaseFee returns the BaseFee param from the fee market module
 	// adapted according to the evm denom decimals
 	GetBaseFee(ctx sdk.Context) *big.Int
+	// GetBlobBaseFee returns the EIP-4844 blob base fee from the fee market
+	// module adapted according to the evm denom decimals
+	GetBlobBaseFee(ctx sdk.Context) *big.Int
 	// GetMinGasPrice returns the MinGasPrice param from the fee market module
 	// adapted according to the evm denom decimals
 	GetMinGasPrice(ctx sdk.Context) math.LegacyDec
@@ -42,10 +45,19 @@ type EVMKeeper interface {
 type FeeMarketKeeper interface {
 	GetParams(ctx sdk.Context) (params feemarkettypes.Params)
 	AddTransientGasWanted(ctx sdk.Context, gasWanted uint64) (uint64, error)
+	AddTransientBlobGasUsed(ctx sdk.Context, blobGasUsed uint64) (uint64, error)
 	GetBaseFeeEnabled(ctx sdk.Context) bool
 	GetBaseFee(ctx sdk.Context) math.LegacyDec
 }
 
+// EVMMempool exposes the EVM mempool methods required for ante handlers to
+// accept the replacements of its pending transactions, and to evict the
+// replaced ones on ReCheckTx
+type EVMMempool interface {
+	HasPendingTx(from common.Address, nonce uint64) bool
+	IsReplaced(hash common.Hash) bool
+}
+
 type ProtoTxProvider interface {
 	GetProtoTx() *tx.Tx
 }
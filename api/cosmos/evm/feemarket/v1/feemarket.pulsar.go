@@ -15,14 +15,24 @@ import (
 )
 
 var (
-	md_Params                             protoreflect.MessageDescriptor
-	fd_Params_no_base_fee                 protoreflect.FieldDescriptor
-	fd_Params_base_fee_change_denominator protoreflect.FieldDescriptor
-	fd_Params_elasticity_multiplier       protoreflect.FieldDescriptor
-	fd_Params_enable_height               protoreflect.FieldDescriptor
-	fd_Params_base_fee                    protoreflect.FieldDescriptor
-	fd_Params_min_gas_price               protoreflect.FieldDescriptor
-	fd_Params_min_gas_multiplier          protoreflect.FieldDescriptor
+	md_Params                               protoreflect.MessageDescriptor
+	fd_Params_no_base_fee                   protoreflect.FieldDescriptor
+	fd_Params_base_fee_change_denominator   protoreflect.FieldDescriptor
+	fd_Params_elasticity_multiplier         protoreflect.FieldDescriptor
+	fd_Params_enable_height                 protoreflect.FieldDescriptor
+	fd_Params_base_fee                      protoreflect.FieldDescriptor
+	fd_Params_min_gas_price                 protoreflect.FieldDescriptor
+	fd_Params_min_gas_multiplier            protoreflect.FieldDescriptor
+	fd_Params_min_blob_base_fee             protoreflect.FieldDescriptor
+	fd_Params_target_blob_gas_per_block     protoreflect.FieldDescriptor
+	fd_Params_max_blob_gas_per_block        protoreflect.FieldDescriptor
+	fd_Params_blob_base_fee_update_fraction protoreflect.FieldDescriptor
+	fd_Params_reject_blob_sidecars          protoreflect.FieldDescriptor
+	fd_Params_base_fee_strategy             protoreflect.FieldDescriptor
+	fd_Params_gas_target                    protoreflect.FieldDescriptor
+	fd_Params_max_base_fee_change           protoreflect.FieldDescriptor
+	fd_Params_max_base_fee                  protoreflect.FieldDescriptor
+	fd_Params_base_fee_update_fraction      protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -35,6 +45,16 @@ func init() {
 	fd_Params_base_fee = md_Params.Fields().ByName("base_fee")
 	fd_Params_min_gas_price = md_Params.Fields().ByName("min_gas_price")
 	fd_Params_min_gas_multiplier = md_Params.Fields().ByName("min_gas_multiplier")
+	fd_Params_min_blob_base_fee = md_Params.Fields().ByName("min_blob_base_fee")
+	fd_Params_target_blob_gas_per_block = md_Params.Fields().ByName("target_blob_gas_per_block")
+	fd_Params_max_blob_gas_per_block = md_Params.Fields().ByName("max_blob_gas_per_block")
+	fd_Params_blob_base_fee_update_fraction = md_Params.Fields().ByName("blob_base_fee_update_fraction")
+	fd_Params_reject_blob_sidecars = md_Params.Fields().ByName("reject_blob_sidecars")
+	fd_Params_base_fee_strategy = md_Params.Fields().ByName("base_fee_strategy")
+	fd_Params_gas_target = md_Params.Fields().ByName("gas_target")
+	fd_Params_max_base_fee_change = md_Params.Fields().ByName("max_base_fee_change")
+	fd_Params_max_base_fee = md_Params.Fields().ByName("max_base_fee")
+	fd_Params_base_fee_update_fraction = md_Params.Fields().ByName("base_fee_update_fraction")
 }
 
 var _ protoreflect.Message = (*fastReflection_Params)(nil)
@@ -144,6 +164,66 @@ func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, proto
 			return
 		}
 	}
+	if x.MinBlobBaseFee != "" {
+		value := protoreflect.ValueOfString(x.MinBlobBaseFee)
+		if !f(fd_Params_min_blob_base_fee, value) {
+			return
+		}
+	}
+	if x.TargetBlobGasPerBlock != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.TargetBlobGasPerBlock)
+		if !f(fd_Params_target_blob_gas_per_block, value) {
+			return
+		}
+	}
+	if x.MaxBlobGasPerBlock != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxBlobGasPerBlock)
+		if !f(fd_Params_max_blob_gas_per_block, value) {
+			return
+		}
+	}
+	if x.BlobBaseFeeUpdateFraction != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.BlobBaseFeeUpdateFraction)
+		if !f(fd_Params_blob_base_fee_update_fraction, value) {
+			return
+		}
+	}
+	if x.RejectBlobSidecars != false {
+		value := protoreflect.ValueOfBool(x.RejectBlobSidecars)
+		if !f(fd_Params_reject_blob_sidecars, value) {
+			return
+		}
+	}
+	if x.BaseFeeStrategy != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.BaseFeeStrategy))
+		if !f(fd_Params_base_fee_strategy, value) {
+			return
+		}
+	}
+	if x.GasTarget != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.GasTarget)
+		if !f(fd_Params_gas_target, value) {
+			return
+		}
+	}
+	if x.MaxBaseFeeChange != "" {
+		value := protoreflect.ValueOfString(x.MaxBaseFeeChange)
+		if !f(fd_Params_max_base_fee_change, value) {
+			return
+		}
+	}
+	if x.MaxBaseFee != "" {
+		value := protoreflect.ValueOfString(x.MaxBaseFee)
+		if !f(fd_Params_max_base_fee, value) {
+			return
+		}
+	}
+	if x.BaseFeeUpdateFraction != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.BaseFeeUpdateFraction)
+		if !f(fd_Params_base_fee_update_fraction, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -173,6 +253,26 @@ func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.MinGasPrice != ""
 	case "cosmos.evm.feemarket.v1.Params.min_gas_multiplier":
 		return x.MinGasMultiplier != ""
+	case "cosmos.evm.feemarket.v1.Params.min_blob_base_fee":
+		return x.MinBlobBaseFee != ""
+	case "cosmos.evm.feemarket.v1.Params.target_blob_gas_per_block":
+		return x.TargetBlobGasPerBlock != uint64(0)
+	case "cosmos.evm.feemarket.v1.Params.max_blob_gas_per_block":
+		return x.MaxBlobGasPerBlock != uint64(0)
+	case "cosmos.evm.feemarket.v1.Params.blob_base_fee_update_fraction":
+		return x.BlobBaseFeeUpdateFraction != uint64(0)
+	case "cosmos.evm.feemarket.v1.Params.reject_blob_sidecars":
+		return x.RejectBlobSidecars != false
+	case "cosmos.evm.feemarket.v1.Params.base_fee_strategy":
+		return x.BaseFeeStrategy != 0
+	case "cosmos.evm.feemarket.v1.Params.gas_target":
+		return x.GasTarget != uint64(0)
+	case "cosmos.evm.feemarket.v1.Params.max_base_fee_change":
+		return x.MaxBaseFeeChange != ""
+	case "cosmos.evm.feemarket.v1.Params.max_base_fee":
+		return x.MaxBaseFee != ""
+	case "cosmos.evm.feemarket.v1.Params.base_fee_update_fraction":
+		return x.BaseFeeUpdateFraction != uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.feemarket.v1.Params"))
@@ -203,6 +303,26 @@ func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 		x.MinGasPrice = ""
 	case "cosmos.evm.feemarket.v1.Params.min_gas_multiplier":
 		x.MinGasMultiplier = ""
+	case "cosmos.evm.feemarket.v1.Params.min_blob_base_fee":
+		x.MinBlobBaseFee = ""
+	case "cosmos.evm.feemarket.v1.Params.target_blob_gas_per_block":
+		x.TargetBlobGasPerBlock = uint64(0)
+	case "cosmos.evm.feemarket.v1.Params.max_blob_gas_per_block":
+		x.MaxBlobGasPerBlock = uint64(0)
+	case "cosmos.evm.feemarket.v1.Params.blob_base_fee_update_fraction":
+		x.BlobBaseFeeUpdateFraction = uint64(0)
+	case "cosmos.evm.feemarket.v1.Params.reject_blob_sidecars":
+		x.RejectBlobSidecars = false
+	case "cosmos.evm.feemarket.v1.Params.base_fee_strategy":
+		x.BaseFeeStrategy = 0
+	case "cosmos.evm.feemarket.v1.Params.gas_target":
+		x.GasTarget = uint64(0)
+	case "cosmos.evm.feemarket.v1.Params.max_base_fee_change":
+		x.MaxBaseFeeChange = ""
+	case "cosmos.evm.feemarket.v1.Params.max_base_fee":
+		x.MaxBaseFee = ""
+	case "cosmos.evm.feemarket.v1.Params.base_fee_update_fraction":
+		x.BaseFeeUpdateFraction = uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.feemarket.v1.Params"))
@@ -240,6 +360,36 @@ func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) pro
 	case "cosmos.evm.feemarket.v1.Params.min_gas_multiplier":
 		value := x.MinGasMultiplier
 		return protoreflect.ValueOfString(value)
+	case "cosmos.evm.feemarket.v1.Params.min_blob_base_fee":
+		value := x.MinBlobBaseFee
+		return protoreflect.ValueOfString(value)
+	case "cosmos.evm.feemarket.v1.Params.target_blob_gas_per_block":
+		value := x.TargetBlobGasPerBlock
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.evm.feemarket.v1.Params.max_blob_gas_per_block":
+		value := x.MaxBlobGasPerBlock
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.evm.feemarket.v1.Params.blob_base_fee_update_fraction":
+		value := x.BlobBaseFeeUpdateFraction
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.evm.feemarket.v1.Params.reject_blob_sidecars":
+		value := x.RejectBlobSidecars
+		return protoreflect.ValueOfBool(value)
+	case "cosmos.evm.feemarket.v1.Params.base_fee_strategy":
+		value := x.BaseFeeStrategy
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "cosmos.evm.feemarket.v1.Params.gas_target":
+		value := x.GasTarget
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.evm.feemarket.v1.Params.max_base_fee_change":
+		value := x.MaxBaseFeeChange
+		return protoreflect.ValueOfString(value)
+	case "cosmos.evm.feemarket.v1.Params.max_base_fee":
+		value := x.MaxBaseFee
+		return protoreflect.ValueOfString(value)
+	case "cosmos.evm.feemarket.v1.Params.base_fee_update_fraction":
+		value := x.BaseFeeUpdateFraction
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.feemarket.v1.Params"))
@@ -274,6 +424,26 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 		x.MinGasPrice = value.Interface().(string)
 	case "cosmos.evm.feemarket.v1.Params.min_gas_multiplier":
 		x.MinGasMultiplier = value.Interface().(string)
+	case "cosmos.evm.feemarket.v1.Params.min_blob_base_fee":
+		x.MinBlobBaseFee = value.Interface().(string)
+	case "cosmos.evm.feemarket.v1.Params.target_blob_gas_per_block":
+		x.TargetBlobGasPerBlock = value.Uint()
+	case "cosmos.evm.feemarket.v1.Params.max_blob_gas_per_block":
+		x.MaxBlobGasPerBlock = value.Uint()
+	case "cosmos.evm.feemarket.v1.Params.blob_base_fee_update_fraction":
+		x.BlobBaseFeeUpdateFraction = value.Uint()
+	case "cosmos.evm.feemarket.v1.Params.reject_blob_sidecars":
+		x.RejectBlobSidecars = value.Bool()
+	case "cosmos.evm.feemarket.v1.Params.base_fee_strategy":
+		x.BaseFeeStrategy = (BaseFeeStrategy)(value.Enum())
+	case "cosmos.evm.feemarket.v1.Params.gas_target":
+		x.GasTarget = value.Uint()
+	case "cosmos.evm.feemarket.v1.Params.max_base_fee_change":
+		x.MaxBaseFeeChange = value.Interface().(string)
+	case "cosmos.evm.feemarket.v1.Params.max_base_fee":
+		x.MaxBaseFee = value.Interface().(string)
+	case "cosmos.evm.feemarket.v1.Params.base_fee_update_fraction":
+		x.BaseFeeUpdateFraction = value.Uint()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.feemarket.v1.Params"))
@@ -308,6 +478,26 @@ func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protore
 		panic(fmt.Errorf("field min_gas_price of message cosmos.evm.feemarket.v1.Params is not mutable"))
 	case "cosmos.evm.feemarket.v1.Params.min_gas_multiplier":
 		panic(fmt.Errorf("field min_gas_multiplier of message cosmos.evm.feemarket.v1.Params is not mutable"))
+	case "cosmos.evm.feemarket.v1.Params.min_blob_base_fee":
+		panic(fmt.Errorf("field min_blob_base_fee of message cosmos.evm.feemarket.v1.Params is not mutable"))
+	case "cosmos.evm.feemarket.v1.Params.target_blob_gas_per_block":
+		panic(fmt.Errorf("field target_blob_gas_per_block of message cosmos.evm.feemarket.v1.Params is not mutable"))
+	case "cosmos.evm.feemarket.v1.Params.max_blob_gas_per_block":
+		panic(fmt.Errorf("field max_blob_gas_per_block of message cosmos.evm.feemarket.v1.Params is not mutable"))
+	case "cosmos.evm.feemarket.v1.Params.blob_base_fee_update_fraction":
+		panic(fmt.Errorf("field blob_base_fee_update_fraction of message cosmos.evm.feemarket.v1.Params is not mutable"))
+	case "cosmos.evm.feemarket.v1.Params.reject_blob_sidecars":
+		panic(fmt.Errorf("field reject_blob_sidecars of message cosmos.evm.feemarket.v1.Params is not mutable"))
+	case "cosmos.evm.feemarket.v1.Params.base_fee_strategy":
+		panic(fmt.Errorf("field base_fee_strategy of message cosmos.evm.feemarket.v1.Params is not mutable"))
+	case "cosmos.evm.feemarket.v1.Params.gas_target":
+		panic(fmt.Errorf("field gas_target of message cosmos.evm.feemarket.v1.Params is not mutable"))
+	case "cosmos.evm.feemarket.v1.Params.max_base_fee_change":
+		panic(fmt.Errorf("field max_base_fee_change of message cosmos.evm.feemarket.v1.Params is not mutable"))
+	case "cosmos.evm.feemarket.v1.Params.max_base_fee":
+		panic(fmt.Errorf("field max_base_fee of message cosmos.evm.feemarket.v1.Params is not mutable"))
+	case "cosmos.evm.feemarket.v1.Params.base_fee_update_fraction":
+		panic(fmt.Errorf("field base_fee_update_fraction of message cosmos.evm.feemarket.v1.Params is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.feemarket.v1.Params"))
@@ -335,6 +525,26 @@ func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protor
 		return protoreflect.ValueOfString("")
 	case "cosmos.evm.feemarket.v1.Params.min_gas_multiplier":
 		return protoreflect.ValueOfString("")
+	case "cosmos.evm.feemarket.v1.Params.min_blob_base_fee":
+		return protoreflect.ValueOfString("")
+	case "cosmos.evm.feemarket.v1.Params.target_blob_gas_per_block":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.evm.feemarket.v1.Params.max_blob_gas_per_block":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.evm.feemarket.v1.Params.blob_base_fee_update_fraction":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.evm.feemarket.v1.Params.reject_blob_sidecars":
+		return protoreflect.ValueOfBool(false)
+	case "cosmos.evm.feemarket.v1.Params.base_fee_strategy":
+		return protoreflect.ValueOfEnum(0)
+	case "cosmos.evm.feemarket.v1.Params.gas_target":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.evm.feemarket.v1.Params.max_base_fee_change":
+		return protoreflect.ValueOfString("")
+	case "cosmos.evm.feemarket.v1.Params.max_base_fee":
+		return protoreflect.ValueOfString("")
+	case "cosmos.evm.feemarket.v1.Params.base_fee_update_fraction":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.feemarket.v1.Params"))
@@ -428,6 +638,39 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		l = len(x.MinBlobBaseFee)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.TargetBlobGasPerBlock != 0 {
+			n += 1 + runtime.Sov(uint64(x.TargetBlobGasPerBlock))
+		}
+		if x.MaxBlobGasPerBlock != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxBlobGasPerBlock))
+		}
+		if x.BlobBaseFeeUpdateFraction != 0 {
+			n += 1 + runtime.Sov(uint64(x.BlobBaseFeeUpdateFraction))
+		}
+		if x.RejectBlobSidecars {
+			n += 2
+		}
+		if x.BaseFeeStrategy != 0 {
+			n += 1 + runtime.Sov(uint64(x.BaseFeeStrategy))
+		}
+		if x.GasTarget != 0 {
+			n += 1 + runtime.Sov(uint64(x.GasTarget))
+		}
+		l = len(x.MaxBaseFeeChange)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.MaxBaseFee)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if x.BaseFeeUpdateFraction != 0 {
+			n += 2 + runtime.Sov(uint64(x.BaseFeeUpdateFraction))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -457,6 +700,73 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.BaseFeeUpdateFraction != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BaseFeeUpdateFraction))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x90
+		}
+		if len(x.MaxBaseFee) > 0 {
+			i -= len(x.MaxBaseFee)
+			copy(dAtA[i:], x.MaxBaseFee)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MaxBaseFee)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+		if len(x.MaxBaseFeeChange) > 0 {
+			i -= len(x.MaxBaseFeeChange)
+			copy(dAtA[i:], x.MaxBaseFeeChange)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MaxBaseFeeChange)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+		if x.GasTarget != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.GasTarget))
+			i--
+			dAtA[i] = 0x78
+		}
+		if x.BaseFeeStrategy != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BaseFeeStrategy))
+			i--
+			dAtA[i] = 0x70
+		}
+		if x.RejectBlobSidecars {
+			i--
+			if x.RejectBlobSidecars {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x68
+		}
+		if x.BlobBaseFeeUpdateFraction != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlobBaseFeeUpdateFraction))
+			i--
+			dAtA[i] = 0x60
+		}
+		if x.MaxBlobGasPerBlock != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxBlobGasPerBlock))
+			i--
+			dAtA[i] = 0x58
+		}
+		if x.TargetBlobGasPerBlock != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TargetBlobGasPerBlock))
+			i--
+			dAtA[i] = 0x50
+		}
+		if len(x.MinBlobBaseFee) > 0 {
+			i -= len(x.MinBlobBaseFee)
+			copy(dAtA[i:], x.MinBlobBaseFee)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MinBlobBaseFee)))
+			i--
+			dAtA[i] = 0x4a
+		}
 		if len(x.MinGasMultiplier) > 0 {
 			i -= len(x.MinGasMultiplier)
 			copy(dAtA[i:], x.MinGasMultiplier)
@@ -725,6 +1035,236 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 				}
 				x.MinGasMultiplier = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinBlobBaseFee", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MinBlobBaseFee = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 10:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TargetBlobGasPerBlock", wireType)
+				}
+				x.TargetBlobGasPerBlock = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TargetBlobGasPerBlock |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 11:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxBlobGasPerBlock", wireType)
+				}
+				x.MaxBlobGasPerBlock = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxBlobGasPerBlock |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 12:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlobBaseFeeUpdateFraction", wireType)
+				}
+				x.BlobBaseFeeUpdateFraction = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BlobBaseFeeUpdateFraction |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 13:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RejectBlobSidecars", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.RejectBlobSidecars = bool(v != 0)
+			case 14:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BaseFeeStrategy", wireType)
+				}
+				x.BaseFeeStrategy = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BaseFeeStrategy |= BaseFeeStrategy(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 15:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GasTarget", wireType)
+				}
+				x.GasTarget = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.GasTarget |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 16:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxBaseFeeChange", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MaxBaseFeeChange = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 17:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxBaseFee", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MaxBaseFee = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 18:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BaseFeeUpdateFraction", wireType)
+				}
+				x.BaseFeeUpdateFraction = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BaseFeeUpdateFraction |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -773,6 +1313,63 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// BaseFeeStrategy defines the algorithms updating the base fee between blocks
+type BaseFeeStrategy int32
+
+const (
+	// BASE_FEE_STRATEGY_EIP1559 moves the base fee by the EIP-1559 formula,
+	// towards the gas target.
+	BaseFeeStrategy_BASE_FEE_STRATEGY_EIP1559 BaseFeeStrategy = 0
+	// BASE_FEE_STRATEGY_CLAMPED_EIP1559 moves the base fee by the EIP-1559
+	// formula, with a change bounded by the max base fee change.
+	BaseFeeStrategy_BASE_FEE_STRATEGY_CLAMPED_EIP1559 BaseFeeStrategy = 1
+	// BASE_FEE_STRATEGY_EXPONENTIAL derives the base fee from the gas used
+	// above the gas target by the previous blocks, like the EIP-4844 blob base
+	// fee, with the min gas price as the minimum.
+	BaseFeeStrategy_BASE_FEE_STRATEGY_EXPONENTIAL BaseFeeStrategy = 2
+)
+
+// Enum value maps for BaseFeeStrategy.
+var (
+	BaseFeeStrategy_name = map[int32]string{
+		0: "BASE_FEE_STRATEGY_EIP1559",
+		1: "BASE_FEE_STRATEGY_CLAMPED_EIP1559",
+		2: "BASE_FEE_STRATEGY_EXPONENTIAL",
+	}
+	BaseFeeStrategy_value = map[string]int32{
+		"BASE_FEE_STRATEGY_EIP1559":         0,
+		"BASE_FEE_STRATEGY_CLAMPED_EIP1559": 1,
+		"BASE_FEE_STRATEGY_EXPONENTIAL":     2,
+	}
+)
+
+func (x BaseFeeStrategy) Enum() *BaseFeeStrategy {
+	p := new(BaseFeeStrategy)
+	*p = x
+	return p
+}
+
+func (x BaseFeeStrategy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BaseFeeStrategy) Descriptor() protoreflect.EnumDescriptor {
+	return file_cosmos_evm_feemarket_v1_feemarket_proto_enumTypes[0].Descriptor()
+}
+
+func (BaseFeeStrategy) Type() protoreflect.EnumType {
+	return &file_cosmos_evm_feemarket_v1_feemarket_proto_enumTypes[0]
+}
+
+func (x BaseFeeStrategy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BaseFeeStrategy.Descriptor instead.
+func (BaseFeeStrategy) EnumDescriptor() ([]byte, []int) {
+	return file_cosmos_evm_feemarket_v1_feemarket_proto_rawDescGZIP(), []int{0}
+}
+
 // Params defines the EVM module parameters
 type Params struct {
 	state         protoimpl.MessageState
@@ -798,6 +1395,34 @@ type Params struct {
 	// min_gas_multiplier bounds the minimum gas used to be charged
 	// to senders based on gas limit
 	MinGasMultiplier string `protobuf:"bytes,8,opt,name=min_gas_multiplier,json=minGasMultiplier,proto3" json:"min_gas_multiplier,omitempty"`
+	// min_blob_base_fee is the minimum EIP-4844 blob base fee, reached when the
+	// blocks don't use more blob gas than the target.
+	MinBlobBaseFee string `protobuf:"bytes,9,opt,name=min_blob_base_fee,json=minBlobBaseFee,proto3" json:"min_blob_base_fee,omitempty"`
+	// target_blob_gas_per_block is the blob gas a block can use without
+	// increasing the blob base fee.
+	TargetBlobGasPerBlock uint64 `protobuf:"varint,10,opt,name=target_blob_gas_per_block,json=targetBlobGasPerBlock,proto3" json:"target_blob_gas_per_block,omitempty"`
+	// max_blob_gas_per_block is the maximum blob gas of the transactions of a
+	// block.
+	MaxBlobGasPerBlock uint64 `protobuf:"varint,11,opt,name=max_blob_gas_per_block,json=maxBlobGasPerBlock,proto3" json:"max_blob_gas_per_block,omitempty"`
+	// blob_base_fee_update_fraction bounds the amount the blob base fee can
+	// change between blocks.
+	BlobBaseFeeUpdateFraction uint64 `protobuf:"varint,12,opt,name=blob_base_fee_update_fraction,json=blobBaseFeeUpdateFraction,proto3" json:"blob_base_fee_update_fraction,omitempty"`
+	// reject_blob_sidecars rejects the blob transactions submitted with their
+	// blob sidecar instead of discarding the sidecar.
+	RejectBlobSidecars bool `protobuf:"varint,13,opt,name=reject_blob_sidecars,json=rejectBlobSidecars,proto3" json:"reject_blob_sidecars,omitempty"`
+	// base_fee_strategy is the algorithm updating the base fee between blocks.
+	BaseFeeStrategy BaseFeeStrategy `protobuf:"varint,14,opt,name=base_fee_strategy,json=baseFeeStrategy,proto3,enum=cosmos.evm.feemarket.v1.BaseFeeStrategy" json:"base_fee_strategy,omitempty"`
+	// gas_target is the block gas the base fee calculation targets. Zero
+	// defaults to the block max gas divided by the elasticity multiplier.
+	GasTarget uint64 `protobuf:"varint,15,opt,name=gas_target,json=gasTarget,proto3" json:"gas_target,omitempty"`
+	// max_base_fee_change bounds the relative change of the base fee between
+	// blocks with the clamped EIP-1559 strategy, e.g. 0.125 for 12.5%.
+	MaxBaseFeeChange string `protobuf:"bytes,16,opt,name=max_base_fee_change,json=maxBaseFeeChange,proto3" json:"max_base_fee_change,omitempty"`
+	// max_base_fee is the upper bound of the base fee. Zero means unbounded.
+	MaxBaseFee string `protobuf:"bytes,17,opt,name=max_base_fee,json=maxBaseFee,proto3" json:"max_base_fee,omitempty"`
+	// base_fee_update_fraction bounds the change of the base fee with the
+	// exponential strategy, as the excess gas multiplying the base fee by e.
+	BaseFeeUpdateFraction uint64 `protobuf:"varint,18,opt,name=base_fee_update_fraction,json=baseFeeUpdateFraction,proto3" json:"base_fee_update_fraction,omitempty"`
 }
 
 func (x *Params) Reset() {
@@ -869,6 +1494,76 @@ func (x *Params) GetMinGasMultiplier() string {
 	return ""
 }
 
+func (x *Params) GetMinBlobBaseFee() string {
+	if x != nil {
+		return x.MinBlobBaseFee
+	}
+	return ""
+}
+
+func (x *Params) GetTargetBlobGasPerBlock() uint64 {
+	if x != nil {
+		return x.TargetBlobGasPerBlock
+	}
+	return 0
+}
+
+func (x *Params) GetMaxBlobGasPerBlock() uint64 {
+	if x != nil {
+		return x.MaxBlobGasPerBlock
+	}
+	return 0
+}
+
+func (x *Params) GetBlobBaseFeeUpdateFraction() uint64 {
+	if x != nil {
+		return x.BlobBaseFeeUpdateFraction
+	}
+	return 0
+}
+
+func (x *Params) GetRejectBlobSidecars() bool {
+	if x != nil {
+		return x.RejectBlobSidecars
+	}
+	return false
+}
+
+func (x *Params) GetBaseFeeStrategy() BaseFeeStrategy {
+	if x != nil {
+		return x.BaseFeeStrategy
+	}
+	return BaseFeeStrategy_BASE_FEE_STRATEGY_EIP1559
+}
+
+func (x *Params) GetGasTarget() uint64 {
+	if x != nil {
+		return x.GasTarget
+	}
+	return 0
+}
+
+func (x *Params) GetMaxBaseFeeChange() string {
+	if x != nil {
+		return x.MaxBaseFeeChange
+	}
+	return ""
+}
+
+func (x *Params) GetMaxBaseFee() string {
+	if x != nil {
+		return x.MaxBaseFee
+	}
+	return ""
+}
+
+func (x *Params) GetBaseFeeUpdateFraction() uint64 {
+	if x != nil {
+		return x.BaseFeeUpdateFraction
+	}
+	return 0
+}
+
 var File_cosmos_evm_feemarket_v1_feemarket_proto protoreflect.FileDescriptor
 
 var file_cosmos_evm_feemarket_v1_feemarket_proto_rawDesc = []byte{
@@ -878,7 +1573,7 @@ var file_cosmos_evm_feemarket_v1_feemarket_proto_rawDesc = []byte{
 	0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x66, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e,
 	0x76, 0x31, 0x1a, 0x11, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2f, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2e,
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xe8, 0x03, 0x0a, 0x06,
+	0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xf2, 0x08, 0x0a, 0x06,
 	0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x1e, 0x0a, 0x0b, 0x6e, 0x6f, 0x5f, 0x62, 0x61, 0x73,
 	0x65, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x6e, 0x6f, 0x42,
 	0x61, 0x73, 0x65, 0x46, 0x65, 0x65, 0x12, 0x3d, 0x0a, 0x1b, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x66,
@@ -905,26 +1600,80 @@ var file_cosmos_evm_feemarket_v1_feemarket_proto_rawDesc = []byte{
 	0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64,
 	0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79,
 	0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x10, 0x6d, 0x69, 0x6e, 0x47, 0x61, 0x73,
-	0x4d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x3a, 0x22, 0x8a, 0xe7, 0xb0, 0x2a,
-	0x1d, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x65, 0x76, 0x6d, 0x2f, 0x78, 0x2f, 0x66, 0x65,
-	0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2f, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x4a, 0x04,
-	0x08, 0x04, 0x10, 0x05, 0x52, 0x10, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x5f, 0x62, 0x61,
-	0x73, 0x65, 0x5f, 0x66, 0x65, 0x65, 0x42, 0xe2, 0x01, 0x0a, 0x1b, 0x63, 0x6f, 0x6d, 0x2e, 0x63,
+	0x4d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x12, 0x53, 0x0a, 0x11, 0x6d, 0x69,
+	0x6e, 0x5f, 0x62, 0x6c, 0x6f, 0x62, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x66, 0x65, 0x65, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x09, 0x42, 0x28, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68,
+	0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52,
+	0x0e, 0x6d, 0x69, 0x6e, 0x42, 0x6c, 0x6f, 0x62, 0x42, 0x61, 0x73, 0x65, 0x46, 0x65, 0x65, 0x12,
+	0x38, 0x0a, 0x19, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x62, 0x6c, 0x6f, 0x62, 0x5f, 0x67,
+	0x61, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x15, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x62, 0x47, 0x61,
+	0x73, 0x50, 0x65, 0x72, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x32, 0x0a, 0x16, 0x6d, 0x61, 0x78,
+	0x5f, 0x62, 0x6c, 0x6f, 0x62, 0x5f, 0x67, 0x61, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x04, 0x52, 0x12, 0x6d, 0x61, 0x78, 0x42, 0x6c,
+	0x6f, 0x62, 0x47, 0x61, 0x73, 0x50, 0x65, 0x72, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x40, 0x0a,
+	0x1d, 0x62, 0x6c, 0x6f, 0x62, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x66, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0c,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x19, 0x62, 0x6c, 0x6f, 0x62, 0x42, 0x61, 0x73, 0x65, 0x46, 0x65,
+	0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x30, 0x0a, 0x14, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x62, 0x6c, 0x6f, 0x62, 0x5f, 0x73,
+	0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x72,
+	0x65, 0x6a, 0x65, 0x63, 0x74, 0x42, 0x6c, 0x6f, 0x62, 0x53, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72,
+	0x73, 0x12, 0x54, 0x0a, 0x11, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x73, 0x74,
+	0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x28, 0x2e, 0x63,
 	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x66, 0x65, 0x65, 0x6d, 0x61, 0x72,
-	0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x42, 0x0e, 0x46, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65,
-	0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x34, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
-	0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
-	0x73, 0x2f, 0x65, 0x76, 0x6d, 0x2f, 0x66, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2f,
-	0x76, 0x31, 0x3b, 0x66, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x76, 0x31, 0xa2, 0x02,
-	0x03, 0x43, 0x45, 0x46, 0xaa, 0x02, 0x17, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x45, 0x76,
-	0x6d, 0x2e, 0x46, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x56, 0x31, 0xca, 0x02,
-	0x17, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x45, 0x76, 0x6d, 0x5c, 0x46, 0x65, 0x65, 0x6d,
-	0x61, 0x72, 0x6b, 0x65, 0x74, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x23, 0x43, 0x6f, 0x73, 0x6d, 0x6f,
-	0x73, 0x5c, 0x45, 0x76, 0x6d, 0x5c, 0x46, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5c,
-	0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02,
-	0x1a, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x3a, 0x3a, 0x45, 0x76, 0x6d, 0x3a, 0x3a, 0x46, 0x65,
-	0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
+	0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x46, 0x65, 0x65, 0x53, 0x74,
+	0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x52, 0x0f, 0x62, 0x61, 0x73, 0x65, 0x46, 0x65, 0x65, 0x53,
+	0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x67, 0x61, 0x73, 0x5f, 0x74,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x67, 0x61, 0x73,
+	0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x57, 0x0a, 0x13, 0x6d, 0x61, 0x78, 0x5f, 0x62, 0x61,
+	0x73, 0x65, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x10, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x28, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c,
+	0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x10, 0x6d,
+	0x61, 0x78, 0x42, 0x61, 0x73, 0x65, 0x46, 0x65, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12,
+	0x4a, 0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x66, 0x65, 0x65, 0x18,
+	0x11, 0x20, 0x01, 0x28, 0x09, 0x42, 0x28, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68,
+	0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52,
+	0x0a, 0x6d, 0x61, 0x78, 0x42, 0x61, 0x73, 0x65, 0x46, 0x65, 0x65, 0x12, 0x37, 0x0a, 0x18, 0x62,
+	0x61, 0x73, 0x65, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x66,
+	0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x12, 0x20, 0x01, 0x28, 0x04, 0x52, 0x15, 0x62,
+	0x61, 0x73, 0x65, 0x46, 0x65, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x72, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x3a, 0x22, 0x8a, 0xe7, 0xb0, 0x2a, 0x1d, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2f, 0x65, 0x76, 0x6d, 0x2f, 0x78, 0x2f, 0x66, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65,
+	0x74, 0x2f, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x4a, 0x04, 0x08, 0x04, 0x10, 0x05, 0x52, 0x10,
+	0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x66, 0x65, 0x65,
+	0x2a, 0xdf, 0x01, 0x0a, 0x0f, 0x42, 0x61, 0x73, 0x65, 0x46, 0x65, 0x65, 0x53, 0x74, 0x72, 0x61,
+	0x74, 0x65, 0x67, 0x79, 0x12, 0x39, 0x0a, 0x19, 0x42, 0x41, 0x53, 0x45, 0x5f, 0x46, 0x45, 0x45,
+	0x5f, 0x53, 0x54, 0x52, 0x41, 0x54, 0x45, 0x47, 0x59, 0x5f, 0x45, 0x49, 0x50, 0x31, 0x35, 0x35,
+	0x39, 0x10, 0x00, 0x1a, 0x1a, 0x8a, 0x9d, 0x20, 0x16, 0x42, 0x61, 0x73, 0x65, 0x46, 0x65, 0x65,
+	0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x45, 0x49, 0x50, 0x31, 0x35, 0x35, 0x39, 0x12,
+	0x48, 0x0a, 0x21, 0x42, 0x41, 0x53, 0x45, 0x5f, 0x46, 0x45, 0x45, 0x5f, 0x53, 0x54, 0x52, 0x41,
+	0x54, 0x45, 0x47, 0x59, 0x5f, 0x43, 0x4c, 0x41, 0x4d, 0x50, 0x45, 0x44, 0x5f, 0x45, 0x49, 0x50,
+	0x31, 0x35, 0x35, 0x39, 0x10, 0x01, 0x1a, 0x21, 0x8a, 0x9d, 0x20, 0x1d, 0x42, 0x61, 0x73, 0x65,
+	0x46, 0x65, 0x65, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x43, 0x6c, 0x61, 0x6d, 0x70,
+	0x65, 0x64, 0x45, 0x49, 0x50, 0x31, 0x35, 0x35, 0x39, 0x12, 0x41, 0x0a, 0x1d, 0x42, 0x41, 0x53,
+	0x45, 0x5f, 0x46, 0x45, 0x45, 0x5f, 0x53, 0x54, 0x52, 0x41, 0x54, 0x45, 0x47, 0x59, 0x5f, 0x45,
+	0x58, 0x50, 0x4f, 0x4e, 0x45, 0x4e, 0x54, 0x49, 0x41, 0x4c, 0x10, 0x02, 0x1a, 0x1e, 0x8a, 0x9d,
+	0x20, 0x1a, 0x42, 0x61, 0x73, 0x65, 0x46, 0x65, 0x65, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67,
+	0x79, 0x45, 0x78, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x1a, 0x04, 0x88, 0xa3,
+	0x1e, 0x00, 0x42, 0xe2, 0x01, 0x0a, 0x1b, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x66, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e,
+	0x76, 0x31, 0x42, 0x0e, 0x46, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x50, 0x72, 0x6f,
+	0x74, 0x6f, 0x50, 0x01, 0x5a, 0x34, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e,
+	0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x65, 0x76,
+	0x6d, 0x2f, 0x66, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2f, 0x76, 0x31, 0x3b, 0x66,
+	0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x43, 0x45, 0x46,
+	0xaa, 0x02, 0x17, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x45, 0x76, 0x6d, 0x2e, 0x46, 0x65,
+	0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x17, 0x43, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x5c, 0x45, 0x76, 0x6d, 0x5c, 0x46, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65,
+	0x74, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x23, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x45, 0x76,
+	0x6d, 0x5c, 0x46, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5c, 0x56, 0x31, 0x5c, 0x47,
+	0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x1a, 0x43, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x3a, 0x3a, 0x45, 0x76, 0x6d, 0x3a, 0x3a, 0x46, 0x65, 0x65, 0x6d, 0x61, 0x72,
+	0x6b, 0x65, 0x74, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -939,16 +1688,19 @@ func file_cosmos_evm_feemarket_v1_feemarket_proto_rawDescGZIP() []byte {
 	return file_cosmos_evm_feemarket_v1_feemarket_proto_rawDescData
 }
 
+var file_cosmos_evm_feemarket_v1_feemarket_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
 var file_cosmos_evm_feemarket_v1_feemarket_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
 var file_cosmos_evm_feemarket_v1_feemarket_proto_goTypes = []interface{}{
-	(*Params)(nil), // 0: cosmos.evm.feemarket.v1.Params
+	(BaseFeeStrategy)(0), // 0: cosmos.evm.feemarket.v1.BaseFeeStrategy
+	(*Params)(nil),       // 1: cosmos.evm.feemarket.v1.Params
 }
 var file_cosmos_evm_feemarket_v1_feemarket_proto_depIdxs = []int32{
-	0, // [0:0] is the sub-list for method output_type
-	0, // [0:0] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	0, // 0: cosmos.evm.feemarket.v1.Params.base_fee_strategy:type_name -> cosmos.evm.feemarket.v1.BaseFeeStrategy
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
 }
 
 func init() { file_cosmos_evm_feemarket_v1_feemarket_proto_init() }
@@ -975,13 +1727,14 @@ func file_cosmos_evm_feemarket_v1_feemarket_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_cosmos_evm_feemarket_v1_feemarket_proto_rawDesc,
-			NumEnums:      0,
+			NumEnums:      1,
 			NumMessages:   1,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_cosmos_evm_feemarket_v1_feemarket_proto_goTypes,
 		DependencyIndexes: file_cosmos_evm_feemarket_v1_feemarket_proto_depIdxs,
+		EnumInfos:         file_cosmos_evm_feemarket_v1_feemarket_proto_enumTypes,
 		MessageInfos:      file_cosmos_evm_feemarket_v1_feemarket_proto_msgTypes,
 	}.Build()
 	File_cosmos_evm_feemarket_v1_feemarket_proto = out.File
@@ -1,6 +1,8 @@
 // SPDX-License-Identifier: LGPL-3.0-only
 pragma solidity >=0.8.18;
 
+import "../common/Types.sol";
+
 /// @dev The IBank contract's address.
 address constant IBANK_PRECOMPILE_ADDRESS = 0x0000000000000000000000000000000000000804;
 
@@ -15,12 +17,49 @@ struct Balance {
     uint256 amount;
 }
 
+/// @dev Output specifies the recipient and the coins sent to it in a multiSend transaction.
+struct Output {
+    /// to defines the address of the recipient.
+    address to;
+    /// coins defines the coins sent to the recipient.
+    Coin[] coins;
+}
+
 /**
  * @author Evmos Team
  * @title Bank Interface
- * @dev Interface for querying balances and supply from the Bank module.
+ * @dev Interface for querying balances and supply from the Bank module
+ * and for sending any of its native tokens.
  */
 interface IBank {
+    /// @dev Transfer is emitted for each native token with a registered ERC20 token pair
+    /// that is sent. It is emitted by the ERC20 contract of the token pair, so that the
+    /// transfer is tracked like any other transfer of the ERC20 token.
+    /// @param from the address of the sender.
+    /// @param to the address of the recipient.
+    /// @param value the amount of tokens sent.
+    event Transfer(address indexed from, address indexed to, uint256 value);
+
+    /// @dev send defines a method for sending a native token from the caller
+    /// to a recipient. The amount is in the original decimals of the token in the Bank module.
+    /// @param to the address of the recipient.
+    /// @param denom the denomination of the native token.
+    /// @param amount the amount of tokens to send.
+    /// @return success whether the tokens were sent.
+    function send(
+        address to,
+        string calldata denom,
+        uint256 amount
+    ) external returns (bool success);
+
+    /// @dev multiSend defines a method for sending native tokens from the caller
+    /// to several recipients. The amounts are in the original decimals of the tokens in the Bank module.
+    /// @param outputs the recipients and the coins sent to each of them.
+    /// @return success whether the tokens were sent.
+    function multiSend(
+        Output[] calldata outputs
+    ) external returns (bool success);
+
     /// @dev balances defines a method for retrieving all the native token balances
     /// for a given account.
     /// @param account the address of the account to query balances for.
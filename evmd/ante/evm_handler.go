@@ -8,12 +8,18 @@ import (
 
 // newMonoEVMAnteHandler creates the sdk.AnteHandler implementation for the EVM transactions.
 func newMonoEVMAnteHandler(options HandlerOptions) sdk.AnteHandler {
-	return sdk.ChainAnteDecorators(
-		evmante.NewEVMMonoDecorator(
-			options.AccountKeeper,
-			options.FeeMarketKeeper,
-			options.EvmKeeper,
-			options.MaxTxGasWanted,
-		),
-	)
+	monoDecorator := evmante.NewEVMMonoDecorator(
+		options.AccountKeeper,
+		options.FeeMarketKeeper,
+		options.EvmKeeper,
+		options.MaxTxGasWanted,
+	).WithMempoolNonceCheck(options.MempoolNonceCheck)
+	if options.EVMMempool != nil {
+		monoDecorator = monoDecorator.WithPendingTxReplacement(options.EVMMempool)
+	}
+	if options.EVMFeeGrant {
+		monoDecorator = monoDecorator.WithFeegrantKeeper(options.FeegrantKeeper)
+	}
+
+	return sdk.ChainAnteDecorators(monoDecorator)
 }
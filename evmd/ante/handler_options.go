@@ -24,12 +24,28 @@ type HandlerOptions struct {
 	IBCKeeper              *ibckeeper.Keeper
 	FeeMarketKeeper        anteinterfaces.FeeMarketKeeper
 	EvmKeeper              anteinterfaces.EVMKeeper
-	FeegrantKeeper         ante.FeegrantKeeper
+	FeegrantKeeper         anteinterfaces.FeegrantKeeper
 	ExtensionOptionChecker ante.ExtensionOptionChecker
 	SignModeHandler        *txsigning.HandlerMap
 	SigGasConsumer         func(meter storetypes.GasMeter, sig signing.SignatureV2, params authtypes.Params) error
 	MaxTxGasWanted         uint64
 	TxFeeChecker           ante.TxFeeChecker
+	// MempoolNonceCheck defers the validation of EVM transaction nonces that
+	// are ahead of the account sequence in CheckTx to the app-side EVM mempool.
+	// It must only be enabled when the application uses that mempool.
+	MempoolNonceCheck bool
+	// EVMMempool is the app-side EVM mempool, whose pending transactions can
+	// be replaced by transactions with the same sender and nonce in CheckTx.
+	// It is optional.
+	EVMMempool anteinterfaces.EVMMempool
+	// EVMFeeGrant accepts a fee granter on the Cosmos transactions wrapping the
+	// EVM transactions, which then pays their fees from the allowance granted
+	// to the sender with the FeegrantKeeper. It is disabled by default: the
+	// fee granter is not covered by the Ethereum signature, so anyone relaying
+	// a signed EVM transaction can wrap it with the sender as grantee, and the
+	// granters opt in with allowances restricted to messages including
+	// MsgEthereumTx.
+	EVMFeeGrant bool
 }
 
 // Validate checks if the keepers are defined
@@ -58,6 +74,9 @@ func (options HandlerOptions) Validate() error {
 	if options.SignModeHandler == nil {
 		return errorsmod.Wrap(errortypes.ErrLogic, "sign mode handler is required for AnteHandler")
 	}
+	if options.EVMFeeGrant && options.FeegrantKeeper == nil {
+		return errorsmod.Wrap(errortypes.ErrLogic, "fee grant keeper is required for AnteHandler with EVM fee grants")
+	}
 	if options.TxFeeChecker == nil {
 		return errorsmod.Wrap(errortypes.ErrLogic, "tx fee checker is required for AnteHandler")
 	}
@@ -20,6 +20,7 @@ import (
 	evmconfig "github.com/cosmos/evm/config"
 	evmosencoding "github.com/cosmos/evm/encoding"
 	"github.com/cosmos/evm/evmd/ante"
+	evmmempool "github.com/cosmos/evm/mempool"
 	srvflags "github.com/cosmos/evm/server/flags"
 	cosmosevmtypes "github.com/cosmos/evm/types"
 	"github.com/cosmos/evm/x/erc20"
@@ -29,6 +30,9 @@ import (
 	"github.com/cosmos/evm/x/feemarket"
 	feemarketkeeper "github.com/cosmos/evm/x/feemarket/keeper"
 	feemarkettypes "github.com/cosmos/evm/x/feemarket/types"
+	"github.com/cosmos/evm/x/feeshare"
+	feesharekeeper "github.com/cosmos/evm/x/feeshare/keeper"
+	feesharetypes "github.com/cosmos/evm/x/feeshare/types"
 	ibccallbackskeeper "github.com/cosmos/evm/x/ibc/callbacks/keeper"
 	// NOTE: override ICS20 keeper to support IBC transfers of ERC20 tokens
 	evmdconfig "github.com/cosmos/evm/evmd/cmd/evmd/config"
@@ -38,6 +42,8 @@ import (
 	"github.com/cosmos/evm/x/precisebank"
 	precisebankkeeper "github.com/cosmos/evm/x/precisebank/keeper"
 	precisebanktypes "github.com/cosmos/evm/x/precisebank/types"
+	evmvesting "github.com/cosmos/evm/x/vesting"
+	vestingkeeper "github.com/cosmos/evm/x/vesting/keeper"
 	"github.com/cosmos/evm/x/vm"
 	evmkeeper "github.com/cosmos/evm/x/vm/keeper"
 	evmtypes "github.com/cosmos/evm/x/vm/types"
@@ -86,6 +92,7 @@ import (
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 	testdata_pulsar "github.com/cosmos/cosmos-sdk/testutil/testdata/testpb"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkmempool "github.com/cosmos/cosmos-sdk/types/mempool"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
 	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
@@ -189,6 +196,8 @@ type EVMD struct {
 	EVMKeeper         *evmkeeper.Keeper
 	Erc20Keeper       erc20keeper.Keeper
 	PreciseBankKeeper precisebankkeeper.Keeper
+	FeeShareKeeper    feesharekeeper.Keeper
+	VestingKeeper     vestingkeeper.Keeper
 
 	// the module manager
 	ModuleManager      *module.Manager
@@ -272,6 +281,7 @@ func NewExampleApp(
 		ibcexported.StoreKey, ibctransfertypes.StoreKey,
 		// Cosmos EVM store keys
 		evmtypes.StoreKey, feemarkettypes.StoreKey, erc20types.StoreKey, precisebanktypes.StoreKey,
+		feesharetypes.StoreKey,
 	)
 
 	tkeys := storetypes.NewTransientStoreKeys(paramstypes.TStoreKey, evmtypes.TransientKey, feemarkettypes.TransientKey)
@@ -382,7 +392,9 @@ func NewExampleApp(
 		authAddr,
 	)
 
-	app.FeeGrantKeeper = feegrantkeeper.NewKeeper(appCodec, runtime.NewKVStoreService(keys[feegrant.StoreKey]), app.AccountKeeper)
+	// the bank keeper checks that the grantee accounts created by the grants are not blocked
+	app.FeeGrantKeeper = feegrantkeeper.NewKeeper(appCodec, runtime.NewKVStoreService(keys[feegrant.StoreKey]), app.AccountKeeper).
+		SetBankKeeper(app.BankKeeper)
 
 	// register the staking hooks
 	// NOTE: stakingKeeper above is passed by reference, so that it will contain these hooks
@@ -495,6 +507,24 @@ func NewExampleApp(
 		&app.TransferKeeper,
 	)
 
+	app.FeeShareKeeper = feesharekeeper.NewKeeper(
+		keys[feesharetypes.StoreKey],
+		appCodec,
+		authtypes.NewModuleAddress(govtypes.ModuleName),
+		app.PreciseBankKeeper,
+		app.EVMKeeper,
+		authtypes.FeeCollectorName,
+	)
+
+	// register the EVM hooks paying the fee shares of the contract developers
+	app.EVMKeeper.SetHooks(
+		evmkeeper.NewMultiEvmHooks(
+			app.FeeShareKeeper.Hooks(),
+		),
+	)
+
+	app.VestingKeeper = vestingkeeper.NewKeeper(app.AccountKeeper, app.BankKeeper)
+
 	// instantiate IBC transfer keeper AFTER the ERC-20 keeper to use it in the instantiation
 	app.TransferKeeper = transferkeeper.NewKeeper(
 		appCodec,
@@ -571,6 +601,11 @@ func NewExampleApp(
 			app.EVMKeeper,
 			app.GovKeeper,
 			app.SlashingKeeper,
+			app.AccountKeeper,
+			app.BankKeeper,
+			app.VestingKeeper,
+			app.AuthzKeeper,
+			app.FeeGrantKeeper,
 			app.AppCodec(),
 		),
 	)
@@ -606,6 +641,8 @@ func NewExampleApp(
 		feemarket.NewAppModule(app.FeeMarketKeeper),
 		erc20.NewAppModule(app.Erc20Keeper, app.AccountKeeper),
 		precisebank.NewAppModule(app.PreciseBankKeeper, app.BankKeeper, app.AccountKeeper),
+		feeshare.NewAppModule(app.FeeShareKeeper),
+		evmvesting.NewAppModule(app.VestingKeeper),
 	)
 
 	// BasicModuleManager defines the module BasicManager which is in charge of setting up basic,
@@ -696,6 +733,7 @@ func NewExampleApp(
 		feemarkettypes.ModuleName,
 		erc20types.ModuleName,
 		precisebanktypes.ModuleName,
+		feesharetypes.ModuleName,
 
 		ibctransfertypes.ModuleName,
 		genutiltypes.ModuleName, evidencetypes.ModuleName, authz.ModuleName,
@@ -750,7 +788,14 @@ func NewExampleApp(
 	app.SetBeginBlocker(app.BeginBlocker)
 	app.SetEndBlocker(app.EndBlocker)
 
-	app.setAnteHandler(app.txConfig, maxGasWanted)
+	// Replace the FIFO ordering of EVM transactions by the nonce and tip based
+	// ordering of the EVM mempool when the app-side mempool is enabled.
+	if maxTxs := appOpts.Get(server.FlagMempoolMaxTxs); maxTxs != nil && cast.ToInt(maxTxs) >= 0 {
+		app.setEVMMempool(appOpts, cast.ToInt(maxTxs))
+	}
+
+	evmFeeGrant := cast.ToBool(appOpts.Get(srvflags.EVMEnableFeeGrant))
+	app.setAnteHandler(app.txConfig, maxGasWanted, evmFeeGrant)
 
 	// In v0.46, the SDK introduces _postHandlers_. PostHandlers are like
 	// antehandlers, but are run _after_ the `runMsgs` execution. They are also
@@ -795,7 +840,7 @@ func NewExampleApp(
 	return app
 }
 
-func (app *EVMD) setAnteHandler(txConfig client.TxConfig, maxGasWanted uint64) {
+func (app *EVMD) setAnteHandler(txConfig client.TxConfig, maxGasWanted uint64, evmFeeGrant bool) {
 	options := ante.HandlerOptions{
 		Cdc:                    app.appCodec,
 		AccountKeeper:          app.AccountKeeper,
@@ -810,6 +855,14 @@ func (app *EVMD) setAnteHandler(txConfig client.TxConfig, maxGasWanted uint64) {
 		MaxTxGasWanted:         maxGasWanted,
 		TxFeeChecker:           cosmosevmante.NewDynamicFeeChecker(app.FeeMarketKeeper),
 	}
+	// fee granters can pay the fees of the EVM transactions when it is enabled
+	// with the evm.enable-fee-grant option, see the relay risk documented on it
+	options.EVMFeeGrant = evmFeeGrant
+	// the EVM mempool validates the nonces of queued and replacement transactions
+	if evmMempool, ok := app.Mempool().(*evmmempool.EVMMempool); ok {
+		options.MempoolNonceCheck = true
+		options.EVMMempool = evmMempool
+	}
 	if err := options.Validate(); err != nil {
 		panic(err)
 	}
@@ -817,6 +870,33 @@ func (app *EVMD) setAnteHandler(txConfig client.TxConfig, maxGasWanted uint64) {
 	app.SetAnteHandler(ante.NewAnteHandler(options))
 }
 
+// setEVMMempool sets the app-side EVM mempool, which orders Ethereum
+// transactions by sender nonce and effective tip, together with the ABCI
+// proposal handlers that select transactions from it. Cosmos transactions are
+// kept in a priority nonce mempool.
+func (app *EVMD) setEVMMempool(appOpts servertypes.AppOptions, maxTxs int) {
+	cosmosPool := sdkmempool.NewPriorityMempool(sdkmempool.PriorityNonceMempoolConfig[int64]{
+		TxPriority:      sdkmempool.NewDefaultTxPriority(),
+		SignerExtractor: NewEthSignerExtractionAdapter(sdkmempool.NewDefaultSignerExtractionAdapter()),
+		MaxTx:           maxTxs,
+	})
+
+	evmMempool := evmmempool.NewEVMMempool(app.EVMKeeper, evmmempool.Config{
+		PriceBump:   cast.ToUint64(appOpts.Get(srvflags.EVMMempoolPriceBump)),
+		MaxTx:       maxTxs,
+		MaxNonceGap: cast.ToUint64(appOpts.Get(srvflags.EVMMempoolMaxNonceGap)),
+		CosmosPool:  cosmosPool,
+	})
+	app.SetMempool(evmMempool)
+	// the transactions following the committed ones become executable once the
+	// pool is synced with the committed state, before they are rechecked
+	app.SetPrepareCheckStater(evmMempool.Sync)
+
+	handler := baseapp.NewDefaultProposalHandler(evmMempool, app)
+	app.SetPrepareProposal(handler.PrepareProposalHandler())
+	app.SetProcessProposal(handler.ProcessProposalHandler())
+}
+
 func (app *EVMD) setPostHandler() {
 	postHandler, err := posthandler.NewPostHandler(
 		posthandler.HandlerOptions{},
@@ -1022,6 +1102,14 @@ func (app *EVMD) SetErc20Keeper(erc20Keeper erc20keeper.Keeper) {
 	app.Erc20Keeper = erc20Keeper
 }
 
+func (app *EVMD) GetFeeShareKeeper() *feesharekeeper.Keeper {
+	return &app.FeeShareKeeper
+}
+
+func (app *EVMD) GetVestingKeeper() vestingkeeper.Keeper {
+	return app.VestingKeeper
+}
+
 func (app *EVMD) GetGovKeeper() govkeeper.Keeper {
 	return app.GovKeeper
 }
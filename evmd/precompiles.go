@@ -9,22 +9,31 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/vm"
 
+	evmdante "github.com/cosmos/evm/evmd/ante"
+	authzprecompile "github.com/cosmos/evm/precompiles/authz"
 	bankprecompile "github.com/cosmos/evm/precompiles/bank"
 	"github.com/cosmos/evm/precompiles/bech32"
 	cmn "github.com/cosmos/evm/precompiles/common"
 	distprecompile "github.com/cosmos/evm/precompiles/distribution"
+	feegrantprecompile "github.com/cosmos/evm/precompiles/feegrant"
 	govprecompile "github.com/cosmos/evm/precompiles/gov"
 	ics20precompile "github.com/cosmos/evm/precompiles/ics20"
 	"github.com/cosmos/evm/precompiles/p256"
 	slashingprecompile "github.com/cosmos/evm/precompiles/slashing"
 	stakingprecompile "github.com/cosmos/evm/precompiles/staking"
+	vestingprecompile "github.com/cosmos/evm/precompiles/vesting"
 	erc20Keeper "github.com/cosmos/evm/x/erc20/keeper"
 	transferkeeper "github.com/cosmos/evm/x/ibc/transfer/keeper"
+	vestingkeeper "github.com/cosmos/evm/x/vesting/keeper"
 	evmkeeper "github.com/cosmos/evm/x/vm/keeper"
 	channelkeeper "github.com/cosmos/ibc-go/v10/modules/core/04-channel/keeper"
 
 	"cosmossdk.io/core/address"
+	feegrantkeeper "cosmossdk.io/x/feegrant/keeper"
 	"github.com/cosmos/cosmos-sdk/codec"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	distributionkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
 	govkeeper "github.com/cosmos/cosmos-sdk/x/gov/keeper"
 	slashingkeeper "github.com/cosmos/cosmos-sdk/x/slashing/keeper"
@@ -35,7 +44,7 @@ import (
 // Extend this struct, add a sane default to defaultOptionals, and an Option function to provide users with a non-breaking
 // way to provide custom args to certain precompiles.
 type Optionals struct {
-	AddressCodec       address.Codec // used by gov/staking
+	AddressCodec       address.Codec // used by gov/staking/vesting/authz/feegrant
 	ValidatorAddrCodec address.Codec // used by slashing
 	ConsensusAddrCodec address.Codec // used by slashing
 }
@@ -83,6 +92,11 @@ func NewAvailableStaticPrecompiles(
 	evmKeeper *evmkeeper.Keeper,
 	govKeeper govkeeper.Keeper,
 	slashingKeeper slashingkeeper.Keeper,
+	accountKeeper authkeeper.AccountKeeper,
+	sdkBankKeeper bankkeeper.Keeper,
+	vestingKeeper vestingkeeper.Keeper,
+	authzKeeper authzkeeper.Keeper,
+	feegrantKeeper feegrantkeeper.Keeper,
 	codec codec.Codec,
 	opts ...Option,
 ) map[common.Address]vm.PrecompiledContract {
@@ -127,7 +141,11 @@ func NewAvailableStaticPrecompiles(
 		panic(fmt.Errorf("failed to instantiate ICS20 precompile: %w", err))
 	}
 
-	bankPrecompile, err := bankprecompile.NewPrecompile(bankKeeper, erc20Keeper)
+	// NOTE: the bank precompile sends the coins in their x/bank denoms with the
+	// x/bank keeper, because the precisebank keeper emits the coin events of the
+	// extended denom on top of the x/bank ones, which the balance handler would
+	// count twice.
+	bankPrecompile, err := bankprecompile.NewPrecompile(sdkBankKeeper, erc20Keeper)
 	if err != nil {
 		panic(fmt.Errorf("failed to instantiate bank precompile: %w", err))
 	}
@@ -142,6 +160,35 @@ func NewAvailableStaticPrecompiles(
 		panic(fmt.Errorf("failed to instantiate slashing precompile: %w", err))
 	}
 
+	// NOTE: the vesting precompile funds the vesting accounts with the x/bank
+	// keeper, like the x/vesting module.
+	vestingPrecompile, err := vestingprecompile.NewPrecompile(
+		vestingKeeper,
+		accountKeeper,
+		sdkBankKeeper,
+		options.AddressCodec,
+	)
+	if err != nil {
+		panic(fmt.Errorf("failed to instantiate vesting precompile: %w", err))
+	}
+
+	// NOTE: the authz precompile blocks the same msg types as the authz limiter
+	// of the Cosmos transactions.
+	authzPrecompile, err := authzprecompile.NewPrecompile(
+		authzKeeper,
+		evmdante.NewAuthzLimiterDecorator(),
+		codec,
+		options.AddressCodec,
+	)
+	if err != nil {
+		panic(fmt.Errorf("failed to instantiate authz precompile: %w", err))
+	}
+
+	feegrantPrecompile, err := feegrantprecompile.NewPrecompile(feegrantKeeper, codec, options.AddressCodec)
+	if err != nil {
+		panic(fmt.Errorf("failed to instantiate feegrant precompile: %w", err))
+	}
+
 	// Stateless precompiles
 	precompiles[bech32Precompile.Address()] = bech32Precompile
 	precompiles[p256Precompile.Address()] = p256Precompile
@@ -150,9 +197,12 @@ func NewAvailableStaticPrecompiles(
 	precompiles[stakingPrecompile.Address()] = stakingPrecompile
 	precompiles[distributionPrecompile.Address()] = distributionPrecompile
 	precompiles[ibcTransferPrecompile.Address()] = ibcTransferPrecompile
+	precompiles[vestingPrecompile.Address()] = vestingPrecompile
 	precompiles[bankPrecompile.Address()] = bankPrecompile
 	precompiles[govPrecompile.Address()] = govPrecompile
 	precompiles[slashingPrecompile.Address()] = slashingPrecompile
+	precompiles[authzPrecompile.Address()] = authzPrecompile
+	precompiles[feegrantPrecompile.Address()] = feegrantPrecompile
 
 	return precompiles
 }
@@ -7,6 +7,7 @@ import (
 	"github.com/cosmos/evm"
 	"github.com/cosmos/evm/evmd"
 	"github.com/cosmos/evm/evmd/cmd/evmd/config"
+	srvflags "github.com/cosmos/evm/server/flags"
 	testconfig "github.com/cosmos/evm/testutil/config"
 	"github.com/cosmos/evm/testutil/constants"
 	feemarkettypes "github.com/cosmos/evm/x/feemarket/types"
@@ -16,6 +17,7 @@ import (
 	"cosmossdk.io/log"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client/flags"
 	simutils "github.com/cosmos/cosmos-sdk/testutil/sims"
 	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
@@ -23,6 +25,21 @@ import (
 
 // CreateEvmd creates an evmos app
 func CreateEvmd(chainID string, evmChainID uint64, customBaseAppOptions ...func(*baseapp.BaseApp)) evm.EvmApp {
+	return createEvmd(simutils.AppOptionsMap{}, chainID, evmChainID, customBaseAppOptions...)
+}
+
+// CreateEvmdWithEVMFeeGrant creates an evmos app accepting fee granters on
+// the EVM transactions
+func CreateEvmdWithEVMFeeGrant(chainID string, evmChainID uint64, customBaseAppOptions ...func(*baseapp.BaseApp)) evm.EvmApp {
+	return createEvmd(simutils.AppOptionsMap{srvflags.EVMEnableFeeGrant: true}, chainID, evmChainID, customBaseAppOptions...)
+}
+
+func createEvmd(
+	appOptions simutils.AppOptionsMap,
+	chainID string,
+	evmChainID uint64,
+	customBaseAppOptions ...func(*baseapp.BaseApp),
+) evm.EvmApp {
 	defaultNodeHome, err := clienthelpers.GetNodeHomeDirectory(".evmd")
 	if err != nil {
 		panic(err)
@@ -31,7 +48,7 @@ func CreateEvmd(chainID string, evmChainID uint64, customBaseAppOptions ...func(
 	db := dbm.NewMemDB()
 	logger := log.NewNopLogger()
 	loadLatest := true
-	appOptions := simutils.NewAppOptionsWithFlagHome(defaultNodeHome)
+	appOptions[flags.FlagHome] = defaultNodeHome
 	baseAppOptions := append(customBaseAppOptions, baseapp.SetChainID(chainID)) //nolint:gocritic
 
 	return evmd.NewExampleApp(
@@ -9,3 +9,15 @@ import (
 func TestKVIndexer(t *testing.T) {
 	indexer.TestKVIndexer(t, CreateEvmd)
 }
+
+func TestKVIndexerAddressAppearances(t *testing.T) {
+	indexer.TestKVIndexerAddressAppearances(t, CreateEvmd)
+}
+
+func TestKVIndexerTxsByAddress(t *testing.T) {
+	indexer.TestKVIndexerTxsByAddress(t, CreateEvmd)
+}
+
+func TestKVIndexerAddressIndexStart(t *testing.T) {
+	indexer.TestKVIndexerAddressIndexStart(t, CreateEvmd)
+}
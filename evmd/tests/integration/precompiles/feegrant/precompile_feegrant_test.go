@@ -0,0 +1,19 @@
+package feegrant
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cosmos/evm/evmd/tests/integration"
+	"github.com/cosmos/evm/tests/integration/precompiles/feegrant"
+)
+
+func TestFeegrantPrecompileTestSuite(t *testing.T) {
+	s := feegrant.NewPrecompileTestSuite(integration.CreateEvmd)
+	suite.Run(t, s)
+}
+
+func TestFeegrantPrecompileIntegrationTestSuite(t *testing.T) {
+	feegrant.TestPrecompileIntegrationTestSuite(t, integration.CreateEvmdWithEVMFeeGrant)
+}
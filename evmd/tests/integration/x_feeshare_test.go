@@ -0,0 +1,14 @@
+package integration
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cosmos/evm/tests/integration/x/feeshare"
+)
+
+func TestFeeShareKeeperTestSuite(t *testing.T) {
+	s := feeshare.NewKeeperTestSuite(CreateEvmd)
+	suite.Run(t, s)
+}
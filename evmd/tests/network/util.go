@@ -133,7 +133,7 @@ func startInProcess(cfg Config, val *Validator) error {
 		tmEndpoint := "/websocket"
 		tmRPCAddr := fmt.Sprintf("tcp://%s", val.AppConfig.GRPC.Address)
 
-		val.jsonrpc, err = server.StartJSONRPC(ctx, val.Ctx, val.ClientCtx, val.errGroup, tmRPCAddr, tmEndpoint, val.AppConfig, nil)
+		val.jsonrpc, err = server.StartJSONRPC(ctx, val.Ctx, val.ClientCtx, val.errGroup, tmRPCAddr, tmEndpoint, val.AppConfig, nil, server.EVMMempool(app))
 		if err != nil {
 			return err
 		}
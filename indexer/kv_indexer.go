@@ -1,9 +1,13 @@
 package indexer
 
 import (
+	"bytes"
+	"encoding/json"
+	"errors"
 	"fmt"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
 
 	abci "github.com/cometbft/cometbft/abci/types"
 	cmttypes "github.com/cometbft/cometbft/types"
@@ -15,6 +19,7 @@ import (
 
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -23,25 +28,63 @@ import (
 )
 
 const (
-	KeyPrefixTxHash  = 1
-	KeyPrefixTxIndex = 2
+	KeyPrefixTxHash            = 1
+	KeyPrefixTxIndex           = 2
+	KeyPrefixAddressAppearance = 3
+	// KeyAddressIndexStart is the key of the first block of the contiguous
+	// range indexed with the address appearances and their roles
+	KeyAddressIndexStart = 4
 
 	// TxIndexKeyLength is the length of tx-index key
 	TxIndexKeyLength = 1 + 8 + 8
+	// TxPositionLength is the length of the (block number, tx index) position
+	// of a tx in the chain
+	TxPositionLength = 8 + 8
+	// AddressAppearanceKeyLength is the length of address-appearance key
+	AddressAppearanceKeyLength = 1 + common.AddressLength + TxPositionLength
+	// AddressAppearanceValueLength is the length of address-appearance value:
+	// the tx hash followed by the roles of the address in the tx
+	AddressAppearanceValueLength = common.HashLength + 1
 )
 
+// Roles of an address in an eth tx, stored as bit flags in the
+// address-appearance values
+const (
+	AppearanceRoleSender byte = 1 << iota
+	AppearanceRoleRecipient
+	AppearanceRoleCreated
+	AppearanceRoleLogEmitter
+)
+
+// ErrAddressIndexIncomplete is returned by the address queries when some of
+// the indexed blocks were indexed without the address appearances, by an
+// older version of the indexer.
+var ErrAddressIndexIncomplete = errors.New("address index doesn't cover all the indexed blocks, re-index them with `index-eth-tx reindex`")
+
 var _ cosmosevmtypes.EVMTxIndexer = &KVIndexer{}
 
 // KVIndexer implements a eth tx indexer on a KV db.
 type KVIndexer struct {
-	db        dbm.DB
-	logger    log.Logger
-	clientCtx client.Context
+	db         dbm.DB
+	logger     log.Logger
+	clientCtx  client.Context
+	logIndexer cosmosevmtypes.EVMLogIndexer
 }
 
 // NewKVIndexer creates the KVIndexer
 func NewKVIndexer(db dbm.DB, logger log.Logger, clientCtx client.Context) *KVIndexer {
-	return &KVIndexer{db, logger, clientCtx}
+	return &KVIndexer{db: db, logger: logger, clientCtx: clientCtx}
+}
+
+// WithLogIndexer sets the log index maintained along the txs
+func (kv *KVIndexer) WithLogIndexer(logIndexer cosmosevmtypes.EVMLogIndexer) *KVIndexer {
+	kv.logIndexer = logIndexer
+	return kv
+}
+
+// LogIndexer returns the log index maintained along the txs, nil if disabled
+func (kv *KVIndexer) LogIndexer() cosmosevmtypes.EVMLogIndexer {
+	return kv.logIndexer
 }
 
 // IndexBlock index all the eth txs in a block through the following steps:
@@ -55,6 +98,10 @@ func (kv *KVIndexer) IndexBlock(block *cmttypes.Block, txResults []*abci.ExecTxR
 	batch := kv.db.NewBatch()
 	defer batch.Close()
 
+	if err := kv.extendAddressIndex(batch, height); err != nil {
+		return errorsmod.Wrapf(err, "IndexBlock %d", height)
+	}
+
 	// record index of valid eth tx during the iteration
 	var ethTxIndex int32
 	for txIndex, tx := range block.Txs {
@@ -115,6 +162,10 @@ func (kv *KVIndexer) IndexBlock(block *cmttypes.Block, txResults []*abci.ExecTxR
 			if err := saveTxResult(kv.clientCtx.Codec, batch, txHash, &txResult); err != nil {
 				return errorsmod.Wrapf(err, "IndexBlock %d", height)
 			}
+			addrs := appearances(ethMsg, &txResult, result.Events, msgIndex)
+			if err := saveAddressAppearances(batch, addrs, txHash, &txResult); err != nil {
+				return errorsmod.Wrapf(err, "IndexBlock %d", height)
+			}
 		}
 	}
 	if err := batch.Write(); err != nil {
@@ -133,6 +184,50 @@ func (kv *KVIndexer) FirstIndexedBlock() (int64, error) {
 	return LoadFirstBlock(kv.db)
 }
 
+// AddressIndexStart returns the first block of the contiguous range indexed
+// with the address appearances, returns -1 if there is none
+func (kv *KVIndexer) AddressIndexStart() (int64, error) {
+	bz, err := kv.db.Get([]byte{KeyAddressIndexStart})
+	if err != nil {
+		return 0, errorsmod.Wrap(err, "AddressIndexStart")
+	}
+	if len(bz) == 0 {
+		return -1, nil
+	}
+	return int64(sdk.BigEndianToUint64(bz)), nil //#nosec G115 -- int overflow is not a concern here
+}
+
+// extendAddressIndex records the block as the start of the address index if
+// it is the first indexed block, or the block right before the current start.
+// Other blocks, indexed past a gap in the address index, don't move its start.
+func (kv *KVIndexer) extendAddressIndex(batch dbm.Batch, height int64) error {
+	start, err := kv.AddressIndexStart()
+	if err != nil {
+		return err
+	}
+	if start != -1 && height != start-1 {
+		return nil
+	}
+	return batch.Set([]byte{KeyAddressIndexStart}, sdk.Uint64ToBigEndian(uint64(height))) //nolint:gosec // G115 // block number won't exceed uint64
+}
+
+// checkAddressIndex returns an error if some eth txs were indexed before the
+// start of the address index, so that the address queries would miss them.
+func (kv *KVIndexer) checkAddressIndex() error {
+	first, err := kv.FirstIndexedBlock()
+	if err != nil {
+		return err
+	}
+	start, err := kv.AddressIndexStart()
+	if err != nil {
+		return err
+	}
+	if first != -1 && (start == -1 || start > first) {
+		return ErrAddressIndexIncomplete
+	}
+	return nil
+}
+
 // GetByTxHash finds eth tx by eth tx hash
 func (kv *KVIndexer) GetByTxHash(hash common.Hash) (*cosmosevmtypes.TxResult, error) {
 	bz, err := kv.db.Get(TxHashKey(hash))
@@ -161,6 +256,135 @@ func (kv *KVIndexer) GetByBlockAndIndex(blockNumber int64, txIndex int32) (*cosm
 	return kv.GetByTxHash(common.BytesToHash(bz))
 }
 
+// GetAddressAppearances returns the hashes of the eth txs the address appeared
+// in, strictly before the given block in descending order, or strictly after
+// it in ascending order. A zero block number starts from the latest or the
+// earliest indexed block respectively. Once the limit is reached, the txs of
+// the last block are still all returned so that the block number of the last
+// tx can be used as the next cursor. The returned flag is true if there are
+// more appearances left.
+func (kv *KVIndexer) GetAddressAppearances(
+	address common.Address,
+	blockNumber int64,
+	ascending bool,
+	limit int,
+) ([]common.Hash, bool, error) {
+	if err := kv.checkAddressIndex(); err != nil {
+		return nil, false, err
+	}
+
+	prefix := append([]byte{KeyPrefixAddressAppearance}, address.Bytes()...)
+	start, end := prefix, storetypes.PrefixEndBytes(prefix)
+
+	var (
+		it  dbm.Iterator
+		err error
+	)
+	if ascending {
+		start = AddressAppearanceKey(address, blockNumber+1, 0)
+		it, err = kv.db.Iterator(start, end)
+	} else {
+		if blockNumber > 0 {
+			end = AddressAppearanceKey(address, blockNumber, 0)
+		}
+		it, err = kv.db.ReverseIterator(start, end)
+	}
+	if err != nil {
+		return nil, false, errorsmod.Wrapf(err, "GetAddressAppearances %s", address.Hex())
+	}
+	defer it.Close()
+
+	var (
+		hashes     []common.Hash
+		lastHeight int64
+	)
+	for ; it.Valid(); it.Next() {
+		height, err := parseBlockNumberFromAppearanceKey(it.Key())
+		if err != nil {
+			return nil, false, err
+		}
+		if limit > 0 && len(hashes) >= limit && height != lastHeight {
+			return hashes, true, nil
+		}
+		hashes = append(hashes, common.BytesToHash(it.Value()[:common.HashLength]))
+		lastHeight = height
+	}
+	return hashes, false, nil
+}
+
+// GetTxsByAddress returns up to limit hashes of the eth txs sent from, sent to
+// or creating the address, as selected by the filter, ordered by block and tx
+// index. The page starts at the given tx position, inclusive, or at the first
+// (last if reverse) tx if nil. The position of the first tx of the next page is
+// returned, nil if there is none.
+func (kv *KVIndexer) GetTxsByAddress(
+	address common.Address,
+	filter cosmosevmtypes.AddressTxFilter,
+	start []byte,
+	limit int,
+	reverse bool,
+) ([]common.Hash, []byte, error) {
+	if start != nil && len(start) != TxPositionLength {
+		return nil, nil, fmt.Errorf("wrong tx position length, expect: %d, got: %d", TxPositionLength, len(start))
+	}
+	if err := kv.checkAddressIndex(); err != nil {
+		return nil, nil, err
+	}
+
+	var roles byte
+	if filter.Sent {
+		roles |= AppearanceRoleSender
+	}
+	if filter.Received {
+		roles |= AppearanceRoleRecipient
+	}
+	if filter.Created {
+		roles |= AppearanceRoleCreated
+	}
+
+	prefix := append([]byte{KeyPrefixAddressAppearance}, address.Bytes()...)
+	lower, upper := prefix, storetypes.PrefixEndBytes(prefix)
+
+	var (
+		it  dbm.Iterator
+		err error
+	)
+	switch {
+	case reverse && start != nil:
+		// keys have a fixed length, so the start key is the last one before
+		// the appended zero byte
+		upper = append(append(prefix, start...), 0)
+		it, err = kv.db.ReverseIterator(lower, upper)
+	case reverse:
+		it, err = kv.db.ReverseIterator(lower, upper)
+	case start != nil:
+		lower = append(prefix, start...)
+		it, err = kv.db.Iterator(lower, upper)
+	default:
+		it, err = kv.db.Iterator(lower, upper)
+	}
+	if err != nil {
+		return nil, nil, errorsmod.Wrapf(err, "GetTxsByAddress %s", address.Hex())
+	}
+	defer it.Close()
+
+	var hashes []common.Hash
+	for ; it.Valid(); it.Next() {
+		value := it.Value()
+		if len(value) != AddressAppearanceValueLength {
+			return nil, nil, fmt.Errorf("wrong address appearance value length, expect: %d, got: %d", AddressAppearanceValueLength, len(value))
+		}
+		if value[common.HashLength]&roles == 0 {
+			continue
+		}
+		if limit > 0 && len(hashes) >= limit {
+			return hashes, bytes.Clone(it.Key()[1+common.AddressLength:]), nil
+		}
+		hashes = append(hashes, common.BytesToHash(value[:common.HashLength]))
+	}
+	return hashes, nil, nil
+}
+
 // TxHashKey returns the key for db entry: `tx hash -> tx result struct`
 func TxHashKey(hash common.Hash) []byte {
 	return append([]byte{KeyPrefixTxHash}, hash.Bytes()...)
@@ -173,6 +397,19 @@ func TxIndexKey(blockNumber int64, txIndex int32) []byte {
 	return append(append([]byte{KeyPrefixTxIndex}, bz1...), bz2...)
 }
 
+// AddressAppearanceKey returns the key for db entry: `(address, block number, tx index) -> (tx hash, roles)`
+func AddressAppearanceKey(address common.Address, blockNumber int64, txIndex int32) []byte {
+	key := append([]byte{KeyPrefixAddressAppearance}, address.Bytes()...)
+	return append(key, TxPosition(blockNumber, txIndex)...)
+}
+
+// TxPosition returns the position of a tx in the chain: `(block number, tx index)`
+func TxPosition(blockNumber int64, txIndex int32) []byte {
+	bz1 := sdk.Uint64ToBigEndian(uint64(blockNumber)) //nolint:gosec // G115 // block number won't exceed uint64
+	bz2 := sdk.Uint64ToBigEndian(uint64(txIndex))     //nolint:gosec // G115 // index won't exceed uint64
+	return append(bz1, bz2...)
+}
+
 // LoadLastBlock returns the latest indexed block number, returns -1 if db is empty
 func LoadLastBlock(db dbm.DB) (int64, error) {
 	it, err := db.ReverseIterator([]byte{KeyPrefixTxIndex}, []byte{KeyPrefixTxIndex + 1})
@@ -224,6 +461,83 @@ func saveTxResult(codec codec.Codec, batch dbm.Batch, txHash common.Hash, txResu
 	return nil
 }
 
+// addressAppearance is the appearance of an address in an eth tx, with the
+// roles it has in the tx
+type addressAppearance struct {
+	address common.Address
+	roles   byte
+}
+
+// appearances returns the addresses appearing in an eth tx: its sender, its
+// recipient or the contract it created, and the emitters of its logs, in the
+// order they first appear. Addresses only touched by internal calls are not
+// included.
+func appearances(ethMsg *evmtypes.MsgEthereumTx, txResult *cosmosevmtypes.TxResult, events []abci.Event, msgIndex int) []addressAppearance {
+	var appearances []addressAppearance
+	add := func(address common.Address, role byte) {
+		for i := range appearances {
+			if appearances[i].address == address {
+				appearances[i].roles |= role
+				return
+			}
+		}
+		appearances = append(appearances, addressAppearance{address: address, roles: role})
+	}
+
+	tx := ethMsg.AsTransaction()
+	from := ethMsg.GetSender()
+
+	add(from, AppearanceRoleSender)
+	switch {
+	case tx.To() != nil:
+		add(*tx.To(), AppearanceRoleRecipient)
+	case !txResult.Failed:
+		add(crypto.CreateAddress(from, tx.Nonce()), AppearanceRoleCreated)
+	}
+
+	for _, event := range events {
+		if event.Type != evmtypes.EventTypeTxLog {
+			continue
+		}
+		if msgIndex > 0 {
+			// not the eth tx we want
+			msgIndex--
+			continue
+		}
+		for _, attr := range event.Attributes {
+			if attr.Key != evmtypes.AttributeKeyTxLog {
+				continue
+			}
+			var txLog evmtypes.Log
+			if err := json.Unmarshal([]byte(attr.Value), &txLog); err != nil {
+				continue
+			}
+			add(common.HexToAddress(txLog.Address), AppearanceRoleLogEmitter)
+		}
+		break
+	}
+	return appearances
+}
+
+// saveAddressAppearances index the appearances of the addresses in the tx into the kv db batch
+func saveAddressAppearances(batch dbm.Batch, appearances []addressAppearance, txHash common.Hash, txResult *cosmosevmtypes.TxResult) error {
+	for _, appearance := range appearances {
+		value := append(txHash.Bytes(), appearance.roles)
+		if err := batch.Set(AddressAppearanceKey(appearance.address, txResult.Height, txResult.EthTxIndex), value); err != nil {
+			return errorsmod.Wrap(err, "set address-appearance key")
+		}
+	}
+	return nil
+}
+
+func parseBlockNumberFromAppearanceKey(key []byte) (int64, error) {
+	if len(key) != AddressAppearanceKeyLength {
+		return 0, fmt.Errorf("wrong address appearance key length, expect: %d, got: %d", AddressAppearanceKeyLength, len(key))
+	}
+
+	return int64(sdk.BigEndianToUint64(key[1+common.AddressLength : 1+common.AddressLength+8])), nil //#nosec G115 -- int overflow is not a concern here
+}
+
 func parseBlockNumberFromKey(key []byte) (int64, error) {
 	if len(key) != TxIndexKeyLength {
 		return 0, fmt.Errorf("wrong tx index key length, expect: %d, got: %d", TxIndexKeyLength, len(key))
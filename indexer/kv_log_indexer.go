@@ -0,0 +1,320 @@
+package indexer
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	dbm "github.com/cosmos/cosmos-db"
+	cosmosevmtypes "github.com/cosmos/evm/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/log"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// The log index shares the db of the tx indexer, so its prefixes don't overlap
+// with the tx indexer ones.
+const (
+	KeyPrefixLog          = 16
+	KeyPrefixLogAddress   = 17
+	KeyPrefixLogTopic     = 18
+	KeyPrefixLogIndexMeta = 19
+
+	// LogPositionLength is the length of the (block number, log index) position
+	// of a log in the chain
+	LogPositionLength = 8 + 8
+	// MaxIndexedTopics is the number of topic positions of a log
+	MaxIndexedTopics = 4
+)
+
+// KeyLogIndexedRange is the key of the range of blocks covered by the log index
+var KeyLogIndexedRange = []byte{KeyPrefixLogIndexMeta, 0}
+
+var _ cosmosevmtypes.EVMLogIndexer = &KVLogIndexer{}
+
+// KVLogIndexer implements an eth log index on a KV db. The logs are stored by
+// position, and their positions are indexed by address and by topic, so the
+// cost of a query grows with the number of logs matching its address or first
+// topic criteria rather than with the size of its block range.
+type KVLogIndexer struct {
+	db     dbm.DB
+	logger log.Logger
+}
+
+// NewKVLogIndexer creates the KVLogIndexer
+func NewKVLogIndexer(db dbm.DB, logger log.Logger) *KVLogIndexer {
+	return &KVLogIndexer{db, logger}
+}
+
+// IndexBlock index all the eth logs emitted in a block, and extends the range
+// of indexed blocks. The index only covers a contiguous range of blocks, so
+// indexing a block that is not adjacent to the range starts a new one.
+func (kv *KVLogIndexer) IndexBlock(block *cmttypes.Block, txResults []*abci.ExecTxResult) error {
+	height := block.Height
+
+	batch := kv.db.NewBatch()
+	defer batch.Close()
+
+	// the log index is the position of the log in the block
+	var logIndex uint64
+	for _, result := range txResults {
+		for _, event := range result.Events {
+			if event.Type != evmtypes.EventTypeTxLog {
+				continue
+			}
+			for _, attr := range event.Attributes {
+				if attr.Key != evmtypes.AttributeKeyTxLog {
+					continue
+				}
+				var txLog evmtypes.Log
+				if err := json.Unmarshal([]byte(attr.Value), &txLog); err != nil {
+					return errorsmod.Wrapf(err, "failed to parse log, block %d", height)
+				}
+				if err := saveLog(batch, height, logIndex, &txLog); err != nil {
+					return errorsmod.Wrapf(err, "IndexBlock %d", height)
+				}
+				logIndex++
+			}
+		}
+	}
+
+	first, last, err := kv.indexedRange()
+	if err != nil {
+		return err
+	}
+	switch {
+	case first == -1:
+		first, last = height, height
+	case height > last+1 || height < first-1:
+		kv.logger.Info("indexed block is not adjacent to the log index range, starting a new range", "height", height, "first", first, "last", last)
+		first, last = height, height
+	case height == last+1:
+		last = height
+	case height == first-1:
+		first = height
+	}
+	if err := batch.Set(KeyLogIndexedRange, append(sdk.Uint64ToBigEndian(uint64(first)), sdk.Uint64ToBigEndian(uint64(last))...)); err != nil { //nolint:gosec // G115 // block number won't exceed uint64
+		return errorsmod.Wrap(err, "set log-indexed-range key")
+	}
+	if err := batch.Write(); err != nil {
+		return errorsmod.Wrapf(err, "IndexBlock %d, write batch", block.Height)
+	}
+	return nil
+}
+
+// FirstIndexedBlock returns the first block of the indexed range, returns -1 if db is empty
+func (kv *KVLogIndexer) FirstIndexedBlock() (int64, error) {
+	first, _, err := kv.indexedRange()
+	return first, err
+}
+
+// LastIndexedBlock returns the last block of the indexed range, returns -1 if db is empty
+func (kv *KVLogIndexer) LastIndexedBlock() (int64, error) {
+	_, last, err := kv.indexedRange()
+	return last, err
+}
+
+// GetLogs returns at most limit logs of the [from, to] block range matching
+// the criteria, no limit if zero. The candidate logs are found through the
+// addresses if any, otherwise through the first non empty topic position,
+// otherwise all the logs of the range are candidates.
+func (kv *KVLogIndexer) GetLogs(
+	from, to int64,
+	addresses []common.Address,
+	topics [][]common.Hash,
+	limit int,
+) ([]*ethtypes.Log, error) {
+	var prefixes [][]byte
+	for _, address := range addresses {
+		prefixes = append(prefixes, append([]byte{KeyPrefixLogAddress}, address.Bytes()...))
+	}
+	if len(prefixes) == 0 {
+		for i, topicList := range topics {
+			if len(topicList) == 0 || i >= MaxIndexedTopics {
+				continue
+			}
+			for _, topic := range topicList {
+				prefixes = append(prefixes, append([]byte{KeyPrefixLogTopic, byte(i)}, topic.Bytes()...)) //#nosec G115 -- topic index is lower than MaxIndexedTopics
+			}
+			break
+		}
+	}
+	if len(prefixes) == 0 {
+		prefixes = [][]byte{{KeyPrefixLog}}
+	}
+
+	its := make([]dbm.Iterator, 0, len(prefixes))
+	defer func() {
+		for _, it := range its {
+			it.Close()
+		}
+	}()
+	lowerPosition := LogPosition(from, 0)
+	upperPosition := LogPosition(to+1, 0)
+	for _, prefix := range prefixes {
+		lower := append(bytes.Clone(prefix), lowerPosition...)
+		upper := append(bytes.Clone(prefix), upperPosition...)
+		it, err := kv.db.Iterator(lower, upper)
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "GetLogs [%d, %d]", from, to)
+		}
+		its = append(its, it)
+	}
+
+	// merge the sorted iterators, skipping the logs found through several keys
+	logs := []*ethtypes.Log{}
+	for limit <= 0 || len(logs) < limit {
+		var position []byte
+		for _, it := range its {
+			if !it.Valid() {
+				continue
+			}
+			itPosition := it.Key()[len(it.Key())-LogPositionLength:]
+			if position == nil || bytes.Compare(itPosition, position) < 0 {
+				position = itPosition
+			}
+		}
+		if position == nil {
+			break
+		}
+
+		position = bytes.Clone(position)
+		for _, it := range its {
+			if it.Valid() && bytes.HasSuffix(it.Key(), position) {
+				it.Next()
+			}
+		}
+
+		ethLog, err := kv.getLog(position)
+		if err != nil {
+			return nil, err
+		}
+		if matchLog(ethLog, addresses, topics) {
+			logs = append(logs, ethLog)
+		}
+	}
+	return logs, nil
+}
+
+// indexedRange returns the range of indexed blocks, -1 if db is empty
+func (kv *KVLogIndexer) indexedRange() (int64, int64, error) {
+	bz, err := kv.db.Get(KeyLogIndexedRange)
+	if err != nil {
+		return 0, 0, errorsmod.Wrap(err, "load log indexed range")
+	}
+	if len(bz) == 0 {
+		return -1, -1, nil
+	}
+	if len(bz) != 16 {
+		return 0, 0, fmt.Errorf("wrong log indexed range length, expect: 16, got: %d", len(bz))
+	}
+	return int64(sdk.BigEndianToUint64(bz[:8])), int64(sdk.BigEndianToUint64(bz[8:])), nil //#nosec G115 -- int overflow is not a concern here
+}
+
+// getLog loads the log at the given position
+func (kv *KVLogIndexer) getLog(position []byte) (*ethtypes.Log, error) {
+	bz, err := kv.db.Get(append([]byte{KeyPrefixLog}, position...))
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "load log")
+	}
+	if len(bz) == 0 {
+		return nil, fmt.Errorf("log not found, position: %x", position)
+	}
+	var txLog evmtypes.Log
+	if err := txLog.Unmarshal(bz); err != nil {
+		return nil, errorsmod.Wrap(err, "decode log")
+	}
+	return txLog.ToEthereum(), nil
+}
+
+// LogKey returns the key for db entry: `(block number, log index) -> log`
+func LogKey(blockNumber int64, logIndex uint64) []byte {
+	return append([]byte{KeyPrefixLog}, LogPosition(blockNumber, logIndex)...)
+}
+
+// LogAddressKey returns the key for db entry: `(address, block number, log index) -> nil`
+func LogAddressKey(address common.Address, blockNumber int64, logIndex uint64) []byte {
+	key := append([]byte{KeyPrefixLogAddress}, address.Bytes()...)
+	return append(key, LogPosition(blockNumber, logIndex)...)
+}
+
+// LogTopicKey returns the key for db entry: `(topic position, topic, block number, log index) -> nil`
+func LogTopicKey(topicIndex int, topic common.Hash, blockNumber int64, logIndex uint64) []byte {
+	key := append([]byte{KeyPrefixLogTopic, byte(topicIndex)}, topic.Bytes()...) //#nosec G115 -- topic index is lower than MaxIndexedTopics
+	return append(key, LogPosition(blockNumber, logIndex)...)
+}
+
+// LogPosition returns the position of a log in the chain: `(block number, log index)`
+func LogPosition(blockNumber int64, logIndex uint64) []byte {
+	bz1 := sdk.Uint64ToBigEndian(uint64(blockNumber)) //nolint:gosec // G115 // block number won't exceed uint64
+	bz2 := sdk.Uint64ToBigEndian(logIndex)
+	return append(bz1, bz2...)
+}
+
+// saveLog index the log and its address and topics into the kv db batch
+func saveLog(batch dbm.Batch, height int64, logIndex uint64, txLog *evmtypes.Log) error {
+	bz, err := txLog.Marshal()
+	if err != nil {
+		return errorsmod.Wrap(err, "encode log")
+	}
+	if err := batch.Set(LogKey(height, logIndex), bz); err != nil {
+		return errorsmod.Wrap(err, "set log key")
+	}
+	if err := batch.Set(LogAddressKey(common.HexToAddress(txLog.Address), height, logIndex), []byte{}); err != nil {
+		return errorsmod.Wrap(err, "set log-address key")
+	}
+	for i, topic := range txLog.Topics {
+		if i >= MaxIndexedTopics {
+			break
+		}
+		if err := batch.Set(LogTopicKey(i, common.HexToHash(topic), height, logIndex), []byte{}); err != nil {
+			return errorsmod.Wrap(err, "set log-topic key")
+		}
+	}
+	return nil
+}
+
+// matchLog returns true if the log is emitted by one of the addresses and
+// matches the positional topics. Empty criteria match any log.
+func matchLog(ethLog *ethtypes.Log, addresses []common.Address, topics [][]common.Hash) bool {
+	if len(addresses) > 0 && !containsAddress(addresses, ethLog.Address) {
+		return false
+	}
+	if len(topics) > len(ethLog.Topics) {
+		return false
+	}
+	for i, topicList := range topics {
+		if len(topicList) == 0 {
+			continue
+		}
+		found := false
+		for _, topic := range topicList {
+			if ethLog.Topics[i] == topic {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+func containsAddress(addresses []common.Address, address common.Address) bool {
+	for _, addr := range addresses {
+		if addr == address {
+			return true
+		}
+	}
+	return false
+}
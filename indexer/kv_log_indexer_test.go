@@ -0,0 +1,154 @@
+package indexer
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	dbm "github.com/cosmos/cosmos-db"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"cosmossdk.io/log"
+)
+
+func TestKVLogIndexer(t *testing.T) {
+	addr1 := common.HexToAddress("0x01")
+	addr2 := common.HexToAddress("0x02")
+	topicA := common.HexToHash("0x0a")
+	topicB := common.HexToHash("0x0b")
+
+	newLog := func(height uint64, address common.Address, topics ...common.Hash) *ethtypes.Log {
+		return &ethtypes.Log{Address: address, Topics: topics, BlockNumber: height, Data: []byte{}}
+	}
+	// tx result emitting the given logs
+	newTxResult := func(logs ...*ethtypes.Log) *abci.ExecTxResult {
+		event := abci.Event{Type: evmtypes.EventTypeTxLog}
+		for _, ethLog := range evmtypes.NewLogsFromEth(logs) {
+			bz, err := json.Marshal(ethLog)
+			require.NoError(t, err)
+			event.Attributes = append(event.Attributes, abci.EventAttribute{Key: evmtypes.AttributeKeyTxLog, Value: string(bz)})
+		}
+		return &abci.ExecTxResult{Events: []abci.Event{event}}
+	}
+	newBlock := func(height int64) *cmttypes.Block {
+		return &cmttypes.Block{Header: cmttypes.Header{Height: height}}
+	}
+
+	idxer := NewKVLogIndexer(dbm.NewMemDB(), log.NewNopLogger())
+	first, err := idxer.FirstIndexedBlock()
+	require.NoError(t, err)
+	require.Equal(t, int64(-1), first)
+
+	blockLogs := map[int64][]*abci.ExecTxResult{
+		1: {newTxResult(newLog(1, addr1, topicA)), newTxResult(newLog(1, addr2, topicB, topicA))},
+		2: {},
+		3: {newTxResult(newLog(3, addr2, topicA), newLog(3, addr1, topicA, topicB))},
+	}
+	for height := int64(1); height <= 3; height++ {
+		require.NoError(t, idxer.IndexBlock(newBlock(height), blockLogs[height]))
+	}
+	first, err = idxer.FirstIndexedBlock()
+	require.NoError(t, err)
+	require.Equal(t, int64(1), first)
+	last, err := idxer.LastIndexedBlock()
+	require.NoError(t, err)
+	require.Equal(t, int64(3), last)
+
+	type logID struct {
+		height  uint64
+		address common.Address
+	}
+	testCases := []struct {
+		name      string
+		from, to  int64
+		addresses []common.Address
+		topics    [][]common.Hash
+		limit     int
+		expLogs   []logID
+	}{
+		{
+			"all logs",
+			1, 3, nil, nil, 0,
+			[]logID{{1, addr1}, {1, addr2}, {3, addr2}, {3, addr1}},
+		},
+		{
+			"limit",
+			1, 3, nil, nil, 3,
+			[]logID{{1, addr1}, {1, addr2}, {3, addr2}},
+		},
+		{
+			"range",
+			2, 3, nil, nil, 0,
+			[]logID{{3, addr2}, {3, addr1}},
+		},
+		{
+			"address",
+			1, 3, []common.Address{addr1}, nil, 0,
+			[]logID{{1, addr1}, {3, addr1}},
+		},
+		{
+			"several addresses",
+			1, 3, []common.Address{addr1, addr2}, nil, 0,
+			[]logID{{1, addr1}, {1, addr2}, {3, addr2}, {3, addr1}},
+		},
+		{
+			"address and topic",
+			1, 3, []common.Address{addr2}, [][]common.Hash{{topicA}}, 0,
+			[]logID{{3, addr2}},
+		},
+		{
+			"first topic",
+			1, 3, nil, [][]common.Hash{{topicA}}, 0,
+			[]logID{{1, addr1}, {3, addr2}, {3, addr1}},
+		},
+		{
+			"several first topics",
+			1, 3, nil, [][]common.Hash{{topicA, topicB}}, 0,
+			[]logID{{1, addr1}, {1, addr2}, {3, addr2}, {3, addr1}},
+		},
+		{
+			"second topic only",
+			1, 3, nil, [][]common.Hash{{}, {topicA}}, 0,
+			[]logID{{1, addr2}},
+		},
+		{
+			"no match",
+			1, 3, []common.Address{common.HexToAddress("0x03")}, nil, 0,
+			nil,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			logs, err := idxer.GetLogs(tc.from, tc.to, tc.addresses, tc.topics, tc.limit)
+			require.NoError(t, err)
+			require.NotNil(t, logs)
+			require.Len(t, logs, len(tc.expLogs))
+			for i, expLog := range tc.expLogs {
+				require.Equal(t, expLog.height, logs[i].BlockNumber)
+				require.Equal(t, expLog.address, logs[i].Address)
+			}
+		})
+	}
+
+	// indexing a block again doesn't change the range
+	require.NoError(t, idxer.IndexBlock(newBlock(2), nil))
+	first, err = idxer.FirstIndexedBlock()
+	require.NoError(t, err)
+	require.Equal(t, int64(1), first)
+
+	// a block not adjacent to the range starts a new one, extended backward
+	require.NoError(t, idxer.IndexBlock(newBlock(6), nil))
+	require.NoError(t, idxer.IndexBlock(newBlock(5), nil))
+	first, err = idxer.FirstIndexedBlock()
+	require.NoError(t, err)
+	require.Equal(t, int64(5), first)
+	last, err = idxer.LastIndexedBlock()
+	require.NoError(t, err)
+	require.Equal(t, int64(6), last)
+}
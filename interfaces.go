@@ -5,9 +5,11 @@ import (
 
 	erc20keeper "github.com/cosmos/evm/x/erc20/keeper"
 	feemarketkeeper "github.com/cosmos/evm/x/feemarket/keeper"
+	feesharekeeper "github.com/cosmos/evm/x/feeshare/keeper"
 	"github.com/cosmos/evm/x/ibc/callbacks/keeper"
 	transferkeeper "github.com/cosmos/evm/x/ibc/transfer/keeper"
 	precisebankkeeper "github.com/cosmos/evm/x/precisebank/keeper"
+	vestingkeeper "github.com/cosmos/evm/x/vesting/keeper"
 	evmkeeper "github.com/cosmos/evm/x/vm/keeper"
 	ibctesting "github.com/cosmos/ibc-go/v10/testing"
 
@@ -40,6 +42,8 @@ type EvmApp interface { //nolint:revive
 	GetEVMKeeper() *evmkeeper.Keeper
 	GetErc20Keeper() *erc20keeper.Keeper
 	SetErc20Keeper(erc20keeper.Keeper)
+	GetFeeShareKeeper() *feesharekeeper.Keeper
+	GetVestingKeeper() vestingkeeper.Keeper
 	GetGovKeeper() govkeeper.Keeper
 	GetSlashingKeeper() slashingkeeper.Keeper
 	GetEvidenceKeeper() *evidencekeeper.Keeper
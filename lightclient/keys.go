@@ -0,0 +1,52 @@
+package lightclient
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+
+	precisebanktypes "github.com/cosmos/evm/x/precisebank/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// balanceKeyCodec is the key codec of the x/bank balances, by address and denom.
+var balanceKeyCodec = collections.PairKeyCodec(sdk.AccAddressKey, collections.StringKey)
+
+// AccountKey returns the key of the account in the x/auth store, which holds
+// the account nonce.
+func AccountKey(address common.Address) []byte {
+	return append(append([]byte{}, authtypes.AddressStoreKeyPrefix...), address.Bytes()...)
+}
+
+// CodeHashKey returns the key of the account code hash in the x/vm store.
+func CodeHashKey(address common.Address) []byte {
+	return append(append([]byte{}, evmtypes.KeyPrefixCodeHash...), address.Bytes()...)
+}
+
+// StorageKey returns the key of an account storage slot in the x/vm store.
+func StorageKey(address common.Address, slot common.Hash) []byte {
+	return evmtypes.StateKey(address, slot.Bytes())
+}
+
+// BalanceKey returns the key of the account balance of the denom in the x/bank
+// store.
+func BalanceKey(address common.Address, denom string) ([]byte, error) {
+	return collections.EncodeKeyWithPrefix(
+		banktypes.BalancesPrefix,
+		balanceKeyCodec,
+		collections.Join(sdk.AccAddress(address.Bytes()), denom),
+	)
+}
+
+// FractionalBalanceKey returns the key of the account fractional balance in
+// the x/precisebank store.
+func FractionalBalanceKey(address common.Address) []byte {
+	return append(
+		append([]byte{}, precisebanktypes.FractionalBalancePrefix...),
+		precisebanktypes.FractionalBalanceKey(address.Bytes())...,
+	)
+}
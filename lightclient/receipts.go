@@ -0,0 +1,292 @@
+package lightclient
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtmerkle "github.com/cometbft/cometbft/crypto/merkle"
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	rpctypes "github.com/cosmos/evm/rpc/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+var (
+	// msgEthereumTxResponseURL is the type URL of the response of the Ethereum
+	// transaction messages.
+	msgEthereumTxResponseURL = sdk.MsgTypeURL(&evmtypes.MsgEthereumTxResponse{})
+	// msgEthereumTxURL is the type URL of the Ethereum transaction messages.
+	msgEthereumTxURL = sdk.MsgTypeURL(&evmtypes.MsgEthereumTx{})
+	// extensionOptionsEthereumTxURL is the type URL of the extension option
+	// of the Cosmos transactions wrapping Ethereum transactions.
+	extensionOptionsEthereumTxURL = sdk.MsgTypeURL(&evmtypes.ExtensionOptionsEthereumTx{})
+)
+
+// VerifyTxResults verifies the ABCI results of all the transactions of a block
+// against the last results hash of the header of the next block. The header
+// must be verified by a CometBFT light client for the results to be trusted.
+func VerifyTxResults(header *cmttypes.Header, results []*abci.ExecTxResult) error {
+	if header == nil {
+		return fmt.Errorf("header cannot be nil")
+	}
+	if hash := cmttypes.NewResults(results).Hash(); !bytes.Equal(hash, header.LastResultsHash) {
+		return fmt.Errorf("results hash mismatch, expected %X, got %X", header.LastResultsHash, hash)
+	}
+	return nil
+}
+
+// VerifyTxResult verifies the ABCI result of a transaction and its merkle
+// proof against the last results hash of the header of the next block. The
+// header must be verified by a CometBFT light client for the result to be
+// trusted.
+func VerifyTxResult(header *cmttypes.Header, result *abci.ExecTxResult, proof *cmtmerkle.Proof) error {
+	if header == nil {
+		return fmt.Errorf("header cannot be nil")
+	}
+	if result == nil || proof == nil {
+		return fmt.Errorf("result and proof cannot be nil")
+	}
+
+	// the results are committed without their events, logs and info
+	leaf, err := cmttypes.NewResults([]*abci.ExecTxResult{result})[0].Marshal()
+	if err != nil {
+		return err
+	}
+	if err := proof.Verify(header.LastResultsHash, leaf); err != nil {
+		return fmt.Errorf("invalid result proof: %w", err)
+	}
+	return nil
+}
+
+// Receipt is an EVM receipt rebuilt from the results of a block.
+type Receipt struct {
+	*ethtypes.Receipt
+	// Unverified is set if the receipt, or its transaction index, depends on the
+	// log or the events of the results. Only their code, data, gas wanted and
+	// gas used are committed, so an untrusted provider can forge the unverified
+	// receipts.
+	Unverified bool
+}
+
+// VerifyReceipts verifies the ABCI results of all the transactions of a block
+// against the header of the next block, and returns the receipts of its
+// Ethereum transactions.
+//
+// The receipts are rebuilt from the Ethereum transaction responses of the
+// result data, as the events are not committed. The cumulative gas used of a
+// receipt includes the gas used by the previous Cosmos transactions of the
+// block, as served by eth_getTransactionReceipt.
+//
+// The Ethereum transactions that failed because they exceeded the block gas
+// limit have no response but are still included in the block, so their failed
+// receipts are rebuilt from the transactions of the block, which must be
+// verified against the data hash of its header. As done by the EVM indexer,
+// they are told apart from the other failed transactions by the log of their
+// result, which is not committed. Their receipts, and the ones following a
+// failed Ethereum transaction in the block, are therefore unverified.
+func VerifyReceipts(header *cmttypes.Header, txs cmttypes.Txs, results []*abci.ExecTxResult) ([]*Receipt, error) {
+	if err := VerifyTxResults(header, results); err != nil {
+		return nil, err
+	}
+	if len(txs) != len(results) {
+		return nil, fmt.Errorf("transactions and results length mismatch, %d != %d", len(txs), len(results))
+	}
+
+	var (
+		receipts          []*Receipt
+		cumulativeGasUsed uint64
+		logIndex          uint
+		unverified        bool
+	)
+	for i, result := range results {
+		var responses []*evmtypes.MsgEthereumTxResponse
+		if result.Code == abci.CodeTypeOK {
+			res, err := decodeEthereumTxResponse(result)
+			if err != nil {
+				return nil, fmt.Errorf("invalid result of transaction %d: %w", i, err)
+			}
+			if res != nil {
+				responses = append(responses, res)
+			}
+		} else if body := decodeEthereumTxBody(txs[i]); body != nil {
+			// whether a failed Ethereum transaction has a receipt depends on its
+			// log, which shifts the index of the following receipts
+			unverified = true
+			if rpctypes.TxSucessOrExpectedFailure(result) {
+				res, err := expectedFailureResponses(body, result)
+				if err != nil {
+					return nil, fmt.Errorf("invalid transaction %d: %w", i, err)
+				}
+				responses = res
+			}
+		}
+
+		var txGasUsed uint64
+		for _, res := range responses {
+			txGasUsed += res.GasUsed
+			receipt := newReceipt(header, res, uint(len(receipts)), logIndex)
+			receipt.CumulativeGasUsed = cumulativeGasUsed + txGasUsed
+			logIndex += uint(len(receipt.Logs))
+
+			receipts = append(receipts, &Receipt{Receipt: receipt, Unverified: unverified})
+		}
+		cumulativeGasUsed += uint64(result.GasUsed) //#nosec G115 -- gas used is positive
+	}
+
+	return receipts, nil
+}
+
+// VerifyReceipt verifies the ABCI result of an Ethereum transaction and its
+// merkle proof against the header of the next block, and returns its receipt.
+//
+// As the results of the other transactions of the block are unknown, the
+// transaction index is taken from the events of the result, which are not
+// committed, and checked against the committed logs. The receipts without logs
+// are therefore unverified. The log indexes are taken from the committed logs,
+// and the cumulative gas used is left unset.
+func VerifyReceipt(header *cmttypes.Header, result *abci.ExecTxResult, proof *cmtmerkle.Proof) (*Receipt, error) {
+	if err := VerifyTxResult(header, result, proof); err != nil {
+		return nil, err
+	}
+
+	res, err := decodeEthereumTxResponse(result)
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, fmt.Errorf("result is not the result of an Ethereum transaction")
+	}
+
+	parsedTxs, err := rpctypes.ParseTxResult(result, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse the result events: %w", err)
+	}
+	parsedTx := parsedTxs.GetTxByHash(common.HexToHash(res.Hash))
+	if parsedTx == nil || parsedTx.EthTxIndex < 0 {
+		return nil, fmt.Errorf("transaction index of %s not found in the result events", res.Hash)
+	}
+	txIndex := uint(parsedTx.EthTxIndex) //#nosec G115 -- checked for negative values already
+
+	if len(res.Logs) == 0 {
+		return &Receipt{Receipt: newReceipt(header, res, txIndex, 0), Unverified: true}, nil
+	}
+	if uint(res.Logs[0].TxIndex) != txIndex { //#nosec G115 -- index of the block transactions
+		return nil, fmt.Errorf("transaction index mismatch, events: %d, logs: %d", txIndex, res.Logs[0].TxIndex)
+	}
+	logIndex := uint(res.Logs[0].Index) //#nosec G115 -- index of the block logs
+	return &Receipt{Receipt: newReceipt(header, res, txIndex, logIndex)}, nil
+}
+
+// decodeEthereumTxResponse returns the Ethereum transaction response of the
+// result data, or nil if the result is not the one of an executed Ethereum
+// transaction.
+func decodeEthereumTxResponse(result *abci.ExecTxResult) (*evmtypes.MsgEthereumTxResponse, error) {
+	if result.Code != abci.CodeTypeOK || len(result.Data) == 0 {
+		return nil, nil
+	}
+
+	var txMsgData sdk.TxMsgData
+	if err := txMsgData.Unmarshal(result.Data); err != nil {
+		return nil, err
+	}
+	if len(txMsgData.MsgResponses) == 0 || txMsgData.MsgResponses[0].TypeUrl != msgEthereumTxResponseURL {
+		return nil, nil
+	}
+
+	var res evmtypes.MsgEthereumTxResponse
+	if err := res.Unmarshal(txMsgData.MsgResponses[0].Value); err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+// decodeEthereumTxBody returns the body of a Cosmos transaction wrapping
+// Ethereum transactions, or nil if the transaction is not one of them.
+func decodeEthereumTxBody(tx cmttypes.Tx) *txtypes.TxBody {
+	var raw txtypes.TxRaw
+	if err := raw.Unmarshal(tx); err != nil {
+		return nil
+	}
+	var body txtypes.TxBody
+	if err := body.Unmarshal(raw.BodyBytes); err != nil {
+		return nil
+	}
+	opts := body.ExtensionOptions
+	if len(opts) != 1 || opts[0].TypeUrl != extensionOptionsEthereumTxURL {
+		return nil
+	}
+	return &body
+}
+
+// expectedFailureResponses returns the responses of the Ethereum transactions
+// of a Cosmos transaction that failed because it exceeded the block gas limit.
+// Their gas used is their gas limit, which is what the ante handler charged.
+func expectedFailureResponses(body *txtypes.TxBody, result *abci.ExecTxResult) ([]*evmtypes.MsgEthereumTxResponse, error) {
+	registry := codectypes.NewInterfaceRegistry()
+	evmtypes.RegisterInterfaces(registry)
+
+	responses := make([]*evmtypes.MsgEthereumTxResponse, 0, len(body.Messages))
+	for _, msgAny := range body.Messages {
+		if msgAny.TypeUrl != msgEthereumTxURL {
+			return nil, fmt.Errorf("unexpected message %s in an Ethereum transaction", msgAny.TypeUrl)
+		}
+		var msg evmtypes.MsgEthereumTx
+		if err := msg.Unmarshal(msgAny.Value); err != nil {
+			return nil, err
+		}
+		if err := msg.UnpackInterfaces(registry); err != nil {
+			return nil, err
+		}
+		ethTx := msg.AsTransaction()
+		if ethTx == nil {
+			return nil, fmt.Errorf("invalid Ethereum transaction data")
+		}
+		responses = append(responses, &evmtypes.MsgEthereumTxResponse{
+			Hash:    ethTx.Hash().Hex(),
+			GasUsed: ethTx.Gas(),
+			VmError: result.Log,
+		})
+	}
+	return responses, nil
+}
+
+// newReceipt returns the receipt of an Ethereum transaction response of the
+// block preceding the header, with the inclusion information of its logs set
+// from the header.
+func newReceipt(header *cmttypes.Header, res *evmtypes.MsgEthereumTxResponse, txIndex, logIndex uint) *ethtypes.Receipt {
+	receipt := &ethtypes.Receipt{
+		Status:           ethtypes.ReceiptStatusSuccessful,
+		TxHash:           common.HexToHash(res.Hash),
+		GasUsed:          res.GasUsed,
+		BlockHash:        common.BytesToHash(header.LastBlockID.Hash),
+		BlockNumber:      big.NewInt(header.Height - 1),
+		TransactionIndex: txIndex,
+		Logs:             evmtypes.LogsToEthereum(res.Logs),
+	}
+	if res.Failed() {
+		receipt.Status = ethtypes.ReceiptStatusFailed
+	}
+	if receipt.Logs == nil {
+		receipt.Logs = []*ethtypes.Log{}
+	}
+
+	for i, log := range receipt.Logs {
+		log.BlockNumber = receipt.BlockNumber.Uint64()
+		log.BlockHash = receipt.BlockHash
+		log.TxHash = receipt.TxHash
+		log.TxIndex = txIndex
+		log.Index = logIndex + uint(i)
+	}
+	receipt.Bloom = ethtypes.CreateBloom(receipt)
+
+	return receipt
+}
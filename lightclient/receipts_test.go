@@ -0,0 +1,398 @@
+package lightclient
+
+import (
+	"math/big"
+	"strconv"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	rpctypes "github.com/cosmos/evm/rpc/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func newTxResult(t *testing.T, gasUsed int64, msgResponse sdk.Msg) *abci.ExecTxResult {
+	t.Helper()
+
+	msgAny, err := codectypes.NewAnyWithValue(msgResponse)
+	require.NoError(t, err)
+	data, err := (&sdk.TxMsgData{MsgResponses: []*codectypes.Any{msgAny}}).Marshal()
+	require.NoError(t, err)
+
+	return &abci.ExecTxResult{Data: data, GasUsed: gasUsed, GasWanted: gasUsed}
+}
+
+// newEthTxEvent returns the ethereum_tx event of an Ethereum transaction at
+// the given index of the block.
+func newEthTxEvent(txHash common.Hash, txIndex int) abci.Event {
+	return abci.Event{
+		Type: evmtypes.EventTypeEthereumTx,
+		Attributes: []abci.EventAttribute{
+			{Key: evmtypes.AttributeKeyEthereumTxHash, Value: txHash.Hex()},
+			{Key: evmtypes.AttributeKeyTxIndex, Value: strconv.Itoa(txIndex)},
+		},
+	}
+}
+
+// newRawEthTx returns the encoding of a Cosmos transaction wrapping an
+// Ethereum transaction with the given gas limit, and the Ethereum transaction
+// hash.
+func newRawEthTx(t *testing.T, gasLimit uint64) (cmttypes.Tx, common.Hash) {
+	t.Helper()
+
+	to := common.HexToAddress("0x1")
+	msg := evmtypes.NewTx(&evmtypes.EvmTxArgs{
+		ChainID:  big.NewInt(1),
+		GasLimit: gasLimit,
+		GasPrice: big.NewInt(1),
+		To:       &to,
+	})
+	msgAny, err := codectypes.NewAnyWithValue(msg)
+	require.NoError(t, err)
+	optAny, err := codectypes.NewAnyWithValue(&evmtypes.ExtensionOptionsEthereumTx{})
+	require.NoError(t, err)
+
+	body := txtypes.TxBody{Messages: []*codectypes.Any{msgAny}, ExtensionOptions: []*codectypes.Any{optAny}}
+	bodyBytes, err := body.Marshal()
+	require.NoError(t, err)
+	tx, err := (&txtypes.TxRaw{BodyBytes: bodyBytes}).Marshal()
+	require.NoError(t, err)
+	return tx, msg.AsTransaction().Hash()
+}
+
+func TestVerifyReceipts(t *testing.T) {
+	contract := common.HexToAddress("0x1")
+	topic := common.HexToHash("0x2")
+	txHash1 := common.HexToHash("0x3")
+	txHash2 := common.HexToHash("0x4")
+
+	results := []*abci.ExecTxResult{
+		newTxResult(t, 50_000, &banktypes.MsgSendResponse{}),
+		newTxResult(t, 30_000, &evmtypes.MsgEthereumTxResponse{
+			Hash:    txHash1.Hex(),
+			GasUsed: 30_000,
+			Logs: []*evmtypes.Log{
+				{Address: contract.Hex(), Topics: []string{topic.Hex()}, Data: []byte{1}},
+				{Address: contract.Hex(), Data: []byte{2}},
+			},
+		}),
+		{Code: 5, GasUsed: 21_000, Log: "insufficient funds"},
+		newTxResult(t, 25_000, &evmtypes.MsgEthereumTxResponse{
+			Hash:    txHash2.Hex(),
+			GasUsed: 25_000,
+			VmError: "execution reverted",
+		}),
+	}
+
+	blockHash := common.HexToHash("0xabcd")
+	header := &cmttypes.Header{
+		Height:          11,
+		LastBlockID:     cmttypes.BlockID{Hash: blockHash.Bytes()},
+		LastResultsHash: cmttypes.NewResults(results).Hash(),
+	}
+
+	// the transactions are only decoded for the expected failures
+	txs := cmttypes.Txs{{0}, {1}, {2}, {3}}
+
+	receipts, err := VerifyReceipts(header, txs, results)
+	require.NoError(t, err)
+	require.Len(t, receipts, 2)
+
+	require.Equal(t, ethtypes.ReceiptStatusSuccessful, receipts[0].Status)
+	require.Equal(t, txHash1, receipts[0].TxHash)
+	require.Equal(t, blockHash, receipts[0].BlockHash)
+	require.Equal(t, int64(10), receipts[0].BlockNumber.Int64())
+	require.Equal(t, uint(0), receipts[0].TransactionIndex)
+	require.Equal(t, uint64(30_000), receipts[0].GasUsed)
+	require.Equal(t, uint64(80_000), receipts[0].CumulativeGasUsed)
+	require.Len(t, receipts[0].Logs, 2)
+	for i, log := range receipts[0].Logs {
+		require.Equal(t, contract, log.Address)
+		require.Equal(t, uint(i), log.Index)
+		require.Equal(t, txHash1, log.TxHash)
+		require.Equal(t, blockHash, log.BlockHash)
+		require.Equal(t, uint64(10), log.BlockNumber)
+	}
+	require.True(t, receipts[0].Bloom.Test(contract.Bytes()))
+	require.True(t, receipts[0].Bloom.Test(topic.Bytes()))
+
+	require.Equal(t, ethtypes.ReceiptStatusFailed, receipts[1].Status)
+	require.Equal(t, txHash2, receipts[1].TxHash)
+	require.Equal(t, uint(1), receipts[1].TransactionIndex)
+	require.Equal(t, uint64(126_000), receipts[1].CumulativeGasUsed)
+	require.Empty(t, receipts[1].Logs)
+
+	// the failed transaction is not an Ethereum transaction, so the receipts
+	// don't depend on its log
+	require.False(t, receipts[0].Unverified)
+	require.False(t, receipts[1].Unverified)
+
+	// tampered results are rejected
+	tampered := append([]*abci.ExecTxResult{}, results...)
+	tampered[3] = newTxResult(t, 25_000, &evmtypes.MsgEthereumTxResponse{Hash: txHash2.Hex(), GasUsed: 25_000})
+	_, err = VerifyReceipts(header, txs, tampered)
+	require.Error(t, err)
+
+	// missing results are rejected
+	_, err = VerifyReceipts(header, txs[:3], results[:3])
+	require.Error(t, err)
+
+	// missing transactions are rejected
+	_, err = VerifyReceipts(header, txs[:3], results)
+	require.Error(t, err)
+}
+
+func TestVerifyReceiptsExpectedFailure(t *testing.T) {
+	failedTx, failedHash := newRawEthTx(t, 40_000)
+	txHash := common.HexToHash("0x4")
+
+	results := []*abci.ExecTxResult{
+		// the transaction exceeded the block gas limit, its result has no data
+		{Code: 11, GasUsed: 40_000, GasWanted: 40_000, Log: rpctypes.ExceedBlockGasLimitError + " 40000"},
+		newTxResult(t, 30_000, &evmtypes.MsgEthereumTxResponse{
+			Hash:    txHash.Hex(),
+			GasUsed: 30_000,
+			Logs: []*evmtypes.Log{
+				{Address: common.HexToAddress("0x1").Hex(), Data: []byte{1}},
+			},
+		}),
+	}
+	header := &cmttypes.Header{
+		Height:          11,
+		LastBlockID:     cmttypes.BlockID{Hash: common.HexToHash("0xabcd").Bytes()},
+		LastResultsHash: cmttypes.NewResults(results).Hash(),
+	}
+	txs := cmttypes.Txs{failedTx, {1}}
+
+	receipts, err := VerifyReceipts(header, txs, results)
+	require.NoError(t, err)
+	require.Len(t, receipts, 2)
+
+	require.Equal(t, failedHash, receipts[0].TxHash)
+	require.Equal(t, ethtypes.ReceiptStatusFailed, receipts[0].Status)
+	require.Equal(t, uint(0), receipts[0].TransactionIndex)
+	require.Equal(t, uint64(40_000), receipts[0].GasUsed)
+	require.Equal(t, uint64(40_000), receipts[0].CumulativeGasUsed)
+	require.Empty(t, receipts[0].Logs)
+
+	// the index of the following transaction matches the one of the indexer
+	require.Equal(t, txHash, receipts[1].TxHash)
+	require.Equal(t, ethtypes.ReceiptStatusSuccessful, receipts[1].Status)
+	require.Equal(t, uint(1), receipts[1].TransactionIndex)
+	require.Equal(t, uint64(70_000), receipts[1].CumulativeGasUsed)
+	require.Equal(t, uint(1), receipts[1].Logs[0].TxIndex)
+	require.Equal(t, uint(0), receipts[1].Logs[0].Index)
+
+	// the log of the failed transaction is not committed, so both receipts
+	// are unverified
+	require.True(t, receipts[0].Unverified)
+	require.True(t, receipts[1].Unverified)
+
+	// the other failed transactions are not included in the block
+	results[0].Log = "insufficient funds"
+	receipts, err = VerifyReceipts(header, txs, results)
+	require.NoError(t, err)
+	require.Len(t, receipts, 1)
+	require.Equal(t, uint(0), receipts[0].TransactionIndex)
+	require.True(t, receipts[0].Unverified)
+}
+
+func TestVerifyReceiptsTamperedLog(t *testing.T) {
+	failedTx, failedHash := newRawEthTx(t, 40_000)
+	txHash := common.HexToHash("0x4")
+
+	results := []*abci.ExecTxResult{
+		// the transaction failed in the ante handler, it has no receipt
+		{Code: 5, GasUsed: 0, Log: "insufficient funds"},
+		newTxResult(t, 30_000, &evmtypes.MsgEthereumTxResponse{Hash: txHash.Hex(), GasUsed: 30_000}),
+	}
+	header := &cmttypes.Header{
+		Height:          11,
+		LastBlockID:     cmttypes.BlockID{Hash: common.HexToHash("0xabcd").Bytes()},
+		LastResultsHash: cmttypes.NewResults(results).Hash(),
+	}
+	txs := cmttypes.Txs{failedTx, {1}}
+
+	receipts, err := VerifyReceipts(header, txs, results)
+	require.NoError(t, err)
+	require.Len(t, receipts, 1)
+	require.Equal(t, txHash, receipts[0].TxHash)
+	require.Equal(t, uint(0), receipts[0].TransactionIndex)
+	require.True(t, receipts[0].Unverified)
+
+	// a log forged as an expected failure still matches the results hash, the
+	// forged receipt and the shifted index are flagged as unverified
+	results[0].Log = rpctypes.ExceedBlockGasLimitError + " 40000"
+	receipts, err = VerifyReceipts(header, txs, results)
+	require.NoError(t, err)
+	require.Len(t, receipts, 2)
+	require.Equal(t, failedHash, receipts[0].TxHash)
+	require.True(t, receipts[0].Unverified)
+	require.Equal(t, txHash, receipts[1].TxHash)
+	require.Equal(t, uint(1), receipts[1].TransactionIndex)
+	require.True(t, receipts[1].Unverified)
+}
+
+func TestVerifyReceipt(t *testing.T) {
+	txHash1 := common.HexToHash("0x3")
+	txHash2 := common.HexToHash("0x4")
+
+	results := []*abci.ExecTxResult{
+		newTxResult(t, 50_000, &banktypes.MsgSendResponse{}),
+		newTxResult(t, 30_000, &evmtypes.MsgEthereumTxResponse{
+			Hash:    txHash1.Hex(),
+			GasUsed: 30_000,
+			Logs: []*evmtypes.Log{
+				{Address: common.HexToAddress("0x1").Hex(), Data: []byte{1}, TxIndex: 0, Index: 0},
+			},
+		}),
+		newTxResult(t, 21_000, &evmtypes.MsgEthereumTxResponse{
+			Hash:    txHash2.Hex(),
+			GasUsed: 21_000,
+		}),
+	}
+	// the events are not committed
+	results[1].Events = []abci.Event{newEthTxEvent(txHash1, 0)}
+	results[2].Events = []abci.Event{newEthTxEvent(txHash2, 1)}
+
+	abciResults := cmttypes.NewResults(results)
+	header := &cmttypes.Header{
+		Height:          11,
+		LastBlockID:     cmttypes.BlockID{Hash: common.HexToHash("0xabcd").Bytes()},
+		LastResultsHash: abciResults.Hash(),
+	}
+
+	testCases := []struct {
+		name          string
+		index         int
+		result        func() *abci.ExecTxResult
+		expPass       bool
+		expHash       common.Hash
+		expTxIndex    uint
+		expLogs       int
+		expUnverified bool
+	}{
+		{
+			"pass - Ethereum transaction result",
+			1,
+			func() *abci.ExecTxResult { return results[1] },
+			true,
+			txHash1,
+			0,
+			1,
+			false,
+		},
+		{
+			"pass - Ethereum transaction result without logs",
+			2,
+			func() *abci.ExecTxResult { return results[2] },
+			true,
+			txHash2,
+			1,
+			0,
+			true,
+		},
+		{
+			"pass - tampered transaction index of a result without logs",
+			2,
+			func() *abci.ExecTxResult {
+				result := *results[2]
+				result.Events = []abci.Event{newEthTxEvent(txHash2, 5)}
+				return &result
+			},
+			true,
+			txHash2,
+			5,
+			0,
+			true,
+		},
+		{
+			"fail - Cosmos transaction result",
+			0,
+			func() *abci.ExecTxResult { return results[0] },
+			false,
+			common.Hash{},
+			0,
+			0,
+			false,
+		},
+		{
+			"fail - proof of another result",
+			0,
+			func() *abci.ExecTxResult { return results[1] },
+			false,
+			common.Hash{},
+			0,
+			0,
+			false,
+		},
+		{
+			"fail - tampered gas used",
+			1,
+			func() *abci.ExecTxResult {
+				result := *results[1]
+				result.GasUsed++
+				return &result
+			},
+			false,
+			common.Hash{},
+			0,
+			0,
+			false,
+		},
+		{
+			"fail - missing transaction index",
+			2,
+			func() *abci.ExecTxResult {
+				result := *results[2]
+				result.Events = nil
+				return &result
+			},
+			false,
+			common.Hash{},
+			0,
+			0,
+			false,
+		},
+		{
+			"fail - transaction index of the events doesn't match the logs",
+			1,
+			func() *abci.ExecTxResult {
+				result := *results[1]
+				result.Events = []abci.Event{newEthTxEvent(txHash1, 1)}
+				return &result
+			},
+			false,
+			common.Hash{},
+			0,
+			0,
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			proof := abciResults.ProveResult(tc.index)
+			receipt, err := VerifyReceipt(header, tc.result(), &proof)
+			if tc.expPass {
+				require.NoError(t, err)
+				require.Equal(t, tc.expHash, receipt.TxHash)
+				require.Equal(t, tc.expTxIndex, receipt.TransactionIndex)
+				require.Len(t, receipt.Logs, tc.expLogs)
+				require.Equal(t, tc.expUnverified, receipt.Unverified)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
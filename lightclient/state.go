@@ -0,0 +1,261 @@
+package lightclient
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	cmtmerkle "github.com/cometbft/cometbft/crypto/merkle"
+	cmtcrypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
+
+	rpctypes "github.com/cosmos/evm/rpc/types"
+	precisebanktypes "github.com/cosmos/evm/x/precisebank/types"
+	evmvestingtypes "github.com/cosmos/evm/x/vesting/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"cosmossdk.io/store/rootmulti"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// ProvenAccount is the state of an account proven against an app hash.
+type ProvenAccount struct {
+	Address common.Address
+	Nonce   uint64
+	// Balance is the total balance of the account, in 18 decimals. It includes
+	// the locked coins of vesting accounts, which are not part of the spendable
+	// balance reported by eth_getBalance and eth_getProof.
+	Balance *big.Int
+	// SpendableBalance is the proven spendable balance of the account, in 18
+	// decimals, which is the total balance of non-vesting accounts. It is nil
+	// for vesting accounts, as their locked coins depend on the block time.
+	SpendableBalance *big.Int
+	CodeHash         common.Hash
+	Storage          map[common.Hash]common.Hash
+}
+
+// StateVerifier verifies the eth_getProof results of a chain against the app
+// hash of its blocks. The app hash must come from a header verified by a
+// CometBFT light client for the result to be trusted.
+type StateVerifier struct {
+	coinInfo evmtypes.EvmCoinInfo
+	cdc      codec.Codec
+	runtime  *cmtmerkle.ProofRuntime
+}
+
+// NewStateVerifier returns a state verifier for a chain with the given EVM
+// coin.
+func NewStateVerifier(coinInfo evmtypes.EvmCoinInfo) *StateVerifier {
+	registry := codectypes.NewInterfaceRegistry()
+	authtypes.RegisterInterfaces(registry)
+	vestingtypes.RegisterInterfaces(registry)
+	evmvestingtypes.RegisterInterfaces(registry)
+
+	return &StateVerifier{
+		coinInfo: coinInfo,
+		cdc:      codec.NewProtoCodec(registry),
+		runtime:  rootmulti.DefaultProofRuntime(),
+	}
+}
+
+// VerifyAccountResult verifies the proofs of an eth_getProof result against the
+// app hash of the block at the result AppHashHeight, and returns the proven
+// account. It fails if the nonce, code hash, balance or storage values of the
+// result differ from the proven ones. The balance of vesting accounts can't be
+// proven, and only has to be lower than their proven total balance.
+func (v *StateVerifier) VerifyAccountResult(appHash []byte, res *rpctypes.AccountResult) (*ProvenAccount, error) {
+	if res.StateProof == nil {
+		return nil, fmt.Errorf("missing state proof of account %s", res.Address)
+	}
+
+	account := &ProvenAccount{
+		Address: res.Address,
+		Storage: make(map[common.Hash]common.Hash, len(res.StorageProof)),
+	}
+
+	// nonce
+	value, err := v.VerifyStoreProof(appHash, authtypes.StoreKey, AccountKey(res.Address), res.StateProof.Account)
+	if err != nil {
+		return nil, fmt.Errorf("invalid account proof: %w", err)
+	}
+	var vesting bool
+	if len(value) > 0 {
+		var acc sdk.AccountI
+		if err := v.cdc.UnmarshalInterface(value, &acc); err != nil {
+			return nil, fmt.Errorf("failed to decode account: %w", err)
+		}
+		account.Nonce = acc.GetSequence()
+		_, vesting = acc.(vestingexported.VestingAccount)
+	}
+	if account.Nonce != uint64(res.Nonce) {
+		return nil, fmt.Errorf("nonce mismatch, expected %d, got %d", account.Nonce, res.Nonce)
+	}
+
+	// code hash
+	value, err = v.VerifyStoreProof(appHash, evmtypes.StoreKey, CodeHashKey(res.Address), res.StateProof.CodeHash)
+	if err != nil {
+		return nil, fmt.Errorf("invalid code hash proof: %w", err)
+	}
+	account.CodeHash = common.BytesToHash(evmtypes.EmptyCodeHash)
+	if len(value) > 0 {
+		account.CodeHash = common.BytesToHash(value)
+	}
+	if account.CodeHash != res.CodeHash {
+		return nil, fmt.Errorf("code hash mismatch, expected %s, got %s", account.CodeHash, res.CodeHash)
+	}
+
+	// balance
+	account.Balance, err = v.verifyBalance(appHash, res.Address, res.StateProof)
+	if err != nil {
+		return nil, err
+	}
+	if res.Balance == nil {
+		return nil, fmt.Errorf("missing balance of account %s", res.Address)
+	}
+	if vesting {
+		if res.Balance.ToInt().Cmp(account.Balance) > 0 {
+			return nil, fmt.Errorf("balance %s is higher than the proven balance %s", res.Balance, account.Balance)
+		}
+	} else {
+		if res.Balance.ToInt().Cmp(account.Balance) != 0 {
+			return nil, fmt.Errorf("balance mismatch, expected %s, got %s", account.Balance, res.Balance)
+		}
+		account.SpendableBalance = account.Balance
+	}
+
+	// storage
+	for _, storage := range res.StorageProof {
+		slot := common.HexToHash(storage.Key)
+		proof := rpctypes.StoreProof{
+			StoreKey: evmtypes.StoreKey,
+			Key:      StorageKey(res.Address, slot),
+			Proof:    storage.Proof,
+		}
+		var stored common.Hash
+		if storage.Value != nil {
+			stored = common.BigToHash(storage.Value.ToInt())
+		}
+		if stored != (common.Hash{}) {
+			proof.Value = stored.Bytes()
+		}
+		if _, err := v.VerifyStoreProof(appHash, evmtypes.StoreKey, proof.Key, proof); err != nil {
+			return nil, fmt.Errorf("invalid storage proof of slot %s: %w", slot, err)
+		}
+		account.Storage[slot] = stored
+	}
+
+	return account, nil
+}
+
+// verifyBalance verifies the balance proofs of the account and returns its
+// total balance in 18 decimals.
+func (v *StateVerifier) verifyBalance(appHash []byte, address common.Address, stateProof *rpctypes.AccountStateProof) (*big.Int, error) {
+	key, err := BalanceKey(address, v.coinInfo.Denom)
+	if err != nil {
+		return nil, err
+	}
+	value, err := v.VerifyStoreProof(appHash, banktypes.StoreKey, key, stateProof.Balance)
+	if err != nil {
+		return nil, fmt.Errorf("invalid balance proof: %w", err)
+	}
+	balance, err := decodeInt(value)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode balance: %w", err)
+	}
+	balance.Mul(balance, v.coinInfo.Decimals.ConversionFactor().BigInt())
+
+	if v.coinInfo.Decimals == evmtypes.EighteenDecimals {
+		return balance, nil
+	}
+
+	if stateProof.FractionalBalance == nil {
+		return nil, fmt.Errorf("missing fractional balance proof")
+	}
+	value, err = v.VerifyStoreProof(appHash, precisebanktypes.StoreKey, FractionalBalanceKey(address), *stateProof.FractionalBalance)
+	if err != nil {
+		return nil, fmt.Errorf("invalid fractional balance proof: %w", err)
+	}
+	fractional, err := decodeInt(value)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode fractional balance: %w", err)
+	}
+
+	return balance.Add(balance, fractional), nil
+}
+
+// VerifyStoreProof verifies the proof of the key of a module store against the
+// app hash, and returns the proven value. An empty value is proven to be absent
+// from the store.
+func (v *StateVerifier) VerifyStoreProof(appHash []byte, storeKey string, key []byte, proof rpctypes.StoreProof) ([]byte, error) {
+	if proof.StoreKey != "" && proof.StoreKey != storeKey {
+		return nil, fmt.Errorf("store mismatch, expected %s, got %s", storeKey, proof.StoreKey)
+	}
+	if len(proof.Key) > 0 && !bytes.Equal(proof.Key, key) {
+		return nil, fmt.Errorf("key mismatch, expected %x, got %x", key, []byte(proof.Key))
+	}
+
+	proofOps, err := storeProofOps(storeKey, key, proof.Proof)
+	if err != nil {
+		return nil, err
+	}
+
+	keyPath := cmtmerkle.KeyPath{}.
+		AppendKey([]byte(storeKey), cmtmerkle.KeyEncodingURL).
+		AppendKey(key, cmtmerkle.KeyEncodingHex).
+		String()
+
+	if len(proof.Value) == 0 {
+		return nil, v.runtime.VerifyAbsence(proofOps, appHash, keyPath)
+	}
+	if err := v.runtime.VerifyValue(proofOps, appHash, keyPath, proof.Value); err != nil {
+		return nil, err
+	}
+	return proof.Value, nil
+}
+
+// storeProofOps rebuilds the proof operations of a store key from the hex
+// encoded data of the IAVL and simple merkle commitment proofs.
+func storeProofOps(storeKey string, key []byte, proof []string) (*cmtcrypto.ProofOps, error) {
+	if len(proof) != 2 {
+		return nil, fmt.Errorf("expected the store and multistore proofs, got %d proofs", len(proof))
+	}
+
+	storeProof, err := hexutil.Decode(proof[0])
+	if err != nil {
+		return nil, fmt.Errorf("invalid store proof: %w", err)
+	}
+	multiStoreProof, err := hexutil.Decode(proof[1])
+	if err != nil {
+		return nil, fmt.Errorf("invalid multistore proof: %w", err)
+	}
+
+	return &cmtcrypto.ProofOps{
+		Ops: []cmtcrypto.ProofOp{
+			{Type: storetypes.ProofOpIAVLCommitment, Key: key, Data: storeProof},
+			{Type: storetypes.ProofOpSimpleMerkleCommitment, Key: []byte(storeKey), Data: multiStoreProof},
+		},
+	}, nil
+}
+
+// decodeInt decodes an integer amount of the x/bank or x/precisebank stores,
+// where absent amounts are zero.
+func decodeInt(value []byte) (*big.Int, error) {
+	if len(value) == 0 {
+		return new(big.Int), nil
+	}
+	amount, err := sdk.IntValue.Decode(value)
+	if err != nil {
+		return nil, err
+	}
+	return amount.BigInt(), nil
+}
@@ -0,0 +1,387 @@
+package lightclient
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/require"
+
+	dbm "github.com/cosmos/cosmos-db"
+	rpctypes "github.com/cosmos/evm/rpc/types"
+	utiltx "github.com/cosmos/evm/testutil/tx"
+	precisebanktypes "github.com/cosmos/evm/x/precisebank/types"
+	evmvestingtypes "github.com/cosmos/evm/x/vesting/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"cosmossdk.io/log"
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store/metrics"
+	"cosmossdk.io/store/rootmulti"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// testChain is a multistore holding the stores proven by the state verifier.
+type testChain struct {
+	t       *testing.T
+	cms     *rootmulti.Store
+	keys    map[string]*storetypes.KVStoreKey
+	cdc     codec.Codec
+	denom   string
+	appHash []byte
+	height  int64
+}
+
+func newTestChain(t *testing.T, denom string) *testChain {
+	t.Helper()
+
+	cms := rootmulti.NewStore(dbm.NewMemDB(), log.NewNopLogger(), metrics.NewNoOpMetrics())
+	keys := storetypes.NewKVStoreKeys(authtypes.StoreKey, banktypes.StoreKey, evmtypes.StoreKey, precisebanktypes.StoreKey)
+	for _, key := range keys {
+		cms.MountStoreWithDB(key, storetypes.StoreTypeIAVL, nil)
+	}
+	require.NoError(t, cms.LoadLatestVersion())
+
+	registry := codectypes.NewInterfaceRegistry()
+	authtypes.RegisterInterfaces(registry)
+	vestingtypes.RegisterInterfaces(registry)
+	evmvestingtypes.RegisterInterfaces(registry)
+
+	return &testChain{t: t, cms: cms, keys: keys, cdc: codec.NewProtoCodec(registry), denom: denom}
+}
+
+func (c *testChain) set(storeKey string, key, value []byte) {
+	c.cms.GetKVStore(c.keys[storeKey]).Set(key, value)
+}
+
+func (c *testChain) setAccount(address common.Address, nonce uint64, balance, fractional int64, codeHash common.Hash, storage map[common.Hash]common.Hash) {
+	acc := authtypes.NewBaseAccount(address.Bytes(), nil, 0, nonce)
+	bz, err := c.cdc.MarshalInterface(acc)
+	require.NoError(c.t, err)
+	c.set(authtypes.StoreKey, AccountKey(address), bz)
+
+	balanceKey, err := BalanceKey(address, c.denom)
+	require.NoError(c.t, err)
+	bz, err = sdk.IntValue.Encode(sdkmath.NewInt(balance))
+	require.NoError(c.t, err)
+	c.set(banktypes.StoreKey, balanceKey, bz)
+
+	if fractional > 0 {
+		bz, err = sdkmath.NewInt(fractional).Marshal()
+		require.NoError(c.t, err)
+		c.set(precisebanktypes.StoreKey, FractionalBalanceKey(address), bz)
+	}
+
+	c.set(evmtypes.StoreKey, CodeHashKey(address), codeHash.Bytes())
+	for slot, value := range storage {
+		c.set(evmtypes.StoreKey, StorageKey(address, slot), value.Bytes())
+	}
+}
+
+// setVestingAccount replaces the account with a continuous vesting account
+// vesting the given amount.
+func (c *testChain) setVestingAccount(address common.Address, nonce uint64, vesting int64) {
+	baseAcc := authtypes.NewBaseAccount(address.Bytes(), nil, 0, nonce)
+	acc, err := vestingtypes.NewContinuousVestingAccount(baseAcc, sdk.NewCoins(sdk.NewInt64Coin(c.denom, vesting)), 1, 2)
+	require.NoError(c.t, err)
+	c.setAccountI(address, acc)
+}
+
+// setClawbackVestingAccount replaces the account with a clawback vesting
+// account vesting the given amount.
+func (c *testChain) setClawbackVestingAccount(address common.Address, nonce uint64, vesting int64) {
+	baseAcc := authtypes.NewBaseAccount(address.Bytes(), nil, 0, nonce)
+	acc := evmvestingtypes.NewClawbackVestingAccount(baseAcc, utiltx.GenerateAddress().Bytes(), 1)
+	acc.OriginalVesting = sdk.NewCoins(sdk.NewInt64Coin(c.denom, vesting))
+	acc.EndTime = 2
+	acc.VestingPeriods = vestingtypes.Periods{{Length: 1, Amount: acc.OriginalVesting}}
+	c.setAccountI(address, acc)
+}
+
+func (c *testChain) setAccountI(address common.Address, acc sdk.AccountI) {
+	bz, err := c.cdc.MarshalInterface(acc)
+	require.NoError(c.t, err)
+	c.set(authtypes.StoreKey, AccountKey(address), bz)
+}
+
+func (c *testChain) commit() {
+	commitID := c.cms.Commit()
+	c.appHash = commitID.Hash
+	c.height = commitID.Version
+}
+
+func (c *testChain) storeProof(storeKey string, key []byte) rpctypes.StoreProof {
+	res, err := c.cms.Query(&storetypes.RequestQuery{
+		Path:   "/" + storeKey + "/key",
+		Data:   key,
+		Height: c.height,
+		Prove:  true,
+	})
+	require.NoError(c.t, err)
+
+	proof := make([]string, len(res.ProofOps.Ops))
+	for i, op := range res.ProofOps.Ops {
+		proof[i] = hexutil.Encode(op.Data)
+	}
+	return rpctypes.StoreProof{StoreKey: storeKey, Key: key, Value: res.Value, Proof: proof}
+}
+
+// accountResult builds the eth_getProof result of the account, as served by
+// the JSON-RPC backend.
+func (c *testChain) accountResult(address common.Address, nonce uint64, balance *big.Int, codeHash common.Hash, slots ...common.Hash) *rpctypes.AccountResult {
+	balanceKey, err := BalanceKey(address, c.denom)
+	require.NoError(c.t, err)
+
+	accountProof := c.storeProof(authtypes.StoreKey, AccountKey(address))
+	fractionalProof := c.storeProof(precisebanktypes.StoreKey, FractionalBalanceKey(address))
+	res := &rpctypes.AccountResult{
+		Address:       address,
+		AccountProof:  accountProof.Proof,
+		Balance:       (*hexutil.Big)(balance),
+		CodeHash:      codeHash,
+		Nonce:         hexutil.Uint64(nonce),
+		AppHashHeight: hexutil.Uint64(c.height + 1), //#nosec G115 -- test height is positive
+		StateProof: &rpctypes.AccountStateProof{
+			Account:           accountProof,
+			CodeHash:          c.storeProof(evmtypes.StoreKey, CodeHashKey(address)),
+			Balance:           c.storeProof(banktypes.StoreKey, balanceKey),
+			FractionalBalance: &fractionalProof,
+		},
+	}
+
+	for _, slot := range slots {
+		proof := c.storeProof(evmtypes.StoreKey, StorageKey(address, slot))
+		res.StorageProof = append(res.StorageProof, rpctypes.StorageResult{
+			Key:   slot.Hex(),
+			Value: (*hexutil.Big)(new(big.Int).SetBytes(proof.Value)),
+			Proof: proof.Proof,
+		})
+	}
+	return res
+}
+
+func TestVerifyAccountResult(t *testing.T) {
+	address := utiltx.GenerateAddress()
+	codeHash := common.HexToHash("0x1234")
+	slot := common.HexToHash("0x1")
+	emptySlot := common.HexToHash("0x2")
+	value := common.HexToHash("0xabcd")
+
+	coinInfo := evmtypes.EvmCoinInfo{Denom: "aatom", Decimals: evmtypes.EighteenDecimals}
+	chain := newTestChain(t, coinInfo.Denom)
+	chain.setAccount(address, 5, 100, 0, codeHash, map[common.Hash]common.Hash{slot: value})
+	chain.commit()
+
+	verifier := NewStateVerifier(coinInfo)
+
+	testCases := []struct {
+		name     string
+		res      func() *rpctypes.AccountResult
+		appHash  func() []byte
+		expPass  bool
+		expProof *ProvenAccount
+	}{
+		{
+			"pass - existing account",
+			func() *rpctypes.AccountResult {
+				return chain.accountResult(address, 5, big.NewInt(100), codeHash, slot, emptySlot)
+			},
+			func() []byte { return chain.appHash },
+			true,
+			&ProvenAccount{
+				Address:          address,
+				Nonce:            5,
+				Balance:          big.NewInt(100),
+				SpendableBalance: big.NewInt(100),
+				CodeHash:         codeHash,
+				Storage:          map[common.Hash]common.Hash{slot: value, emptySlot: {}},
+			},
+		},
+		{
+			"fail - balance lower than the proven balance",
+			func() *rpctypes.AccountResult {
+				return chain.accountResult(address, 5, big.NewInt(40), codeHash, slot)
+			},
+			func() []byte { return chain.appHash },
+			false,
+			nil,
+		},
+		{
+			"pass - absent account",
+			func() *rpctypes.AccountResult {
+				return chain.accountResult(common.HexToAddress("0x1"), 0, big.NewInt(0), common.BytesToHash(evmtypes.EmptyCodeHash), slot)
+			},
+			func() []byte { return chain.appHash },
+			true,
+			&ProvenAccount{
+				Address:          common.HexToAddress("0x1"),
+				Balance:          big.NewInt(0),
+				SpendableBalance: big.NewInt(0),
+				CodeHash:         common.BytesToHash(evmtypes.EmptyCodeHash),
+				Storage:          map[common.Hash]common.Hash{slot: {}},
+			},
+		},
+		{
+			"fail - missing state proof",
+			func() *rpctypes.AccountResult {
+				res := chain.accountResult(address, 5, big.NewInt(100), codeHash)
+				res.StateProof = nil
+				return res
+			},
+			func() []byte { return chain.appHash },
+			false,
+			nil,
+		},
+		{
+			"fail - other app hash",
+			func() *rpctypes.AccountResult {
+				return chain.accountResult(address, 5, big.NewInt(100), codeHash)
+			},
+			func() []byte { return common.HexToHash("0x1").Bytes() },
+			false,
+			nil,
+		},
+		{
+			"fail - nonce mismatch",
+			func() *rpctypes.AccountResult {
+				return chain.accountResult(address, 6, big.NewInt(100), codeHash)
+			},
+			func() []byte { return chain.appHash },
+			false,
+			nil,
+		},
+		{
+			"fail - code hash mismatch",
+			func() *rpctypes.AccountResult {
+				return chain.accountResult(address, 5, big.NewInt(100), common.BytesToHash(evmtypes.EmptyCodeHash))
+			},
+			func() []byte { return chain.appHash },
+			false,
+			nil,
+		},
+		{
+			"fail - balance higher than the proven balance",
+			func() *rpctypes.AccountResult {
+				return chain.accountResult(address, 5, big.NewInt(101), codeHash)
+			},
+			func() []byte { return chain.appHash },
+			false,
+			nil,
+		},
+		{
+			"fail - tampered storage value",
+			func() *rpctypes.AccountResult {
+				res := chain.accountResult(address, 5, big.NewInt(100), codeHash, slot)
+				res.StorageProof[0].Value = (*hexutil.Big)(big.NewInt(1))
+				return res
+			},
+			func() []byte { return chain.appHash },
+			false,
+			nil,
+		},
+		{
+			"fail - storage proof of another slot",
+			func() *rpctypes.AccountResult {
+				res := chain.accountResult(address, 5, big.NewInt(100), codeHash, slot)
+				res.StorageProof[0].Key = emptySlot.Hex()
+				return res
+			},
+			func() []byte { return chain.appHash },
+			false,
+			nil,
+		},
+		{
+			"fail - account proof of another store",
+			func() *rpctypes.AccountResult {
+				res := chain.accountResult(address, 5, big.NewInt(100), codeHash)
+				res.StateProof.Account = res.StateProof.CodeHash
+				return res
+			},
+			func() []byte { return chain.appHash },
+			false,
+			nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			account, err := verifier.VerifyAccountResult(tc.appHash(), tc.res())
+			if tc.expPass {
+				require.NoError(t, err)
+				require.Equal(t, tc.expProof, account)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestVerifyAccountResultVestingBalance(t *testing.T) {
+	codeHash := common.BytesToHash(evmtypes.EmptyCodeHash)
+	coinInfo := evmtypes.EvmCoinInfo{Denom: "aatom", Decimals: evmtypes.EighteenDecimals}
+
+	testCases := []struct {
+		name       string
+		setAccount func(chain *testChain, address common.Address)
+	}{
+		{
+			"continuous vesting account",
+			func(chain *testChain, address common.Address) { chain.setVestingAccount(address, 1, 60) },
+		},
+		{
+			"clawback vesting account",
+			func(chain *testChain, address common.Address) { chain.setClawbackVestingAccount(address, 1, 60) },
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			address := utiltx.GenerateAddress()
+			chain := newTestChain(t, coinInfo.Denom)
+			chain.setAccount(address, 1, 100, 0, codeHash, nil)
+			tc.setAccount(chain, address)
+			chain.commit()
+
+			verifier := NewStateVerifier(coinInfo)
+
+			// the spendable balance of vesting accounts is only bounded by the total one
+			account, err := verifier.VerifyAccountResult(chain.appHash, chain.accountResult(address, 1, big.NewInt(40), codeHash))
+			require.NoError(t, err)
+			require.Equal(t, big.NewInt(100), account.Balance)
+			require.Nil(t, account.SpendableBalance)
+
+			_, err = verifier.VerifyAccountResult(chain.appHash, chain.accountResult(address, 1, big.NewInt(101), codeHash))
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestVerifyAccountResultFractionalBalance(t *testing.T) {
+	address := utiltx.GenerateAddress()
+	codeHash := common.BytesToHash(evmtypes.EmptyCodeHash)
+
+	coinInfo := evmtypes.EvmCoinInfo{Denom: "uatom", Decimals: evmtypes.SixDecimals}
+	chain := newTestChain(t, coinInfo.Denom)
+	chain.setAccount(address, 1, 2, 3, codeHash, nil)
+	chain.commit()
+
+	verifier := NewStateVerifier(coinInfo)
+	expBalance := big.NewInt(2_000_000_000_003)
+
+	account, err := verifier.VerifyAccountResult(chain.appHash, chain.accountResult(address, 1, expBalance, codeHash))
+	require.NoError(t, err)
+	require.Equal(t, expBalance, account.Balance)
+
+	res := chain.accountResult(address, 1, expBalance, codeHash)
+	res.StateProof.FractionalBalance = nil
+	_, err = verifier.VerifyAccountResult(chain.appHash, res)
+	require.Error(t, err)
+}
@@ -228,5 +228,5 @@ evmd start "$TRACE" \
 	--log_level $LOGLEVEL \
 	--minimum-gas-prices=0.0001atest \
 	--home "$CHAINDIR" \
-	--json-rpc.api eth,txpool,personal,net,debug,web3 \
+	--json-rpc.api eth,txpool,personal,net,debug,web3,trace,ots,cosmos \
 	--chain-id "$CHAINID"
@@ -0,0 +1,16 @@
+package mempool
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// VMKeeper defines the EVM keeper methods required by the EVM mempool to
+// classify and order transactions.
+type VMKeeper interface {
+	GetNonce(ctx sdk.Context, addr common.Address) uint64
+	GetBaseFee(ctx sdk.Context) *big.Int
+}
@@ -0,0 +1,110 @@
+package mempool
+
+import (
+	"container/heap"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkmempool "github.com/cosmos/cosmos-sdk/types/mempool"
+)
+
+var _ sdkmempool.Iterator = (*iterator)(nil)
+
+// iterator walks the executable EVM transactions of the pool in the same order
+// as go-ethereum's miner: at every step it returns the transaction with the
+// highest effective tip among the lowest-nonce transactions of each sender.
+// Once all the EVM transactions are consumed, it continues with the Cosmos
+// transactions of the underlying Cosmos mempool.
+type iterator struct {
+	heads   *priceHeap
+	pending map[common.Address][]*txEntry
+	cosmos  sdkmempool.Iterator
+}
+
+// newIterator returns an iterator over the given pending transactions, sorted
+// by nonce for each sender, followed by the given Cosmos iterator. It returns nil
+// if there is nothing to iterate over.
+func newIterator(pending map[common.Address][]*txEntry, baseFee *big.Int, cosmos sdkmempool.Iterator) sdkmempool.Iterator {
+	heads := &priceHeap{baseFee: baseFee}
+	for from, txs := range pending {
+		heads.entries = append(heads.entries, txs[0])
+		pending[from] = txs[1:]
+	}
+	heap.Init(heads)
+
+	if heads.Len() == 0 && cosmos == nil {
+		return nil
+	}
+
+	return &iterator{
+		heads:   heads,
+		pending: pending,
+		cosmos:  cosmos,
+	}
+}
+
+// Tx implements the sdkmempool.Iterator interface.
+func (it *iterator) Tx() sdk.Tx {
+	if it.heads.Len() > 0 {
+		return it.heads.entries[0].tx
+	}
+	return it.cosmos.Tx()
+}
+
+// Next implements the sdkmempool.Iterator interface.
+func (it *iterator) Next() sdkmempool.Iterator {
+	if it.heads.Len() > 0 {
+		head := it.heads.entries[0]
+		if next := it.pending[head.from]; len(next) > 0 {
+			it.heads.entries[0] = next[0]
+			it.pending[head.from] = next[1:]
+			heap.Fix(it.heads, 0)
+		} else {
+			heap.Pop(it.heads)
+		}
+
+		if it.heads.Len() > 0 || it.cosmos != nil {
+			return it
+		}
+		return nil
+	}
+
+	if it.cosmos = it.cosmos.Next(); it.cosmos == nil {
+		return nil
+	}
+	return it
+}
+
+// priceHeap is a max-heap of transactions sorted by effective tip, then by
+// arrival order.
+type priceHeap struct {
+	baseFee *big.Int
+	entries []*txEntry
+}
+
+func (h *priceHeap) Len() int { return len(h.entries) }
+
+func (h *priceHeap) Less(i, j int) bool {
+	cmp := h.entries[i].effectiveTip(h.baseFee).Cmp(h.entries[j].effectiveTip(h.baseFee))
+	if cmp != 0 {
+		return cmp > 0
+	}
+	return h.entries[i].arrival < h.entries[j].arrival
+}
+
+func (h *priceHeap) Swap(i, j int) { h.entries[i], h.entries[j] = h.entries[j], h.entries[i] }
+
+func (h *priceHeap) Push(x interface{}) {
+	h.entries = append(h.entries, x.(*txEntry))
+}
+
+func (h *priceHeap) Pop() interface{} {
+	old := h.entries
+	n := len(old)
+	e := old[n-1]
+	old[n-1] = nil
+	h.entries = old[:n-1]
+	return e
+}
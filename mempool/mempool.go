@@ -0,0 +1,447 @@
+package mempool
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkmempool "github.com/cosmos/cosmos-sdk/types/mempool"
+)
+
+// DefaultPriceBump is the default minimum price bump percentage required to
+// replace a transaction with the same nonce, matching go-ethereum's txpool.
+const DefaultPriceBump uint64 = 10
+
+var (
+	// ErrReplaceUnderpriced is returned if a transaction is attempted to be
+	// replaced with a different one without the required price bump.
+	ErrReplaceUnderpriced = errors.New("replacement transaction underpriced")
+
+	// ErrNonceTooLow is returned if the nonce of a transaction is lower than
+	// the one expected by the chain for its sender.
+	ErrNonceTooLow = errors.New("nonce too low")
+
+	// ErrNonceGapTooLarge is returned if the nonce of a transaction is too far
+	// ahead of the next executable nonce of its sender to be queued.
+	ErrNonceGapTooLarge = errors.New("nonce gap too large")
+)
+
+var _ sdkmempool.ExtMempool = (*EVMMempool)(nil)
+
+// Config defines the configuration of the EVM mempool.
+type Config struct {
+	// PriceBump is the minimum percentage by which both the fee cap and the tip
+	// cap of a transaction must be increased to replace a transaction with the
+	// same nonce.
+	PriceBump uint64
+	// MaxTx caps the number of EVM transactions held by the pool. If zero, the
+	// number of transactions is not capped.
+	MaxTx int
+	// MaxNonceGap is the maximum distance between the nonce of a queued
+	// transaction and the next executable nonce of its sender. If zero, the
+	// distance is not capped.
+	MaxNonceGap uint64
+	// CosmosPool is the mempool used for non-EVM transactions. If nil, a
+	// priority nonce mempool with the default configuration is used.
+	CosmosPool sdkmempool.Mempool
+}
+
+// DefaultConfig returns the default EVM mempool configuration.
+func DefaultConfig() Config {
+	return Config{
+		PriceBump:   DefaultPriceBump,
+		MaxNonceGap: 64,
+	}
+}
+
+// EVMMempool is an application-side mempool that handles Ethereum transactions
+// the way go-ethereum's txpool does. Transactions are tracked per sender and
+// split in two tiers:
+//
+//   - pending: transactions whose nonces form a contiguous sequence starting at
+//     the next nonce expected by the chain, which are ready to be included in a block.
+//   - queued: transactions that can't be executed yet because of a nonce gap.
+//
+// Block proposals select pending transactions by effective tip while keeping the
+// nonce ordering of each sender. A transaction with the same sender and nonce as
+// an existing one replaces it only if it pays at least PriceBump percent more.
+// The replaced transactions are tracked until they are evicted from the CometBFT
+// mempool on ReCheckTx (see IsReplaced), since it still holds them. Non-EVM
+// transactions are delegated to a Cosmos mempool and are selected after the EVM
+// ones.
+type EVMMempool struct {
+	mtx sync.RWMutex
+
+	vmKeeper   VMKeeper
+	cosmosPool sdkmempool.Mempool
+	cfg        Config
+
+	senders map[common.Address]*txList
+	all     map[common.Hash]*txEntry
+	// replaced holds the transactions replaced by others with the same sender
+	// and nonce, which are still in the CometBFT mempool.
+	replaced map[common.Hash]*txEntry
+	baseFee  *big.Int
+	arrival  uint64
+}
+
+// NewEVMMempool creates a new EVM mempool with the given configuration.
+func NewEVMMempool(vmKeeper VMKeeper, cfg Config) *EVMMempool {
+	cosmosPool := cfg.CosmosPool
+	if cosmosPool == nil {
+		cosmosPool = sdkmempool.DefaultPriorityMempool()
+	}
+
+	return &EVMMempool{
+		vmKeeper:   vmKeeper,
+		cosmosPool: cosmosPool,
+		cfg:        cfg,
+		senders:    make(map[common.Address]*txList),
+		all:        make(map[common.Hash]*txEntry),
+		replaced:   make(map[common.Hash]*txEntry),
+	}
+}
+
+// Insert implements the sdkmempool.Mempool interface. It is called after the
+// ante handler has successfully run in CheckTx, so the transaction nonce is
+// validated against the sender sequence the ante handler observed, which is
+// read from the context. If it is not set, the sequence of the context state
+// is used instead.
+func (m *EVMMempool) Insert(goCtx context.Context, tx sdk.Tx) error {
+	msg := getEthMsg(tx)
+	if msg == nil {
+		return m.cosmosPool.Insert(goCtx, tx)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	ethTx := msg.AsTransaction()
+	if ethTx == nil {
+		return fmt.Errorf("failed to unpack ethereum transaction data")
+	}
+
+	from := msg.GetSender()
+	nonce := ethTx.Nonce()
+	seq, ok := evmtypes.AccountSequenceFromContext(ctx)
+	if !ok {
+		seq = m.vmKeeper.GetNonce(ctx, from)
+	}
+
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	entry := &txEntry{
+		tx:    tx,
+		msg:   msg,
+		ethTx: ethTx,
+		from:  from,
+		hash:  ethTx.Hash(),
+	}
+
+	list, ok := m.senders[from]
+	if !ok {
+		list = newTxList(seq)
+	}
+
+	// replace a transaction with the same nonce
+	if old := list.Get(nonce); old != nil {
+		if old.hash == entry.hash {
+			return nil
+		}
+		if !replaces(old.ethTx, ethTx, m.cfg.PriceBump) {
+			return ErrReplaceUnderpriced
+		}
+		m.add(list, entry)
+		delete(m.all, old.hash)
+		m.replaced[old.hash] = old
+		return nil
+	}
+
+	if m.cfg.MaxTx > 0 && len(m.all) >= m.cfg.MaxTx {
+		return sdkmempool.ErrMempoolTxMaxCapacity
+	}
+
+	if nonce < seq {
+		// the nonce was already used, either by a committed transaction or by a
+		// transaction that is still pending in the pool
+		return fmt.Errorf("%w: address %s, tx nonce %d, next nonce %d", ErrNonceTooLow, from, nonce, seq)
+	}
+
+	pendingNonce := list.PendingNonce()
+	if pendingNonce < seq {
+		pendingNonce = seq
+	}
+	if m.cfg.MaxNonceGap > 0 && nonce > pendingNonce+m.cfg.MaxNonceGap {
+		return fmt.Errorf("%w: address %s, tx nonce %d, next nonce %d", ErrNonceGapTooLarge, from, nonce, pendingNonce)
+	}
+	m.add(list, entry)
+
+	m.senders[from] = list
+	return nil
+}
+
+// add indexes the entry and stores it in the sender list. It must be called
+// with the lock held.
+func (m *EVMMempool) add(list *txList, entry *txEntry) {
+	m.arrival++
+	entry.arrival = m.arrival
+	list.Put(entry)
+	m.all[entry.hash] = entry
+}
+
+// Select implements the sdkmempool.Mempool interface. If the context is an SDK
+// context, the pool is first synced with the sender nonces and base fee of its
+// state, dropping the transactions that can no longer be executed.
+func (m *EVMMempool) Select(goCtx context.Context, txs [][]byte) sdkmempool.Iterator {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	return m.selectLocked(goCtx, txs)
+}
+
+// SelectBy implements the sdkmempool.ExtMempool interface. The pool is locked
+// while iterating over the transactions.
+func (m *EVMMempool) SelectBy(goCtx context.Context, txs [][]byte, callback func(sdk.Tx) bool) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	iter := m.selectLocked(goCtx, txs)
+	for iter != nil && callback(iter.Tx()) {
+		iter = iter.Next()
+	}
+}
+
+func (m *EVMMempool) selectLocked(goCtx context.Context, txs [][]byte) sdkmempool.Iterator {
+	if ctx, ok := goCtx.(sdk.Context); ok {
+		m.sync(ctx)
+	}
+
+	pending := make(map[common.Address][]*txEntry, len(m.senders))
+	for from, list := range m.senders {
+		if txs := list.Pending(); len(txs) > 0 {
+			pending[from] = txs
+		}
+	}
+
+	return newIterator(pending, m.baseFee, m.cosmosPool.Select(goCtx, txs))
+}
+
+// sync updates the next expected nonce of every sender and the base fee from
+// the given state. It must be called with the lock held.
+func (m *EVMMempool) sync(ctx sdk.Context) {
+	m.baseFee = m.vmKeeper.GetBaseFee(ctx)
+
+	for from, list := range m.senders {
+		for _, e := range list.Forward(m.vmKeeper.GetNonce(ctx, from)) {
+			delete(m.all, e.hash)
+		}
+		if list.Len() == 0 {
+			delete(m.senders, from)
+		}
+	}
+
+	// the replaced transactions with a used nonce fail ReCheckTx anyway
+	for hash, e := range m.replaced {
+		if e.ethTx.Nonce() < m.vmKeeper.GetNonce(ctx, e.from) {
+			delete(m.replaced, hash)
+		}
+	}
+}
+
+// CountTx implements the sdkmempool.Mempool interface.
+func (m *EVMMempool) CountTx() int {
+	m.mtx.RLock()
+	defer m.mtx.RUnlock()
+
+	return len(m.all) + m.cosmosPool.CountTx()
+}
+
+// Sync updates the next expected nonce of every sender and the base fee from
+// the given state, dropping the transactions that can no longer be executed.
+// It must be called with the check state once a block is committed, so that
+// the transactions following the committed ones become executable.
+func (m *EVMMempool) Sync(ctx sdk.Context) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	m.sync(ctx)
+}
+
+// Remove implements the sdkmempool.Mempool interface. Removing a transaction
+// doesn't move the next expected nonce of its sender, since it is called both
+// for the transactions included in a block and for the ones evicted on
+// ReCheckTx, whose nonces are not used. The transactions following an evicted
+// one are therefore queued, while the ones following a committed one become
+// executable once the pool is synced with the committed state. Removing a
+// replaced transaction stops tracking it.
+func (m *EVMMempool) Remove(tx sdk.Tx) error {
+	msg := getEthMsg(tx)
+	if msg == nil {
+		return m.cosmosPool.Remove(tx)
+	}
+
+	ethTx := msg.AsTransaction()
+	if ethTx == nil {
+		return sdkmempool.ErrTxNotFound
+	}
+
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	entry, ok := m.all[ethTx.Hash()]
+	if !ok {
+		if _, ok := m.replaced[ethTx.Hash()]; ok {
+			delete(m.replaced, ethTx.Hash())
+			return nil
+		}
+		return sdkmempool.ErrTxNotFound
+	}
+
+	list := m.senders[entry.from]
+	list.Remove(entry.ethTx.Nonce())
+	delete(m.all, entry.hash)
+
+	if list.Len() == 0 {
+		delete(m.senders, entry.from)
+	}
+
+	return nil
+}
+
+// HasPendingTx returns true if the pool holds an executable transaction with
+// the given sender and nonce.
+func (m *EVMMempool) HasPendingTx(from common.Address, nonce uint64) bool {
+	m.mtx.RLock()
+	defer m.mtx.RUnlock()
+
+	list, ok := m.senders[from]
+	return ok && nonce >= list.nonce && nonce < list.PendingNonce()
+}
+
+// IsReplaced returns true if the transaction with the given hash was replaced
+// by another one with the same sender and nonce. The ante handler must reject
+// it on ReCheckTx, so that it is evicted from the CometBFT mempool before it
+// uses the nonce of the transaction replacing it.
+func (m *EVMMempool) IsReplaced(hash common.Hash) bool {
+	m.mtx.RLock()
+	defer m.mtx.RUnlock()
+
+	_, ok := m.replaced[hash]
+	return ok
+}
+
+// Pending returns the executable EVM transactions of the pool grouped by
+// sender and sorted by nonce.
+func (m *EVMMempool) Pending() map[common.Address][]*evmtypes.MsgEthereumTx {
+	m.mtx.RLock()
+	defer m.mtx.RUnlock()
+
+	pending := make(map[common.Address][]*evmtypes.MsgEthereumTx)
+	for from, list := range m.senders {
+		if msgs := toMsgs(list.Pending()); len(msgs) > 0 {
+			pending[from] = msgs
+		}
+	}
+	return pending
+}
+
+// Queued returns the non-executable EVM transactions of the pool grouped by
+// sender and sorted by nonce.
+func (m *EVMMempool) Queued() map[common.Address][]*evmtypes.MsgEthereumTx {
+	m.mtx.RLock()
+	defer m.mtx.RUnlock()
+
+	queued := make(map[common.Address][]*evmtypes.MsgEthereumTx)
+	for from, list := range m.senders {
+		if msgs := toMsgs(list.Queued()); len(msgs) > 0 {
+			queued[from] = msgs
+		}
+	}
+	return queued
+}
+
+// PendingInOrder returns the executable EVM transactions of the pool in the
+// order they would be selected for the next block, under the base fee last
+// observed by the pool.
+func (m *EVMMempool) PendingInOrder() []*evmtypes.MsgEthereumTx {
+	m.mtx.RLock()
+	defer m.mtx.RUnlock()
+
+	pending := make(map[common.Address][]*txEntry)
+	for from, list := range m.senders {
+		if entries := list.Pending(); len(entries) > 0 {
+			pending[from] = entries
+		}
+	}
+
+	var msgs []*evmtypes.MsgEthereumTx
+	for it := newIterator(pending, m.baseFee, nil); it != nil; it = it.Next() {
+		msgs = append(msgs, getEthMsg(it.Tx()))
+	}
+	return msgs
+}
+
+// ContentFrom returns the pending and queued EVM transactions of the given
+// sender, sorted by nonce.
+func (m *EVMMempool) ContentFrom(addr common.Address) (pending, queued []*evmtypes.MsgEthereumTx) {
+	m.mtx.RLock()
+	defer m.mtx.RUnlock()
+
+	list, ok := m.senders[addr]
+	if !ok {
+		return nil, nil
+	}
+	return toMsgs(list.Pending()), toMsgs(list.Queued())
+}
+
+// Stats returns the number of pending and queued EVM transactions of the pool.
+func (m *EVMMempool) Stats() (pending, queued int) {
+	m.mtx.RLock()
+	defer m.mtx.RUnlock()
+
+	for _, list := range m.senders {
+		p := len(list.Pending())
+		pending += p
+		queued += list.Len() - p
+	}
+	return pending, queued
+}
+
+// BaseFee returns the base fee last observed by the pool.
+func (m *EVMMempool) BaseFee() *big.Int {
+	m.mtx.RLock()
+	defer m.mtx.RUnlock()
+
+	if m.baseFee == nil {
+		return nil
+	}
+	return new(big.Int).Set(m.baseFee)
+}
+
+// getEthMsg returns the Ethereum message of the transaction, or nil if it is
+// not an Ethereum transaction.
+func getEthMsg(tx sdk.Tx) *evmtypes.MsgEthereumTx {
+	msgs := tx.GetMsgs()
+	if len(msgs) != 1 {
+		return nil
+	}
+	msg, ok := msgs[0].(*evmtypes.MsgEthereumTx)
+	if !ok {
+		return nil
+	}
+	return msg
+}
+
+func toMsgs(entries []*txEntry) []*evmtypes.MsgEthereumTx {
+	msgs := make([]*evmtypes.MsgEthereumTx, len(entries))
+	for i, e := range entries {
+		msgs[i] = e.msg
+	}
+	return msgs
+}
@@ -0,0 +1,298 @@
+package mempool_test
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+	protov2 "google.golang.org/protobuf/proto"
+
+	"github.com/cosmos/evm/mempool"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkmempool "github.com/cosmos/cosmos-sdk/types/mempool"
+)
+
+type mockVMKeeper struct {
+	nonces  map[common.Address]uint64
+	baseFee *big.Int
+}
+
+func (k mockVMKeeper) GetNonce(_ sdk.Context, addr common.Address) uint64 {
+	return k.nonces[addr]
+}
+
+func (k mockVMKeeper) GetBaseFee(_ sdk.Context) *big.Int {
+	return k.baseFee
+}
+
+type testTx struct {
+	msg *evmtypes.MsgEthereumTx
+}
+
+func (tx testTx) GetMsgs() []sdk.Msg {
+	return []sdk.Msg{tx.msg}
+}
+
+func (tx testTx) GetMsgsV2() ([]protov2.Message, error) {
+	return nil, nil
+}
+
+var (
+	alice = common.HexToAddress("0x1000000000000000000000000000000000000001")
+	bob   = common.HexToAddress("0x2000000000000000000000000000000000000002")
+)
+
+func newTx(from common.Address, nonce uint64, feeCap, tipCap int64) testTx {
+	to := common.HexToAddress("0x3000000000000000000000000000000000000003")
+	msg := evmtypes.NewTx(&evmtypes.EvmTxArgs{
+		Nonce:     nonce,
+		GasLimit:  21000,
+		GasFeeCap: big.NewInt(feeCap),
+		GasTipCap: big.NewInt(tipCap),
+		ChainID:   big.NewInt(1),
+		Amount:    big.NewInt(1),
+		To:        &to,
+	})
+	msg.From = from.Bytes()
+	return testTx{msg: msg}
+}
+
+// insert inserts the transaction in the pool after simulating the sequence
+// increment done by the ante handler.
+func insert(t *testing.T, pool *mempool.EVMMempool, keeper mockVMKeeper, tx testTx) error {
+	t.Helper()
+	from := tx.msg.GetSender()
+	ctx := evmtypes.ContextWithAccountSequence(sdk.Context{}.WithContext(context.Background()), keeper.nonces[from])
+	if tx.msg.AsTransaction().Nonce() == keeper.nonces[from] {
+		keeper.nonces[from]++
+	}
+	return pool.Insert(ctx, tx)
+}
+
+func selectNonces(pool *mempool.EVMMempool) []string {
+	var order []string
+	for iter := pool.Select(sdk.Context{}, nil); iter != nil; iter = iter.Next() {
+		msg := iter.Tx().GetMsgs()[0].(*evmtypes.MsgEthereumTx)
+		name := "alice"
+		if msg.GetSender() == bob {
+			name = "bob"
+		}
+		order = append(order, fmt.Sprintf("%s-%d", name, msg.AsTransaction().Nonce()))
+	}
+	return order
+}
+
+func TestPendingAndQueued(t *testing.T) {
+	checkState := mockVMKeeper{nonces: map[common.Address]uint64{}}
+	pool := mempool.NewEVMMempool(checkState, mempool.DefaultConfig())
+
+	require.NoError(t, insert(t, pool, checkState, newTx(alice, 0, 100, 10)))
+	require.NoError(t, insert(t, pool, checkState, newTx(alice, 2, 100, 10)))
+	require.NoError(t, insert(t, pool, checkState, newTx(alice, 3, 100, 10)))
+
+	pending, queued := pool.Stats()
+	require.Equal(t, 1, pending)
+	require.Equal(t, 2, queued)
+
+	// filling the gap promotes the queued transactions
+	require.NoError(t, insert(t, pool, checkState, newTx(alice, 1, 100, 10)))
+	pending, queued = pool.Stats()
+	require.Equal(t, 4, pending)
+	require.Equal(t, 0, queued)
+	require.Len(t, pool.Pending()[alice], 4)
+	require.Equal(t, 4, pool.CountTx())
+}
+
+func TestNonceValidation(t *testing.T) {
+	checkState := mockVMKeeper{nonces: map[common.Address]uint64{alice: 5}}
+	cfg := mempool.DefaultConfig()
+	cfg.MaxNonceGap = 2
+	pool := mempool.NewEVMMempool(checkState, cfg)
+
+	err := insert(t, pool, checkState, newTx(alice, 3, 100, 10))
+	require.ErrorIs(t, err, mempool.ErrNonceTooLow)
+
+	err = insert(t, pool, checkState, newTx(alice, 8, 100, 10))
+	require.ErrorIs(t, err, mempool.ErrNonceGapTooLarge)
+
+	require.NoError(t, insert(t, pool, checkState, newTx(alice, 7, 100, 10)))
+	_, queued := pool.ContentFrom(alice)
+	require.Len(t, queued, 1)
+}
+
+func TestReplacement(t *testing.T) {
+	checkState := mockVMKeeper{nonces: map[common.Address]uint64{}}
+	pool := mempool.NewEVMMempool(checkState, mempool.DefaultConfig())
+
+	require.NoError(t, insert(t, pool, checkState, newTx(alice, 1, 100, 10)))
+
+	// less than 10% bump on the tip
+	err := insert(t, pool, checkState, newTx(alice, 1, 200, 10))
+	require.ErrorIs(t, err, mempool.ErrReplaceUnderpriced)
+
+	// less than 10% bump on the fee cap
+	err = insert(t, pool, checkState, newTx(alice, 1, 105, 20))
+	require.ErrorIs(t, err, mempool.ErrReplaceUnderpriced)
+
+	replacement := newTx(alice, 1, 110, 11)
+	require.NoError(t, insert(t, pool, checkState, replacement))
+	require.Equal(t, 1, pool.CountTx())
+
+	_, queued := pool.ContentFrom(alice)
+	require.Len(t, queued, 1)
+	require.Equal(t, replacement.msg.AsTransaction().Hash(), queued[0].AsTransaction().Hash())
+}
+
+func TestReplacePending(t *testing.T) {
+	checkState := mockVMKeeper{nonces: map[common.Address]uint64{}}
+	pool := mempool.NewEVMMempool(checkState, mempool.DefaultConfig())
+
+	require.NoError(t, insert(t, pool, checkState, newTx(alice, 0, 100, 10)))
+	require.True(t, pool.HasPendingTx(alice, 0))
+	require.False(t, pool.HasPendingTx(alice, 1))
+	require.False(t, pool.HasPendingTx(bob, 0))
+
+	// the nonce of the pending tx is already used in the check state
+	err := insert(t, pool, checkState, newTx(alice, 0, 105, 20))
+	require.ErrorIs(t, err, mempool.ErrReplaceUnderpriced)
+
+	replacement := newTx(alice, 0, 110, 11)
+	require.NoError(t, insert(t, pool, checkState, replacement))
+	require.Equal(t, 1, pool.CountTx())
+
+	pending, queued := pool.ContentFrom(alice)
+	require.Empty(t, queued)
+	require.Len(t, pending, 1)
+	require.Equal(t, replacement.msg.AsTransaction().Hash(), pending[0].AsTransaction().Hash())
+}
+
+func TestRemoveReplaced(t *testing.T) {
+	checkState := mockVMKeeper{nonces: map[common.Address]uint64{}}
+	pool := mempool.NewEVMMempool(checkState, mempool.DefaultConfig())
+
+	first := newTx(alice, 0, 100, 10)
+	require.NoError(t, insert(t, pool, checkState, first))
+	second := newTx(alice, 1, 100, 10)
+	require.NoError(t, insert(t, pool, checkState, second))
+	require.NoError(t, insert(t, pool, checkState, newTx(alice, 0, 110, 11)))
+	require.NoError(t, insert(t, pool, checkState, newTx(alice, 1, 110, 11)))
+
+	// the replaced txs are tracked until they are evicted on ReCheckTx
+	require.True(t, pool.IsReplaced(first.msg.AsTransaction().Hash()))
+	require.True(t, pool.IsReplaced(second.msg.AsTransaction().Hash()))
+	require.Equal(t, 2, pool.CountTx())
+
+	require.NoError(t, pool.Remove(first))
+	require.False(t, pool.IsReplaced(first.msg.AsTransaction().Hash()))
+	require.ErrorIs(t, pool.Remove(first), sdkmempool.ErrTxNotFound)
+	pending, _ := pool.Stats()
+	require.Equal(t, 2, pending)
+
+	// or until their nonce is used
+	checkState.nonces[alice] = 2
+	pool.Sync(sdk.Context{})
+	require.False(t, pool.IsReplaced(second.msg.AsTransaction().Hash()))
+}
+
+func TestStaleNonce(t *testing.T) {
+	checkState := mockVMKeeper{nonces: map[common.Address]uint64{}}
+	pool := mempool.NewEVMMempool(checkState, mempool.DefaultConfig())
+
+	first := newTx(alice, 0, 100, 10)
+	require.NoError(t, insert(t, pool, checkState, first))
+	require.NoError(t, pool.Remove(first))
+	pool.Sync(sdk.Context{})
+
+	// a different transaction with the nonce of the committed one is stale and
+	// doesn't rewind the next nonce of the sender
+	err := insert(t, pool, checkState, newTx(alice, 0, 200, 20))
+	require.ErrorIs(t, err, mempool.ErrNonceTooLow)
+
+	require.NoError(t, insert(t, pool, checkState, newTx(alice, 1, 100, 10)))
+	pending, queued := pool.Stats()
+	require.Equal(t, 1, pending)
+	require.Equal(t, 0, queued)
+}
+
+func TestSelectOrdering(t *testing.T) {
+	checkState := mockVMKeeper{nonces: map[common.Address]uint64{}, baseFee: big.NewInt(50)}
+	pool := mempool.NewEVMMempool(checkState, mempool.DefaultConfig())
+
+	// alice pays a low tip on her first tx and a high one on the second
+	require.NoError(t, insert(t, pool, checkState, newTx(alice, 0, 100, 5)))
+	require.NoError(t, insert(t, pool, checkState, newTx(alice, 1, 100, 40)))
+	// bob's effective tip is capped by the fee cap: min(30, 80-50) = 30
+	require.NoError(t, insert(t, pool, checkState, newTx(bob, 0, 80, 30)))
+	// queued transactions are never selected
+	require.NoError(t, insert(t, pool, checkState, newTx(bob, 5, 1000, 1000)))
+
+	// proposals are built on top of the committed state
+	checkState.nonces[alice], checkState.nonces[bob] = 0, 0
+	require.Equal(t, []string{"bob-0", "alice-0", "alice-1"}, selectNonces(pool))
+	require.Equal(t, big.NewInt(50), pool.BaseFee())
+
+	// the pending block lists the transactions in the selection order
+	var pendingOrder []string
+	for _, msg := range pool.PendingInOrder() {
+		name := "alice"
+		if msg.GetSender() == bob {
+			name = "bob"
+		}
+		pendingOrder = append(pendingOrder, fmt.Sprintf("%s-%d", name, msg.AsTransaction().Nonce()))
+	}
+	require.Equal(t, []string{"bob-0", "alice-0", "alice-1"}, pendingOrder)
+}
+
+func TestRemoveAndSync(t *testing.T) {
+	checkState := mockVMKeeper{nonces: map[common.Address]uint64{}}
+	pool := mempool.NewEVMMempool(checkState, mempool.DefaultConfig())
+
+	first := newTx(alice, 0, 100, 10)
+	require.NoError(t, insert(t, pool, checkState, first))
+	require.NoError(t, insert(t, pool, checkState, newTx(alice, 1, 100, 10)))
+	require.NoError(t, insert(t, pool, checkState, newTx(alice, 2, 100, 10)))
+
+	// removing the first tx, as done when it gets included in a block, keeps
+	// the following ones executable once the pool is synced with the
+	// committed state
+	require.NoError(t, pool.Remove(first))
+	require.ErrorIs(t, pool.Remove(first), sdkmempool.ErrTxNotFound)
+	checkState.nonces[alice] = 1
+	pool.Sync(sdk.Context{})
+	pending, queued := pool.Stats()
+	require.Equal(t, 2, pending)
+	require.Equal(t, 0, queued)
+
+	// the committed state moved past the second tx, which got replaced by a
+	// transaction received by another node
+	checkState.nonces[alice] = 2
+	require.Equal(t, []string{"alice-2"}, selectNonces(pool))
+	require.Equal(t, 1, pool.CountTx())
+}
+
+func TestRemoveEvicted(t *testing.T) {
+	checkState := mockVMKeeper{nonces: map[common.Address]uint64{}}
+	pool := mempool.NewEVMMempool(checkState, mempool.DefaultConfig())
+
+	first := newTx(alice, 0, 100, 10)
+	require.NoError(t, insert(t, pool, checkState, first))
+	require.NoError(t, insert(t, pool, checkState, newTx(alice, 1, 100, 10)))
+	require.NoError(t, insert(t, pool, checkState, newTx(alice, 2, 100, 10)))
+
+	// evicting the first tx on ReCheckTx, for instance because its sender
+	// can't pay for it anymore, doesn't use its nonce
+	require.NoError(t, pool.Remove(first))
+	checkState.nonces[alice] = 0
+	pool.Sync(sdk.Context{})
+
+	pending, queued := pool.Stats()
+	require.Equal(t, 0, pending)
+	require.Equal(t, 2, queued)
+	require.False(t, pool.HasPendingTx(alice, 1))
+	require.Empty(t, selectNonces(pool))
+}
@@ -0,0 +1,147 @@
+package mempool
+
+import (
+	"math/big"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// txEntry wraps a transaction held by the pool together with its decoded
+// Ethereum representation, so that it doesn't need to be unpacked on every
+// comparison.
+type txEntry struct {
+	tx    sdk.Tx
+	msg   *evmtypes.MsgEthereumTx
+	ethTx *ethtypes.Transaction
+	from  common.Address
+	hash  common.Hash
+	// arrival is a monotonically increasing counter used to break ties between
+	// transactions paying the same effective tip (first come, first served).
+	arrival uint64
+}
+
+// effectiveTip returns the miner tip the transaction pays under the given base fee.
+// Transactions that don't cover the base fee are assigned a negative tip.
+func (e *txEntry) effectiveTip(baseFee *big.Int) *big.Int {
+	if baseFee == nil {
+		return e.ethTx.GasTipCap()
+	}
+	tip := new(big.Int).Sub(e.ethTx.GasFeeCap(), baseFee)
+	if tip.Cmp(e.ethTx.GasTipCap()) > 0 {
+		tip = e.ethTx.GasTipCap()
+	}
+	return tip
+}
+
+// txList holds all the transactions of a single sender indexed by nonce.
+type txList struct {
+	// nonce is the next nonce expected by the chain for the sender, as last
+	// observed by the pool.
+	nonce uint64
+	txs   map[uint64]*txEntry
+}
+
+// newTxList creates an empty list for a sender whose next expected nonce is
+// the given one.
+func newTxList(nonce uint64) *txList {
+	return &txList{
+		nonce: nonce,
+		txs:   make(map[uint64]*txEntry),
+	}
+}
+
+// Len returns the number of transactions (pending and queued) in the list.
+func (l *txList) Len() int {
+	return len(l.txs)
+}
+
+// Get returns the transaction with the given nonce, or nil if there is none.
+func (l *txList) Get(nonce uint64) *txEntry {
+	return l.txs[nonce]
+}
+
+// Put inserts the entry in the list, overriding any transaction with the same nonce.
+func (l *txList) Put(e *txEntry) {
+	l.txs[e.ethTx.Nonce()] = e
+}
+
+// Remove deletes the transaction with the given nonce from the list.
+func (l *txList) Remove(nonce uint64) {
+	delete(l.txs, nonce)
+}
+
+// Forward sets the next expected nonce of the sender and drops all
+// transactions with a lower nonce, returning them.
+func (l *txList) Forward(nonce uint64) []*txEntry {
+	var removed []*txEntry
+	for n, e := range l.txs {
+		if n < nonce {
+			removed = append(removed, e)
+			delete(l.txs, n)
+		}
+	}
+	l.nonce = nonce
+	return removed
+}
+
+// PendingNonce returns the nonce following the last transaction of the
+// contiguous sequence that starts at the next expected nonce of the sender.
+func (l *txList) PendingNonce() uint64 {
+	n := l.nonce
+	for l.txs[n] != nil {
+		n++
+	}
+	return n
+}
+
+// Pending returns the executable transactions of the list, sorted by nonce.
+func (l *txList) Pending() []*txEntry {
+	var pending []*txEntry
+	for n := l.nonce; l.txs[n] != nil; n++ {
+		pending = append(pending, l.txs[n])
+	}
+	return pending
+}
+
+// Queued returns the transactions of the list that are not executable because
+// of a nonce gap, sorted by nonce.
+func (l *txList) Queued() []*txEntry {
+	pendingNonce := l.PendingNonce()
+
+	var queued []*txEntry
+	for n, e := range l.txs {
+		if n >= pendingNonce {
+			queued = append(queued, e)
+		}
+	}
+	sort.Slice(queued, func(i, j int) bool {
+		return queued[i].ethTx.Nonce() < queued[j].ethTx.Nonce()
+	})
+	return queued
+}
+
+// replaces checks whether the new transaction pays enough to replace the old
+// one, that is, both its fee cap and its tip cap are higher than the old ones
+// by at least priceBump percent.
+func replaces(oldTx, newTx *ethtypes.Transaction, priceBump uint64) bool {
+	if newTx.GasFeeCap().Cmp(oldTx.GasFeeCap()) <= 0 || newTx.GasTipCap().Cmp(oldTx.GasTipCap()) <= 0 {
+		return false
+	}
+
+	bump := new(big.Int).SetUint64(100 + priceBump)
+	hundred := big.NewInt(100)
+
+	// thresholds are computed as old * (100 + bump) / 100
+	minFeeCap := new(big.Int).Mul(oldTx.GasFeeCap(), bump)
+	minFeeCap.Div(minFeeCap, hundred)
+	minTipCap := new(big.Int).Mul(oldTx.GasTipCap(), bump)
+	minTipCap.Div(minTipCap, hundred)
+
+	return newTx.GasFeeCap().Cmp(minFeeCap) >= 0 && newTx.GasTipCap().Cmp(minTipCap) >= 0
+}
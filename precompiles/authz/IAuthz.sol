@@ -0,0 +1,141 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.17;
+
+import "../common/Types.sol";
+
+/// @dev The IAuthz contract's address.
+address constant AUTHZ_PRECOMPILE_ADDRESS = 0x0000000000000000000000000000000000000807;
+
+/// @dev The IAuthz contract's instance.
+IAuthz constant AUTHZ_CONTRACT = IAuthz(AUTHZ_PRECOMPILE_ADDRESS);
+
+/// @dev GrantData is the grant of an authorization from a granter to a grantee.
+struct GrantData {
+    /// @dev The address of the account granting the authorization
+    address granter;
+    /// @dev The address of the account receiving the authorization
+    address grantee;
+    /// @dev The type URL of the authorization, e.g. "/cosmos.authz.v1beta1.GenericAuthorization"
+    string authorizationType;
+    /// @dev The type URL of the messages allowed by the authorization
+    string msgTypeUrl;
+    /// @dev The coins that can still be sent, only set for send authorizations
+    Coin[] spendLimit;
+    /// @dev The only recipients of the coins, only set for send authorizations
+    address[] allowList;
+    /// @dev The unix time at which the grant expires, or zero if it does not expire
+    int64 expiration;
+}
+
+/// @author Evmos Team
+/// @title Authz Precompiled Contract
+/// @dev The interface through which solidity contracts will interact with the Cosmos SDK authz module.
+/// A granter allows a grantee to execute messages on its behalf, either any message of a type with
+/// a generic authorization, or bank sends within a spend limit with a send authorization.
+/// @custom:address 0x0000000000000000000000000000000000000807
+interface IAuthz {
+    /// @dev Emitted when an authorization is granted
+    /// @param granter The address of the granter
+    /// @param grantee The address of the grantee
+    /// @param msgTypeUrl The type URL of the messages allowed by the authorization
+    /// @param expiration The unix time at which the grant expires, or zero if it does not expire
+    event Grant(address indexed granter, address indexed grantee, string msgTypeUrl, int64 expiration);
+
+    /// @dev Emitted when an authorization is revoked
+    /// @param granter The address of the granter
+    /// @param grantee The address of the grantee
+    /// @param msgTypeUrl The type URL of the messages of the revoked authorization
+    event Revoke(address indexed granter, address indexed grantee, string msgTypeUrl);
+
+    /// @dev Emitted when a grantee executes messages with its authorizations
+    /// @param grantee The address of the grantee
+    /// @param msgTypeUrls The type URLs of the executed messages
+    event Exec(address indexed grantee, string[] msgTypeUrls);
+
+    /// TRANSACTIONS
+
+    /// @dev Grants a generic authorization to execute any message of a type on behalf of the granter.
+    /// @param granter The address of the granter, which must be the caller
+    /// @param grantee The address of the grantee
+    /// @param msgTypeUrl The type URL of the allowed messages, e.g. "/cosmos.staking.v1beta1.MsgDelegate"
+    /// @param expiration The unix time at which the grant expires, or zero if it does not expire
+    /// @return success Whether the transaction was successful or not
+    function grant(
+        address granter,
+        address grantee,
+        string calldata msgTypeUrl,
+        int64 expiration
+    ) external returns (bool success);
+
+    /// @dev Grants a send authorization to send coins of the granter within a spend limit.
+    /// @param granter The address of the granter, which must be the caller
+    /// @param grantee The address of the grantee
+    /// @param spendLimit The coins that can be sent with the authorization
+    /// @param allowList The only recipients of the coins, or empty to allow any recipient
+    /// @param expiration The unix time at which the grant expires, or zero if it does not expire
+    /// @return success Whether the transaction was successful or not
+    function grantSend(
+        address granter,
+        address grantee,
+        Coin[] calldata spendLimit,
+        address[] calldata allowList,
+        int64 expiration
+    ) external returns (bool success);
+
+    /// @dev Revokes the authorization of a grantee to execute messages of a type.
+    /// @param granter The address of the granter, which must be the caller
+    /// @param grantee The address of the grantee
+    /// @param msgTypeUrl The type URL of the messages of the authorization
+    /// @return success Whether the transaction was successful or not
+    function revoke(
+        address granter,
+        address grantee,
+        string calldata msgTypeUrl
+    ) external returns (bool success);
+
+    /// @dev Executes messages on behalf of their signers with the authorizations granted to the grantee.
+    /// @dev Messages signed by the grantee itself are rejected, each message requires a grant.
+    /// @param grantee The address of the grantee, which must be the caller
+    /// @param msgs The protoJSON encoded messages, e.g. {"@type": "/cosmos.bank.v1beta1.MsgSend", ...}
+    /// @return results The protobuf encoded responses of the messages
+    function exec(
+        address grantee,
+        bytes[] calldata msgs
+    ) external returns (bytes[] memory results);
+
+    /// QUERIES
+
+    /// @dev Returns the grants of a granter to a grantee.
+    /// @param granter The address of the granter
+    /// @param grantee The address of the grantee
+    /// @param msgTypeUrl The type URL of the messages of the grant, or empty for all the grants
+    /// @param pagination The pagination options
+    /// @return grants The grants
+    /// @return pageResponse The pagination response
+    function grants(
+        address granter,
+        address grantee,
+        string calldata msgTypeUrl,
+        PageRequest calldata pagination
+    ) external view returns (GrantData[] memory grants, PageResponse memory pageResponse);
+
+    /// @dev Returns the grants given by a granter.
+    /// @param granter The address of the granter
+    /// @param pagination The pagination options
+    /// @return grants The grants
+    /// @return pageResponse The pagination response
+    function granterGrants(
+        address granter,
+        PageRequest calldata pagination
+    ) external view returns (GrantData[] memory grants, PageResponse memory pageResponse);
+
+    /// @dev Returns the grants received by a grantee.
+    /// @param grantee The address of the grantee
+    /// @param pagination The pagination options
+    /// @return grants The grants
+    /// @return pageResponse The pagination response
+    function granteeGrants(
+        address grantee,
+        PageRequest calldata pagination
+    ) external view returns (GrantData[] memory grants, PageResponse memory pageResponse);
+}
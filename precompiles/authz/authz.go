@@ -0,0 +1,181 @@
+package authz
+
+import (
+	"embed"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/tracing"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	cosmosante "github.com/cosmos/evm/ante/cosmos"
+	cmn "github.com/cosmos/evm/precompiles/common"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"cosmossdk.io/core/address"
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
+)
+
+var _ vm.PrecompiledContract = &Precompile{}
+
+// Embed abi json file to the executable binary. Needed when importing as dependency.
+//
+//go:embed abi.json
+var f embed.FS
+
+// Precompile defines the precompiled contract for authz.
+type Precompile struct {
+	cmn.Precompile
+	authzKeeper authzkeeper.Keeper
+	// limiter blocks the msg types that cannot be granted or executed with
+	// authz, like the Cosmos transactions' AuthzLimiterDecorator does.
+	limiter cosmosante.AuthzLimiterDecorator
+	cdc     codec.Codec
+	addrCdc address.Codec
+}
+
+// LoadABI loads the authz ABI from the embedded abi.json file
+// for the authz precompile.
+func LoadABI() (abi.ABI, error) {
+	return cmn.LoadABI(f, "abi.json")
+}
+
+// NewPrecompile creates a new authz Precompile instance as a
+// PrecompiledContract interface.
+func NewPrecompile(
+	authzKeeper authzkeeper.Keeper,
+	limiter cosmosante.AuthzLimiterDecorator,
+	cdc codec.Codec,
+	addrCdc address.Codec,
+) (*Precompile, error) {
+	abi, err := LoadABI()
+	if err != nil {
+		return nil, err
+	}
+
+	p := &Precompile{
+		Precompile: cmn.Precompile{
+			ABI:                  abi,
+			KvGasConfig:          storetypes.KVGasConfig(),
+			TransientKVGasConfig: storetypes.TransientGasConfig(),
+		},
+		authzKeeper: authzKeeper,
+		limiter:     limiter,
+		cdc:         cdc,
+		addrCdc:     addrCdc,
+	}
+
+	// SetAddress defines the address of the authz precompiled contract.
+	p.SetAddress(common.HexToAddress(evmtypes.AuthzPrecompileAddress))
+
+	return p, nil
+}
+
+// RequiredGas calculates the precompiled contract's base gas rate.
+func (p Precompile) RequiredGas(input []byte) uint64 {
+	// NOTE: This check avoid panicking when trying to decode the method ID
+	if len(input) < 4 {
+		return 0
+	}
+	methodID := input[:4]
+
+	method, err := p.MethodById(methodID)
+	if err != nil {
+		// This should never happen since this method is going to fail during Run
+		return 0
+	}
+
+	return p.Precompile.RequiredGas(input, p.IsTransaction(method))
+}
+
+// Run executes the precompiled contract authz methods defined in the ABI.
+func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz []byte, err error) {
+	bz, err = p.run(evm, contract, readOnly)
+	if err != nil {
+		return cmn.ReturnRevertError(evm, err)
+	}
+
+	return bz, nil
+}
+
+func (p Precompile) run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz []byte, err error) {
+	ctx, stateDB, method, initialGas, args, err := p.RunSetup(evm, contract, readOnly, p.IsTransaction)
+	if err != nil {
+		return nil, err
+	}
+
+	// Start the balance change handler before executing the precompile.
+	p.GetBalanceHandler().BeforeBalanceChange(ctx)
+
+	// This handles any out of gas errors that may occur during the execution of a precompile tx or query.
+	// It avoids panics and returns the out of gas error so the EVM can continue gracefully.
+	defer cmn.HandleGasError(ctx, contract, initialGas, &err)()
+
+	switch method.Name {
+	// authz transactions
+	case GrantMethod:
+		bz, err = p.Grant(ctx, contract, stateDB, method, args)
+	case GrantSendMethod:
+		bz, err = p.GrantSend(ctx, contract, stateDB, method, args)
+	case RevokeMethod:
+		bz, err = p.Revoke(ctx, contract, stateDB, method, args)
+	case ExecMethod:
+		bz, err = p.Exec(ctx, contract, stateDB, method, args)
+	// authz queries
+	case GrantsMethod:
+		bz, err = p.Grants(ctx, contract, method, args)
+	case GranterGrantsMethod:
+		bz, err = p.GranterGrants(ctx, contract, method, args)
+	case GranteeGrantsMethod:
+		bz, err = p.GranteeGrants(ctx, contract, method, args)
+	default:
+		return nil, fmt.Errorf(cmn.ErrUnknownMethod, method.Name)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	cost := ctx.GasMeter().GasConsumed() - initialGas
+
+	if !contract.UseGas(cost, nil, tracing.GasChangeCallPrecompiledContract) {
+		return nil, vm.ErrOutOfGas
+	}
+
+	// Process the native balance changes after the method execution.
+	if err = p.GetBalanceHandler().AfterBalanceChange(ctx, stateDB); err != nil {
+		return nil, err
+	}
+
+	return bz, nil
+}
+
+// IsTransaction checks if the given method name corresponds to a transaction or query.
+//
+// Available authz transactions are:
+//   - Grant
+//   - GrantSend
+//   - Revoke
+//   - Exec
+func (Precompile) IsTransaction(method *abi.Method) bool {
+	switch method.Name {
+	case GrantMethod,
+		GrantSendMethod,
+		RevokeMethod,
+		ExecMethod:
+		return true
+	default:
+		return false
+	}
+}
+
+// Logger returns a precompile-specific logger.
+func (p Precompile) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("evm extension", "authz")
+}
@@ -0,0 +1,10 @@
+package authz
+
+const (
+	// ErrInvalidMsgTypeURL is raised when the msg type URL of an authorization is empty or invalid.
+	ErrInvalidMsgTypeURL = "invalid msg type URL: %v"
+	// ErrInvalidMsgs is raised when the messages to execute are empty or invalid.
+	ErrInvalidMsgs = "invalid messages: %v"
+	// ErrMsgSignedByGrantee is raised when a message to execute is signed by the grantee, which requires no grant.
+	ErrMsgSignedByGrantee = "message %d is signed by the grantee %s"
+)
@@ -0,0 +1,70 @@
+package authz
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// EventTypeGrant defines the event type for the authz Grant transactions.
+	EventTypeGrant = "Grant"
+	// EventTypeRevoke defines the event type for the authz Revoke transaction.
+	EventTypeRevoke = "Revoke"
+	// EventTypeExec defines the event type for the authz Exec transaction.
+	EventTypeExec = "Exec"
+)
+
+// EmitGrantEvent creates a new event emitted on the Grant and GrantSend transactions.
+func (p Precompile) EmitGrantEvent(ctx sdk.Context, stateDB vm.StateDB, granter, grantee common.Address, msgTypeURL string, expiration int64) error {
+	return p.emitEvent(ctx, stateDB, EventTypeGrant, []common.Address{granter, grantee}, msgTypeURL, expiration)
+}
+
+// EmitRevokeEvent creates a new event emitted on a Revoke transaction.
+func (p Precompile) EmitRevokeEvent(ctx sdk.Context, stateDB vm.StateDB, granter, grantee common.Address, msgTypeURL string) error {
+	return p.emitEvent(ctx, stateDB, EventTypeRevoke, []common.Address{granter, grantee}, msgTypeURL)
+}
+
+// EmitExecEvent creates a new event emitted on an Exec transaction.
+func (p Precompile) EmitExecEvent(ctx sdk.Context, stateDB vm.StateDB, grantee common.Address, msgTypeURLs []string) error {
+	return p.emitEvent(ctx, stateDB, EventTypeExec, []common.Address{grantee}, msgTypeURLs)
+}
+
+// emitEvent emits an event of the given type, indexing the given addresses.
+// The remaining values are encoded as the event data.
+func (p Precompile) emitEvent(ctx sdk.Context, stateDB vm.StateDB, eventType string, indexed []common.Address, data ...interface{}) error {
+	// Prepare the event topics
+	event := p.Events[eventType]
+	topics := make([]common.Hash, len(indexed)+1)
+
+	// The first topic is always the signature of the event.
+	topics[0] = event.ID
+
+	var err error
+	for i, addr := range indexed {
+		topics[i+1], err = cmn.MakeTopic(addr)
+		if err != nil {
+			return err
+		}
+	}
+
+	packed, err := event.Inputs.NonIndexed().Pack(data...)
+	if err != nil {
+		return fmt.Errorf("failed to pack event data: %w", err)
+	}
+
+	stateDB.AddLog(&ethtypes.Log{
+		Address:     p.Address(),
+		Topics:      topics,
+		Data:        packed,
+		BlockNumber: uint64(ctx.BlockHeight()), //nolint:gosec // G115 // won't exceed uint64
+	})
+
+	return nil
+}
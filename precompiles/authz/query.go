@@ -0,0 +1,90 @@
+package authz
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// GrantsMethod defines the ABI method name for the authz Grants query.
+	GrantsMethod = "grants"
+	// GranterGrantsMethod defines the ABI method name for the authz GranterGrants query.
+	GranterGrantsMethod = "granterGrants"
+	// GranteeGrantsMethod defines the ABI method name for the authz GranteeGrants query.
+	GranteeGrantsMethod = "granteeGrants"
+)
+
+// Grants returns the grants of a granter to a grantee, optionally only the
+// grant of a msg type.
+func (p Precompile) Grants(
+	ctx sdk.Context,
+	_ *vm.Contract,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	req, granter, grantee, err := NewQueryGrantsRequest(method, args, p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := p.authzKeeper.Grants(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := new(GrantsOutput).FromGrantsResponse(p.cdc, granter, grantee, res)
+	if err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack(out.Grants, out.PageResponse)
+}
+
+// GranterGrants returns the grants given by a granter.
+func (p Precompile) GranterGrants(
+	ctx sdk.Context,
+	_ *vm.Contract,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	req, err := NewQueryGranterGrantsRequest(method, args, p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := p.authzKeeper.GranterGrants(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := new(GrantsOutput).FromGrantAuthorizations(p.cdc, res.Grants, res.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack(out.Grants, out.PageResponse)
+}
+
+// GranteeGrants returns the grants received by a grantee.
+func (p Precompile) GranteeGrants(
+	ctx sdk.Context,
+	_ *vm.Contract,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	req, err := NewQueryGranteeGrantsRequest(method, args, p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := p.authzKeeper.GranteeGrants(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := new(GrantsOutput).FromGrantAuthorizations(p.cdc, res.Grants, res.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack(out.Grants, out.PageResponse)
+}
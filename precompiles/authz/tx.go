@@ -0,0 +1,188 @@
+package authz
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+)
+
+const (
+	// GrantMethod defines the ABI method name for the authz Grant transaction
+	// of a generic authorization.
+	GrantMethod = "grant"
+	// GrantSendMethod defines the ABI method name for the authz Grant transaction
+	// of a send authorization.
+	GrantSendMethod = "grantSend"
+	// RevokeMethod defines the ABI method name for the authz Revoke transaction.
+	RevokeMethod = "revoke"
+	// ExecMethod defines the ABI method name for the authz Exec transaction.
+	ExecMethod = "exec"
+)
+
+// Grant grants a generic authorization of the caller to the grantee.
+func (p *Precompile) Grant(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, granterHexAddr, err := NewMsgGrant(args, p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.grant(ctx, contract, stateDB, method, msg, granterHexAddr, args[1].(common.Address))
+}
+
+// GrantSend grants a send authorization of the caller to the grantee.
+func (p *Precompile) GrantSend(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, granterHexAddr, err := NewMsgGrantSend(method, args, p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.grant(ctx, contract, stateDB, method, msg, granterHexAddr, args[1].(common.Address))
+}
+
+// Revoke revokes an authorization of the caller to the grantee.
+func (p *Precompile) Revoke(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, granterHexAddr, err := NewMsgRevoke(args, p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	msgSender := contract.Caller()
+	if msgSender != granterHexAddr {
+		return nil, fmt.Errorf(cmn.ErrRequesterIsNotMsgSender, msgSender.String(), granterHexAddr.String())
+	}
+
+	cmn.TraceMsg(ctx, msg)
+	if _, err := p.authzKeeper.Revoke(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	if err = p.EmitRevokeEvent(ctx, stateDB, granterHexAddr, args[1].(common.Address), msg.MsgTypeUrl); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// Exec executes messages on behalf of their signers with the authorizations
+// granted to the caller.
+func (p *Precompile) Exec(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, granteeHexAddr, err := NewMsgExec(args, p.cdc)
+	if err != nil {
+		return nil, err
+	}
+
+	msgSender := contract.Caller()
+	if msgSender != granteeHexAddr {
+		return nil, fmt.Errorf(cmn.ErrRequesterIsNotMsgSender, msgSender.String(), granteeHexAddr.String())
+	}
+
+	if err := p.limiter.CheckDisabledMsgs(msg); err != nil {
+		return nil, err
+	}
+
+	// the authz keeper executes the messages signed by the grantee without
+	// any grant, which would let the calling contract execute arbitrary
+	// messages as itself
+	msgs, err := msg.GetMessages()
+	if err != nil {
+		return nil, err
+	}
+	for i, m := range msgs {
+		signers, _, err := p.cdc.GetMsgV1Signers(m)
+		if err != nil {
+			return nil, err
+		}
+		for _, signer := range signers {
+			if bytes.Equal(signer, granteeHexAddr.Bytes()) {
+				return nil, fmt.Errorf(ErrMsgSignedByGrantee, i, granteeHexAddr.String())
+			}
+		}
+	}
+
+	cmn.TraceMsg(ctx, msg)
+	res, err := p.authzKeeper.Exec(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	msgTypeURLs := make([]string, len(msg.Msgs))
+	for i, m := range msg.Msgs {
+		msgTypeURLs[i] = m.TypeUrl
+	}
+	if err = p.EmitExecEvent(ctx, stateDB, granteeHexAddr, msgTypeURLs); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(res.Results)
+}
+
+// grant grants the authorization of the MsgGrant if the caller is the granter.
+func (p *Precompile) grant(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	msg *authz.MsgGrant,
+	granterHexAddr, granteeHexAddr common.Address,
+) ([]byte, error) {
+	msgSender := contract.Caller()
+	if msgSender != granterHexAddr {
+		return nil, fmt.Errorf(cmn.ErrRequesterIsNotMsgSender, msgSender.String(), granterHexAddr.String())
+	}
+
+	if err := p.limiter.CheckDisabledMsgs(msg); err != nil {
+		return nil, err
+	}
+
+	cmn.TraceMsg(ctx, msg)
+	if _, err := p.authzKeeper.Grant(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	authorization, err := msg.GetAuthorization()
+	if err != nil {
+		return nil, err
+	}
+
+	var expiration int64
+	if msg.Grant.Expiration != nil {
+		expiration = msg.Grant.Expiration.Unix()
+	}
+	if err = p.EmitGrantEvent(ctx, stateDB, granterHexAddr, granteeHexAddr, authorization.MsgTypeURL(), expiration); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
@@ -0,0 +1,408 @@
+package authz
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+	"github.com/cosmos/evm/utils"
+
+	"cosmossdk.io/core/address"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// GrantData defines a grant of an authorization in types native to the EVM.
+type GrantData struct {
+	Granter           common.Address   `abi:"granter"`
+	Grantee           common.Address   `abi:"grantee"`
+	AuthorizationType string           `abi:"authorizationType"`
+	MsgTypeUrl        string           `abi:"msgTypeUrl"` //nolint:revive
+	SpendLimit        []cmn.Coin       `abi:"spendLimit"`
+	AllowList         []common.Address `abi:"allowList"`
+	Expiration        int64            `abi:"expiration"`
+}
+
+// GrantSendInput defines the input of the grantSend method.
+type GrantSendInput struct {
+	Granter    common.Address   `abi:"granter"`
+	Grantee    common.Address   `abi:"grantee"`
+	SpendLimit []cmn.Coin       `abi:"spendLimit"`
+	AllowList  []common.Address `abi:"allowList"`
+	Expiration int64            `abi:"expiration"`
+}
+
+// GrantsInput defines the input of the grants query.
+type GrantsInput struct {
+	Granter    common.Address
+	Grantee    common.Address
+	MsgTypeUrl string //nolint:revive
+	Pagination query.PageRequest
+}
+
+// GranterGrantsInput defines the input of the granterGrants query.
+type GranterGrantsInput struct {
+	Granter    common.Address
+	Pagination query.PageRequest
+}
+
+// GranteeGrantsInput defines the input of the granteeGrants query.
+type GranteeGrantsInput struct {
+	Grantee    common.Address
+	Pagination query.PageRequest
+}
+
+// GrantsOutput defines the output of the grants queries.
+type GrantsOutput struct {
+	Grants       []GrantData        `abi:"grants"`
+	PageResponse query.PageResponse `abi:"pageResponse"`
+}
+
+// NewMsgGrant creates a new MsgGrant message of a generic authorization and
+// returns the address of the granter.
+func NewMsgGrant(args []interface{}, addrCdc address.Codec) (*authz.MsgGrant, common.Address, error) {
+	if len(args) != 4 {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 4, len(args))
+	}
+
+	granter, grantee, err := parseGranterGrantee(args[0], args[1])
+	if err != nil {
+		return nil, common.Address{}, err
+	}
+	msgTypeURL, ok := args[2].(string)
+	if !ok || msgTypeURL == "" {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidMsgTypeURL, args[2])
+	}
+	expiration, ok := args[3].(int64)
+	if !ok {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidType, "expiration", int64(0), args[3])
+	}
+
+	msg, err := newMsgGrant(granter, grantee, authz.NewGenericAuthorization(msgTypeURL), expiration, addrCdc)
+	if err != nil {
+		return nil, common.Address{}, err
+	}
+	return msg, granter, nil
+}
+
+// NewMsgGrantSend creates a new MsgGrant message of a send authorization and
+// returns the address of the granter.
+func NewMsgGrantSend(method *abi.Method, args []interface{}, addrCdc address.Codec) (*authz.MsgGrant, common.Address, error) {
+	if len(args) != 5 {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 5, len(args))
+	}
+
+	var input GrantSendInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, common.Address{}, fmt.Errorf("error while unpacking args to GrantSendInput: %s", err)
+	}
+
+	granter, grantee, err := parseGranterGrantee(input.Granter, input.Grantee)
+	if err != nil {
+		return nil, common.Address{}, err
+	}
+
+	spendLimit, err := cmn.NewSdkCoinsFromCoins(input.SpendLimit)
+	if err != nil {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidAmount, err)
+	}
+
+	allowList := make([]sdk.AccAddress, len(input.AllowList))
+	for i, addr := range input.AllowList {
+		if addr == (common.Address{}) {
+			return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidHexAddress, addr)
+		}
+		allowList[i] = addr.Bytes()
+	}
+
+	authorization := banktypes.NewSendAuthorization(spendLimit, allowList)
+	msg, err := newMsgGrant(granter, grantee, authorization, input.Expiration, addrCdc)
+	if err != nil {
+		return nil, common.Address{}, err
+	}
+	return msg, granter, nil
+}
+
+// NewMsgRevoke creates a new MsgRevoke message and returns the address of the
+// granter.
+func NewMsgRevoke(args []interface{}, addrCdc address.Codec) (*authz.MsgRevoke, common.Address, error) {
+	if len(args) != 3 {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 3, len(args))
+	}
+
+	granter, grantee, err := parseGranterGrantee(args[0], args[1])
+	if err != nil {
+		return nil, common.Address{}, err
+	}
+	msgTypeURL, ok := args[2].(string)
+	if !ok || msgTypeURL == "" {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidMsgTypeURL, args[2])
+	}
+
+	granterAddr, granteeAddr, err := encodeAddresses(granter, grantee, addrCdc)
+	if err != nil {
+		return nil, common.Address{}, err
+	}
+
+	msg := &authz.MsgRevoke{
+		Granter:    granterAddr,
+		Grantee:    granteeAddr,
+		MsgTypeUrl: msgTypeURL,
+	}
+	return msg, granter, nil
+}
+
+// NewMsgExec creates a new MsgExec message from the protoJSON encoded messages
+// and returns the address of the grantee.
+func NewMsgExec(args []interface{}, cdc codec.Codec) (*authz.MsgExec, common.Address, error) {
+	if len(args) != 2 {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	grantee, ok := args[0].(common.Address)
+	if !ok || grantee == (common.Address{}) {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidHexAddress, args[0])
+	}
+	jsonMsgs, ok := args[1].([][]byte)
+	if !ok || len(jsonMsgs) == 0 {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidMsgs, args[1])
+	}
+
+	msgs := make([]sdk.Msg, len(jsonMsgs))
+	for i, m := range jsonMsgs {
+		var msg sdk.Msg
+		if err := cdc.UnmarshalInterfaceJSON(m, &msg); err != nil {
+			return nil, common.Address{}, fmt.Errorf("failed to decode message %d: %w", i, err)
+		}
+		msgs[i] = msg
+	}
+
+	msg := authz.NewMsgExec(grantee.Bytes(), msgs)
+	return &msg, grantee, nil
+}
+
+// NewQueryGrantsRequest creates a new QueryGrantsRequest from the args of the
+// grants query, and returns the granter and grantee addresses.
+func NewQueryGrantsRequest(method *abi.Method, args []interface{}, addrCdc address.Codec) (*authz.QueryGrantsRequest, common.Address, common.Address, error) {
+	if len(args) != 4 {
+		return nil, common.Address{}, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 4, len(args))
+	}
+
+	var input GrantsInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, common.Address{}, common.Address{}, fmt.Errorf("error while unpacking args to GrantsInput: %s", err)
+	}
+
+	granter, grantee, err := encodeAddresses(input.Granter, input.Grantee, addrCdc)
+	if err != nil {
+		return nil, common.Address{}, common.Address{}, err
+	}
+
+	req := &authz.QueryGrantsRequest{
+		Granter:    granter,
+		Grantee:    grantee,
+		MsgTypeUrl: input.MsgTypeUrl,
+		Pagination: &input.Pagination,
+	}
+	return req, input.Granter, input.Grantee, nil
+}
+
+// NewQueryGranterGrantsRequest creates a new QueryGranterGrantsRequest from
+// the args of the granterGrants query.
+func NewQueryGranterGrantsRequest(method *abi.Method, args []interface{}, addrCdc address.Codec) (*authz.QueryGranterGrantsRequest, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	var input GranterGrantsInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, fmt.Errorf("error while unpacking args to GranterGrantsInput: %s", err)
+	}
+
+	granter, err := encodeAddress(input.Granter, addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	return &authz.QueryGranterGrantsRequest{
+		Granter:    granter,
+		Pagination: &input.Pagination,
+	}, nil
+}
+
+// NewQueryGranteeGrantsRequest creates a new QueryGranteeGrantsRequest from
+// the args of the granteeGrants query.
+func NewQueryGranteeGrantsRequest(method *abi.Method, args []interface{}, addrCdc address.Codec) (*authz.QueryGranteeGrantsRequest, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	var input GranteeGrantsInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, fmt.Errorf("error while unpacking args to GranteeGrantsInput: %s", err)
+	}
+
+	grantee, err := encodeAddress(input.Grantee, addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	return &authz.QueryGranteeGrantsRequest{
+		Grantee:    grantee,
+		Pagination: &input.Pagination,
+	}, nil
+}
+
+// FromGrantsResponse populates the GrantsOutput from a QueryGrantsResponse,
+// whose grants are all given by the granter to the grantee.
+func (o *GrantsOutput) FromGrantsResponse(cdc codec.Codec, granter, grantee common.Address, res *authz.QueryGrantsResponse) (*GrantsOutput, error) {
+	o.Grants = make([]GrantData, len(res.Grants))
+	for i, g := range res.Grants {
+		grant, err := newGrantData(cdc, granter, grantee, g.Authorization, g.Expiration)
+		if err != nil {
+			return nil, err
+		}
+		o.Grants[i] = grant
+	}
+	o.setPageResponse(res.Pagination)
+	return o, nil
+}
+
+// FromGrantAuthorizations populates the GrantsOutput from the grants of the
+// granterGrants and granteeGrants queries.
+func (o *GrantsOutput) FromGrantAuthorizations(cdc codec.Codec, grants []*authz.GrantAuthorization, pageRes *query.PageResponse) (*GrantsOutput, error) {
+	o.Grants = make([]GrantData, len(grants))
+	for i, g := range grants {
+		granter, err := utils.HexAddressFromBech32String(g.Granter)
+		if err != nil {
+			return nil, err
+		}
+		grantee, err := utils.HexAddressFromBech32String(g.Grantee)
+		if err != nil {
+			return nil, err
+		}
+		grant, err := newGrantData(cdc, granter, grantee, g.Authorization, g.Expiration)
+		if err != nil {
+			return nil, err
+		}
+		o.Grants[i] = grant
+	}
+	o.setPageResponse(pageRes)
+	return o, nil
+}
+
+func (o *GrantsOutput) setPageResponse(pageRes *query.PageResponse) {
+	if pageRes != nil {
+		o.PageResponse = query.PageResponse{
+			NextKey: pageRes.NextKey,
+			Total:   pageRes.Total,
+		}
+	}
+}
+
+// newGrantData converts an authorization of the granter to the grantee into
+// GrantData. The spend limit and allow list are only set for send
+// authorizations.
+func newGrantData(cdc codec.Codec, granter, grantee common.Address, authorizationAny *codectypes.Any, expiration *time.Time) (GrantData, error) {
+	var authorization authz.Authorization
+	if err := cdc.UnpackAny(authorizationAny, &authorization); err != nil {
+		return GrantData{}, err
+	}
+
+	grant := GrantData{
+		Granter:           granter,
+		Grantee:           grantee,
+		AuthorizationType: authorizationAny.TypeUrl,
+		MsgTypeUrl:        authorization.MsgTypeURL(),
+		SpendLimit:        []cmn.Coin{},
+		AllowList:         []common.Address{},
+	}
+	if expiration != nil {
+		grant.Expiration = expiration.Unix()
+	}
+
+	if sendAuthz, ok := authorization.(*banktypes.SendAuthorization); ok {
+		grant.SpendLimit = cmn.NewCoinsResponse(sendAuthz.SpendLimit)
+		for _, addr := range sendAuthz.AllowList {
+			hexAddr, err := utils.HexAddressFromBech32String(addr)
+			if err != nil {
+				return GrantData{}, err
+			}
+			grant.AllowList = append(grant.AllowList, hexAddr)
+		}
+	}
+
+	return grant, nil
+}
+
+// newMsgGrant creates a new MsgGrant of the authorization, which does not
+// expire if the expiration is zero.
+func newMsgGrant(granter, grantee common.Address, authorization authz.Authorization, expiration int64, addrCdc address.Codec) (*authz.MsgGrant, error) {
+	granterAddr, granteeAddr, err := encodeAddresses(granter, grantee, addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	var exp *time.Time
+	if expiration != 0 {
+		t := time.Unix(expiration, 0).UTC()
+		exp = &t
+	}
+
+	msg := &authz.MsgGrant{
+		Granter: granterAddr,
+		Grantee: granteeAddr,
+		Grant:   authz.Grant{Expiration: exp},
+	}
+	if err := msg.SetAuthorization(authorization); err != nil {
+		return nil, err
+	}
+	return msg, nil
+}
+
+// parseGranterGrantee parses the granter and grantee address args, which
+// cannot be the zero address.
+func parseGranterGrantee(granterArg, granteeArg interface{}) (common.Address, common.Address, error) {
+	granter, ok := granterArg.(common.Address)
+	if !ok || granter == (common.Address{}) {
+		return common.Address{}, common.Address{}, fmt.Errorf(cmn.ErrInvalidHexAddress, granterArg)
+	}
+	grantee, ok := granteeArg.(common.Address)
+	if !ok || grantee == (common.Address{}) {
+		return common.Address{}, common.Address{}, fmt.Errorf(cmn.ErrInvalidHexAddress, granteeArg)
+	}
+	return granter, grantee, nil
+}
+
+// encodeAddresses encodes the granter and grantee addresses.
+func encodeAddresses(granter, grantee common.Address, addrCdc address.Codec) (string, string, error) {
+	granterAddr, err := encodeAddress(granter, addrCdc)
+	if err != nil {
+		return "", "", err
+	}
+	granteeAddr, err := encodeAddress(grantee, addrCdc)
+	if err != nil {
+		return "", "", err
+	}
+	return granterAddr, granteeAddr, nil
+}
+
+// encodeAddress encodes an address, which cannot be the zero address.
+func encodeAddress(addr common.Address, addrCdc address.Codec) (string, error) {
+	if addr == (common.Address{}) {
+		return "", fmt.Errorf(cmn.ErrInvalidHexAddress, addr)
+	}
+	encoded, err := addrCdc.BytesToString(addr.Bytes())
+	if err != nil {
+		return "", fmt.Errorf("failed to decode address %s: %w", addr, err)
+	}
+	return encoded, nil
+}
@@ -0,0 +1,10 @@
+package bank
+
+const (
+	// ErrBlockedAddress is raised when the recipient is not allowed to receive funds.
+	ErrBlockedAddress = "%s is not allowed to receive funds"
+	// ErrNoOutputs is raised when a multiSend transaction has no outputs.
+	ErrNoOutputs = "no outputs to send"
+	// ErrNoCoins is raised when an output of a multiSend transaction has no coins.
+	ErrNoCoins = "no coins to send to %s"
+)
@@ -0,0 +1,54 @@
+package bank
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// EventTypeTransfer defines the event type for the ERC-20 Transfer event.
+const EventTypeTransfer = "Transfer"
+
+// EmitTransferEvent creates a new ERC-20 Transfer event emitted by the given
+// ERC-20 contract on the send and multiSend transactions.
+func (p Precompile) EmitTransferEvent(ctx sdk.Context, stateDB vm.StateDB, erc20Address, from, to common.Address, value *big.Int) error {
+	// Prepare the event topics
+	event := p.Events[EventTypeTransfer]
+	topics := make([]common.Hash, 3)
+
+	// The first topic is always the signature of the event.
+	topics[0] = event.ID
+
+	var err error
+	topics[1], err = cmn.MakeTopic(from)
+	if err != nil {
+		return err
+	}
+
+	topics[2], err = cmn.MakeTopic(to)
+	if err != nil {
+		return err
+	}
+
+	arguments := abi.Arguments{event.Inputs[2]}
+	packed, err := arguments.Pack(value)
+	if err != nil {
+		return err
+	}
+
+	stateDB.AddLog(&ethtypes.Log{
+		Address:     erc20Address,
+		Topics:      topics,
+		Data:        packed,
+		BlockNumber: uint64(ctx.BlockHeight()), //nolint:gosec // G115 // block height won't exceed uint64
+	})
+
+	return nil
+}
@@ -0,0 +1,128 @@
+package bank
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+const (
+	// SendMethod defines the ABI method name for the bank Send
+	// transaction.
+	SendMethod = "send"
+	// MultiSendMethod defines the ABI method name for the bank MultiSend
+	// transaction.
+	MultiSendMethod = "multiSend"
+)
+
+// Send sends the coins of the given denom from the caller to the recipient.
+// The amount has the original decimals precision stored in the x/bank.
+func (p Precompile) Send(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	to, coins, err := ParseSendArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	from := contract.Caller()
+
+	cmn.TraceMsg(ctx, banktypes.NewMsgSend(from.Bytes(), to.Bytes(), coins))
+
+	if err := p.send(ctx, stateDB, from, to, coins); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// MultiSend sends the coins of each output from the caller to its recipient.
+// The amounts have the original decimals precision stored in the x/bank.
+// This method charges the account the corresponding value of a send
+// for each output.
+func (p Precompile) MultiSend(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	recipients, amounts, err := ParseMultiSendArgs(method, args)
+	if err != nil {
+		return nil, err
+	}
+
+	from := contract.Caller()
+
+	total := sdk.NewCoins()
+	outputs := make([]banktypes.Output, len(recipients))
+	for i, to := range recipients {
+		total = total.Add(amounts[i]...)
+		outputs[i] = banktypes.NewOutput(to.Bytes(), amounts[i])
+	}
+
+	cmn.TraceMsg(ctx, banktypes.NewMsgMultiSend(banktypes.NewInput(from.Bytes(), total), outputs))
+
+	for i, to := range recipients {
+		// NOTE: we already charged for a single send so we don't
+		// need to charge on the first output
+		if i > 0 {
+			ctx.GasMeter().ConsumeGas(GasSend, "bank precompile multiSend method")
+		}
+
+		if err := p.send(ctx, stateDB, from, to, amounts[i]); err != nil {
+			return nil, err
+		}
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// send sends the coins from the sender to the recipient, with the same checks
+// as the x/bank Send message. It emits an ERC-20 Transfer event for each coin
+// of a native Cosmos coin token pair, which is handled by an ERC-20 precompile.
+// The coins of native ERC-20 token pairs are not represented in the balances
+// of their contracts, so no event is emitted for them.
+func (p Precompile) send(
+	ctx sdk.Context,
+	stateDB vm.StateDB,
+	from, to common.Address,
+	coins sdk.Coins,
+) error {
+	if err := p.bankKeeper.IsSendEnabledCoins(ctx, coins...); err != nil {
+		return err
+	}
+
+	if p.bankKeeper.BlockedAddr(to.Bytes()) {
+		return fmt.Errorf(ErrBlockedAddress, to)
+	}
+
+	if err := p.bankKeeper.SendCoins(ctx, from.Bytes(), to.Bytes(), coins); err != nil {
+		return err
+	}
+
+	for _, coin := range coins {
+		tokenPairID := p.erc20Keeper.GetTokenPairID(ctx, coin.Denom)
+		tokenPair, found := p.erc20Keeper.GetTokenPair(ctx, tokenPairID)
+		if !found || !tokenPair.IsNativeCoin() {
+			continue
+		}
+
+		if err := p.EmitTransferEvent(ctx, stateDB, tokenPair.GetERC20Contract(), from, to, coin.Amount.BigInt()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -4,10 +4,13 @@ import (
 	"fmt"
 	"math/big"
 
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 
 	cmn "github.com/cosmos/evm/precompiles/common"
 
+	"cosmossdk.io/math"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -17,6 +20,17 @@ type Balance struct {
 	Amount          *big.Int
 }
 
+// Output contains the recipient and the coins sent to it in a multiSend transaction.
+type Output struct {
+	To    common.Address
+	Coins []cmn.Coin
+}
+
+// MultiSendInput defines the input of the bank MultiSend transaction.
+type MultiSendInput struct {
+	Outputs []Output
+}
+
 // ParseBalancesArgs parses the call arguments for the bank Balances query.
 func ParseBalancesArgs(args []interface{}) (sdk.AccAddress, error) {
 	if len(args) != 1 {
@@ -44,3 +58,72 @@ func ParseSupplyOfArgs(args []interface{}) (common.Address, error) {
 
 	return erc20Address, nil
 }
+
+// ParseSendArgs parses the call arguments for the bank Send transaction.
+func ParseSendArgs(args []interface{}) (common.Address, sdk.Coins, error) {
+	if len(args) != 3 {
+		return common.Address{}, nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 3, len(args))
+	}
+
+	to, ok := args[0].(common.Address)
+	if !ok {
+		return common.Address{}, nil, fmt.Errorf(cmn.ErrInvalidType, "to", common.Address{}, args[0])
+	}
+
+	denom, ok := args[1].(string)
+	if !ok {
+		return common.Address{}, nil, fmt.Errorf(cmn.ErrInvalidType, "denom", "", args[1])
+	}
+
+	amount, ok := args[2].(*big.Int)
+	if !ok || amount == nil {
+		return common.Address{}, nil, fmt.Errorf(cmn.ErrInvalidAmount, args[2])
+	}
+
+	coins := sdk.Coins{{Denom: denom, Amount: math.NewIntFromBigInt(amount)}}
+	if err := coins.Validate(); err != nil {
+		return common.Address{}, nil, err
+	}
+
+	return to, coins, nil
+}
+
+// ParseMultiSendArgs parses the call arguments for the bank MultiSend transaction.
+// It returns the outputs with the coins in the Cosmos SDK representation.
+func ParseMultiSendArgs(method *abi.Method, args []interface{}) ([]common.Address, []sdk.Coins, error) {
+	if len(args) != 1 {
+		return nil, nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	var input MultiSendInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, nil, fmt.Errorf("error while unpacking args to MultiSendInput struct: %s", err)
+	}
+
+	if len(input.Outputs) == 0 {
+		return nil, nil, fmt.Errorf(ErrNoOutputs)
+	}
+
+	recipients := make([]common.Address, len(input.Outputs))
+	amounts := make([]sdk.Coins, len(input.Outputs))
+	for i, output := range input.Outputs {
+		if len(output.Coins) == 0 {
+			return nil, nil, fmt.Errorf(ErrNoCoins, output.To)
+		}
+
+		coins := make(sdk.Coins, len(output.Coins))
+		for j, coin := range output.Coins {
+			coins[j] = sdk.Coin{Denom: coin.Denom, Amount: math.NewIntFromBigInt(coin.Amount)}
+		}
+
+		coins = coins.Sort()
+		if err := coins.Validate(); err != nil {
+			return nil, nil, err
+		}
+
+		recipients[i] = output.To
+		amounts[i] = coins
+	}
+
+	return recipients, amounts, nil
+}
@@ -17,6 +17,24 @@ type BankKeeper struct {
 	mock.Mock
 }
 
+// BlockedAddr provides a mock function with given fields: addr
+func (_m *BankKeeper) BlockedAddr(addr types.AccAddress) bool {
+	ret := _m.Called(addr)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BlockedAddr")
+	}
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(types.AccAddress) bool); ok {
+		r0 = rf(addr)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
 // GetBalance provides a mock function with given fields: ctx, addr, denom
 func (_m *BankKeeper) GetBalance(ctx context.Context, addr types.AccAddress, denom string) types.Coin {
 	ret := _m.Called(ctx, addr, denom)
@@ -81,6 +99,31 @@ func (_m *BankKeeper) GetSupply(ctx context.Context, denom string) types.Coin {
 	return r0
 }
 
+// IsSendEnabledCoins provides a mock function with given fields: ctx, coins
+func (_m *BankKeeper) IsSendEnabledCoins(ctx context.Context, coins ...types.Coin) error {
+	_va := make([]interface{}, len(coins))
+	for _i := range coins {
+		_va[_i] = coins[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsSendEnabledCoins")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, ...types.Coin) error); ok {
+		r0 = rf(ctx, coins...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // IterateAccountBalances provides a mock function with given fields: ctx, account, cb
 func (_m *BankKeeper) IterateAccountBalances(ctx context.Context, account types.AccAddress, cb func(types.Coin) bool) {
 	_m.Called(ctx, account, cb)
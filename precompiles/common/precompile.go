@@ -10,6 +10,7 @@ import (
 	"github.com/holiman/uint256"
 
 	"github.com/cosmos/evm/x/vm/statedb"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
 
 	storetypes "cosmossdk.io/store/types"
 
@@ -147,9 +148,21 @@ func (p Precompile) RunSetup(
 	// we need to consume the gas that was already used by the EVM
 	ctx.GasMeter().ConsumeGas(initialGas, "creating a new gas meter")
 
+	if tracer := evmtypes.PrecompileTracerFromContext(ctx); tracer != nil {
+		tracer.OnPrecompileCall(ctx, method, args)
+	}
+
 	return ctx, stateDB, method, initialGas, args, nil
 }
 
+// TraceMsg records the Cosmos SDK message executed by the precompile call when
+// precompile calls are traced. It must be called before executing the message.
+func TraceMsg(ctx sdk.Context, msg sdk.Msg) {
+	if tracer := evmtypes.PrecompileTracerFromContext(ctx); tracer != nil {
+		tracer.OnMsg(msg)
+	}
+}
+
 // HandleGasError handles the out of gas panic by resetting the gas meter and returning an error.
 // This is used in order to avoid panics and to allow for the EVM to continue cleanup if the tx or query run out of gas.
 func HandleGasError(ctx sdk.Context, contract *vm.Contract, initialGas storetypes.Gas, err *error) func() {
@@ -0,0 +1,15 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.18;
+
+import "./IERC20Metadata.sol";
+import "./IERC20Permit.sol";
+import "./IERC3009.sol";
+
+/**
+ * @author Evmos Team
+ * @title ERC-20 Precompile Interface
+ * @dev Interface of the ERC-20 precompile, which extends the ERC-20 standard with
+ * the metadata functions, the EIP-2612 permit approvals and the EIP-3009 transfers
+ * with signed authorizations.
+ */
+interface IERC20MetadataPermit is IERC20Metadata, IERC20Permit, IERC3009 {}
@@ -0,0 +1,89 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.18;
+
+/**
+ * @author Evmos Team
+ * @title ERC-3009 Interface
+ * @dev Interface of the transfers with signed authorizations, as defined in
+ * https://eips.ethereum.org/EIPS/eip-3009[EIP-3009]. The authorizations are
+ * signed with the same EIP712 domain as the {IERC20Permit-permit} approvals.
+ */
+interface IERC3009 {
+    /// @dev Emitted when an authorization is used.
+    /// @param authorizer The account that signed the authorization.
+    /// @param nonce The unique nonce of the authorization.
+    event AuthorizationUsed(address indexed authorizer, bytes32 indexed nonce);
+
+    /// @dev Emitted when an authorization is canceled.
+    /// @param authorizer The account that signed the authorization.
+    /// @param nonce The unique nonce of the authorization.
+    event AuthorizationCanceled(address indexed authorizer, bytes32 indexed nonce);
+
+    /// @dev Returns whether the authorization of the nonce has been used or canceled.
+    /// @param authorizer The account that signed the authorization.
+    /// @param nonce The unique nonce of the authorization.
+    /// @return used True if the nonce has been used or canceled.
+    function authorizationState(
+        address authorizer,
+        bytes32 nonce
+    ) external view returns (bool used);
+
+    /// @dev Executes a transfer with a signed authorization of the sender.
+    /// @param from The account sending the tokens, which signed the authorization.
+    /// @param to The account receiving the tokens.
+    /// @param value The amount of tokens transferred.
+    /// @param validAfter The unix time after which the authorization is valid.
+    /// @param validBefore The unix time before which the authorization is valid.
+    /// @param nonce The unique nonce of the authorization.
+    /// @param v The recovery byte of the signature.
+    /// @param r The first half of the ECDSA signature.
+    /// @param s The second half of the ECDSA signature.
+    function transferWithAuthorization(
+        address from,
+        address to,
+        uint256 value,
+        uint256 validAfter,
+        uint256 validBefore,
+        bytes32 nonce,
+        uint8 v,
+        bytes32 r,
+        bytes32 s
+    ) external;
+
+    /// @dev Receives a transfer with a signed authorization of the sender. The caller
+    /// must be the recipient, so that the authorization can't be front-run.
+    /// @param from The account sending the tokens, which signed the authorization.
+    /// @param to The account receiving the tokens, which must be the caller.
+    /// @param value The amount of tokens transferred.
+    /// @param validAfter The unix time after which the authorization is valid.
+    /// @param validBefore The unix time before which the authorization is valid.
+    /// @param nonce The unique nonce of the authorization.
+    /// @param v The recovery byte of the signature.
+    /// @param r The first half of the ECDSA signature.
+    /// @param s The second half of the ECDSA signature.
+    function receiveWithAuthorization(
+        address from,
+        address to,
+        uint256 value,
+        uint256 validAfter,
+        uint256 validBefore,
+        bytes32 nonce,
+        uint8 v,
+        bytes32 r,
+        bytes32 s
+    ) external;
+
+    /// @dev Cancels an authorization that hasn't been used yet.
+    /// @param authorizer The account that signed the authorization.
+    /// @param nonce The unique nonce of the authorization.
+    /// @param v The recovery byte of the signature.
+    /// @param r The first half of the ECDSA signature.
+    /// @param s The second half of the ECDSA signature.
+    function cancelAuthorization(
+        address authorizer,
+        bytes32 nonce,
+        uint8 v,
+        bytes32 r,
+        bytes32 s
+    ) external;
+}
@@ -0,0 +1,205 @@
+package erc20
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// AuthorizationStateMethod defines the ABI method name for the ERC-3009
+	// authorizationState query.
+	AuthorizationStateMethod = "authorizationState"
+	// TransferWithAuthorizationMethod defines the ABI method name for the ERC-3009
+	// transferWithAuthorization transaction.
+	TransferWithAuthorizationMethod = "transferWithAuthorization"
+	// ReceiveWithAuthorizationMethod defines the ABI method name for the ERC-3009
+	// receiveWithAuthorization transaction.
+	ReceiveWithAuthorizationMethod = "receiveWithAuthorization"
+	// CancelAuthorizationMethod defines the ABI method name for the ERC-3009
+	// cancelAuthorization transaction.
+	CancelAuthorizationMethod = "cancelAuthorization"
+)
+
+// AuthorizationState returns true if the authorization of the given nonce has
+// already been used or canceled by the authorizer.
+func (p Precompile) AuthorizationState(
+	ctx sdk.Context,
+	_ *vm.Contract,
+	_ vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	authorizer, nonce, err := ParseAuthorizationStateArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	used := p.erc20Keeper.IsAuthorizationNonceUsed(ctx, p.Address(), authorizer, nonce)
+
+	return method.Outputs.Pack(used)
+}
+
+// TransferWithAuthorization executes a transfer given the EIP-712 signed
+// authorization of the sender. Anyone can submit the authorization.
+func (p *Precompile) TransferWithAuthorization(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	return p.transferWithAuthorization(ctx, contract, stateDB, method, args, transferWithAuthorizationTypeHash)
+}
+
+// ReceiveWithAuthorization executes a transfer given the EIP-712 signed
+// authorization of the sender. The caller must be the recipient of the transfer,
+// which prevents the authorization from being front-run.
+func (p *Precompile) ReceiveWithAuthorization(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	return p.transferWithAuthorization(ctx, contract, stateDB, method, args, receiveWithAuthorizationTypeHash)
+}
+
+// transferWithAuthorization is a common function that handles the ERC-3009
+// TransferWithAuthorization and ReceiveWithAuthorization methods. It checks the
+// validity period, the nonce and the signature of the authorization, marks the
+// nonce as used and executes the transfer.
+func (p *Precompile) transferWithAuthorization(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+	typeHash common.Hash,
+) ([]byte, error) {
+	input, err := ParseTransferWithAuthorizationArgs(method, args)
+	if err != nil {
+		return nil, err
+	}
+
+	if method.Name == ReceiveWithAuthorizationMethod && contract.Caller() != input.To {
+		return nil, ErrCallerMustBePayee
+	}
+
+	nonce := common.Hash(input.Nonce)
+	if err := p.checkAuthorization(ctx, input.From, nonce, input.ValidAfter, input.ValidBefore); err != nil {
+		return nil, err
+	}
+
+	structHash := hashStruct(
+		typeHash,
+		addressToHash(input.From),
+		addressToHash(input.To),
+		common.BigToHash(input.Value),
+		common.BigToHash(input.ValidAfter),
+		common.BigToHash(input.ValidBefore),
+		nonce,
+	)
+	if err := p.verifyAuthorizationSignature(ctx, structHash, input.From, input.V, input.R, input.S); err != nil {
+		return nil, err
+	}
+
+	msg, err := p.newMsgSend(input.From, input.To, input.Value)
+	if err != nil {
+		return nil, err
+	}
+
+	p.erc20Keeper.SetAuthorizationNonceUsed(ctx, p.Address(), input.From, nonce)
+
+	if err := p.EmitAuthorizationUsedEvent(ctx, stateDB, input.From, nonce); err != nil {
+		return nil, err
+	}
+
+	if err := p.send(ctx, stateDB, msg, input.From, input.To, input.Value); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack()
+}
+
+// CancelAuthorization cancels an authorization that hasn't been used yet,
+// given the EIP-712 signed cancellation of the authorizer.
+func (p *Precompile) CancelAuthorization(
+	ctx sdk.Context,
+	_ *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	input, err := ParseCancelAuthorizationArgs(method, args)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := common.Hash(input.Nonce)
+	if p.erc20Keeper.IsAuthorizationNonceUsed(ctx, p.Address(), input.Authorizer, nonce) {
+		return nil, ErrAuthorizationUsed
+	}
+
+	structHash := hashStruct(cancelAuthorizationTypeHash, addressToHash(input.Authorizer), nonce)
+	if err := p.verifyAuthorizationSignature(ctx, structHash, input.Authorizer, input.V, input.R, input.S); err != nil {
+		return nil, err
+	}
+
+	p.erc20Keeper.SetAuthorizationNonceUsed(ctx, p.Address(), input.Authorizer, nonce)
+
+	if err := p.EmitAuthorizationCanceledEvent(ctx, stateDB, input.Authorizer, nonce); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack()
+}
+
+// checkAuthorization checks that the current block time is within the validity
+// period of the authorization and that its nonce hasn't been used.
+func (p Precompile) checkAuthorization(
+	ctx sdk.Context,
+	authorizer common.Address,
+	nonce common.Hash,
+	validAfter, validBefore *big.Int,
+) error {
+	now := big.NewInt(ctx.BlockTime().Unix())
+	if now.Cmp(validAfter) <= 0 {
+		return ErrAuthorizationNotYetValid
+	}
+	if now.Cmp(validBefore) >= 0 {
+		return ErrAuthorizationExpired
+	}
+
+	if p.erc20Keeper.IsAuthorizationNonceUsed(ctx, p.Address(), authorizer, nonce) {
+		return ErrAuthorizationUsed
+	}
+
+	return nil
+}
+
+// verifyAuthorizationSignature checks that the authorization with the given
+// struct hash has been signed by the authorizer.
+func (p Precompile) verifyAuthorizationSignature(
+	ctx sdk.Context,
+	structHash common.Hash,
+	authorizer common.Address,
+	v uint8,
+	r, s [32]byte,
+) error {
+	digest, err := p.hashTypedData(ctx, structHash)
+	if err != nil {
+		return ConvertErrToERC20Error(err)
+	}
+
+	signer, err := recoverSigner(digest, v, r, s)
+	if err != nil || signer != authorizer {
+		return ErrInvalidAuthorizationSignature
+	}
+
+	return nil
+}
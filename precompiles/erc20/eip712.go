@@ -0,0 +1,109 @@
+package erc20
+
+import (
+	"errors"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// domainVersion is the version of the EIP-712 signing domain of the ERC-20 precompiles.
+const domainVersion = "1"
+
+var (
+	// domainTypeHash is the EIP-712 type hash of the signing domain.
+	domainTypeHash = crypto.Keccak256Hash([]byte("EIP712Domain(string name,string version,uint256 chainId,address verifyingContract)"))
+	// permitTypeHash is the EIP-712 type hash of the EIP-2612 permits.
+	permitTypeHash = crypto.Keccak256Hash([]byte("Permit(address owner,address spender,uint256 value,uint256 nonce,uint256 deadline)"))
+	// transferWithAuthorizationTypeHash is the EIP-712 type hash of the ERC-3009 transfer authorizations.
+	transferWithAuthorizationTypeHash = crypto.Keccak256Hash([]byte("TransferWithAuthorization(address from,address to,uint256 value,uint256 validAfter,uint256 validBefore,bytes32 nonce)"))
+	// receiveWithAuthorizationTypeHash is the EIP-712 type hash of the ERC-3009 receive authorizations.
+	receiveWithAuthorizationTypeHash = crypto.Keccak256Hash([]byte("ReceiveWithAuthorization(address from,address to,uint256 value,uint256 validAfter,uint256 validBefore,bytes32 nonce)"))
+	// cancelAuthorizationTypeHash is the EIP-712 type hash of the ERC-3009 authorization cancellations.
+	cancelAuthorizationTypeHash = crypto.Keccak256Hash([]byte("CancelAuthorization(address authorizer,bytes32 nonce)"))
+)
+
+// errInvalidSignature is returned when the signature values are malformed or
+// the public key cannot be recovered from them.
+var errInvalidSignature = errors.New("invalid signature")
+
+// domainSeparator returns the EIP-712 domain separator of the precompile. The
+// domain uses the token name, the EVM chain ID and the precompile address, so
+// that signatures can't be replayed on other tokens or chains.
+//
+// NOTE: the name is read from the bank metadata of the token on every call, so
+// updating the metadata name changes the domain separator and invalidates all
+// the outstanding permits and authorizations signed for the previous name.
+func (p Precompile) domainSeparator(ctx sdk.Context) (common.Hash, error) {
+	name, err := p.name(ctx)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	return crypto.Keccak256Hash(
+		domainTypeHash.Bytes(),
+		crypto.Keccak256([]byte(name)),
+		crypto.Keccak256([]byte(domainVersion)),
+		common.BigToHash(evmtypes.GetEthChainConfig().ChainID).Bytes(),
+		common.BytesToHash(p.Address().Bytes()).Bytes(),
+	), nil
+}
+
+// hashTypedData returns the EIP-712 digest of the given struct hash, which is
+// the message that has to be signed.
+func (p Precompile) hashTypedData(ctx sdk.Context, structHash common.Hash) (common.Hash, error) {
+	domainSeparator, err := p.domainSeparator(ctx)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	return crypto.Keccak256Hash([]byte("\x19\x01"), domainSeparator.Bytes(), structHash.Bytes()), nil
+}
+
+// hashStruct returns the EIP-712 hash of a struct with the given type hash and
+// the already encoded fields.
+func hashStruct(typeHash common.Hash, fields ...common.Hash) common.Hash {
+	data := make([]byte, 0, (len(fields)+1)*common.HashLength)
+	data = append(data, typeHash.Bytes()...)
+	for _, field := range fields {
+		data = append(data, field.Bytes()...)
+	}
+
+	return crypto.Keccak256Hash(data)
+}
+
+// addressToHash encodes an address as an EIP-712 struct field.
+func addressToHash(address common.Address) common.Hash {
+	return common.BytesToHash(address.Bytes())
+}
+
+// recoverSigner returns the address that signed the digest with the given
+// signature values. The v value must be 27 or 28 and the s value must be in
+// the lower half of the curve order, as in the OpenZeppelin ECDSA library.
+func recoverSigner(digest common.Hash, v uint8, r, s [32]byte) (common.Address, error) {
+	if v != 27 && v != 28 {
+		return common.Address{}, errInvalidSignature
+	}
+
+	recoveryID := v - 27
+	if !crypto.ValidateSignatureValues(recoveryID, new(big.Int).SetBytes(r[:]), new(big.Int).SetBytes(s[:]), true) {
+		return common.Address{}, errInvalidSignature
+	}
+
+	sig := make([]byte, crypto.SignatureLength)
+	copy(sig[:32], r[:])
+	copy(sig[32:64], s[:])
+	sig[64] = recoveryID
+
+	pubKey, err := crypto.SigToPub(digest.Bytes(), sig)
+	if err != nil {
+		return common.Address{}, errInvalidSignature
+	}
+
+	return crypto.PubkeyToAddress(*pubKey), nil
+}
@@ -37,6 +37,17 @@ const (
 	GasTotalSupply  = 2_480
 	GasBalanceOf    = 2_870
 	GasAllowance    = 3_225
+
+	// NOTE: The gas values of the EIP-2612 and ERC-3009 methods are estimated from the
+	// ERC-20 transactions above plus the cost of the ecrecover precompile (3_000).
+
+	GasPermit                    = 11_100
+	GasNonces                    = 2_500
+	GasDomainSeparator           = 3_500
+	GasTransferWithAuthorization = 12_000
+	GasReceiveWithAuthorization  = 12_000
+	GasCancelAuthorization       = 5_000
+	GasAuthorizationState        = 2_500
 )
 
 // Embed abi json file to the executable binary. Needed when importing as dependency.
@@ -122,6 +133,22 @@ func (p Precompile) RequiredGas(input []byte) uint64 {
 		return GasBalanceOf
 	case AllowanceMethod:
 		return GasAllowance
+	// EIP-2612 methods
+	case PermitMethod:
+		return GasPermit
+	case NoncesMethod:
+		return GasNonces
+	case DomainSeparatorMethod:
+		return GasDomainSeparator
+	// ERC-3009 methods
+	case TransferWithAuthorizationMethod:
+		return GasTransferWithAuthorization
+	case ReceiveWithAuthorizationMethod:
+		return GasReceiveWithAuthorization
+	case CancelAuthorizationMethod:
+		return GasCancelAuthorization
+	case AuthorizationStateMethod:
+		return GasAuthorizationState
 	default:
 		return 0
 	}
@@ -174,7 +201,11 @@ func (Precompile) IsTransaction(method *abi.Method) bool {
 	switch method.Name {
 	case TransferMethod,
 		TransferFromMethod,
-		ApproveMethod:
+		ApproveMethod,
+		PermitMethod,
+		TransferWithAuthorizationMethod,
+		ReceiveWithAuthorizationMethod,
+		CancelAuthorizationMethod:
 		return true
 	default:
 		return false
@@ -210,6 +241,22 @@ func (p *Precompile) HandleMethod(
 		bz, err = p.BalanceOf(ctx, contract, stateDB, method, args)
 	case AllowanceMethod:
 		bz, err = p.Allowance(ctx, contract, stateDB, method, args)
+	// EIP-2612 methods
+	case PermitMethod:
+		bz, err = p.Permit(ctx, contract, stateDB, method, args)
+	case NoncesMethod:
+		bz, err = p.Nonces(ctx, contract, stateDB, method, args)
+	case DomainSeparatorMethod:
+		bz, err = p.DomainSeparator(ctx, contract, stateDB, method, args)
+	// ERC-3009 methods
+	case TransferWithAuthorizationMethod:
+		bz, err = p.TransferWithAuthorization(ctx, contract, stateDB, method, args)
+	case ReceiveWithAuthorizationMethod:
+		bz, err = p.ReceiveWithAuthorization(ctx, contract, stateDB, method, args)
+	case CancelAuthorizationMethod:
+		bz, err = p.CancelAuthorization(ctx, contract, stateDB, method, args)
+	case AuthorizationStateMethod:
+		bz, err = p.AuthorizationState(ctx, contract, stateDB, method, args)
 	default:
 		return nil, fmt.Errorf(cmn.ErrUnknownMethod, method.Name)
 	}
@@ -31,6 +31,17 @@ var (
 	ErrDecreasedAllowanceBelowZero  = errors.New("ERC20: decreased allowance below zero")
 	ErrInsufficientAllowance        = errors.New("ERC20: insufficient allowance")
 	ErrTransferAmountExceedsBalance = errors.New("ERC20: transfer amount exceeds balance")
+
+	// ERC20Permit errors
+	ErrExpiredDeadline        = errors.New("ERC20Permit: expired deadline")
+	ErrInvalidPermitSignature = errors.New("ERC20Permit: invalid signature")
+
+	// ERC3009 errors
+	ErrAuthorizationNotYetValid      = errors.New("ERC3009: authorization is not yet valid")
+	ErrAuthorizationExpired          = errors.New("ERC3009: authorization is expired")
+	ErrAuthorizationUsed             = errors.New("ERC3009: authorization is used or canceled")
+	ErrCallerMustBePayee             = errors.New("ERC3009: caller must be the payee")
+	ErrInvalidAuthorizationSignature = errors.New("ERC3009: invalid signature")
 )
 
 // ConvertErrToERC20Error is a helper function which maps errors raised by the Cosmos SDK stack
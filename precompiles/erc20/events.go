@@ -19,6 +19,12 @@ const (
 
 	// EventTypeApproval defines the event type for the ERC-20 Approval event.
 	EventTypeApproval = "Approval"
+
+	// EventTypeAuthorizationUsed defines the event type for the ERC-3009 AuthorizationUsed event.
+	EventTypeAuthorizationUsed = "AuthorizationUsed"
+
+	// EventTypeAuthorizationCanceled defines the event type for the ERC-3009 AuthorizationCanceled event.
+	EventTypeAuthorizationCanceled = "AuthorizationCanceled"
 )
 
 // EmitTransferEvent creates a new Transfer event emitted on transfer and transferFrom transactions.
@@ -92,3 +98,42 @@ func (p Precompile) EmitApprovalEvent(ctx sdk.Context, stateDB vm.StateDB, owner
 
 	return nil
 }
+
+// EmitAuthorizationUsedEvent creates a new AuthorizationUsed event emitted on the
+// transferWithAuthorization and receiveWithAuthorization transactions.
+func (p Precompile) EmitAuthorizationUsedEvent(ctx sdk.Context, stateDB vm.StateDB, authorizer common.Address, nonce common.Hash) error {
+	return p.emitAuthorizationEvent(ctx, stateDB, EventTypeAuthorizationUsed, authorizer, nonce)
+}
+
+// EmitAuthorizationCanceledEvent creates a new AuthorizationCanceled event emitted on
+// cancelAuthorization transactions.
+func (p Precompile) EmitAuthorizationCanceledEvent(ctx sdk.Context, stateDB vm.StateDB, authorizer common.Address, nonce common.Hash) error {
+	return p.emitAuthorizationEvent(ctx, stateDB, EventTypeAuthorizationCanceled, authorizer, nonce)
+}
+
+// emitAuthorizationEvent emits an ERC-3009 event, which has the authorizer and
+// the nonce as indexed topics and no data.
+func (p Precompile) emitAuthorizationEvent(ctx sdk.Context, stateDB vm.StateDB, eventType string, authorizer common.Address, nonce common.Hash) error {
+	// Prepare the event topics
+	event := p.Events[eventType]
+	topics := make([]common.Hash, 3)
+
+	// The first topic is always the signature of the event.
+	topics[0] = event.ID
+
+	var err error
+	topics[1], err = cmn.MakeTopic(authorizer)
+	if err != nil {
+		return err
+	}
+
+	topics[2] = nonce
+
+	stateDB.AddLog(&ethtypes.Log{
+		Address:     p.Address(),
+		Topics:      topics,
+		BlockNumber: uint64(ctx.BlockHeight()), //nolint:gosec // G115 // block height won't exceed uint64
+	})
+
+	return nil
+}
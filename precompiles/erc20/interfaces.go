@@ -13,4 +13,8 @@ type Erc20Keeper interface {
 	GetAllowance(ctx sdk.Context, erc20 common.Address, owner common.Address, spender common.Address) (*big.Int, error)
 	SetAllowance(ctx sdk.Context, erc20 common.Address, owner common.Address, spender common.Address, value *big.Int) error
 	DeleteAllowance(ctx sdk.Context, erc20 common.Address, owner common.Address, spender common.Address) error
+	GetPermitNonce(ctx sdk.Context, erc20 common.Address, owner common.Address) uint64
+	SetPermitNonce(ctx sdk.Context, erc20 common.Address, owner common.Address, nonce uint64)
+	IsAuthorizationNonceUsed(ctx sdk.Context, erc20 common.Address, authorizer common.Address, nonce common.Hash) bool
+	SetAuthorizationNonceUsed(ctx sdk.Context, erc20 common.Address, authorizer common.Address, nonce common.Hash)
 }
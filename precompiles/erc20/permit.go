@@ -0,0 +1,120 @@
+package erc20
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// PermitMethod defines the ABI method name for the EIP-2612 permit
+	// transaction.
+	PermitMethod = "permit"
+	// NoncesMethod defines the ABI method name for the EIP-2612 nonces
+	// query.
+	NoncesMethod = "nonces"
+	// DomainSeparatorMethod defines the ABI method name for the EIP-2612
+	// DOMAIN_SEPARATOR query.
+	DomainSeparatorMethod = "DOMAIN_SEPARATOR"
+)
+
+// Permit sets the given value as the allowance of the spender over the owner's
+// tokens, given the owner's EIP-712 signed approval. The signature must use the
+// current nonce of the owner, which is then incremented. It emits the Approval
+// event on success.
+//
+// NOTE: anyone can submit the signed permit, so the caller is not checked.
+func (p Precompile) Permit(
+	ctx sdk.Context,
+	_ *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	input, err := ParsePermitArgs(method, args)
+	if err != nil {
+		return nil, err
+	}
+
+	if input.Deadline.Cmp(big.NewInt(ctx.BlockTime().Unix())) < 0 {
+		return nil, ErrExpiredDeadline
+	}
+
+	nonce := p.erc20Keeper.GetPermitNonce(ctx, p.Address(), input.Owner)
+
+	structHash := hashStruct(
+		permitTypeHash,
+		addressToHash(input.Owner),
+		addressToHash(input.Spender),
+		common.BigToHash(input.Value),
+		common.BigToHash(new(big.Int).SetUint64(nonce)),
+		common.BigToHash(input.Deadline),
+	)
+
+	digest, err := p.hashTypedData(ctx, structHash)
+	if err != nil {
+		return nil, ConvertErrToERC20Error(err)
+	}
+
+	signer, err := recoverSigner(digest, input.V, input.R, input.S)
+	if err != nil || signer != input.Owner {
+		return nil, ErrInvalidPermitSignature
+	}
+
+	p.erc20Keeper.SetPermitNonce(ctx, p.Address(), input.Owner, nonce+1)
+
+	if input.Value.Sign() == 0 {
+		err = p.erc20Keeper.DeleteAllowance(ctx, p.Address(), input.Owner, input.Spender)
+	} else {
+		err = p.setAllowance(ctx, input.Owner, input.Spender, input.Value)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := p.EmitApprovalEvent(ctx, stateDB, input.Owner, input.Spender, input.Value); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack()
+}
+
+// Nonces returns the current nonce of the owner, which must be included in
+// the next permit signature.
+func (p Precompile) Nonces(
+	ctx sdk.Context,
+	_ *vm.Contract,
+	_ vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	owner, err := ParseNoncesArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := p.erc20Keeper.GetPermitNonce(ctx, p.Address(), owner)
+
+	return method.Outputs.Pack(new(big.Int).SetUint64(nonce))
+}
+
+// DomainSeparator returns the EIP-712 domain separator used in the encoding of
+// the permit and authorization signatures.
+func (p Precompile) DomainSeparator(
+	ctx sdk.Context,
+	_ *vm.Contract,
+	_ vm.StateDB,
+	method *abi.Method,
+	_ []interface{},
+) ([]byte, error) {
+	domainSeparator, err := p.domainSeparator(ctx)
+	if err != nil {
+		return nil, ConvertErrToERC20Error(err)
+	}
+
+	return method.Outputs.Pack([32]byte(domainSeparator))
+}
@@ -45,17 +45,11 @@ func (p Precompile) Name(
 	method *abi.Method,
 	_ []interface{},
 ) ([]byte, error) {
-	metadata, found := p.BankKeeper.GetDenomMetaData(ctx, p.tokenPair.Denom)
-	if found {
-		return method.Outputs.Pack(metadata.Name)
-	}
-
-	baseDenom, err := p.getBaseDenomFromIBCVoucher(ctx, p.tokenPair.Denom)
+	name, err := p.name(ctx)
 	if err != nil {
 		return nil, ConvertErrToERC20Error(err)
 	}
 
-	name := strings.ToUpper(string(baseDenom[1])) + baseDenom[2:]
 	return method.Outputs.Pack(name)
 }
 
@@ -193,6 +187,22 @@ func (p Precompile) Allowance(
 	return method.Outputs.Pack(allowance)
 }
 
+// name returns the name of the token, which is also used as the name of the
+// EIP-712 domain for permits and authorizations.
+func (p Precompile) name(ctx sdk.Context) (string, error) {
+	metadata, found := p.BankKeeper.GetDenomMetaData(ctx, p.tokenPair.Denom)
+	if found {
+		return metadata.Name, nil
+	}
+
+	baseDenom, err := p.getBaseDenomFromIBCVoucher(ctx, p.tokenPair.Denom)
+	if err != nil {
+		return "", err
+	}
+
+	return strings.ToUpper(string(baseDenom[1])) + baseDenom[2:], nil
+}
+
 // getBaseDenomFromIBCVoucher returns the base denomination from the given IBC voucher denomination.
 func (p Precompile) getBaseDenomFromIBCVoucher(ctx sdk.Context, voucherDenom string) (string, error) {
 	// Infer the denomination name from the coin denomination base voucherDenom
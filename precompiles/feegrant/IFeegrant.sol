@@ -0,0 +1,126 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.17;
+
+import "../common/Types.sol";
+
+/// @dev The IFeegrant contract's address.
+address constant FEEGRANT_PRECOMPILE_ADDRESS = 0x0000000000000000000000000000000000000808;
+
+/// @dev The IFeegrant contract's instance.
+IFeegrant constant FEEGRANT_CONTRACT = IFeegrant(FEEGRANT_PRECOMPILE_ADDRESS);
+
+/// @dev Allowance is a fee allowance granted by a granter to a grantee.
+struct Allowance {
+    /// @dev The address of the account paying the fees
+    address granter;
+    /// @dev The address of the account whose fees are paid
+    address grantee;
+    /// @dev The type URL of the allowance, e.g. "/cosmos.feegrant.v1beta1.BasicAllowance"
+    string allowanceType;
+    /// @dev The coins that can still be spent on fees, or empty if there is no limit
+    Coin[] spendLimit;
+    /// @dev The unix time at which the allowance expires, or zero if it does not expire
+    int64 expiration;
+    /// @dev The duration in seconds of a period, only set for periodic allowances
+    int64 period;
+    /// @dev The coins that can be spent on fees in a period, only set for periodic allowances
+    Coin[] periodSpendLimit;
+    /// @dev The coins that can still be spent on fees in the current period, only set for periodic allowances
+    Coin[] periodCanSpend;
+    /// @dev The unix time at which the current period ends, only set for periodic allowances
+    int64 periodReset;
+    /// @dev The type URLs of the only messages whose fees are paid, or empty if all the messages are allowed
+    string[] allowedMessages;
+}
+
+/// @author Evmos Team
+/// @title Feegrant Precompiled Contract
+/// @dev The interface through which solidity contracts will interact with the Cosmos SDK feegrant module.
+/// A granter pays the fees of the transactions of a grantee within an allowance. The fees of the
+/// Ethereum transactions are paid by the fee granter set on the Cosmos transaction wrapping them,
+/// when the chain enables it.
+/// @custom:address 0x0000000000000000000000000000000000000808
+interface IFeegrant {
+    /// @dev Emitted when a fee allowance is granted
+    /// @param granter The address of the granter
+    /// @param grantee The address of the grantee
+    /// @param allowanceType The type URL of the allowance
+    event GrantAllowance(address indexed granter, address indexed grantee, string allowanceType);
+
+    /// @dev Emitted when a fee allowance is revoked
+    /// @param granter The address of the granter
+    /// @param grantee The address of the grantee
+    event RevokeAllowance(address indexed granter, address indexed grantee);
+
+    /// TRANSACTIONS
+
+    /// @dev Grants a basic allowance to pay the fees of the grantee.
+    /// @param granter The address of the granter, which must be the caller
+    /// @param grantee The address of the grantee
+    /// @param spendLimit The coins that can be spent on fees, or empty if there is no limit
+    /// @param expiration The unix time at which the allowance expires, or zero if it does not expire
+    /// @return success Whether the transaction was successful or not
+    function grantBasicAllowance(
+        address granter,
+        address grantee,
+        Coin[] calldata spendLimit,
+        int64 expiration
+    ) external returns (bool success);
+
+    /// @dev Grants a periodic allowance to pay the fees of the grantee, whose spend limit is reset every period.
+    /// @param granter The address of the granter, which must be the caller
+    /// @param grantee The address of the grantee
+    /// @param spendLimit The coins that can be spent on fees in total, or empty if there is no limit
+    /// @param expiration The unix time at which the allowance expires, or zero if it does not expire
+    /// @param period The duration in seconds of a period
+    /// @param periodSpendLimit The coins that can be spent on fees in a period
+    /// @return success Whether the transaction was successful or not
+    function grantPeriodicAllowance(
+        address granter,
+        address grantee,
+        Coin[] calldata spendLimit,
+        int64 expiration,
+        int64 period,
+        Coin[] calldata periodSpendLimit
+    ) external returns (bool success);
+
+    /// @dev Revokes the fee allowance of the grantee.
+    /// @param granter The address of the granter, which must be the caller
+    /// @param grantee The address of the grantee
+    /// @return success Whether the transaction was successful or not
+    function revokeAllowance(
+        address granter,
+        address grantee
+    ) external returns (bool success);
+
+    /// QUERIES
+
+    /// @dev Returns the fee allowance granted by a granter to a grantee.
+    /// @param granter The address of the granter
+    /// @param grantee The address of the grantee
+    /// @return allowance The fee allowance
+    function allowance(
+        address granter,
+        address grantee
+    ) external view returns (Allowance memory allowance);
+
+    /// @dev Returns the fee allowances granted to a grantee.
+    /// @param grantee The address of the grantee
+    /// @param pagination The pagination options
+    /// @return allowances The fee allowances
+    /// @return pageResponse The pagination response
+    function allowances(
+        address grantee,
+        PageRequest calldata pagination
+    ) external view returns (Allowance[] memory allowances, PageResponse memory pageResponse);
+
+    /// @dev Returns the fee allowances granted by a granter.
+    /// @param granter The address of the granter
+    /// @param pagination The pagination options
+    /// @return allowances The fee allowances
+    /// @return pageResponse The pagination response
+    function allowancesByGranter(
+        address granter,
+        PageRequest calldata pagination
+    ) external view returns (Allowance[] memory allowances, PageResponse memory pageResponse);
+}
@@ -0,0 +1,6 @@
+package feegrant
+
+const (
+	// ErrInvalidPeriod is raised when the period of a periodic allowance is not positive.
+	ErrInvalidPeriod = "invalid period: %d"
+)
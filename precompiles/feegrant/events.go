@@ -0,0 +1,67 @@
+package feegrant
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// EventTypeGrantAllowance defines the event type for the feegrant grant transactions.
+	EventTypeGrantAllowance = "GrantAllowance"
+	// EventTypeRevokeAllowance defines the event type for the feegrant RevokeAllowance transaction.
+	EventTypeRevokeAllowance = "RevokeAllowance"
+)
+
+// EmitGrantAllowanceEvent creates a new event emitted on the GrantBasicAllowance
+// and GrantPeriodicAllowance transactions.
+func (p Precompile) EmitGrantAllowanceEvent(ctx sdk.Context, stateDB vm.StateDB, granter, grantee common.Address, allowanceType string) error {
+	return p.emitEvent(ctx, stateDB, EventTypeGrantAllowance, granter, grantee, allowanceType)
+}
+
+// EmitRevokeAllowanceEvent creates a new event emitted on a RevokeAllowance transaction.
+func (p Precompile) EmitRevokeAllowanceEvent(ctx sdk.Context, stateDB vm.StateDB, granter, grantee common.Address) error {
+	return p.emitEvent(ctx, stateDB, EventTypeRevokeAllowance, granter, grantee)
+}
+
+// emitEvent emits an event of the given type, indexing the granter and the
+// grantee. The remaining values are encoded as the event data.
+func (p Precompile) emitEvent(ctx sdk.Context, stateDB vm.StateDB, eventType string, granter, grantee common.Address, data ...interface{}) error {
+	// Prepare the event topics
+	event := p.Events[eventType]
+	topics := make([]common.Hash, 3)
+
+	// The first topic is always the signature of the event.
+	topics[0] = event.ID
+
+	var err error
+	topics[1], err = cmn.MakeTopic(granter)
+	if err != nil {
+		return err
+	}
+
+	topics[2], err = cmn.MakeTopic(grantee)
+	if err != nil {
+		return err
+	}
+
+	packed, err := event.Inputs.NonIndexed().Pack(data...)
+	if err != nil {
+		return fmt.Errorf("failed to pack event data: %w", err)
+	}
+
+	stateDB.AddLog(&ethtypes.Log{
+		Address:     p.Address(),
+		Topics:      topics,
+		Data:        packed,
+		BlockNumber: uint64(ctx.BlockHeight()), //nolint:gosec // G115 // won't exceed uint64
+	})
+
+	return nil
+}
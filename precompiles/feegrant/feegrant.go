@@ -0,0 +1,174 @@
+package feegrant
+
+import (
+	"embed"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/tracing"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"cosmossdk.io/core/address"
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/feegrant"
+	feegrantkeeper "cosmossdk.io/x/feegrant/keeper"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ vm.PrecompiledContract = &Precompile{}
+
+// Embed abi json file to the executable binary. Needed when importing as dependency.
+//
+//go:embed abi.json
+var f embed.FS
+
+// Precompile defines the precompiled contract for feegrant.
+type Precompile struct {
+	cmn.Precompile
+	feegrantKeeper feegrantkeeper.Keeper
+	msgServer      feegrant.MsgServer
+	cdc            codec.Codec
+	addrCdc        address.Codec
+}
+
+// LoadABI loads the feegrant ABI from the embedded abi.json file
+// for the feegrant precompile.
+func LoadABI() (abi.ABI, error) {
+	return cmn.LoadABI(f, "abi.json")
+}
+
+// NewPrecompile creates a new feegrant Precompile instance as a
+// PrecompiledContract interface.
+func NewPrecompile(
+	feegrantKeeper feegrantkeeper.Keeper,
+	cdc codec.Codec,
+	addrCdc address.Codec,
+) (*Precompile, error) {
+	abi, err := LoadABI()
+	if err != nil {
+		return nil, err
+	}
+
+	p := &Precompile{
+		Precompile: cmn.Precompile{
+			ABI:                  abi,
+			KvGasConfig:          storetypes.KVGasConfig(),
+			TransientKVGasConfig: storetypes.TransientGasConfig(),
+		},
+		feegrantKeeper: feegrantKeeper,
+		msgServer:      feegrantkeeper.NewMsgServerImpl(feegrantKeeper),
+		cdc:            cdc,
+		addrCdc:        addrCdc,
+	}
+
+	// SetAddress defines the address of the feegrant precompiled contract.
+	p.SetAddress(common.HexToAddress(evmtypes.FeegrantPrecompileAddress))
+
+	return p, nil
+}
+
+// RequiredGas calculates the precompiled contract's base gas rate.
+func (p Precompile) RequiredGas(input []byte) uint64 {
+	// NOTE: This check avoid panicking when trying to decode the method ID
+	if len(input) < 4 {
+		return 0
+	}
+	methodID := input[:4]
+
+	method, err := p.MethodById(methodID)
+	if err != nil {
+		// This should never happen since this method is going to fail during Run
+		return 0
+	}
+
+	return p.Precompile.RequiredGas(input, p.IsTransaction(method))
+}
+
+// Run executes the precompiled contract feegrant methods defined in the ABI.
+func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz []byte, err error) {
+	bz, err = p.run(evm, contract, readOnly)
+	if err != nil {
+		return cmn.ReturnRevertError(evm, err)
+	}
+
+	return bz, nil
+}
+
+func (p Precompile) run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz []byte, err error) {
+	ctx, stateDB, method, initialGas, args, err := p.RunSetup(evm, contract, readOnly, p.IsTransaction)
+	if err != nil {
+		return nil, err
+	}
+
+	// Start the balance change handler before executing the precompile.
+	p.GetBalanceHandler().BeforeBalanceChange(ctx)
+
+	// This handles any out of gas errors that may occur during the execution of a precompile tx or query.
+	// It avoids panics and returns the out of gas error so the EVM can continue gracefully.
+	defer cmn.HandleGasError(ctx, contract, initialGas, &err)()
+
+	switch method.Name {
+	// feegrant transactions
+	case GrantBasicAllowanceMethod:
+		bz, err = p.GrantBasicAllowance(ctx, contract, stateDB, method, args)
+	case GrantPeriodicAllowanceMethod:
+		bz, err = p.GrantPeriodicAllowance(ctx, contract, stateDB, method, args)
+	case RevokeAllowanceMethod:
+		bz, err = p.RevokeAllowance(ctx, contract, stateDB, method, args)
+	// feegrant queries
+	case AllowanceMethod:
+		bz, err = p.Allowance(ctx, contract, method, args)
+	case AllowancesMethod:
+		bz, err = p.Allowances(ctx, contract, method, args)
+	case AllowancesByGranterMethod:
+		bz, err = p.AllowancesByGranter(ctx, contract, method, args)
+	default:
+		return nil, fmt.Errorf(cmn.ErrUnknownMethod, method.Name)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	cost := ctx.GasMeter().GasConsumed() - initialGas
+
+	if !contract.UseGas(cost, nil, tracing.GasChangeCallPrecompiledContract) {
+		return nil, vm.ErrOutOfGas
+	}
+
+	// Process the native balance changes after the method execution.
+	if err = p.GetBalanceHandler().AfterBalanceChange(ctx, stateDB); err != nil {
+		return nil, err
+	}
+
+	return bz, nil
+}
+
+// IsTransaction checks if the given method name corresponds to a transaction or query.
+//
+// Available feegrant transactions are:
+//   - GrantBasicAllowance
+//   - GrantPeriodicAllowance
+//   - RevokeAllowance
+func (Precompile) IsTransaction(method *abi.Method) bool {
+	switch method.Name {
+	case GrantBasicAllowanceMethod,
+		GrantPeriodicAllowanceMethod,
+		RevokeAllowanceMethod:
+		return true
+	default:
+		return false
+	}
+}
+
+// Logger returns a precompile-specific logger.
+func (p Precompile) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("evm extension", "feegrant")
+}
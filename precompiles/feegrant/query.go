@@ -0,0 +1,90 @@
+package feegrant
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// AllowanceMethod defines the ABI method name for the feegrant Allowance query.
+	AllowanceMethod = "allowance"
+	// AllowancesMethod defines the ABI method name for the feegrant Allowances query.
+	AllowancesMethod = "allowances"
+	// AllowancesByGranterMethod defines the ABI method name for the feegrant
+	// AllowancesByGranter query.
+	AllowancesByGranterMethod = "allowancesByGranter"
+)
+
+// Allowance returns the fee allowance of a granter to a grantee.
+func (p Precompile) Allowance(
+	ctx sdk.Context,
+	_ *vm.Contract,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	req, err := NewQueryAllowanceRequest(args, p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := p.feegrantKeeper.Allowance(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	allowance, err := NewAllowance(p.cdc, res.Allowance)
+	if err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack(allowance)
+}
+
+// Allowances returns the fee allowances received by a grantee.
+func (p Precompile) Allowances(
+	ctx sdk.Context,
+	_ *vm.Contract,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	req, err := NewQueryAllowancesRequest(method, args, p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := p.feegrantKeeper.Allowances(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := new(AllowancesOutput).FromGrants(p.cdc, res.Allowances, res.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack(out.Allowances, out.PageResponse)
+}
+
+// AllowancesByGranter returns the fee allowances given by a granter.
+func (p Precompile) AllowancesByGranter(
+	ctx sdk.Context,
+	_ *vm.Contract,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	req, err := NewQueryAllowancesByGranterRequest(method, args, p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := p.feegrantKeeper.AllowancesByGranter(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := new(AllowancesOutput).FromGrants(p.cdc, res.Allowances, res.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack(out.Allowances, out.PageResponse)
+}
@@ -0,0 +1,117 @@
+package feegrant
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+
+	"cosmossdk.io/x/feegrant"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// GrantBasicAllowanceMethod defines the ABI method name for the feegrant
+	// GrantAllowance transaction of a basic allowance.
+	GrantBasicAllowanceMethod = "grantBasicAllowance"
+	// GrantPeriodicAllowanceMethod defines the ABI method name for the feegrant
+	// GrantAllowance transaction of a periodic allowance.
+	GrantPeriodicAllowanceMethod = "grantPeriodicAllowance"
+	// RevokeAllowanceMethod defines the ABI method name for the feegrant
+	// RevokeAllowance transaction.
+	RevokeAllowanceMethod = "revokeAllowance"
+)
+
+// GrantBasicAllowance grants a basic allowance of the caller to the grantee.
+func (p *Precompile) GrantBasicAllowance(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, granterHexAddr, err := NewMsgGrantBasicAllowance(method, args, p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.grantAllowance(ctx, contract, stateDB, method, msg, granterHexAddr, args[1].(common.Address))
+}
+
+// GrantPeriodicAllowance grants a periodic allowance of the caller to the
+// grantee.
+func (p *Precompile) GrantPeriodicAllowance(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, granterHexAddr, err := NewMsgGrantPeriodicAllowance(method, args, p.addrCdc, ctx.BlockTime())
+	if err != nil {
+		return nil, err
+	}
+
+	return p.grantAllowance(ctx, contract, stateDB, method, msg, granterHexAddr, args[1].(common.Address))
+}
+
+// RevokeAllowance revokes the fee allowance of the caller to the grantee.
+func (p *Precompile) RevokeAllowance(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, granterHexAddr, err := NewMsgRevokeAllowance(args, p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	msgSender := contract.Caller()
+	if msgSender != granterHexAddr {
+		return nil, fmt.Errorf(cmn.ErrRequesterIsNotMsgSender, msgSender.String(), granterHexAddr.String())
+	}
+
+	cmn.TraceMsg(ctx, msg)
+	if _, err := p.msgServer.RevokeAllowance(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	if err = p.EmitRevokeAllowanceEvent(ctx, stateDB, granterHexAddr, args[1].(common.Address)); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// grantAllowance grants the allowance of the MsgGrantAllowance if the caller
+// is the granter.
+func (p *Precompile) grantAllowance(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	msg *feegrant.MsgGrantAllowance,
+	granterHexAddr, granteeHexAddr common.Address,
+) ([]byte, error) {
+	msgSender := contract.Caller()
+	if msgSender != granterHexAddr {
+		return nil, fmt.Errorf(cmn.ErrRequesterIsNotMsgSender, msgSender.String(), granterHexAddr.String())
+	}
+
+	cmn.TraceMsg(ctx, msg)
+	if _, err := p.msgServer.GrantAllowance(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	if err := p.EmitGrantAllowanceEvent(ctx, stateDB, granterHexAddr, granteeHexAddr, msg.Allowance.TypeUrl); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
@@ -0,0 +1,385 @@
+package feegrant
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+	"github.com/cosmos/evm/utils"
+
+	"cosmossdk.io/core/address"
+	"cosmossdk.io/x/feegrant"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+// Allowance defines a fee allowance in types native to the EVM.
+type Allowance struct {
+	Granter          common.Address `abi:"granter"`
+	Grantee          common.Address `abi:"grantee"`
+	AllowanceType    string         `abi:"allowanceType"`
+	SpendLimit       []cmn.Coin     `abi:"spendLimit"`
+	Expiration       int64          `abi:"expiration"`
+	Period           int64          `abi:"period"`
+	PeriodSpendLimit []cmn.Coin     `abi:"periodSpendLimit"`
+	PeriodCanSpend   []cmn.Coin     `abi:"periodCanSpend"`
+	PeriodReset      int64          `abi:"periodReset"`
+	AllowedMessages  []string       `abi:"allowedMessages"`
+}
+
+// BasicAllowanceInput defines the input of the grantBasicAllowance method.
+type BasicAllowanceInput struct {
+	Granter    common.Address `abi:"granter"`
+	Grantee    common.Address `abi:"grantee"`
+	SpendLimit []cmn.Coin     `abi:"spendLimit"`
+	Expiration int64          `abi:"expiration"`
+}
+
+// PeriodicAllowanceInput defines the input of the grantPeriodicAllowance method.
+type PeriodicAllowanceInput struct {
+	Granter          common.Address `abi:"granter"`
+	Grantee          common.Address `abi:"grantee"`
+	SpendLimit       []cmn.Coin     `abi:"spendLimit"`
+	Expiration       int64          `abi:"expiration"`
+	Period           int64          `abi:"period"`
+	PeriodSpendLimit []cmn.Coin     `abi:"periodSpendLimit"`
+}
+
+// AllowancesInput defines the input of the allowances query.
+type AllowancesInput struct {
+	Grantee    common.Address
+	Pagination query.PageRequest
+}
+
+// AllowancesByGranterInput defines the input of the allowancesByGranter query.
+type AllowancesByGranterInput struct {
+	Granter    common.Address
+	Pagination query.PageRequest
+}
+
+// AllowancesOutput defines the output of the allowances queries.
+type AllowancesOutput struct {
+	Allowances   []Allowance        `abi:"allowances"`
+	PageResponse query.PageResponse `abi:"pageResponse"`
+}
+
+// NewMsgGrantBasicAllowance creates a new MsgGrantAllowance message of a basic
+// allowance and returns the address of the granter.
+func NewMsgGrantBasicAllowance(method *abi.Method, args []interface{}, addrCdc address.Codec) (*feegrant.MsgGrantAllowance, common.Address, error) {
+	if len(args) != 4 {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 4, len(args))
+	}
+
+	var input BasicAllowanceInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, common.Address{}, fmt.Errorf("error while unpacking args to BasicAllowanceInput: %s", err)
+	}
+
+	basic, err := newBasicAllowance(input.SpendLimit, input.Expiration)
+	if err != nil {
+		return nil, common.Address{}, err
+	}
+
+	msg, err := newMsgGrantAllowance(input.Granter, input.Grantee, basic, addrCdc)
+	if err != nil {
+		return nil, common.Address{}, err
+	}
+	return msg, input.Granter, nil
+}
+
+// NewMsgGrantPeriodicAllowance creates a new MsgGrantAllowance message of a
+// periodic allowance, whose first period starts at the given block time, and
+// returns the address of the granter.
+func NewMsgGrantPeriodicAllowance(method *abi.Method, args []interface{}, addrCdc address.Codec, blockTime time.Time) (*feegrant.MsgGrantAllowance, common.Address, error) {
+	if len(args) != 6 {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 6, len(args))
+	}
+
+	var input PeriodicAllowanceInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, common.Address{}, fmt.Errorf("error while unpacking args to PeriodicAllowanceInput: %s", err)
+	}
+
+	if input.Period <= 0 {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidPeriod, input.Period)
+	}
+
+	basic, err := newBasicAllowance(input.SpendLimit, input.Expiration)
+	if err != nil {
+		return nil, common.Address{}, err
+	}
+
+	periodSpendLimit, err := cmn.NewSdkCoinsFromCoins(input.PeriodSpendLimit)
+	if err != nil {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidAmount, err)
+	}
+
+	period := time.Duration(input.Period) * time.Second
+	periodic := &feegrant.PeriodicAllowance{
+		Basic:            *basic,
+		Period:           period,
+		PeriodSpendLimit: periodSpendLimit,
+		PeriodCanSpend:   periodSpendLimit,
+		PeriodReset:      blockTime.Add(period),
+	}
+
+	msg, err := newMsgGrantAllowance(input.Granter, input.Grantee, periodic, addrCdc)
+	if err != nil {
+		return nil, common.Address{}, err
+	}
+	return msg, input.Granter, nil
+}
+
+// NewMsgRevokeAllowance creates a new MsgRevokeAllowance message and returns
+// the address of the granter.
+func NewMsgRevokeAllowance(args []interface{}, addrCdc address.Codec) (*feegrant.MsgRevokeAllowance, common.Address, error) {
+	if len(args) != 2 {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	granter, grantee, err := parseGranterGrantee(args[0], args[1])
+	if err != nil {
+		return nil, common.Address{}, err
+	}
+
+	granterAddr, granteeAddr, err := encodeAddresses(granter, grantee, addrCdc)
+	if err != nil {
+		return nil, common.Address{}, err
+	}
+
+	msg := &feegrant.MsgRevokeAllowance{
+		Granter: granterAddr,
+		Grantee: granteeAddr,
+	}
+	return msg, granter, nil
+}
+
+// NewQueryAllowanceRequest creates a new QueryAllowanceRequest from the args
+// of the allowance query.
+func NewQueryAllowanceRequest(args []interface{}, addrCdc address.Codec) (*feegrant.QueryAllowanceRequest, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	granter, grantee, err := parseGranterGrantee(args[0], args[1])
+	if err != nil {
+		return nil, err
+	}
+
+	granterAddr, granteeAddr, err := encodeAddresses(granter, grantee, addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	return &feegrant.QueryAllowanceRequest{
+		Granter: granterAddr,
+		Grantee: granteeAddr,
+	}, nil
+}
+
+// NewQueryAllowancesRequest creates a new QueryAllowancesRequest from the args
+// of the allowances query.
+func NewQueryAllowancesRequest(method *abi.Method, args []interface{}, addrCdc address.Codec) (*feegrant.QueryAllowancesRequest, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	var input AllowancesInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, fmt.Errorf("error while unpacking args to AllowancesInput: %s", err)
+	}
+
+	grantee, err := encodeAddress(input.Grantee, addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	return &feegrant.QueryAllowancesRequest{
+		Grantee:    grantee,
+		Pagination: &input.Pagination,
+	}, nil
+}
+
+// NewQueryAllowancesByGranterRequest creates a new QueryAllowancesByGranterRequest
+// from the args of the allowancesByGranter query.
+func NewQueryAllowancesByGranterRequest(method *abi.Method, args []interface{}, addrCdc address.Codec) (*feegrant.QueryAllowancesByGranterRequest, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	var input AllowancesByGranterInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, fmt.Errorf("error while unpacking args to AllowancesByGranterInput: %s", err)
+	}
+
+	granter, err := encodeAddress(input.Granter, addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	return &feegrant.QueryAllowancesByGranterRequest{
+		Granter:    granter,
+		Pagination: &input.Pagination,
+	}, nil
+}
+
+// FromGrants populates the AllowancesOutput from the grants of the allowances
+// queries.
+func (o *AllowancesOutput) FromGrants(cdc codec.Codec, grants []*feegrant.Grant, pageRes *query.PageResponse) (*AllowancesOutput, error) {
+	o.Allowances = make([]Allowance, len(grants))
+	for i, g := range grants {
+		allowance, err := NewAllowance(cdc, g)
+		if err != nil {
+			return nil, err
+		}
+		o.Allowances[i] = allowance
+	}
+	if pageRes != nil {
+		o.PageResponse = query.PageResponse{
+			NextKey: pageRes.NextKey,
+			Total:   pageRes.Total,
+		}
+	}
+	return o, nil
+}
+
+// NewAllowance converts a fee allowance grant into an Allowance. The period
+// fields are only set for periodic allowances, and the allowed messages for
+// allowances restricted to some msg types.
+func NewAllowance(cdc codec.Codec, grant *feegrant.Grant) (Allowance, error) {
+	granter, err := utils.HexAddressFromBech32String(grant.Granter)
+	if err != nil {
+		return Allowance{}, err
+	}
+	grantee, err := utils.HexAddressFromBech32String(grant.Grantee)
+	if err != nil {
+		return Allowance{}, err
+	}
+
+	var feeAllowance feegrant.FeeAllowanceI
+	if err := cdc.UnpackAny(grant.Allowance, &feeAllowance); err != nil {
+		return Allowance{}, err
+	}
+
+	out := Allowance{
+		Granter:          granter,
+		Grantee:          grantee,
+		AllowanceType:    grant.Allowance.TypeUrl,
+		SpendLimit:       []cmn.Coin{},
+		PeriodSpendLimit: []cmn.Coin{},
+		PeriodCanSpend:   []cmn.Coin{},
+		AllowedMessages:  []string{},
+	}
+
+	// the allowed msg allowance restricts the msg types of another allowance
+	if filtered, ok := feeAllowance.(*feegrant.AllowedMsgAllowance); ok {
+		out.AllowedMessages = filtered.AllowedMessages
+		if err := cdc.UnpackAny(filtered.Allowance, &feeAllowance); err != nil {
+			return Allowance{}, err
+		}
+	}
+
+	switch a := feeAllowance.(type) {
+	case *feegrant.BasicAllowance:
+		out.setBasic(a)
+	case *feegrant.PeriodicAllowance:
+		out.setBasic(&a.Basic)
+		out.Period = int64(a.Period.Seconds())
+		out.PeriodSpendLimit = cmn.NewCoinsResponse(a.PeriodSpendLimit)
+		out.PeriodCanSpend = cmn.NewCoinsResponse(a.PeriodCanSpend)
+		out.PeriodReset = a.PeriodReset.Unix()
+	}
+
+	return out, nil
+}
+
+func (a *Allowance) setBasic(basic *feegrant.BasicAllowance) {
+	a.SpendLimit = cmn.NewCoinsResponse(basic.SpendLimit)
+	if basic.Expiration != nil {
+		a.Expiration = basic.Expiration.Unix()
+	}
+}
+
+// newBasicAllowance creates a basic allowance of the spend limit, which does
+// not expire if the expiration is zero.
+func newBasicAllowance(spendLimit []cmn.Coin, expiration int64) (*feegrant.BasicAllowance, error) {
+	limit, err := cmn.NewSdkCoinsFromCoins(spendLimit)
+	if err != nil {
+		return nil, fmt.Errorf(cmn.ErrInvalidAmount, err)
+	}
+
+	basic := &feegrant.BasicAllowance{}
+	// a nil spend limit means that there is no limit
+	if len(limit) > 0 {
+		basic.SpendLimit = limit
+	}
+	if expiration != 0 {
+		exp := time.Unix(expiration, 0).UTC()
+		basic.Expiration = &exp
+	}
+	return basic, nil
+}
+
+// newMsgGrantAllowance creates a new MsgGrantAllowance of the fee allowance.
+func newMsgGrantAllowance(granter, grantee common.Address, allowance feegrant.FeeAllowanceI, addrCdc address.Codec) (*feegrant.MsgGrantAllowance, error) {
+	if _, _, err := parseGranterGrantee(granter, grantee); err != nil {
+		return nil, err
+	}
+
+	granterAddr, granteeAddr, err := encodeAddresses(granter, grantee, addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	msg, err := feegrant.NewMsgGrantAllowance(allowance, sdk.AccAddress(granter.Bytes()), sdk.AccAddress(grantee.Bytes()))
+	if err != nil {
+		return nil, err
+	}
+	// use the address codec of the precompile for the bech32 addresses
+	msg.Granter, msg.Grantee = granterAddr, granteeAddr
+	return msg, nil
+}
+
+// parseGranterGrantee parses the granter and grantee address args, which
+// cannot be the zero address.
+func parseGranterGrantee(granterArg, granteeArg interface{}) (common.Address, common.Address, error) {
+	granter, ok := granterArg.(common.Address)
+	if !ok || granter == (common.Address{}) {
+		return common.Address{}, common.Address{}, fmt.Errorf(cmn.ErrInvalidHexAddress, granterArg)
+	}
+	grantee, ok := granteeArg.(common.Address)
+	if !ok || grantee == (common.Address{}) {
+		return common.Address{}, common.Address{}, fmt.Errorf(cmn.ErrInvalidHexAddress, granteeArg)
+	}
+	return granter, grantee, nil
+}
+
+// encodeAddresses encodes the granter and grantee addresses.
+func encodeAddresses(granter, grantee common.Address, addrCdc address.Codec) (string, string, error) {
+	granterAddr, err := encodeAddress(granter, addrCdc)
+	if err != nil {
+		return "", "", err
+	}
+	granteeAddr, err := encodeAddress(grantee, addrCdc)
+	if err != nil {
+		return "", "", err
+	}
+	return granterAddr, granteeAddr, nil
+}
+
+// encodeAddress encodes an address, which cannot be the zero address.
+func encodeAddress(addr common.Address, addrCdc address.Codec) (string, error) {
+	if addr == (common.Address{}) {
+		return "", fmt.Errorf(cmn.ErrInvalidHexAddress, addr)
+	}
+	encoded, err := addrCdc.BytesToString(addr.Bytes())
+	if err != nil {
+		return "", fmt.Errorf("failed to decode address %s: %w", addr, err)
+	}
+	return encoded, nil
+}
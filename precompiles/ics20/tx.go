@@ -119,6 +119,7 @@ func (p *Precompile) Transfer(
 		return nil, fmt.Errorf(cmn.ErrRequesterIsNotMsgSender, msgSender.String(), sender.String())
 	}
 
+	cmn.TraceMsg(ctx, msg)
 	res, err := p.transferKeeper.Transfer(ctx, msg)
 	if err != nil {
 		return nil, err
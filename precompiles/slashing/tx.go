@@ -52,6 +52,7 @@ func (p Precompile) Unjail(
 		ValidatorAddr: valAddr,
 	}
 
+	cmn.TraceMsg(ctx, msg)
 	msgSrv := slashingkeeper.NewMsgServerImpl(p.slashingKeeper)
 	if _, err := msgSrv.Unjail(ctx, msg); err != nil {
 		return nil, err
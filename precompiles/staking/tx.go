@@ -69,6 +69,7 @@ func (p Precompile) CreateValidator(
 	}
 
 	// Execute the transaction using the message server
+	cmn.TraceMsg(ctx, msg)
 	msgSrv := stakingkeeper.NewMsgServerImpl(&p.stakingKeeper)
 	if _, err = msgSrv.CreateValidator(ctx, msg); err != nil {
 		return nil, err
@@ -116,6 +117,7 @@ func (p Precompile) EditValidator(
 	}
 
 	// Execute the transaction using the message server
+	cmn.TraceMsg(ctx, msg)
 	msgSrv := stakingkeeper.NewMsgServerImpl(&p.stakingKeeper)
 	if _, err = msgSrv.EditValidator(ctx, msg); err != nil {
 		return nil, err
@@ -163,6 +165,7 @@ func (p *Precompile) Delegate(
 	}
 
 	// Execute the transaction using the message server
+	cmn.TraceMsg(ctx, msg)
 	msgSrv := stakingkeeper.NewMsgServerImpl(&p.stakingKeeper)
 	if _, err = msgSrv.Delegate(ctx, msg); err != nil {
 		return nil, err
@@ -211,6 +214,7 @@ func (p Precompile) Undelegate(
 	}
 
 	// Execute the transaction using the message server
+	cmn.TraceMsg(ctx, msg)
 	msgSrv := stakingkeeper.NewMsgServerImpl(&p.stakingKeeper)
 	res, err := msgSrv.Undelegate(ctx, msg)
 	if err != nil {
@@ -261,6 +265,7 @@ func (p Precompile) Redelegate(
 		return nil, fmt.Errorf(cmn.ErrRequesterIsNotMsgSender, msgSender.String(), delegatorHexAddr.String())
 	}
 
+	cmn.TraceMsg(ctx, msg)
 	msgSrv := stakingkeeper.NewMsgServerImpl(&p.stakingKeeper)
 	res, err := msgSrv.BeginRedelegate(ctx, msg)
 	if err != nil {
@@ -310,6 +315,7 @@ func (p Precompile) CancelUnbondingDelegation(
 		return nil, fmt.Errorf(cmn.ErrRequesterIsNotMsgSender, msgSender.String(), delegatorHexAddr.String())
 	}
 
+	cmn.TraceMsg(ctx, msg)
 	msgSrv := stakingkeeper.NewMsgServerImpl(&p.stakingKeeper)
 	if _, err = msgSrv.CancelUnbondingDelegation(ctx, msg); err != nil {
 		return nil, err
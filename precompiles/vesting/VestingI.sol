@@ -0,0 +1,129 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.17;
+
+import "../common/Types.sol";
+
+/// @dev The VestingI contract's address.
+address constant VESTING_PRECOMPILE_ADDRESS = 0x0000000000000000000000000000000000000803;
+
+/// @dev The VestingI contract's instance.
+VestingI constant VESTING_CONTRACT = VestingI(VESTING_PRECOMPILE_ADDRESS);
+
+/// @dev Period defines a vesting period. The coins of the period vest once its
+/// length in seconds elapsed from the end of the previous period.
+struct Period {
+    /// @dev Length of the period in seconds
+    int64 length;
+    /// @dev Coins vesting at the end of the period
+    Coin[] amount;
+}
+
+/// @author Evmos Team
+/// @title Vesting Precompiled Contract
+/// @dev The interface through which solidity contracts will interact with vesting accounts.
+/// A clawback vesting account vests the coins funded by its funder following a periodic
+/// schedule, and the funder can claw back the coins that did not vest yet.
+/// @custom:address 0x0000000000000000000000000000000000000803
+interface VestingI {
+    /// @dev Emitted when an account is converted into a clawback vesting account
+    /// @param funderAddress The address of the funder of the vesting account
+    /// @param vestingAddress The address of the vesting account
+    event CreateClawbackVestingAccount(address indexed funderAddress, address indexed vestingAddress);
+
+    /// @dev Emitted when a clawback vesting account is funded
+    /// @param funderAddress The address of the funder of the vesting account
+    /// @param vestingAddress The address of the vesting account
+    /// @param startTime The unix time at which the funded vesting periods start
+    event FundVestingAccount(address indexed funderAddress, address indexed vestingAddress, int64 startTime);
+
+    /// @dev Emitted when the unvested coins of a clawback vesting account are clawed back
+    /// @param funderAddress The address of the funder of the vesting account
+    /// @param accountAddress The address of the vesting account
+    /// @param destAddress The address receiving the clawed back coins
+    event Clawback(address indexed funderAddress, address indexed accountAddress, address destAddress);
+
+    /// @dev Emitted when a continuous vesting account is created
+    /// @param funderAddress The address funding the vesting account
+    /// @param vestingAddress The address of the vesting account
+    /// @param endTime The unix time at which all the coins are vested
+    event CreateContinuousVestingAccount(address indexed funderAddress, address indexed vestingAddress, int64 endTime);
+
+    /// @dev Emitted when a periodic vesting account is created
+    /// @param funderAddress The address funding the vesting account
+    /// @param vestingAddress The address of the vesting account
+    /// @param startTime The unix time at which the vesting periods start
+    event CreatePeriodicVestingAccount(address indexed funderAddress, address indexed vestingAddress, int64 startTime);
+
+    /// @dev Converts the account of the caller into a clawback vesting account that only
+    /// the funder can fund and claw back.
+    /// @param funderAddress The address of the funder of the vesting account
+    /// @param vestingAddress The address of the account to convert, which must be the caller
+    /// @return success Whether the account was converted
+    function createClawbackVestingAccount(
+        address funderAddress,
+        address vestingAddress
+    ) external returns (bool success);
+
+    /// @dev Sends the coins of the vesting periods from the funder to a clawback vesting
+    /// account and adds the periods to its vesting schedule.
+    /// @param funderAddress The address of the funder of the vesting account, which must be the caller
+    /// @param vestingAddress The address of the clawback vesting account
+    /// @param startTime The unix time at which the vesting periods start
+    /// @param vestingPeriods The vesting periods of the funded coins
+    /// @return success Whether the account was funded
+    function fundVestingAccount(
+        address funderAddress,
+        address vestingAddress,
+        int64 startTime,
+        Period[] calldata vestingPeriods
+    ) external returns (bool success);
+
+    /// @dev Claws back the coins of a clawback vesting account that did not vest yet.
+    /// @param funderAddress The address of the funder of the vesting account, which must be the caller
+    /// @param accountAddress The address of the clawback vesting account
+    /// @param destAddress The address receiving the clawed back coins, or the zero address
+    /// for the funder
+    /// @return coins The coins clawed back
+    function clawback(
+        address funderAddress,
+        address accountAddress,
+        address destAddress
+    ) external returns (Coin[] memory coins);
+
+    /// @dev Creates a continuous vesting account funded by the caller. The coins vest
+    /// linearly from the current block time until the end time.
+    /// @param funderAddress The address funding the vesting account, which must be the caller
+    /// @param vestingAddress The address of the vesting account, which must not exist yet
+    /// @param amount The vesting coins
+    /// @param endTime The unix time at which all the coins are vested
+    /// @return success Whether the account was created
+    function createContinuousVestingAccount(
+        address funderAddress,
+        address vestingAddress,
+        Coin[] calldata amount,
+        int64 endTime
+    ) external returns (bool success);
+
+    /// @dev Creates a periodic vesting account funded by the caller.
+    /// @param funderAddress The address funding the vesting account, which must be the caller
+    /// @param vestingAddress The address of the vesting account, which must not exist yet
+    /// @param startTime The unix time at which the vesting periods start
+    /// @param vestingPeriods The vesting periods of the funded coins
+    /// @return success Whether the account was created
+    function createPeriodicVestingAccount(
+        address funderAddress,
+        address vestingAddress,
+        int64 startTime,
+        Period[] calldata vestingPeriods
+    ) external returns (bool success);
+
+    /// @dev Returns the coins of a vesting account that are locked, that did not vest yet,
+    /// and that vested.
+    /// @param vestingAddress The address of the vesting account
+    /// @return locked The coins that cannot be spent
+    /// @return unvested The coins that did not vest yet
+    /// @return vested The coins that vested
+    function balances(
+        address vestingAddress
+    ) external view returns (Coin[] memory locked, Coin[] memory unvested, Coin[] memory vested);
+}
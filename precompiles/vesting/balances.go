@@ -0,0 +1,65 @@
+package vesting
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/tracing"
+
+	"github.com/cosmos/evm/utils"
+	"github.com/cosmos/evm/x/vm/statedb"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// spendableBalances returns the spendable balances in the EVM denom of the
+// addresses passed as arguments to the precompile.
+//
+// NOTE: The state DB tracks the spendable balances of the accounts, while the
+// bank events of the vesting transactions also include the locked coins of the
+// vesting accounts. This is why the vesting precompile updates the state DB
+// with the changes of the spendable balances instead of the balance handler.
+func (p Precompile) spendableBalances(ctx sdk.Context, args []interface{}) map[common.Address]*big.Int {
+	balances := make(map[common.Address]*big.Int)
+	for _, arg := range args {
+		addr, ok := arg.(common.Address)
+		if !ok || addr == (common.Address{}) {
+			continue
+		}
+		balances[addr] = p.bankKeeper.SpendableCoin(ctx, addr.Bytes(), evmtypes.GetEVMCoinDenom()).Amount.BigInt()
+	}
+	return balances
+}
+
+// updateSpendableBalances applies to the state DB the changes of the
+// spendable balances since they were recorded with spendableBalances.
+func (p Precompile) updateSpendableBalances(ctx sdk.Context, stateDB *statedb.StateDB, prevBalances map[common.Address]*big.Int) error {
+	for addr, balance := range p.spendableBalances(ctx, addressesOf(prevBalances)) {
+		delta := new(big.Int).Sub(balance, prevBalances[addr])
+		if delta.Sign() == 0 {
+			continue
+		}
+
+		amount, err := utils.Uint256FromBigInt(evmtypes.ConvertAmountTo18DecimalsBigInt(new(big.Int).Abs(delta)))
+		if err != nil {
+			return err
+		}
+
+		if delta.Sign() > 0 {
+			stateDB.AddBalance(addr, amount, tracing.BalanceChangeUnspecified)
+		} else {
+			stateDB.SubBalance(addr, amount, tracing.BalanceChangeUnspecified)
+		}
+	}
+	return nil
+}
+
+// addressesOf returns the addresses of the balances as precompile arguments.
+func addressesOf(balances map[common.Address]*big.Int) []interface{} {
+	addrs := make([]interface{}, 0, len(balances))
+	for addr := range balances {
+		addrs = append(addrs, addr)
+	}
+	return addrs
+}
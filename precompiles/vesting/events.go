@@ -0,0 +1,84 @@
+package vesting
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// EventTypeCreateClawbackVestingAccount defines the event type for the vesting CreateClawbackVestingAccount transaction.
+	EventTypeCreateClawbackVestingAccount = "CreateClawbackVestingAccount"
+	// EventTypeFundVestingAccount defines the event type for the vesting FundVestingAccount transaction.
+	EventTypeFundVestingAccount = "FundVestingAccount"
+	// EventTypeClawback defines the event type for the vesting Clawback transaction.
+	EventTypeClawback = "Clawback"
+	// EventTypeCreateContinuousVestingAccount defines the event type for the vesting CreateContinuousVestingAccount transaction.
+	EventTypeCreateContinuousVestingAccount = "CreateContinuousVestingAccount"
+	// EventTypeCreatePeriodicVestingAccount defines the event type for the vesting CreatePeriodicVestingAccount transaction.
+	EventTypeCreatePeriodicVestingAccount = "CreatePeriodicVestingAccount"
+)
+
+// EmitCreateClawbackVestingAccountEvent creates a new event emitted on a CreateClawbackVestingAccount transaction.
+func (p Precompile) EmitCreateClawbackVestingAccountEvent(ctx sdk.Context, stateDB vm.StateDB, funder, vestingAddr common.Address) error {
+	return p.emitVestingEvent(ctx, stateDB, EventTypeCreateClawbackVestingAccount, funder, vestingAddr)
+}
+
+// EmitVestingScheduleEvent creates a new event emitted on the FundVestingAccount and
+// CreatePeriodicVestingAccount transactions, which add a vesting schedule starting at
+// the given unix time to a vesting account.
+func (p Precompile) EmitVestingScheduleEvent(ctx sdk.Context, stateDB vm.StateDB, eventType string, funder, vestingAddr common.Address, startTime int64) error {
+	return p.emitVestingEvent(ctx, stateDB, eventType, funder, vestingAddr, startTime)
+}
+
+// EmitClawbackEvent creates a new event emitted on a Clawback transaction.
+func (p Precompile) EmitClawbackEvent(ctx sdk.Context, stateDB vm.StateDB, funder, account, dest common.Address) error {
+	return p.emitVestingEvent(ctx, stateDB, EventTypeClawback, funder, account, dest)
+}
+
+// EmitCreateContinuousVestingAccountEvent creates a new event emitted on a CreateContinuousVestingAccount transaction.
+func (p Precompile) EmitCreateContinuousVestingAccountEvent(ctx sdk.Context, stateDB vm.StateDB, funder, vestingAddr common.Address, endTime int64) error {
+	return p.emitVestingEvent(ctx, stateDB, EventTypeCreateContinuousVestingAccount, funder, vestingAddr, endTime)
+}
+
+// emitVestingEvent emits an event of the given type, indexing the funder and the
+// vesting account addresses. The remaining values are encoded as the event data.
+func (p Precompile) emitVestingEvent(ctx sdk.Context, stateDB vm.StateDB, eventType string, funder, vestingAddr common.Address, data ...interface{}) error {
+	// Prepare the event topics
+	event := p.Events[eventType]
+	topics := make([]common.Hash, 3)
+
+	// The first topic is always the signature of the event.
+	topics[0] = event.ID
+
+	var err error
+	topics[1], err = cmn.MakeTopic(funder)
+	if err != nil {
+		return err
+	}
+
+	topics[2], err = cmn.MakeTopic(vestingAddr)
+	if err != nil {
+		return err
+	}
+
+	packed, err := event.Inputs.NonIndexed().Pack(data...)
+	if err != nil {
+		return fmt.Errorf("failed to pack event data: %w", err)
+	}
+
+	stateDB.AddLog(&ethtypes.Log{
+		Address:     p.Address(),
+		Topics:      topics,
+		Data:        packed,
+		BlockNumber: uint64(ctx.BlockHeight()), //nolint:gosec // G115 // won't exceed uint64
+	})
+
+	return nil
+}
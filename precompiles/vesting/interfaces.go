@@ -0,0 +1,15 @@
+package vesting
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkvestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+)
+
+// BankKeeper defines the bank keeper used by the vesting precompile to fund the
+// vesting accounts and to track the spendable balances of the callers.
+type BankKeeper interface {
+	sdkvestingtypes.BankKeeper
+	SpendableCoin(ctx context.Context, addr sdk.AccAddress, denom string) sdk.Coin
+}
@@ -0,0 +1,34 @@
+package vesting
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// BalancesMethod defines the ABI method name for the vesting Balances query.
+	BalancesMethod = "balances"
+)
+
+// Balances returns the locked, unvested and vested coins of a vesting account.
+func (p Precompile) Balances(
+	ctx sdk.Context,
+	_ *vm.Contract,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	req, err := NewBalancesRequest(args, p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := p.vestingKeeper.Balances(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	out := new(BalancesOutput).FromResponse(res)
+	return method.Outputs.Pack(out.Locked, out.Unvested, out.Vested)
+}
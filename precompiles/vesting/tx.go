@@ -0,0 +1,191 @@
+package vesting
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// CreateClawbackVestingAccountMethod defines the ABI method name for the vesting
+	// CreateClawbackVestingAccount transaction.
+	CreateClawbackVestingAccountMethod = "createClawbackVestingAccount"
+	// FundVestingAccountMethod defines the ABI method name for the vesting
+	// FundVestingAccount transaction.
+	FundVestingAccountMethod = "fundVestingAccount"
+	// ClawbackMethod defines the ABI method name for the vesting Clawback
+	// transaction.
+	ClawbackMethod = "clawback"
+	// CreateContinuousVestingAccountMethod defines the ABI method name for the
+	// Cosmos SDK vesting CreateVestingAccount transaction.
+	CreateContinuousVestingAccountMethod = "createContinuousVestingAccount"
+	// CreatePeriodicVestingAccountMethod defines the ABI method name for the
+	// Cosmos SDK vesting CreatePeriodicVestingAccount transaction.
+	CreatePeriodicVestingAccountMethod = "createPeriodicVestingAccount"
+)
+
+// CreateClawbackVestingAccount converts the account of the caller into a
+// clawback vesting account funded by the given funder.
+func (p *Precompile) CreateClawbackVestingAccount(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, vestingHexAddr, err := NewMsgCreateClawbackVestingAccount(args, p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	msgSender := contract.Caller()
+	if msgSender != vestingHexAddr {
+		return nil, fmt.Errorf(cmn.ErrRequesterIsNotMsgSender, msgSender.String(), vestingHexAddr.String())
+	}
+
+	cmn.TraceMsg(ctx, msg)
+	if _, err := p.vestingKeeper.CreateClawbackVestingAccount(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	if err = p.EmitCreateClawbackVestingAccountEvent(ctx, stateDB, args[0].(common.Address), vestingHexAddr); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// FundVestingAccount sends the coins of the vesting periods from the caller to
+// a clawback vesting account it funds, and adds the periods to its schedule.
+func (p *Precompile) FundVestingAccount(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, funderHexAddr, err := NewMsgFundVestingAccount(method, args, p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	msgSender := contract.Caller()
+	if msgSender != funderHexAddr {
+		return nil, fmt.Errorf(cmn.ErrRequesterIsNotMsgSender, msgSender.String(), funderHexAddr.String())
+	}
+
+	cmn.TraceMsg(ctx, msg)
+	if _, err := p.vestingKeeper.FundVestingAccount(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	if err = p.EmitVestingScheduleEvent(ctx, stateDB, EventTypeFundVestingAccount, funderHexAddr, args[1].(common.Address), msg.StartTime); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// Clawback claws back the coins that did not vest yet of a clawback vesting
+// account funded by the caller.
+func (p *Precompile) Clawback(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, funderHexAddr, err := NewMsgClawback(args, p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	msgSender := contract.Caller()
+	if msgSender != funderHexAddr {
+		return nil, fmt.Errorf(cmn.ErrRequesterIsNotMsgSender, msgSender.String(), funderHexAddr.String())
+	}
+
+	cmn.TraceMsg(ctx, msg)
+	res, err := p.vestingKeeper.Clawback(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	destHexAddr := args[2].(common.Address)
+	if destHexAddr == (common.Address{}) {
+		destHexAddr = funderHexAddr
+	}
+	if err = p.EmitClawbackEvent(ctx, stateDB, funderHexAddr, args[1].(common.Address), destHexAddr); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(cmn.NewCoinsResponse(res.Coins))
+}
+
+// CreateContinuousVestingAccount creates a continuous vesting account funded
+// by the caller, vesting from the current block time until the end time.
+func (p *Precompile) CreateContinuousVestingAccount(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, funderHexAddr, err := NewMsgCreateContinuousVestingAccount(method, args, p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	msgSender := contract.Caller()
+	if msgSender != funderHexAddr {
+		return nil, fmt.Errorf(cmn.ErrRequesterIsNotMsgSender, msgSender.String(), funderHexAddr.String())
+	}
+
+	cmn.TraceMsg(ctx, msg)
+	if _, err := p.sdkMsgServer.CreateVestingAccount(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	if err = p.EmitCreateContinuousVestingAccountEvent(ctx, stateDB, funderHexAddr, args[1].(common.Address), msg.EndTime); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// CreatePeriodicVestingAccount creates a periodic vesting account funded by
+// the caller.
+func (p *Precompile) CreatePeriodicVestingAccount(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, funderHexAddr, err := NewMsgCreatePeriodicVestingAccount(method, args, p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	msgSender := contract.Caller()
+	if msgSender != funderHexAddr {
+		return nil, fmt.Errorf(cmn.ErrRequesterIsNotMsgSender, msgSender.String(), funderHexAddr.String())
+	}
+
+	cmn.TraceMsg(ctx, msg)
+	if _, err := p.sdkMsgServer.CreatePeriodicVestingAccount(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	if err = p.EmitVestingScheduleEvent(ctx, stateDB, EventTypeCreatePeriodicVestingAccount, funderHexAddr, args[1].(common.Address), msg.StartTime); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
@@ -0,0 +1,258 @@
+package vesting
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+	vestingtypes "github.com/cosmos/evm/x/vesting/types"
+
+	"cosmossdk.io/core/address"
+
+	sdkvesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+)
+
+// Period defines a vesting period in types native to the EVM.
+type Period struct {
+	Length int64      `abi:"length"`
+	Amount []cmn.Coin `abi:"amount"`
+}
+
+// VestingPeriodsInput defines the input of the methods funding a vesting
+// account with vesting periods.
+type VestingPeriodsInput struct {
+	FunderAddress  common.Address `abi:"funderAddress"`
+	VestingAddress common.Address `abi:"vestingAddress"`
+	StartTime      int64          `abi:"startTime"`
+	VestingPeriods []Period       `abi:"vestingPeriods"`
+}
+
+// ContinuousVestingInput defines the input of the createContinuousVestingAccount method.
+type ContinuousVestingInput struct {
+	FunderAddress  common.Address `abi:"funderAddress"`
+	VestingAddress common.Address `abi:"vestingAddress"`
+	Amount         []cmn.Coin     `abi:"amount"`
+	EndTime        int64          `abi:"endTime"`
+}
+
+// BalancesOutput defines the output of the balances query.
+type BalancesOutput struct {
+	Locked   []cmn.Coin `abi:"locked"`
+	Unvested []cmn.Coin `abi:"unvested"`
+	Vested   []cmn.Coin `abi:"vested"`
+}
+
+// NewMsgCreateClawbackVestingAccount creates a new MsgCreateClawbackVestingAccount
+// message and returns the address of the vesting account.
+func NewMsgCreateClawbackVestingAccount(args []interface{}, addrCdc address.Codec) (*vestingtypes.MsgCreateClawbackVestingAccount, common.Address, error) {
+	if len(args) != 2 {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	funderAddress, ok := args[0].(common.Address)
+	if !ok || funderAddress == (common.Address{}) {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidHexAddress, args[0])
+	}
+	vestingAddress, ok := args[1].(common.Address)
+	if !ok || vestingAddress == (common.Address{}) {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidHexAddress, args[1])
+	}
+
+	funder, vestingAddr, err := encodeAddresses(funderAddress, vestingAddress, addrCdc)
+	if err != nil {
+		return nil, common.Address{}, err
+	}
+
+	msg := &vestingtypes.MsgCreateClawbackVestingAccount{
+		FunderAddress:  funder,
+		VestingAddress: vestingAddr,
+	}
+	return msg, vestingAddress, nil
+}
+
+// NewMsgFundVestingAccount creates a new MsgFundVestingAccount message and
+// returns the address of the funder.
+func NewMsgFundVestingAccount(method *abi.Method, args []interface{}, addrCdc address.Codec) (*vestingtypes.MsgFundVestingAccount, common.Address, error) {
+	input, funder, vestingAddr, periods, err := parseVestingPeriodsArgs(method, args, addrCdc)
+	if err != nil {
+		return nil, common.Address{}, err
+	}
+
+	msg := &vestingtypes.MsgFundVestingAccount{
+		FunderAddress:  funder,
+		VestingAddress: vestingAddr,
+		StartTime:      input.StartTime,
+		VestingPeriods: periods,
+	}
+	return msg, input.FunderAddress, nil
+}
+
+// NewMsgCreatePeriodicVestingAccount creates a new MsgCreatePeriodicVestingAccount
+// message and returns the address of the funder.
+func NewMsgCreatePeriodicVestingAccount(method *abi.Method, args []interface{}, addrCdc address.Codec) (*sdkvesting.MsgCreatePeriodicVestingAccount, common.Address, error) {
+	input, funder, vestingAddr, periods, err := parseVestingPeriodsArgs(method, args, addrCdc)
+	if err != nil {
+		return nil, common.Address{}, err
+	}
+
+	msg := &sdkvesting.MsgCreatePeriodicVestingAccount{
+		FromAddress:    funder,
+		ToAddress:      vestingAddr,
+		StartTime:      input.StartTime,
+		VestingPeriods: periods,
+	}
+	return msg, input.FunderAddress, nil
+}
+
+// NewMsgCreateContinuousVestingAccount creates a new MsgCreateVestingAccount
+// message for a continuous vesting account and returns the address of the
+// funder.
+func NewMsgCreateContinuousVestingAccount(method *abi.Method, args []interface{}, addrCdc address.Codec) (*sdkvesting.MsgCreateVestingAccount, common.Address, error) {
+	if len(args) != 4 {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 4, len(args))
+	}
+
+	var input ContinuousVestingInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, common.Address{}, fmt.Errorf("error while unpacking args to ContinuousVestingInput: %s", err)
+	}
+
+	funder, vestingAddr, err := encodeAddresses(input.FunderAddress, input.VestingAddress, addrCdc)
+	if err != nil {
+		return nil, common.Address{}, err
+	}
+
+	amount, err := cmn.NewSdkCoinsFromCoins(input.Amount)
+	if err != nil {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidAmount, err)
+	}
+
+	msg := &sdkvesting.MsgCreateVestingAccount{
+		FromAddress: funder,
+		ToAddress:   vestingAddr,
+		Amount:      amount,
+		EndTime:     input.EndTime,
+	}
+	return msg, input.FunderAddress, nil
+}
+
+// NewMsgClawback creates a new MsgClawback message and returns the address of
+// the funder. The funder receives the clawed back coins if the destination is
+// the zero address.
+func NewMsgClawback(args []interface{}, addrCdc address.Codec) (*vestingtypes.MsgClawback, common.Address, error) {
+	if len(args) != 3 {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 3, len(args))
+	}
+
+	funderAddress, ok := args[0].(common.Address)
+	if !ok || funderAddress == (common.Address{}) {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidHexAddress, args[0])
+	}
+	accountAddress, ok := args[1].(common.Address)
+	if !ok || accountAddress == (common.Address{}) {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidHexAddress, args[1])
+	}
+	destAddress, ok := args[2].(common.Address)
+	if !ok {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidHexAddress, args[2])
+	}
+
+	funder, account, err := encodeAddresses(funderAddress, accountAddress, addrCdc)
+	if err != nil {
+		return nil, common.Address{}, err
+	}
+
+	dest := ""
+	if destAddress != (common.Address{}) {
+		if dest, err = addrCdc.BytesToString(destAddress.Bytes()); err != nil {
+			return nil, common.Address{}, fmt.Errorf("failed to decode destination address: %w", err)
+		}
+	}
+
+	msg := &vestingtypes.MsgClawback{
+		FunderAddress:  funder,
+		AccountAddress: account,
+		DestAddress:    dest,
+	}
+	return msg, funderAddress, nil
+}
+
+// NewBalancesRequest creates a new QueryBalancesRequest for the vesting account
+// of the given args.
+func NewBalancesRequest(args []interface{}, addrCdc address.Codec) (*vestingtypes.QueryBalancesRequest, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	vestingAddress, ok := args[0].(common.Address)
+	if !ok || vestingAddress == (common.Address{}) {
+		return nil, fmt.Errorf(cmn.ErrInvalidHexAddress, args[0])
+	}
+
+	addr, err := addrCdc.BytesToString(vestingAddress.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode vesting address: %w", err)
+	}
+	return &vestingtypes.QueryBalancesRequest{Address: addr}, nil
+}
+
+// FromResponse populates the BalancesOutput from a QueryBalancesResponse.
+func (bo *BalancesOutput) FromResponse(res *vestingtypes.QueryBalancesResponse) *BalancesOutput {
+	bo.Locked = cmn.NewCoinsResponse(res.Locked)
+	bo.Unvested = cmn.NewCoinsResponse(res.Unvested)
+	bo.Vested = cmn.NewCoinsResponse(res.Vested)
+	return bo
+}
+
+// parseVestingPeriodsArgs parses the args of the methods funding a vesting
+// account with vesting periods, and returns the encoded funder and vesting
+// addresses with the vesting periods.
+func parseVestingPeriodsArgs(method *abi.Method, args []interface{}, addrCdc address.Codec) (*VestingPeriodsInput, string, string, []sdkvesting.Period, error) {
+	if len(args) != 4 {
+		return nil, "", "", nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 4, len(args))
+	}
+
+	var input VestingPeriodsInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, "", "", nil, fmt.Errorf("error while unpacking args to VestingPeriodsInput: %s", err)
+	}
+
+	funder, vestingAddr, err := encodeAddresses(input.FunderAddress, input.VestingAddress, addrCdc)
+	if err != nil {
+		return nil, "", "", nil, err
+	}
+
+	periods := make([]sdkvesting.Period, len(input.VestingPeriods))
+	for i, p := range input.VestingPeriods {
+		amount, err := cmn.NewSdkCoinsFromCoins(p.Amount)
+		if err != nil {
+			return nil, "", "", nil, fmt.Errorf(cmn.ErrInvalidAmount, fmt.Sprintf("period %d: %s", i, err))
+		}
+		periods[i] = sdkvesting.Period{Length: p.Length, Amount: amount}
+	}
+
+	return &input, funder, vestingAddr, periods, nil
+}
+
+// encodeAddresses encodes the funder and vesting account addresses, which
+// cannot be the zero address.
+func encodeAddresses(funderAddress, vestingAddress common.Address, addrCdc address.Codec) (string, string, error) {
+	if funderAddress == (common.Address{}) {
+		return "", "", fmt.Errorf(cmn.ErrInvalidHexAddress, funderAddress)
+	}
+	if vestingAddress == (common.Address{}) {
+		return "", "", fmt.Errorf(cmn.ErrInvalidHexAddress, vestingAddress)
+	}
+
+	funder, err := addrCdc.BytesToString(funderAddress.Bytes())
+	if err != nil {
+		return "", "", fmt.Errorf("failed to decode funder address: %w", err)
+	}
+	vestingAddr, err := addrCdc.BytesToString(vestingAddress.Bytes())
+	if err != nil {
+		return "", "", fmt.Errorf("failed to decode vesting address: %w", err)
+	}
+	return funder, vestingAddr, nil
+}
@@ -0,0 +1,182 @@
+package vesting
+
+import (
+	"embed"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/tracing"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+	vestingkeeper "github.com/cosmos/evm/x/vesting/keeper"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"cosmossdk.io/core/address"
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	sdkvesting "github.com/cosmos/cosmos-sdk/x/auth/vesting"
+	sdkvestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+)
+
+var _ vm.PrecompiledContract = &Precompile{}
+
+// Embed abi json file to the executable binary. Needed when importing as dependency.
+//
+//go:embed abi.json
+var f embed.FS
+
+// Precompile defines the precompiled contract for vesting.
+type Precompile struct {
+	cmn.Precompile
+	vestingKeeper vestingkeeper.Keeper
+	bankKeeper    BankKeeper
+	// sdkMsgServer creates the continuous and periodic vesting accounts of the
+	// Cosmos SDK vesting module
+	sdkMsgServer sdkvestingtypes.MsgServer
+	addrCdc      address.Codec
+}
+
+// LoadABI loads the vesting ABI from the embedded abi.json file
+// for the vesting precompile.
+func LoadABI() (abi.ABI, error) {
+	return cmn.LoadABI(f, "abi.json")
+}
+
+// NewPrecompile creates a new vesting Precompile instance as a
+// PrecompiledContract interface.
+func NewPrecompile(
+	vestingKeeper vestingkeeper.Keeper,
+	accountKeeper authkeeper.AccountKeeper,
+	bankKeeper BankKeeper,
+	addrCdc address.Codec,
+) (*Precompile, error) {
+	abi, err := LoadABI()
+	if err != nil {
+		return nil, err
+	}
+
+	p := &Precompile{
+		Precompile: cmn.Precompile{
+			ABI:                  abi,
+			KvGasConfig:          storetypes.KVGasConfig(),
+			TransientKVGasConfig: storetypes.TransientGasConfig(),
+		},
+		vestingKeeper: vestingKeeper,
+		bankKeeper:    bankKeeper,
+		sdkMsgServer:  sdkvesting.NewMsgServerImpl(accountKeeper, bankKeeper),
+		addrCdc:       addrCdc,
+	}
+
+	// SetAddress defines the address of the vesting precompiled contract.
+	p.SetAddress(common.HexToAddress(evmtypes.VestingPrecompileAddress))
+
+	return p, nil
+}
+
+// RequiredGas calculates the precompiled contract's base gas rate.
+func (p Precompile) RequiredGas(input []byte) uint64 {
+	// NOTE: This check avoid panicking when trying to decode the method ID
+	if len(input) < 4 {
+		return 0
+	}
+	methodID := input[:4]
+
+	method, err := p.MethodById(methodID)
+	if err != nil {
+		// This should never happen since this method is going to fail during Run
+		return 0
+	}
+
+	return p.Precompile.RequiredGas(input, p.IsTransaction(method))
+}
+
+// Run executes the precompiled contract vesting methods defined in the ABI.
+func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz []byte, err error) {
+	bz, err = p.run(evm, contract, readOnly)
+	if err != nil {
+		return cmn.ReturnRevertError(evm, err)
+	}
+
+	return bz, nil
+}
+
+func (p Precompile) run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz []byte, err error) {
+	ctx, stateDB, method, initialGas, args, err := p.RunSetup(evm, contract, readOnly, p.IsTransaction)
+	if err != nil {
+		return nil, err
+	}
+
+	// Record the spendable balances of the accounts before executing the precompile.
+	prevBalances := p.spendableBalances(ctx, args)
+
+	// This handles any out of gas errors that may occur during the execution of a precompile tx or query.
+	// It avoids panics and returns the out of gas error so the EVM can continue gracefully.
+	defer cmn.HandleGasError(ctx, contract, initialGas, &err)()
+
+	switch method.Name {
+	// vesting transactions
+	case CreateClawbackVestingAccountMethod:
+		bz, err = p.CreateClawbackVestingAccount(ctx, contract, stateDB, method, args)
+	case FundVestingAccountMethod:
+		bz, err = p.FundVestingAccount(ctx, contract, stateDB, method, args)
+	case ClawbackMethod:
+		bz, err = p.Clawback(ctx, contract, stateDB, method, args)
+	case CreateContinuousVestingAccountMethod:
+		bz, err = p.CreateContinuousVestingAccount(ctx, contract, stateDB, method, args)
+	case CreatePeriodicVestingAccountMethod:
+		bz, err = p.CreatePeriodicVestingAccount(ctx, contract, stateDB, method, args)
+	// vesting queries
+	case BalancesMethod:
+		bz, err = p.Balances(ctx, contract, method, args)
+	default:
+		return nil, fmt.Errorf(cmn.ErrUnknownMethod, method.Name)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	cost := ctx.GasMeter().GasConsumed() - initialGas
+
+	if !contract.UseGas(cost, nil, tracing.GasChangeCallPrecompiledContract) {
+		return nil, vm.ErrOutOfGas
+	}
+
+	// Process the spendable balance changes after the method execution.
+	if err := p.updateSpendableBalances(ctx, stateDB, prevBalances); err != nil {
+		return nil, err
+	}
+
+	return bz, nil
+}
+
+// IsTransaction checks if the given method name corresponds to a transaction or query.
+//
+// Available vesting transactions are:
+//   - CreateClawbackVestingAccount
+//   - FundVestingAccount
+//   - Clawback
+//   - CreateContinuousVestingAccount
+//   - CreatePeriodicVestingAccount
+func (Precompile) IsTransaction(method *abi.Method) bool {
+	switch method.Name {
+	case CreateClawbackVestingAccountMethod,
+		FundVestingAccountMethod,
+		ClawbackMethod,
+		CreateContinuousVestingAccountMethod,
+		CreatePeriodicVestingAccountMethod:
+		return true
+	default:
+		return false
+	}
+}
+
+// Logger returns a precompile-specific logger.
+func (p Precompile) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("evm extension", "vesting")
+}
@@ -7,15 +7,20 @@ import (
 
 	rpcclient "github.com/cometbft/cometbft/rpc/jsonrpc/client"
 
+	evmmempool "github.com/cosmos/evm/mempool"
 	"github.com/cosmos/evm/rpc/backend"
+	"github.com/cosmos/evm/rpc/namespaces/cosmos"
 	"github.com/cosmos/evm/rpc/namespaces/ethereum/debug"
 	"github.com/cosmos/evm/rpc/namespaces/ethereum/eth"
 	"github.com/cosmos/evm/rpc/namespaces/ethereum/eth/filters"
 	"github.com/cosmos/evm/rpc/namespaces/ethereum/miner"
 	"github.com/cosmos/evm/rpc/namespaces/ethereum/net"
+	"github.com/cosmos/evm/rpc/namespaces/ethereum/ots"
 	"github.com/cosmos/evm/rpc/namespaces/ethereum/personal"
+	"github.com/cosmos/evm/rpc/namespaces/ethereum/trace"
 	"github.com/cosmos/evm/rpc/namespaces/ethereum/txpool"
 	"github.com/cosmos/evm/rpc/namespaces/ethereum/web3"
+	rpctypes "github.com/cosmos/evm/rpc/types"
 	"github.com/cosmos/evm/types"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -37,6 +42,8 @@ const (
 	TxPoolNamespace   = "txpool"
 	DebugNamespace    = "debug"
 	MinerNamespace    = "miner"
+	TraceNamespace    = "trace"
+	OtsNamespace      = "ots"
 
 	apiVersion = "1.0"
 )
@@ -50,18 +57,33 @@ type APICreator = func(
 	indexer types.EVMTxIndexer,
 ) []rpc.API
 
+// apiCreator creates the JSON-RPC API implementations of the built-in
+// namespaces, which are also given the app-side EVM mempool, nil if the app
+// doesn't use it, and the sync tracker shared by the JSON-RPC servers.
+type apiCreator = func(
+	ctx *server.Context,
+	clientCtx client.Context,
+	tendermintWebsocketClient *rpcclient.WSClient,
+	allowUnprotectedTxs bool,
+	indexer types.EVMTxIndexer,
+	mempool *evmmempool.EVMMempool,
+	syncTracker *rpctypes.SyncTracker,
+) []rpc.API
+
 // apiCreators defines the JSON-RPC API namespaces.
-var apiCreators map[string]APICreator
+var apiCreators map[string]apiCreator
 
 func init() {
-	apiCreators = map[string]APICreator{
+	apiCreators = map[string]apiCreator{
 		EthNamespace: func(ctx *server.Context,
 			clientCtx client.Context,
 			tmWSClient *rpcclient.WSClient,
 			allowUnprotectedTxs bool,
 			indexer types.EVMTxIndexer,
+			mempool *evmmempool.EVMMempool,
+			syncTracker *rpctypes.SyncTracker,
 		) []rpc.API {
-			evmBackend := backend.NewBackend(ctx, ctx.Logger, clientCtx, allowUnprotectedTxs, indexer)
+			evmBackend := newBackend(ctx, clientCtx, allowUnprotectedTxs, indexer, mempool, syncTracker)
 			return []rpc.API{
 				{
 					Namespace: EthNamespace,
@@ -77,7 +99,7 @@ func init() {
 				},
 			}
 		},
-		Web3Namespace: func(*server.Context, client.Context, *rpcclient.WSClient, bool, types.EVMTxIndexer) []rpc.API {
+		Web3Namespace: func(*server.Context, client.Context, *rpcclient.WSClient, bool, types.EVMTxIndexer, *evmmempool.EVMMempool, *rpctypes.SyncTracker) []rpc.API {
 			return []rpc.API{
 				{
 					Namespace: Web3Namespace,
@@ -87,7 +109,7 @@ func init() {
 				},
 			}
 		},
-		NetNamespace: func(ctx *server.Context, clientCtx client.Context, _ *rpcclient.WSClient, _ bool, _ types.EVMTxIndexer) []rpc.API {
+		NetNamespace: func(ctx *server.Context, clientCtx client.Context, _ *rpcclient.WSClient, _ bool, _ types.EVMTxIndexer, _ *evmmempool.EVMMempool, _ *rpctypes.SyncTracker) []rpc.API {
 			return []rpc.API{
 				{
 					Namespace: NetNamespace,
@@ -102,8 +124,10 @@ func init() {
 			_ *rpcclient.WSClient,
 			allowUnprotectedTxs bool,
 			indexer types.EVMTxIndexer,
+			mempool *evmmempool.EVMMempool,
+			syncTracker *rpctypes.SyncTracker,
 		) []rpc.API {
-			evmBackend := backend.NewBackend(ctx, ctx.Logger, clientCtx, allowUnprotectedTxs, indexer)
+			evmBackend := newBackend(ctx, clientCtx, allowUnprotectedTxs, indexer, mempool, syncTracker)
 			return []rpc.API{
 				{
 					Namespace: PersonalNamespace,
@@ -118,8 +142,10 @@ func init() {
 			_ *rpcclient.WSClient,
 			allowUnprotectedTxs bool,
 			indexer types.EVMTxIndexer,
+			mempool *evmmempool.EVMMempool,
+			syncTracker *rpctypes.SyncTracker,
 		) []rpc.API {
-			evmBackend := backend.NewBackend(ctx, ctx.Logger, clientCtx, allowUnprotectedTxs, indexer)
+			evmBackend := newBackend(ctx, clientCtx, allowUnprotectedTxs, indexer, mempool, syncTracker)
 			return []rpc.API{
 				{
 					Namespace: TxPoolNamespace,
@@ -134,8 +160,10 @@ func init() {
 			_ *rpcclient.WSClient,
 			allowUnprotectedTxs bool,
 			indexer types.EVMTxIndexer,
+			mempool *evmmempool.EVMMempool,
+			syncTracker *rpctypes.SyncTracker,
 		) []rpc.API {
-			evmBackend := backend.NewBackend(ctx, ctx.Logger, clientCtx, allowUnprotectedTxs, indexer)
+			evmBackend := newBackend(ctx, clientCtx, allowUnprotectedTxs, indexer, mempool, syncTracker)
 			return []rpc.API{
 				{
 					Namespace: DebugNamespace,
@@ -150,8 +178,10 @@ func init() {
 			_ *rpcclient.WSClient,
 			allowUnprotectedTxs bool,
 			indexer types.EVMTxIndexer,
+			mempool *evmmempool.EVMMempool,
+			syncTracker *rpctypes.SyncTracker,
 		) []rpc.API {
-			evmBackend := backend.NewBackend(ctx, ctx.Logger, clientCtx, allowUnprotectedTxs, indexer)
+			evmBackend := newBackend(ctx, clientCtx, allowUnprotectedTxs, indexer, mempool, syncTracker)
 			return []rpc.API{
 				{
 					Namespace: MinerNamespace,
@@ -161,6 +191,60 @@ func init() {
 				},
 			}
 		},
+		TraceNamespace: func(ctx *server.Context,
+			clientCtx client.Context,
+			_ *rpcclient.WSClient,
+			allowUnprotectedTxs bool,
+			indexer types.EVMTxIndexer,
+			mempool *evmmempool.EVMMempool,
+			syncTracker *rpctypes.SyncTracker,
+		) []rpc.API {
+			evmBackend := newBackend(ctx, clientCtx, allowUnprotectedTxs, indexer, mempool, syncTracker)
+			return []rpc.API{
+				{
+					Namespace: TraceNamespace,
+					Version:   apiVersion,
+					Service:   trace.NewAPI(ctx.Logger, evmBackend),
+					Public:    true,
+				},
+			}
+		},
+		CosmosNamespace: func(ctx *server.Context,
+			clientCtx client.Context,
+			_ *rpcclient.WSClient,
+			allowUnprotectedTxs bool,
+			indexer types.EVMTxIndexer,
+			mempool *evmmempool.EVMMempool,
+			syncTracker *rpctypes.SyncTracker,
+		) []rpc.API {
+			evmBackend := newBackend(ctx, clientCtx, allowUnprotectedTxs, indexer, mempool, syncTracker)
+			return []rpc.API{
+				{
+					Namespace: CosmosNamespace,
+					Version:   apiVersion,
+					Service:   cosmos.NewAPI(ctx.Logger, evmBackend),
+					Public:    true,
+				},
+			}
+		},
+		OtsNamespace: func(ctx *server.Context,
+			clientCtx client.Context,
+			_ *rpcclient.WSClient,
+			allowUnprotectedTxs bool,
+			indexer types.EVMTxIndexer,
+			mempool *evmmempool.EVMMempool,
+			syncTracker *rpctypes.SyncTracker,
+		) []rpc.API {
+			evmBackend := newBackend(ctx, clientCtx, allowUnprotectedTxs, indexer, mempool, syncTracker)
+			return []rpc.API{
+				{
+					Namespace: OtsNamespace,
+					Version:   apiVersion,
+					Service:   ots.NewAPI(ctx.Logger, evmBackend),
+					Public:    true,
+				},
+			}
+		},
 	}
 }
 
@@ -170,13 +254,15 @@ func GetRPCAPIs(ctx *server.Context,
 	tmWSClient *rpcclient.WSClient,
 	allowUnprotectedTxs bool,
 	indexer types.EVMTxIndexer,
+	mempool *evmmempool.EVMMempool,
+	syncTracker *rpctypes.SyncTracker,
 	selectedAPIs []string,
 ) []rpc.API {
 	var apis []rpc.API
 
 	for _, ns := range selectedAPIs {
 		if creator, ok := apiCreators[ns]; ok {
-			apis = append(apis, creator(ctx, clientCtx, tmWSClient, allowUnprotectedTxs, indexer)...)
+			apis = append(apis, creator(ctx, clientCtx, tmWSClient, allowUnprotectedTxs, indexer, mempool, syncTracker)...)
 		} else {
 			ctx.Logger.Error("invalid namespace value", "namespace", ns)
 		}
@@ -191,6 +277,33 @@ func RegisterAPINamespace(ns string, creator APICreator) error {
 	if _, ok := apiCreators[ns]; ok {
 		return fmt.Errorf("duplicated api namespace %s", ns)
 	}
-	apiCreators[ns] = creator
+	apiCreators[ns] = func(
+		ctx *server.Context,
+		clientCtx client.Context,
+		tmWSClient *rpcclient.WSClient,
+		allowUnprotectedTxs bool,
+		indexer types.EVMTxIndexer,
+		_ *evmmempool.EVMMempool,
+		_ *rpctypes.SyncTracker,
+	) []rpc.API {
+		return creator(ctx, clientCtx, tmWSClient, allowUnprotectedTxs, indexer)
+	}
 	return nil
 }
+
+// newBackend creates the backend of the built-in namespaces, serving the
+// transactions of the given EVM mempool and the sync progress of the given
+// tracker.
+func newBackend(
+	ctx *server.Context,
+	clientCtx client.Context,
+	allowUnprotectedTxs bool,
+	indexer types.EVMTxIndexer,
+	mempool *evmmempool.EVMMempool,
+	syncTracker *rpctypes.SyncTracker,
+) *backend.Backend {
+	evmBackend := backend.NewBackend(ctx, ctx.Logger, clientCtx, allowUnprotectedTxs, indexer)
+	evmBackend.Mempool = mempool
+	evmBackend.SyncTracker = syncTracker
+	return evmBackend
+}
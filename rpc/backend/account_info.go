@@ -9,17 +9,19 @@ import (
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/pkg/errors"
 
-	"github.com/cometbft/cometbft/libs/bytes"
-
+	"github.com/cosmos/evm/lightclient"
 	rpctypes "github.com/cosmos/evm/rpc/types"
+	precisebanktypes "github.com/cosmos/evm/x/precisebank/types"
 	evmtypes "github.com/cosmos/evm/x/vm/types"
 
 	errorsmod "cosmossdk.io/errors"
 	sdkmath "cosmossdk.io/math"
 
+	"github.com/cosmos/cosmos-sdk/client"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
 // GetCode returns the contract code at the given address and block number.
@@ -101,8 +103,7 @@ func (b *Backend) GetProof(address common.Address, storageKeys []string, blockNr
 	}
 
 	// query account proofs
-	accountKey := bytes.HexBytes(append(authtypes.AddressStoreKeyPrefix, address.Bytes()...))
-	_, proof, err := b.QueryClient.GetProof(clientCtx, authtypes.StoreKey, accountKey)
+	accountProof, err := b.getStoreProof(clientCtx, authtypes.StoreKey, lightclient.AccountKey(address))
 	if err != nil {
 		return nil, err
 	}
@@ -112,14 +113,72 @@ func (b *Backend) GetProof(address common.Address, storageKeys []string, blockNr
 		return nil, errors.New("invalid balance")
 	}
 
+	stateProof, err := b.getAccountStateProof(clientCtx, address)
+	if err != nil {
+		return nil, err
+	}
+	stateProof.Account = accountProof
+
 	return &rpctypes.AccountResult{
-		Address:      address,
-		AccountProof: GetHexProofs(proof),
-		Balance:      (*hexutil.Big)(balance.BigInt()),
-		CodeHash:     common.HexToHash(res.CodeHash),
-		Nonce:        hexutil.Uint64(res.Nonce),
-		StorageHash:  common.Hash{}, // NOTE: Cosmos EVM doesn't have a storage hash. TODO: implement?
-		StorageProof: storageProofs,
+		Address:       address,
+		AccountProof:  accountProof.Proof,
+		Balance:       (*hexutil.Big)(balance.BigInt()),
+		CodeHash:      common.HexToHash(res.CodeHash),
+		Nonce:         hexutil.Uint64(res.Nonce),
+		StorageHash:   common.Hash{}, // NOTE: Cosmos EVM doesn't have a storage trie, the storage proofs are against the app hash
+		StorageProof:  storageProofs,
+		AppHashHeight: hexutil.Uint64(height + 1), //#nosec G115 -- height is positive
+		StateProof:    stateProof,
+	}, nil
+}
+
+// getAccountStateProof returns the proofs of the code hash and balance of the
+// account against the app hash.
+func (b *Backend) getAccountStateProof(clientCtx client.Context, address common.Address) (*rpctypes.AccountStateProof, error) {
+	codeHashProof, err := b.getStoreProof(clientCtx, evmtypes.StoreKey, lightclient.CodeHashKey(address))
+	if err != nil {
+		return nil, err
+	}
+
+	balanceKey, err := lightclient.BalanceKey(address, evmtypes.GetEVMCoinDenom())
+	if err != nil {
+		return nil, err
+	}
+	balanceProof, err := b.getStoreProof(clientCtx, banktypes.StoreKey, balanceKey)
+	if err != nil {
+		return nil, err
+	}
+
+	stateProof := &rpctypes.AccountStateProof{
+		CodeHash: codeHashProof,
+		Balance:  balanceProof,
+	}
+
+	// the fractional balances only exist for EVM coins with less than 18 decimals
+	if evmtypes.GetEVMCoinDecimals() != evmtypes.EighteenDecimals {
+		fractionalProof, err := b.getStoreProof(clientCtx, precisebanktypes.StoreKey, lightclient.FractionalBalanceKey(address))
+		if err != nil {
+			return nil, err
+		}
+		stateProof.FractionalBalance = &fractionalProof
+	}
+
+	return stateProof, nil
+}
+
+// getStoreProof returns the proof of the key of a module store against the
+// app hash.
+func (b *Backend) getStoreProof(clientCtx client.Context, storeKey string, key []byte) (rpctypes.StoreProof, error) {
+	value, proof, err := b.QueryClient.GetProof(clientCtx, storeKey, key)
+	if err != nil {
+		return rpctypes.StoreProof{}, err
+	}
+
+	return rpctypes.StoreProof{
+		StoreKey: storeKey,
+		Key:      key,
+		Value:    value,
+		Proof:    GetHexProofs(proof),
 	}, nil
 }
 
@@ -17,6 +17,7 @@ import (
 	tmrpcclient "github.com/cometbft/cometbft/rpc/client"
 	tmrpctypes "github.com/cometbft/cometbft/rpc/core/types"
 
+	evmmempool "github.com/cosmos/evm/mempool"
 	rpctypes "github.com/cosmos/evm/rpc/types"
 	"github.com/cosmos/evm/server/config"
 	cosmosevmtypes "github.com/cosmos/evm/types"
@@ -63,6 +64,7 @@ type EVMBackend interface {
 	// Blocks Info
 	BlockNumber() (hexutil.Uint64, error)
 	GetBlockByNumber(blockNum rpctypes.BlockNumber, fullTx bool) (map[string]interface{}, error)
+	PendingBlock(fullTx bool) (map[string]interface{}, error)
 	GetBlockByHash(hash common.Hash, fullTx bool) (map[string]interface{}, error)
 	GetBlockTransactionCountByHash(hash common.Hash) *hexutil.Uint
 	GetBlockTransactionCountByNumber(blockNum rpctypes.BlockNumber) *hexutil.Uint
@@ -91,6 +93,7 @@ type EVMBackend interface {
 	ChainConfig() *params.ChainConfig
 	GlobalMinGasPrice() (*big.Int, error)
 	BaseFee(blockRes *tmrpctypes.ResultBlockResults) (*big.Int, error)
+	BlobBaseFee(blockRes *tmrpctypes.ResultBlockResults) (*big.Int, error)
 	CurrentHeader() (*ethtypes.Header, error)
 	PendingTransactions() ([]*sdk.Tx, error)
 	GetCoinbase() (sdk.AccAddress, error)
@@ -104,6 +107,7 @@ type EVMBackend interface {
 	GetTransactionByBlockAndIndex(block *tmrpctypes.ResultBlock, idx hexutil.Uint) (*rpctypes.RPCTransaction, error)
 	GetTransactionReceipt(hash common.Hash) (map[string]interface{}, error)
 	GetTransactionLogs(hash common.Hash) ([]*ethtypes.Log, error)
+	GetTransactionsByAddress(address common.Address, args rpctypes.TransactionsByAddressArgs) (*rpctypes.TransactionsByAddressResult, error)
 	GetTransactionByBlockHashAndIndex(hash common.Hash, idx hexutil.Uint) (*rpctypes.RPCTransaction, error)
 	GetTransactionByBlockNumberAndIndex(blockNum rpctypes.BlockNumber, idx hexutil.Uint) (*rpctypes.RPCTransaction, error)
 
@@ -111,24 +115,38 @@ type EVMBackend interface {
 	Resend(args evmtypes.TransactionArgs, gasPrice *hexutil.Big, gasLimit *hexutil.Uint64) (common.Hash, error)
 	SendRawTransaction(data hexutil.Bytes) (common.Hash, error)
 	SetTxDefaults(args evmtypes.TransactionArgs) (evmtypes.TransactionArgs, error)
-	EstimateGas(args evmtypes.TransactionArgs, blockNrOptional *rpctypes.BlockNumber) (hexutil.Uint64, error)
-	DoCall(args evmtypes.TransactionArgs, blockNr rpctypes.BlockNumber) (*evmtypes.MsgEthereumTxResponse, error)
+	EstimateGas(args evmtypes.TransactionArgs, blockNrOptional *rpctypes.BlockNumber, overrides *rpctypes.StateOverride) (hexutil.Uint64, error)
+	DoCall(args evmtypes.TransactionArgs, blockNr rpctypes.BlockNumber, overrides *rpctypes.StateOverride) (*evmtypes.MsgEthereumTxResponse, error)
+	SimulateV1(opts evmtypes.SimOpts, blockNr rpctypes.BlockNumber) ([]*evmtypes.SimBlockResult, error)
 	GasPrice() (*hexutil.Big, error)
 
 	// Filter API
 	GetLogs(hash common.Hash) ([][]*ethtypes.Log, error)
 	GetLogsByHeight(height *int64) ([][]*ethtypes.Log, error)
 	BloomStatus() (uint64, uint64)
+	LogIndexer() cosmosevmtypes.EVMLogIndexer
 
 	// TxPool API
 	Content() (map[string]map[string]map[string]*rpctypes.RPCTransaction, error)
-	ContentFrom(address common.Address) (map[string]map[string]map[string]*rpctypes.RPCTransaction, error)
+	ContentFrom(address common.Address) (map[string]map[string]*rpctypes.RPCTransaction, error)
 	Inspect() (map[string]map[string]map[string]string, error)
 	Status() (map[string]hexutil.Uint, error)
 
 	// Tracing
 	TraceTransaction(hash common.Hash, config *evmtypes.TraceConfig) (interface{}, error)
 	TraceBlock(height rpctypes.BlockNumber, config *evmtypes.TraceConfig, block *tmrpctypes.ResultBlock) ([]*evmtypes.TxTraceResult, error)
+	TraceCall(args evmtypes.TransactionArgs, blockNr rpctypes.BlockNumber, config *evmtypes.TraceCallConfig) (interface{}, error)
+	TraceBlockFlat(blockNr rpctypes.BlockNumber) ([]*rpctypes.ParityTrace, error)
+	TraceTransactionFlat(hash common.Hash) ([]*rpctypes.ParityTrace, error)
+	TraceFilter(args rpctypes.TraceFilterArgs) ([]*rpctypes.ParityTrace, error)
+
+	// Otterscan API
+	OtsInternalOperations(hash common.Hash) ([]*rpctypes.OtsInternalOperation, error)
+	OtsTraceTransaction(hash common.Hash) ([]*rpctypes.OtsTrace, error)
+	OtsTransactionError(hash common.Hash) (hexutil.Bytes, error)
+	OtsContractCreator(address common.Address) (*rpctypes.OtsContractCreator, error)
+	OtsSearchTransactionsBefore(address common.Address, blockNumber uint64, pageSize uint64) (*rpctypes.OtsTransactionsPage, error)
+	OtsSearchTransactionsAfter(address common.Address, blockNumber uint64, pageSize uint64) (*rpctypes.OtsTransactionsPage, error)
 }
 
 var _ BackendI = (*Backend)(nil)
@@ -165,7 +183,12 @@ type Backend struct {
 	Cfg                 config.Config
 	AllowUnprotectedTxs bool
 	Indexer             cosmosevmtypes.EVMTxIndexer
-	ProcessBlocker      ProcessBlocker
+	// Mempool is the EVM mempool of the application, nil if the application
+	// doesn't use one.
+	Mempool *evmmempool.EVMMempool
+	// SyncTracker caches the sync progress of the node for eth_syncing.
+	SyncTracker    *rpctypes.SyncTracker
+	ProcessBlocker ProcessBlocker
 }
 
 func (b *Backend) GetConfig() config.Config {
@@ -200,6 +223,7 @@ func NewBackend(
 		Cfg:                 appConf,
 		AllowUnprotectedTxs: allowUnprotectedTxs,
 		Indexer:             indexer,
+		SyncTracker:         rpctypes.NewSyncTracker(clientCtx.Client, logger, rpctypes.SyncPollInterval),
 	}
 	b.ProcessBlocker = b.ProcessBlock
 	return b
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"math/big"
 	"strconv"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
@@ -50,8 +51,14 @@ func (b *Backend) BlockNumber() (hexutil.Uint64, error) {
 
 // GetBlockByNumber returns the JSON-RPC compatible Ethereum block identified by
 // block number. Depending on fullTx it either returns the full transaction
-// objects or if false only the hashes of the transactions.
+// objects or if false only the hashes of the transactions. The "safe" and
+// "finalized" blocks are the latest one, as CometBFT has instant finality, and
+// the "pending" block is built from the mempool.
 func (b *Backend) GetBlockByNumber(blockNum rpctypes.BlockNumber, fullTx bool) (map[string]interface{}, error) {
+	if blockNum == rpctypes.EthPendingBlockNumber {
+		return b.PendingBlock(fullTx)
+	}
+
 	resBlock, err := b.TendermintBlockByNumber(blockNum)
 	if err != nil {
 		return nil, nil
@@ -77,6 +84,127 @@ func (b *Backend) GetBlockByNumber(blockNum rpctypes.BlockNumber, fullTx bool) (
 	return res, nil
 }
 
+// PendingBlock returns the JSON-RPC compatible Ethereum block that would be
+// proposed on top of the latest block, filled with the executable transactions
+// of the mempool in the order they would be selected, up to the block gas
+// limit. The transactions are not executed, so the gas used is the sum of their
+// gas limits and the block has no logs. As in go-ethereum, the hash, nonce and
+// miner of the pending block are null.
+func (b *Backend) PendingBlock(fullTx bool) (map[string]interface{}, error) {
+	resBlock, err := b.TendermintBlockByNumber(rpctypes.EthLatestBlockNumber)
+	if err != nil {
+		return nil, nil
+	}
+	if resBlock == nil || resBlock.Block == nil {
+		return nil, nil
+	}
+
+	blockRes, err := b.RPCClient.BlockResults(b.Ctx, &resBlock.Block.Height)
+	if err != nil {
+		b.Logger.Debug("failed to fetch block result from Tendermint", "height", resBlock.Block.Height, "error", err.Error())
+		return nil, nil
+	}
+
+	// the pending block inherits the fields of the latest block not depending on
+	// its transactions
+	block, err := b.RPCBlockFromTendermintBlock(resBlock, blockRes, false)
+	if err != nil {
+		return nil, err
+	}
+
+	baseFee, err := b.BaseFee(blockRes)
+	if err != nil {
+		b.Logger.Debug("failed to fetch base fee of the latest block", "height", resBlock.Block.Height, "error", err.Error())
+	}
+
+	msgs, err := b.pendingBlockMsgs(uint64(block["gasLimit"].(hexutil.Uint64)))
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		gasUsed uint64
+		size    uint64
+		ethTxs  = make(ethtypes.Transactions, 0, len(msgs))
+		txs     = make([]interface{}, 0, len(msgs))
+	)
+	for _, msg := range msgs {
+		tx := msg.AsTransaction()
+		ethTxs = append(ethTxs, tx)
+		gasUsed += tx.Gas()
+		size += tx.Size()
+
+		if !fullTx {
+			txs = append(txs, tx.Hash())
+			continue
+		}
+		rpcTx, err := rpctypes.NewRPCTransaction(msg, common.Hash{}, 0, 0, baseFee, b.EvmChainID)
+		if err != nil {
+			return nil, err
+		}
+		txs = append(txs, rpcTx)
+	}
+
+	txsRoot := ethtypes.EmptyRootHash
+	if len(ethTxs) > 0 {
+		txsRoot = ethtypes.DeriveSha(ethTxs, trie.NewStackTrie(nil))
+	}
+
+	block["number"] = hexutil.Uint64(resBlock.Block.Height + 1) //nolint:gosec // G115 // won't exceed uint64
+	block["hash"] = nil
+	block["nonce"] = nil
+	block["miner"] = nil
+	block["parentHash"] = common.BytesToHash(resBlock.Block.Hash())
+	block["logsBloom"] = ethtypes.Bloom{}
+	block["timestamp"] = hexutil.Uint64(time.Now().Unix()) //nolint:gosec // G115 // won't exceed uint64
+	block["size"] = hexutil.Uint64(size)
+	block["gasUsed"] = (*hexutil.Big)(new(big.Int).SetUint64(gasUsed))
+	block["transactionsRoot"] = txsRoot
+	block["transactions"] = txs
+	return block, nil
+}
+
+// pendingBlockMsgs returns the executable Ethereum transactions of the mempool
+// in the order they would be included in the next block, skipping the
+// remaining transactions of a sender once one of them exceeds the gas left.
+func (b *Backend) pendingBlockMsgs(gasLimit uint64) ([]*evmtypes.MsgEthereumTx, error) {
+	var msgs []*evmtypes.MsgEthereumTx
+	if b.Mempool != nil {
+		msgs = b.Mempool.PendingInOrder()
+	} else {
+		// the CometBFT mempool holds the transactions in arrival order
+		txs, err := b.PendingTransactions()
+		if err != nil {
+			return nil, err
+		}
+		for _, tx := range txs {
+			for _, msg := range (*tx).GetMsgs() {
+				if ethMsg, ok := msg.(*evmtypes.MsgEthereumTx); ok {
+					msgs = append(msgs, ethMsg)
+				}
+			}
+		}
+	}
+
+	included := make([]*evmtypes.MsgEthereumTx, 0, len(msgs))
+	skipped := make(map[common.Address]bool)
+	gasLeft := gasLimit
+	for _, msg := range msgs {
+		from := msg.GetSender()
+		if skipped[from] {
+			continue
+		}
+		gas := msg.GetGas()
+		if gas > gasLeft {
+			skipped[from] = true
+			continue
+		}
+		gasLeft -= gas
+		included = append(included, msg)
+	}
+	return included, nil
+}
+
 // GetBlockByHash returns the JSON-RPC compatible Ethereum block identified by
 // hash.
 func (b *Backend) GetBlockByHash(hash common.Hash, fullTx bool) (map[string]interface{}, error) {
@@ -645,13 +773,25 @@ func (b *Backend) formatTxReceipt(ethMsg *evmtypes.MsgEthereumTx, blockMsgs []*e
 		receipt["contractAddress"] = crypto.CreateAddress(from, txData.GetNonce())
 	}
 
-	if dynamicTx, ok := txData.(*evmtypes.DynamicFeeTx); ok {
+	switch txData.(type) {
+	case *evmtypes.DynamicFeeTx, *evmtypes.BlobTx, *evmtypes.SetCodeTx:
 		baseFee, err := b.BaseFee(blockRes)
 		if err != nil {
 			// tolerate the error for pruned node.
 			b.Logger.Error("fetch basefee failed, node is pruned?", "height", txResult.Height, "error", err)
 		} else {
-			receipt["effectiveGasPrice"] = hexutil.Big(*dynamicTx.EffectiveGasPrice(baseFee))
+			receipt["effectiveGasPrice"] = hexutil.Big(*txData.EffectiveGasPrice(baseFee))
+		}
+	}
+
+	if blobTx, ok := txData.(*evmtypes.BlobTx); ok {
+		receipt["blobGasUsed"] = hexutil.Uint64(blobTx.GetBlobGas())
+		blobBaseFee, err := b.BlobBaseFee(blockRes)
+		if err != nil {
+			// tolerate the error for the blocks emitting no blob base fee.
+			b.Logger.Error("fetch blob base fee failed", "height", txResult.Height, "error", err)
+		} else {
+			receipt["blobGasPrice"] = hexutil.Big(*blobBaseFee)
 		}
 	}
 
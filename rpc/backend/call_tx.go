@@ -16,6 +16,7 @@ import (
 	"google.golang.org/grpc/status"
 
 	rpctypes "github.com/cosmos/evm/rpc/types"
+	feemarkettypes "github.com/cosmos/evm/x/feemarket/types"
 	evmtypes "github.com/cosmos/evm/x/vm/types"
 
 	errorsmod "cosmossdk.io/errors"
@@ -106,6 +107,19 @@ func (b *Backend) SendRawTransaction(data hexutil.Bytes) (common.Hash, error) {
 		return common.Hash{}, err
 	}
 
+	// the blobs are not stored on chain, so the blob sidecar is either
+	// rejected or discarded
+	if tx.BlobTxSidecar() != nil {
+		params, err := b.QueryClient.FeeMarket.Params(b.Ctx, &feemarkettypes.QueryParamsRequest{})
+		if err != nil {
+			return common.Hash{}, err
+		}
+		if params.Params.RejectBlobSidecars {
+			return common.Hash{}, errors.New("blob transactions with a blob sidecar are not accepted")
+		}
+		tx = tx.WithoutBlobTxSidecar()
+	}
+
 	// check the local node config in case unprotected txs are disabled
 	if !b.UnprotectedAllowed() {
 		if !tx.Protected() {
@@ -271,7 +285,7 @@ func (b *Backend) SetTxDefaults(args evmtypes.TransactionArgs) (evmtypes.Transac
 		}
 
 		blockNr := rpctypes.NewBlockNumber(big.NewInt(0))
-		estimated, err := b.EstimateGas(callArgs, &blockNr)
+		estimated, err := b.EstimateGas(callArgs, &blockNr, nil)
 		if err != nil {
 			return args, err
 		}
@@ -286,8 +300,11 @@ func (b *Backend) SetTxDefaults(args evmtypes.TransactionArgs) (evmtypes.Transac
 	return args, nil
 }
 
-// EstimateGas returns an estimate of gas usage for the given smart contract call.
-func (b *Backend) EstimateGas(args evmtypes.TransactionArgs, blockNrOptional *rpctypes.BlockNumber) (hexutil.Uint64, error) {
+// EstimateGas returns an estimate of gas usage for the given smart contract call,
+// executed on top of the state with the given overrides applied.
+func (b *Backend) EstimateGas(
+	args evmtypes.TransactionArgs, blockNrOptional *rpctypes.BlockNumber, overrides *rpctypes.StateOverride,
+) (hexutil.Uint64, error) {
 	blockNr := rpctypes.EthPendingBlockNumber
 	if blockNrOptional != nil {
 		blockNr = *blockNrOptional
@@ -310,6 +327,11 @@ func (b *Backend) EstimateGas(args evmtypes.TransactionArgs, blockNrOptional *rp
 		ProposerAddress: sdk.ConsAddress(header.Block.ProposerAddress),
 		ChainId:         b.EvmChainID.Int64(),
 	}
+	if overrides != nil {
+		if req.Overrides, err = json.Marshal(overrides); err != nil {
+			return 0, err
+		}
+	}
 
 	// From ContextWithHeight: if the provided height is 0,
 	// it will return an empty context and the gRPC query will use
@@ -324,10 +346,11 @@ func (b *Backend) EstimateGas(args evmtypes.TransactionArgs, blockNrOptional *rp
 	return hexutil.Uint64(res.Gas), nil
 }
 
-// DoCall performs a simulated call operation through the evmtypes. It returns the
+// DoCall performs a simulated call operation through the evmtypes, on top of
+// the state with the given overrides applied. It returns the
 // estimated gas used on the operation or an error if fails.
 func (b *Backend) DoCall(
-	args evmtypes.TransactionArgs, blockNr rpctypes.BlockNumber,
+	args evmtypes.TransactionArgs, blockNr rpctypes.BlockNumber, overrides *rpctypes.StateOverride,
 ) (*evmtypes.MsgEthereumTxResponse, error) {
 	bz, err := json.Marshal(&args)
 	if err != nil {
@@ -345,6 +368,11 @@ func (b *Backend) DoCall(
 		ProposerAddress: sdk.ConsAddress(header.Block.ProposerAddress),
 		ChainId:         b.EvmChainID.Int64(),
 	}
+	if overrides != nil {
+		if req.Overrides, err = json.Marshal(overrides); err != nil {
+			return nil, err
+		}
+	}
 
 	// From ContextWithHeight: if the provided height is 0,
 	// it will return an empty context and the gRPC query will use
@@ -377,6 +405,56 @@ func (b *Backend) DoCall(
 	return res, nil
 }
 
+// SimulateV1 simulates the given blocks of calls on top of the requested block
+// through the evmtypes, each call seeing the state changes of the previous ones.
+func (b *Backend) SimulateV1(
+	opts evmtypes.SimOpts, blockNr rpctypes.BlockNumber,
+) ([]*evmtypes.SimBlockResult, error) {
+	bz, err := json.Marshal(&opts)
+	if err != nil {
+		return nil, err
+	}
+	header, err := b.TendermintBlockByNumber(blockNr)
+	if err != nil {
+		// the error message imitates geth behavior
+		return nil, errors.New("header not found")
+	}
+
+	req := evmtypes.SimulateV1Request{
+		Opts:            bz,
+		GasCap:          b.RPCGasCap(),
+		ProposerAddress: sdk.ConsAddress(header.Block.ProposerAddress),
+		ChainId:         b.EvmChainID.Int64(),
+	}
+
+	// From ContextWithHeight: if the provided height is 0,
+	// it will return an empty context and the gRPC query will use
+	// the latest block height for querying.
+	ctx := rpctypes.ContextWithHeight(blockNr.Int64())
+	timeout := b.RPCEVMTimeout()
+
+	// Setup context so it may be canceled the call has completed
+	// or, in case of unmetered gas, setup a context with a timeout.
+	var cancel context.CancelFunc
+	if timeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+	} else {
+		ctx, cancel = context.WithCancel(ctx)
+	}
+	defer cancel()
+
+	res, err := b.QueryClient.SimulateV1(ctx, &req)
+	if err != nil {
+		return nil, err
+	}
+
+	var results []*evmtypes.SimBlockResult
+	if err := json.Unmarshal(res.Data, &results); err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
 // GasPrice returns the current gas price based on Cosmos EVM' gas price oracle.
 func (b *Backend) GasPrice() (*hexutil.Big, error) {
 	var (
@@ -90,6 +90,28 @@ func (b *Backend) BaseFee(blockRes *cmtrpctypes.ResultBlockResults) (*big.Int, e
 	return res.BaseFee.BigInt(), nil
 }
 
+// BlobBaseFee returns the EIP-4844 blob base fee of the given block, parsed
+// from the fee market event emitted at the beginning of the block.
+func (b *Backend) BlobBaseFee(blockRes *cmtrpctypes.ResultBlockResults) (*big.Int, error) {
+	for i := len(blockRes.FinalizeBlockEvents) - 1; i >= 0; i-- {
+		evt := blockRes.FinalizeBlockEvents[i]
+		if evt.Type != evmtypes.EventTypeFeeMarket {
+			continue
+		}
+		for _, attr := range evt.Attributes {
+			if attr.Key != evmtypes.AttributeKeyBlobBaseFee {
+				continue
+			}
+			blobBaseFee, ok := sdkmath.NewIntFromString(attr.Value)
+			if !ok {
+				return nil, fmt.Errorf("invalid blob base fee %s", attr.Value)
+			}
+			return blobBaseFee.BigInt(), nil
+		}
+	}
+	return nil, fmt.Errorf("blob base fee not found in the block %d", blockRes.Height)
+}
+
 // CurrentHeader returns the latest block header
 // This will return error as per node configuration
 // if the ABCI responses are discarded ('discard_abci_responses' config param)
@@ -4,6 +4,8 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/pkg/errors"
+
+	cosmosevmtypes "github.com/cosmos/evm/types"
 )
 
 // GetLogs returns all the logs from all the ethereum transactions in a block.
@@ -34,3 +36,12 @@ func (b *Backend) GetLogsByHeight(height *int64) ([][]*ethtypes.Log, error) {
 func (b *Backend) BloomStatus() (uint64, uint64) {
 	return 4096, 0
 }
+
+// LogIndexer returns the log index maintained by the indexer service, or nil
+// if it is disabled.
+func (b *Backend) LogIndexer() cosmosevmtypes.EVMLogIndexer {
+	if b.Indexer == nil {
+		return nil
+	}
+	return b.Indexer.LogIndexer()
+}
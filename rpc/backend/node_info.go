@@ -57,29 +57,19 @@ func (b *Backend) Accounts() ([]common.Address, error) {
 }
 
 // Syncing returns false in case the node is currently not syncing with the network. It can be up to date or has not
-// yet received the latest block headers from its pears. In case it is synchronizing:
+// yet received the latest block headers from its pears. In case it is synchronizing, through block sync or state sync:
 // - startingBlock: block number this node started to synchronize from
 // - currentBlock:  block number this node is currently importing
 // - highestBlock:  block number of the highest block header this node has received from peers
-// - pulledStates:  number of state entries processed until now
-// - knownStates:   number of known state entries that still need to be pulled
 func (b *Backend) Syncing() (interface{}, error) {
-	status, err := b.ClientCtx.Client.Status(b.Ctx)
+	progress, err := b.SyncTracker.Progress(b.Ctx)
 	if err != nil {
 		return false, err
 	}
-
-	if !status.SyncInfo.CatchingUp {
+	if progress == nil {
 		return false, nil
 	}
-
-	return map[string]interface{}{
-		"startingBlock": hexutil.Uint64(status.SyncInfo.EarliestBlockHeight), //nolint:gosec // G115 // won't exceed uint64
-		"currentBlock":  hexutil.Uint64(status.SyncInfo.LatestBlockHeight),   //nolint:gosec // G115 // won't exceed uint64
-		// "highestBlock":  nil, // NA
-		// "pulledStates":  nil, // NA
-		// "knownStates":   nil, // NA
-	}, nil
+	return progress, nil
 }
 
 // SetEtherbase sets the etherbase of the miner
@@ -0,0 +1,258 @@
+package backend
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	rpctypes "github.com/cosmos/evm/rpc/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+)
+
+// OtsInternalOperations returns the ether transfers, contract creations and
+// self destructs performed by the internal calls of the given transaction.
+func (b *Backend) OtsInternalOperations(hash common.Hash) ([]*rpctypes.OtsInternalOperation, error) {
+	frame, err := b.traceCallFrame(hash)
+	if err != nil {
+		return nil, err
+	}
+	return rpctypes.OtsInternalOperations(frame), nil
+}
+
+// OtsTraceTransaction returns the calls performed by the given transaction
+// annotated with their depth.
+func (b *Backend) OtsTraceTransaction(hash common.Hash) ([]*rpctypes.OtsTrace, error) {
+	frame, err := b.traceCallFrame(hash)
+	if err != nil {
+		return nil, err
+	}
+	return rpctypes.OtsTraces(frame), nil
+}
+
+// OtsTransactionError returns the raw revert output of the given transaction,
+// or empty bytes if it succeeded.
+func (b *Backend) OtsTransactionError(hash common.Hash) (hexutil.Bytes, error) {
+	frame, err := b.traceCallFrame(hash)
+	if err != nil {
+		return nil, err
+	}
+	if frame.Error == "" {
+		return hexutil.Bytes{}, nil
+	}
+	return frame.Output, nil
+}
+
+// OtsContractCreator returns the transaction and the account that created the
+// given contract, or nil if the address is not a contract or if its creation
+// is not available on the node. The creation block is the first block with the
+// contract code, found with a binary search over the state of the blocks kept
+// by the node, so that contracts created by internal calls are found whether
+// or not the address appeared in earlier transactions. The pruned states have
+// no code for the search, the creation is not available if the contract has
+// code in the earliest state kept.
+//
+// NOTE: a contract self-destructed and recreated at the same address has no
+// code between its incarnations, so the creation found is the one of any
+// incarnation having code in one of the searched blocks, not necessarily the
+// last one.
+func (b *Backend) OtsContractCreator(address common.Address) (*rpctypes.OtsContractCreator, error) {
+	latest, err := b.BlockNumber()
+	if err != nil {
+		return nil, err
+	}
+	status, err := b.ClientCtx.Client.Status(b.Ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	lo, hi := max(status.SyncInfo.EarliestBlockHeight, 1), int64(latest) //#nosec G115 -- int overflow is not a concern here
+	if ok, err := b.hasCode(address, hi); err != nil || !ok {
+		return nil, err
+	}
+	for lo < hi {
+		mid := lo + (hi-lo)/2
+		ok, err := b.hasCode(address, mid)
+		if err != nil && !isPrunedStateError(err) {
+			return nil, err
+		}
+		if ok {
+			hi = mid
+		} else {
+			lo = mid + 1
+		}
+	}
+
+	// the creation block is traced on the state of its parent, which has no
+	// code unless the contract was created before the earliest block kept
+	if lo > 1 {
+		ok, err := b.hasCode(address, lo-1)
+		if isPrunedStateError(err) || (err == nil && ok) {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return b.otsFindContractCreation(address, lo)
+}
+
+// hasCode returns true if the address has code in the state of the given block.
+func (b *Backend) hasCode(address common.Address, height int64) (bool, error) {
+	res, err := b.QueryClient.Code(rpctypes.ContextWithHeight(height), &evmtypes.QueryCodeRequest{
+		Address: address.String(),
+	})
+	if err != nil {
+		return false, err
+	}
+	return len(res.Code) > 0, nil
+}
+
+// isPrunedStateError returns true if the error is returned by a query on the
+// state of a block pruned by the node.
+func isPrunedStateError(err error) bool {
+	return err != nil && strings.Contains(err.Error(), "failed to load state at height")
+}
+
+// otsFindContractCreation traces the eth txs of the given block to find the
+// one creating the contract, at any depth.
+func (b *Backend) otsFindContractCreation(address common.Address, height int64) (*rpctypes.OtsContractCreator, error) {
+	resBlock, err := b.TendermintBlockByNumber(rpctypes.BlockNumber(height))
+	if err != nil {
+		return nil, err
+	}
+	if resBlock == nil {
+		return nil, fmt.Errorf("block %d not found", height)
+	}
+	blockRes, err := b.TendermintBlockResultByNumber(&height)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, msg := range b.EthMsgsFromTendermintBlock(resBlock, blockRes) {
+		hash := common.HexToHash(msg.Hash)
+		frame, err := b.traceCallFrame(hash)
+		if err != nil {
+			return nil, err
+		}
+		if creator := rpctypes.FindCreator(frame, address); creator != nil {
+			return &rpctypes.OtsContractCreator{Hash: hash, Creator: creator.From}, nil
+		}
+	}
+	// the contract was created at genesis or in a block that is not kept
+	return nil, nil
+}
+
+// OtsSearchTransactionsBefore returns a page of the transactions the address
+// appeared in before the given block, or before the latest block if zero.
+func (b *Backend) OtsSearchTransactionsBefore(
+	address common.Address,
+	blockNumber uint64,
+	pageSize uint64,
+) (*rpctypes.OtsTransactionsPage, error) {
+	page, hasMore, err := b.otsSearchTransactions(address, blockNumber, false, pageSize)
+	if err != nil {
+		return nil, err
+	}
+	page.FirstPage = blockNumber == 0
+	page.LastPage = !hasMore
+	return page, nil
+}
+
+// OtsSearchTransactionsAfter returns a page of the transactions the address
+// appeared in after the given block, or after the earliest block if zero.
+func (b *Backend) OtsSearchTransactionsAfter(
+	address common.Address,
+	blockNumber uint64,
+	pageSize uint64,
+) (*rpctypes.OtsTransactionsPage, error) {
+	page, hasMore, err := b.otsSearchTransactions(address, blockNumber, true, pageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	// pages are always sorted in descending order
+	for i, j := 0, len(page.Txs)-1; i < j; i, j = i+1, j-1 {
+		page.Txs[i], page.Txs[j] = page.Txs[j], page.Txs[i]
+		page.Receipts[i], page.Receipts[j] = page.Receipts[j], page.Receipts[i]
+	}
+	page.FirstPage = !hasMore
+	page.LastPage = blockNumber == 0
+	return page, nil
+}
+
+// otsSearchTransactions loads the transactions and receipts of the
+// appearances of the address in the given direction.
+func (b *Backend) otsSearchTransactions(
+	address common.Address,
+	blockNumber uint64,
+	ascending bool,
+	pageSize uint64,
+) (*rpctypes.OtsTransactionsPage, bool, error) {
+	if b.Indexer == nil {
+		return nil, false, rpctypes.ErrIndexerDisabled
+	}
+	if pageSize == 0 {
+		return nil, false, errors.New("page size must be greater than zero")
+	}
+
+	hashes, hasMore, err := b.Indexer.GetAddressAppearances(
+		address,
+		int64(blockNumber), //#nosec G115 -- int overflow is not a concern here
+		ascending,
+		int(pageSize), //#nosec G115 -- int overflow is not a concern here
+	)
+	if err != nil {
+		return nil, false, err
+	}
+
+	page := &rpctypes.OtsTransactionsPage{
+		Txs:      make([]*rpctypes.RPCTransaction, 0, len(hashes)),
+		Receipts: make([]map[string]interface{}, 0, len(hashes)),
+	}
+	timestamps := make(map[uint64]hexutil.Uint64)
+	for _, hash := range hashes {
+		tx, err := b.GetTransactionByHash(hash)
+		if err != nil {
+			return nil, false, err
+		}
+		receipt, err := b.GetTransactionReceipt(hash)
+		if err != nil {
+			return nil, false, err
+		}
+		if tx == nil || receipt == nil {
+			return nil, false, fmt.Errorf("indexed transaction %s not found", hash)
+		}
+
+		height := tx.BlockNumber.ToInt().Uint64()
+		timestamp, ok := timestamps[height]
+		if !ok {
+			blk, err := b.TendermintBlockByNumber(rpctypes.BlockNumber(height)) //#nosec G115 -- int overflow is not a concern here
+			if err != nil {
+				return nil, false, err
+			}
+			if blk == nil {
+				return nil, false, fmt.Errorf("block %d not found", height)
+			}
+			timestamp = hexutil.Uint64(blk.Block.Time.Unix()) //#nosec G115 -- timestamp is always positive
+			timestamps[height] = timestamp
+		}
+		receipt["timestamp"] = timestamp
+
+		page.Txs = append(page.Txs, tx)
+		page.Receipts = append(page.Receipts, receipt)
+	}
+	return page, hasMore, nil
+}
+
+// traceCallFrame returns the call tree of the given transaction.
+func (b *Backend) traceCallFrame(hash common.Hash) (rpctypes.CallFrame, error) {
+	result, err := b.TraceTransaction(hash, callTracerConfig)
+	if err != nil {
+		return rpctypes.CallFrame{}, err
+	}
+	return decodeCallFrame(result)
+}
@@ -135,6 +135,76 @@ func (b *Backend) TraceTransaction(hash common.Hash, config *evmtypes.TraceConfi
 	return decodedResult, nil
 }
 
+// TraceCall returns the structured logs created during the execution of the
+// given call on top of the state of the requested block, with the optional
+// state and block overrides of the config applied.
+func (b *Backend) TraceCall(
+	args evmtypes.TransactionArgs,
+	blockNr rpctypes.BlockNumber,
+	config *evmtypes.TraceCallConfig,
+) (interface{}, error) {
+	bz, err := json.Marshal(&args)
+	if err != nil {
+		return nil, err
+	}
+
+	blk, err := b.TendermintBlockByNumber(blockNr)
+	if err != nil {
+		b.Logger.Debug("block not found", "number", blockNr)
+		return nil, err
+	}
+
+	nc, ok := b.ClientCtx.Client.(tmrpcclient.NetworkClient)
+	if !ok {
+		return nil, errors.New("invalid rpc client")
+	}
+
+	cp, err := nc.ConsensusParams(b.Ctx, &blk.Block.Height)
+	if err != nil {
+		return nil, err
+	}
+
+	traceCallRequest := evmtypes.QueryTraceCallRequest{
+		Args:            bz,
+		GasCap:          b.RPCGasCap(),
+		BlockNumber:     blk.Block.Height,
+		BlockTime:       blk.Block.Time,
+		BlockHash:       common.Bytes2Hex(blk.BlockID.Hash),
+		ProposerAddress: sdk.ConsAddress(blk.Block.ProposerAddress),
+		ChainId:         b.EvmChainID.Int64(),
+		BlockMaxGas:     cp.ConsensusParams.Block.MaxGas,
+	}
+
+	if config != nil {
+		traceCallRequest.TraceConfig = &config.TraceConfig
+		if config.StateOverrides != nil {
+			if traceCallRequest.StateOverrides, err = json.Marshal(config.StateOverrides); err != nil {
+				return nil, err
+			}
+		}
+		if config.BlockOverrides != nil {
+			if traceCallRequest.BlockOverrides, err = json.Marshal(config.BlockOverrides); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	traceResult, err := b.QueryClient.TraceCall(rpctypes.ContextWithHeight(blk.Block.Height), &traceCallRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response format is unknown due to custom tracer config param
+	// More information can be found here https://geth.ethereum.org/docs/dapp/tracing-filtered
+	var decodedResult interface{}
+	err = json.Unmarshal(traceResult.Data, &decodedResult)
+	if err != nil {
+		return nil, err
+	}
+
+	return decodedResult, nil
+}
+
 // TraceBlock configures a new tracer according to the provided configuration, and
 // executes all the transactions contained within. The return value will be one item
 // per transaction, dependent on the requested tracer.
@@ -220,3 +290,168 @@ func (b *Backend) TraceBlock(height rpctypes.BlockNumber,
 
 	return decodedResults, nil
 }
+
+// callTracerConfig is the trace config used to build the flat traces of the
+// trace namespace.
+var callTracerConfig = &evmtypes.TraceConfig{Tracer: "callTracer"}
+
+// TraceBlockFlat returns the flat call traces of all the transactions
+// contained within the given block.
+func (b *Backend) TraceBlockFlat(blockNr rpctypes.BlockNumber) ([]*rpctypes.ParityTrace, error) {
+	blk, err := b.TendermintBlockByNumber(blockNr)
+	if err != nil {
+		b.Logger.Debug("block not found", "number", blockNr)
+		return nil, err
+	}
+	if blk == nil {
+		return nil, fmt.Errorf("block %d not found", blockNr)
+	}
+	if blk.Block.Height == 0 {
+		return nil, errors.New("genesis is not traceable")
+	}
+
+	blockRes, err := b.TendermintBlockResultByNumber(&blk.Block.Height)
+	if err != nil {
+		b.Logger.Debug("block result not found", "height", blk.Block.Height, "error", err.Error())
+		return nil, err
+	}
+
+	results, err := b.TraceBlock(rpctypes.BlockNumber(blk.Block.Height), callTracerConfig, blk)
+	if err != nil {
+		return nil, err
+	}
+
+	// the traced messages follow the same order as the block ethereum messages
+	msgs := b.EthMsgsFromTendermintBlock(blk, blockRes)
+	if len(msgs) != len(results) {
+		return nil, fmt.Errorf("traced %d transactions out of %d in block %d", len(results), len(msgs), blk.Block.Height)
+	}
+
+	blockHash := common.BytesToHash(blk.BlockID.Hash)
+	height := uint64(blk.Block.Height) //#nosec G115 -- checked for int overflow already
+	traces := []*rpctypes.ParityTrace{}
+	for i, result := range results {
+		if result.Error != "" {
+			return nil, fmt.Errorf("failed to trace transaction %s: %s", msgs[i].Hash, result.Error)
+		}
+		frame, err := decodeCallFrame(result.Result)
+		if err != nil {
+			return nil, err
+		}
+		txHash := common.HexToHash(msgs[i].Hash)
+		traces = append(traces, rpctypes.FlattenCallFrame(frame, blockHash, height, txHash, uint64(i))...)
+	}
+
+	return traces, nil
+}
+
+// TraceTransactionFlat returns the flat call traces of the given transaction.
+func (b *Backend) TraceTransactionFlat(hash common.Hash) ([]*rpctypes.ParityTrace, error) {
+	tx, err := b.GetTransactionByHash(hash)
+	if err != nil {
+		return nil, err
+	}
+	if tx == nil || tx.BlockHash == nil {
+		return nil, fmt.Errorf("transaction %s not found", hash)
+	}
+
+	frame, err := b.traceCallFrame(hash)
+	if err != nil {
+		return nil, err
+	}
+
+	return rpctypes.FlattenCallFrame(
+		frame,
+		*tx.BlockHash,
+		tx.BlockNumber.ToInt().Uint64(),
+		hash,
+		uint64(*tx.TransactionIndex),
+	), nil
+}
+
+// TraceFilter returns the flat call traces of the given block range matching
+// the from and to addresses of the filter.
+func (b *Backend) TraceFilter(args rpctypes.TraceFilterArgs) ([]*rpctypes.ParityTrace, error) {
+	head, err := b.BlockNumber()
+	if err != nil {
+		return nil, err
+	}
+
+	resolve := func(blockNr *rpctypes.BlockNumber, defaultValue uint64) (uint64, error) {
+		if blockNr == nil {
+			return defaultValue, nil
+		}
+		switch *blockNr {
+		case rpctypes.EthLatestBlockNumber, rpctypes.EthPendingBlockNumber:
+			return uint64(head), nil
+		case rpctypes.EthEarliestBlockNumber:
+			return 1, nil
+		default:
+			if *blockNr < 0 {
+				return 0, errors.New("negative block number")
+			}
+			return uint64(*blockNr), nil
+		}
+	}
+
+	from, err := resolve(args.FromBlock, 1)
+	if err != nil {
+		return nil, err
+	}
+	to, err := resolve(args.ToBlock, uint64(head))
+	if err != nil {
+		return nil, err
+	}
+	if from > to || to > uint64(head) {
+		return nil, errors.New("invalid block range params")
+	}
+
+	blockLimit := b.RPCBlockRangeCap()
+	if blockLimit > 0 && to-from > uint64(blockLimit) {
+		return nil, fmt.Errorf("maximum [from, to] blocks distance: %d", blockLimit)
+	}
+
+	var after, count uint64
+	if args.After != nil {
+		after = uint64(*args.After)
+	}
+	if args.Count != nil {
+		count = uint64(*args.Count)
+	}
+
+	traces := []*rpctypes.ParityTrace{}
+	for height := from; height <= to; height++ {
+		blockTraces, err := b.TraceBlockFlat(rpctypes.BlockNumber(height)) //#nosec G115 -- checked for int overflow already
+		if err != nil {
+			return nil, err
+		}
+		for _, trace := range blockTraces {
+			if !args.Matches(trace) {
+				continue
+			}
+			if after > 0 {
+				after--
+				continue
+			}
+			traces = append(traces, trace)
+			if count > 0 && uint64(len(traces)) == count {
+				return traces, nil
+			}
+		}
+	}
+
+	return traces, nil
+}
+
+// decodeCallFrame decodes the result of the callTracer into a call frame.
+func decodeCallFrame(result interface{}) (rpctypes.CallFrame, error) {
+	var frame rpctypes.CallFrame
+	bz, err := json.Marshal(result)
+	if err != nil {
+		return frame, err
+	}
+	if err := json.Unmarshal(bz, &frame); err != nil {
+		return frame, err
+	}
+	return frame, nil
+}
@@ -23,6 +23,15 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+const (
+	// DefaultTxsByAddressPageSize is the default number of transactions of a
+	// page of cosmos_getTransactionsByAddress
+	DefaultTxsByAddressPageSize = 100
+	// MaxTxsByAddressPageSize is the maximum number of transactions of a page
+	// of cosmos_getTransactionsByAddress
+	MaxTxsByAddressPageSize = 1000
+)
+
 // GetTransactionByHash returns the Ethereum format transaction identified by Ethereum transaction hash
 func (b *Backend) GetTransactionByHash(txHash common.Hash) (*rpctypes.RPCTransaction, error) {
 	res, err := b.GetTxByEthHash(txHash)
@@ -268,13 +277,25 @@ func (b *Backend) GetTransactionReceipt(hash common.Hash) (map[string]interface{
 		receipt["contractAddress"] = crypto.CreateAddress(from, txData.GetNonce())
 	}
 
-	if dynamicTx, ok := txData.(*evmtypes.DynamicFeeTx); ok {
+	switch txData.(type) {
+	case *evmtypes.DynamicFeeTx, *evmtypes.BlobTx, *evmtypes.SetCodeTx:
 		baseFee, err := b.BaseFee(blockRes)
 		if err != nil {
 			// tolerate the error for pruned node.
 			b.Logger.Error("fetch basefee failed, node is pruned?", "height", res.Height, "error", err)
 		} else {
-			receipt["effectiveGasPrice"] = hexutil.Big(*dynamicTx.EffectiveGasPrice(baseFee))
+			receipt["effectiveGasPrice"] = hexutil.Big(*txData.EffectiveGasPrice(baseFee))
+		}
+	}
+
+	if blobTx, ok := txData.(*evmtypes.BlobTx); ok {
+		receipt["blobGasUsed"] = hexutil.Uint64(blobTx.GetBlobGas())
+		blobBaseFee, err := b.BlobBaseFee(blockRes)
+		if err != nil {
+			// tolerate the error for the blocks emitting no blob base fee.
+			b.Logger.Error("fetch blob base fee failed", "height", res.Height, "error", err)
+		} else {
+			receipt["blobGasPrice"] = hexutil.Big(*blobBaseFee)
 		}
 	}
 
@@ -307,6 +328,58 @@ func (b *Backend) GetTransactionLogs(hash common.Hash) ([]*ethtypes.Log, error)
 	return TxLogsFromEvents(resBlockResult.TxsResults[res.TxIndex].Events, index)
 }
 
+// GetTransactionsByAddress returns a page of the transactions sent from, sent
+// to or creating the given address, as indexed by the EVM tx indexer.
+func (b *Backend) GetTransactionsByAddress(
+	address common.Address,
+	args rpctypes.TransactionsByAddressArgs,
+) (*rpctypes.TransactionsByAddressResult, error) {
+	if b.Indexer == nil {
+		return nil, rpctypes.ErrIndexerDisabled
+	}
+
+	var filter types.AddressTxFilter
+	switch args.Type {
+	case "", rpctypes.AddressTxTypeAll:
+		filter = types.AddressTxFilter{Sent: true, Received: true, Created: true}
+	case rpctypes.AddressTxTypeSent:
+		filter.Sent = true
+	case rpctypes.AddressTxTypeReceived:
+		filter.Received = true
+	case rpctypes.AddressTxTypeCreated:
+		filter.Created = true
+	default:
+		return nil, fmt.Errorf("invalid transactions type %s", args.Type)
+	}
+
+	pageSize := uint64(args.PageSize)
+	switch {
+	case pageSize == 0:
+		pageSize = DefaultTxsByAddressPageSize
+	case pageSize > MaxTxsByAddressPageSize:
+		pageSize = MaxTxsByAddressPageSize
+	}
+
+	hashes, nextKey, err := b.Indexer.GetTxsByAddress(address, filter, args.PageKey, int(pageSize), args.Reverse) //#nosec G115 -- checked for int overflow already
+	if err != nil {
+		return nil, err
+	}
+
+	txs := make([]*rpctypes.RPCTransaction, 0, len(hashes))
+	for _, hash := range hashes {
+		tx, err := b.GetTransactionByHash(hash)
+		if err != nil {
+			return nil, err
+		}
+		txs = append(txs, tx)
+	}
+
+	return &rpctypes.TransactionsByAddressResult{
+		Transactions: txs,
+		NextPageKey:  nextKey,
+	}, nil
+}
+
 // GetTransactionByBlockHashAndIndex returns the transaction identified by hash and index.
 func (b *Backend) GetTransactionByBlockHashAndIndex(hash common.Hash, idx hexutil.Uint) (*rpctypes.RPCTransaction, error) {
 	b.Logger.Debug("eth_getTransactionByBlockHashAndIndex", "hash", hash.Hex(), "index", idx)
@@ -1,43 +1,156 @@
 package backend
 
 import (
+	"fmt"
+	"strconv"
+
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 
 	"github.com/cosmos/evm/rpc/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
 )
 
 // Content returns the transactions contained within the transaction pool
 func (b *Backend) Content() (map[string]map[string]map[string]*types.RPCTransaction, error) {
+	pending, queued, err := b.txPoolContent()
+	if err != nil {
+		return nil, err
+	}
+
 	content := map[string]map[string]map[string]*types.RPCTransaction{
 		"pending": make(map[string]map[string]*types.RPCTransaction),
 		"queued":  make(map[string]map[string]*types.RPCTransaction),
 	}
+	for addr, msgs := range pending {
+		if content["pending"][addr.Hex()], err = b.rpcTransactionsByNonce(msgs); err != nil {
+			return nil, err
+		}
+	}
+	for addr, msgs := range queued {
+		if content["queued"][addr.Hex()], err = b.rpcTransactionsByNonce(msgs); err != nil {
+			return nil, err
+		}
+	}
 	return content, nil
 }
 
-// ContentFrom returns the transactions contained within the transaction pool
-func (b *Backend) ContentFrom(_ common.Address) (map[string]map[string]map[string]*types.RPCTransaction, error) {
-	content := map[string]map[string]map[string]*types.RPCTransaction{
-		"pending": make(map[string]map[string]*types.RPCTransaction),
-		"queued":  make(map[string]map[string]*types.RPCTransaction),
+// ContentFrom returns the transactions sent by the given address contained within the transaction pool
+func (b *Backend) ContentFrom(addr common.Address) (map[string]map[string]*types.RPCTransaction, error) {
+	pending, queued, err := b.txPoolContent()
+	if err != nil {
+		return nil, err
+	}
+
+	content := make(map[string]map[string]*types.RPCTransaction, 2)
+	if content["pending"], err = b.rpcTransactionsByNonce(pending[addr]); err != nil {
+		return nil, err
+	}
+	if content["queued"], err = b.rpcTransactionsByNonce(queued[addr]); err != nil {
+		return nil, err
 	}
 	return content, nil
 }
 
 // Inspect returns the content of the transaction pool and flattens it into an easily inspectable list.
 func (b *Backend) Inspect() (map[string]map[string]map[string]string, error) {
+	pending, queued, err := b.txPoolContent()
+	if err != nil {
+		return nil, err
+	}
+
 	inspect := map[string]map[string]map[string]string{
 		"pending": make(map[string]map[string]string),
 		"queued":  make(map[string]map[string]string),
 	}
+	for addr, msgs := range pending {
+		inspect["pending"][addr.Hex()] = summarizeTransactions(msgs)
+	}
+	for addr, msgs := range queued {
+		inspect["queued"][addr.Hex()] = summarizeTransactions(msgs)
+	}
 	return inspect, nil
 }
 
 // Status returns the number of pending and queued transaction in the pool.
 func (b *Backend) Status() (map[string]hexutil.Uint, error) {
+	pending, queued, err := b.txPoolContent()
+	if err != nil {
+		return nil, err
+	}
+
+	var pendingCount, queuedCount int
+	for _, msgs := range pending {
+		pendingCount += len(msgs)
+	}
+	for _, msgs := range queued {
+		queuedCount += len(msgs)
+	}
+
 	return map[string]hexutil.Uint{
-		"pending": hexutil.Uint(0),
-		"queued":  hexutil.Uint(0),
+		"pending": hexutil.Uint(pendingCount), //nolint:gosec // G115 // count won't exceed uint
+		"queued":  hexutil.Uint(queuedCount),  //nolint:gosec // G115 // count won't exceed uint
 	}, nil
 }
+
+// txPoolContent returns the pending and queued Ethereum transactions grouped by
+// sender. If the application runs the EVM mempool, its tiers are returned.
+// Otherwise, every Ethereum transaction in the CometBFT mempool is considered
+// pending, as the CometBFT mempool doesn't hold nonce-gapped transactions.
+func (b *Backend) txPoolContent() (pending, queued map[common.Address][]*evmtypes.MsgEthereumTx, err error) {
+	if b.Mempool != nil {
+		return b.Mempool.Pending(), b.Mempool.Queued(), nil
+	}
+
+	txs, err := b.PendingTransactions()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pending = make(map[common.Address][]*evmtypes.MsgEthereumTx)
+	for _, tx := range txs {
+		for _, msg := range (*tx).GetMsgs() {
+			ethMsg, ok := msg.(*evmtypes.MsgEthereumTx)
+			if !ok {
+				// not an ethereum tx
+				break
+			}
+			from := ethMsg.GetSender()
+			pending[from] = append(pending[from], ethMsg)
+		}
+	}
+	return pending, map[common.Address][]*evmtypes.MsgEthereumTx{}, nil
+}
+
+// rpcTransactionsByNonce returns the RPC representation of the given
+// transactions of a single sender, indexed by nonce.
+func (b *Backend) rpcTransactionsByNonce(msgs []*evmtypes.MsgEthereumTx) (map[string]*types.RPCTransaction, error) {
+	txs := make(map[string]*types.RPCTransaction, len(msgs))
+	for _, msg := range msgs {
+		rpcTx, err := types.NewRPCTransaction(msg, common.Hash{}, 0, 0, nil, b.EvmChainID)
+		if err != nil {
+			return nil, err
+		}
+		txs[strconv.FormatUint(uint64(rpcTx.Nonce), 10)] = rpcTx
+	}
+	return txs, nil
+}
+
+// summarizeTransactions returns a human-readable summary of the given
+// transactions of a single sender, indexed by nonce.
+func summarizeTransactions(msgs []*evmtypes.MsgEthereumTx) map[string]string {
+	summaries := make(map[string]string, len(msgs))
+	for _, msg := range msgs {
+		tx := msg.AsTransaction()
+		if tx == nil {
+			continue
+		}
+		nonce := strconv.FormatUint(tx.Nonce(), 10)
+		if to := tx.To(); to != nil {
+			summaries[nonce] = fmt.Sprintf("%s: %v wei + %v gas × %v wei", to.Hex(), tx.Value(), tx.Gas(), tx.GasPrice())
+		} else {
+			summaries[nonce] = fmt.Sprintf("contract creation: %v wei + %v gas × %v wei", tx.Value(), tx.Gas(), tx.GasPrice())
+		}
+	}
+	return summaries
+}
@@ -18,8 +18,18 @@ func WithMaxSubscribers(n int) Option {
 	}
 }
 
+// WithSubscriberBufferSize sets the number of events buffered for each
+// subscriber. Events published to a subscriber with a full buffer are dropped
+// for that subscriber only, so slow subscribers don't stall the topic.
+func WithSubscriberBufferSize(n int) Option {
+	return func(bus *memEventBus) {
+		bus.subscriberBufferSize = n
+	}
+}
+
 const (
-	DefaultMaxSubscribers = 500_000
+	DefaultMaxSubscribers       = 500_000
+	DefaultSubscriberBufferSize = 128
 )
 
 var (
@@ -34,6 +44,7 @@ type EventBus interface {
 	RemoveTopic(name string)
 	Subscribe(name string) (<-chan coretypes.ResultEvent, UnsubscribeFunc, error)
 	Topics() []string
+	DroppedEvents() uint64
 }
 
 type memEventBus struct {
@@ -43,17 +54,20 @@ type memEventBus struct {
 	subscribersMux  *sync.RWMutex
 	currentUniqueID uint64
 
-	maxTotalSubscribers int
-	totalSubscribers    atomic.Int64
+	maxTotalSubscribers  int
+	totalSubscribers     atomic.Int64
+	subscriberBufferSize int
+	droppedEvents        atomic.Uint64
 }
 
 func NewEventBus(opts ...Option) EventBus {
 	bus := &memEventBus{
-		topics:              make(map[string]<-chan coretypes.ResultEvent),
-		topicsMux:           new(sync.RWMutex),
-		subscribers:         make(map[string]map[uint64]chan<- coretypes.ResultEvent),
-		subscribersMux:      new(sync.RWMutex),
-		maxTotalSubscribers: DefaultMaxSubscribers,
+		topics:               make(map[string]<-chan coretypes.ResultEvent),
+		topicsMux:            new(sync.RWMutex),
+		subscribers:          make(map[string]map[uint64]chan<- coretypes.ResultEvent),
+		subscribersMux:       new(sync.RWMutex),
+		maxTotalSubscribers:  DefaultMaxSubscribers,
+		subscriberBufferSize: DefaultSubscriberBufferSize,
 	}
 	for _, opt := range opts {
 		opt(bus)
@@ -77,6 +91,12 @@ func (m *memEventBus) Topics() (topics []string) {
 	return topics
 }
 
+// DroppedEvents returns the number of events dropped because of subscribers
+// lagging behind their topic.
+func (m *memEventBus) DroppedEvents() uint64 {
+	return m.droppedEvents.Load()
+}
+
 func (m *memEventBus) AddTopic(name string, src <-chan coretypes.ResultEvent) error {
 	m.topicsMux.RLock()
 	_, ok := m.topics[name]
@@ -110,7 +130,7 @@ func (m *memEventBus) Subscribe(name string) (<-chan coretypes.ResultEvent, Unsu
 		return nil, nil, errors.Wrapf(ErrTopicNotFound, name)
 	}
 
-	ch := make(chan coretypes.ResultEvent)
+	ch := make(chan coretypes.ResultEvent, m.subscriberBufferSize)
 	m.subscribersMux.Lock()
 	defer m.subscribersMux.Unlock()
 
@@ -174,6 +194,8 @@ func (m *memEventBus) publishAllSubscribers(name string, msg coretypes.ResultEve
 		select {
 		case sub <- msg:
 		default:
+			// the subscriber buffer is full, drop the event for it
+			m.droppedEvents.Add(1)
 		}
 	}
 }
@@ -3,6 +3,7 @@ package pubsub
 import (
 	"log"
 	"sort"
+	"strconv"
 	"sync"
 	"testing"
 	"time"
@@ -185,3 +186,45 @@ func subscribeAndPublish(t *testing.T, eb EventBus, topic string, topicChan chan
 	}
 	wg.Wait()
 }
+
+func TestSlowSubscriber(t *testing.T) {
+	var (
+		bufferSize  = 2
+		eventsCount = 5
+		q           = NewEventBus(WithSubscriberBufferSize(bufferSize))
+		topicCh     = make(chan coretypes.ResultEvent)
+	)
+	err := q.AddTopic("kek", topicCh)
+	require.NoError(t, err)
+
+	slowSubC, _, err := q.Subscribe("kek")
+	require.NoError(t, err)
+	fastSubC, _, err := q.Subscribe("kek")
+	require.NoError(t, err)
+
+	// the slow subscriber doesn't read, which must not block the topic nor
+	// the other subscribers
+	for i := 0; i < eventsCount; i++ {
+		select {
+		case topicCh <- coretypes.ResultEvent{Query: strconv.Itoa(i)}:
+		case <-time.After(time.Second):
+			t.Fatal("topic stalled by a slow subscriber")
+		}
+		select {
+		case msg := <-fastSubC:
+			require.Equal(t, strconv.Itoa(i), msg.Query)
+		case <-time.After(time.Second):
+			t.Fatal("event not received by the fast subscriber")
+		}
+	}
+	close(topicCh)
+
+	// the slow subscriber keeps the first buffered events only
+	for i := 0; i < bufferSize; i++ {
+		msg := <-slowSubC
+		require.Equal(t, strconv.Itoa(i), msg.Query)
+	}
+	_, ok := <-slowSubC
+	require.False(t, ok)
+	require.EqualValues(t, eventsCount-bufferSize, q.DroppedEvents())
+}
@@ -0,0 +1,35 @@
+package cosmos
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/cosmos/evm/rpc/backend"
+	rpctypes "github.com/cosmos/evm/rpc/types"
+
+	"cosmossdk.io/log"
+)
+
+// API offers the Cosmos EVM extensions of the Ethereum JSON-RPC API, which are
+// not part of any Ethereum client namespace.
+type API struct {
+	logger  log.Logger
+	backend backend.EVMBackend
+}
+
+// NewAPI creates a new Cosmos EVM API instance.
+func NewAPI(logger log.Logger, backend backend.EVMBackend) *API {
+	return &API{
+		logger:  logger.With("module", "cosmos"),
+		backend: backend,
+	}
+}
+
+// GetTransactionsByAddress returns a page of the transactions sent from, sent
+// to or creating the given address.
+func (a *API) GetTransactionsByAddress(
+	address common.Address,
+	args rpctypes.TransactionsByAddressArgs,
+) (*rpctypes.TransactionsByAddressResult, error) {
+	a.logger.Debug("cosmos_getTransactionsByAddress", "address", address.Hex(), "type", args.Type)
+	return a.backend.GetTransactionsByAddress(address, args)
+}
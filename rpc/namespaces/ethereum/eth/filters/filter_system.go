@@ -161,6 +161,13 @@ func (es *EventSystem) SubscribeLogs(crit filters.FilterCriteria) (*Subscription
 	} else {
 		to = rpc.BlockNumber(crit.ToBlock.Int64())
 	}
+	// CometBFT has instant finality, the safe and finalized blocks are the latest one
+	if from == rpc.SafeBlockNumber || from == rpc.FinalizedBlockNumber {
+		from = rpc.LatestBlockNumber
+	}
+	if to == rpc.SafeBlockNumber || to == rpc.FinalizedBlockNumber {
+		to = rpc.LatestBlockNumber
+	}
 
 	switch {
 	// only interested in new mined logs, mined logs within a specific block range, or
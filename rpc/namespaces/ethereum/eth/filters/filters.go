@@ -168,6 +168,12 @@ func (f *Filter) Logs(_ context.Context, logLimit int, blockLimit int64) ([]*eth
 		return nil, errInvalidBlockRange
 	}
 
+	// the block range cap doesn't apply to the log index, its cost grows with
+	// the number of matching logs rather than with the range
+	if logs, ok, err := f.indexedLogs(from, to, logLimit); ok {
+		return logs, err
+	}
+
 	if blockLimit > 0 && to-from > uint64(blockLimit) {
 		return nil, fmt.Errorf("maximum [from, to] blocks distance: %d", blockLimit)
 	}
@@ -199,6 +205,43 @@ func (f *Filter) Logs(_ context.Context, logLimit int, blockLimit int64) ([]*eth
 	return logs, nil
 }
 
+// indexedLogs returns the logs of the [from, to] range matching the filter
+// criteria from the log index. It returns false if the log index is disabled
+// or doesn't cover the whole range.
+func (f *Filter) indexedLogs(from, to uint64, logLimit int) ([]*ethtypes.Log, bool, error) {
+	logIndexer := f.backend.LogIndexer()
+	if logIndexer == nil {
+		return nil, false, nil
+	}
+
+	first, err := logIndexer.FirstIndexedBlock()
+	if err != nil {
+		return nil, true, err
+	}
+	last, err := logIndexer.LastIndexedBlock()
+	if err != nil {
+		return nil, true, err
+	}
+	if first == -1 || from < uint64(first) || to > uint64(last) { //#nosec G115 -- checked for -1
+		return nil, false, nil
+	}
+
+	logs, err := logIndexer.GetLogs(
+		int64(from), //#nosec G115 -- int overflow is not a concern here
+		int64(to),   //#nosec G115 -- int overflow is not a concern here
+		f.criteria.Addresses,
+		f.criteria.Topics,
+		logLimit+1,
+	)
+	if err != nil {
+		return nil, true, fmt.Errorf("failed to fetch logs from the log index: %w", err)
+	}
+	if len(logs) > logLimit {
+		return nil, true, fmt.Errorf("query returned more than %d results", logLimit)
+	}
+	return logs, true, nil
+}
+
 // blockLogs returns the logs matching the filter criteria within a single block.
 func (f *Filter) blockLogs(blockRes *tmrpctypes.ResultBlockResults, bloom ethtypes.Bloom) ([]*ethtypes.Log, error) {
 	if !bloomFilter(bloom, f.criteria.Addresses, f.criteria.Topics) {
@@ -9,6 +9,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/eth/filters"
+	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
@@ -17,6 +18,7 @@ import (
 
 	filtermocks "github.com/cosmos/evm/rpc/namespaces/ethereum/eth/filters/mocks"
 	rpctypes "github.com/cosmos/evm/rpc/types"
+	cosmosevmtypes "github.com/cosmos/evm/types"
 
 	"cosmossdk.io/log"
 )
@@ -45,6 +47,10 @@ func (m *MockBackend) BloomStatus() (uint64, uint64) {
 	panic("implement me")
 }
 
+func (m *MockBackend) LogIndexer() cosmosevmtypes.EVMLogIndexer {
+	return nil
+}
+
 func (m *MockBackend) RPCFilterCap() int32 {
 	panic("implement me")
 }
@@ -202,6 +208,43 @@ func TestFilter(t *testing.T) {
 			},
 			expErr: "invalid block range params",
 		},
+		{
+			name:   "safe and finalized blocks are the latest block",
+			filter: filters.FilterCriteria{FromBlock: big.NewInt(rpc.SafeBlockNumber.Int64()), ToBlock: big.NewInt(rpc.FinalizedBlockNumber.Int64())},
+			expectations: func(b *filtermocks.Backend) {
+				b.EXPECT().HeaderByNumber(rpctypes.EthLatestBlockNumber).Return(&ethtypes.Header{Number: big.NewInt(5)}, nil)
+				// the index only covers the latest block
+				b.EXPECT().LogIndexer().Return(logIndexerStub{first: 5, last: 5, logs: makeLogs(1)})
+			},
+			expLogs: makeLogs(1),
+		},
+		{
+			name:   "log index covering the range returns the indexed logs, ignoring the block range cap",
+			filter: filters.FilterCriteria{FromBlock: big.NewInt(1), ToBlock: big.NewInt(100)},
+			expectations: func(b *filtermocks.Backend) {
+				b.EXPECT().HeaderByNumber(rpctypes.EthLatestBlockNumber).Return(&ethtypes.Header{Number: big.NewInt(100)}, nil)
+				b.EXPECT().LogIndexer().Return(logIndexerStub{first: 1, last: 100, logs: makeLogs(2)})
+			},
+			expLogs: makeLogs(2),
+		},
+		{
+			name:   "log index returning more logs than the cap returns error",
+			filter: filters.FilterCriteria{FromBlock: big.NewInt(1), ToBlock: big.NewInt(100)},
+			expectations: func(b *filtermocks.Backend) {
+				b.EXPECT().HeaderByNumber(rpctypes.EthLatestBlockNumber).Return(&ethtypes.Header{Number: big.NewInt(100)}, nil)
+				b.EXPECT().LogIndexer().Return(logIndexerStub{first: 1, last: 100, logs: makeLogs(20)})
+			},
+			expErr: "query returned more than 15 results",
+		},
+		{
+			name:   "log index not covering the range falls back to the block scan",
+			filter: filters.FilterCriteria{FromBlock: big.NewInt(1), ToBlock: big.NewInt(100)},
+			expectations: func(b *filtermocks.Backend) {
+				b.EXPECT().HeaderByNumber(rpctypes.EthLatestBlockNumber).Return(&ethtypes.Header{Number: big.NewInt(100)}, nil)
+				b.EXPECT().LogIndexer().Return(logIndexerStub{first: 50, last: 100})
+			},
+			expErr: "maximum [from, to] blocks distance: 50",
+		},
 	}
 
 	for _, tc := range testCases {
@@ -222,3 +265,30 @@ func TestFilter(t *testing.T) {
 		})
 	}
 }
+
+// logIndexerStub is a log index covering a fixed range of blocks
+type logIndexerStub struct {
+	cosmosevmtypes.EVMLogIndexer
+	first, last int64
+	logs        []*ethtypes.Log
+}
+
+func (s logIndexerStub) FirstIndexedBlock() (int64, error) {
+	return s.first, nil
+}
+
+func (s logIndexerStub) LastIndexedBlock() (int64, error) {
+	return s.last, nil
+}
+
+func (s logIndexerStub) GetLogs(_, _ int64, _ []common.Address, _ [][]common.Hash, limit int) ([]*ethtypes.Log, error) {
+	return s.logs[:min(limit, len(s.logs))], nil
+}
+
+func makeLogs(n int) []*ethtypes.Log {
+	logs := make([]*ethtypes.Log, n)
+	for i := range logs {
+		logs[i] = &ethtypes.Log{BlockNumber: uint64(i + 1), Index: uint(i)} //nolint:gosec // G115
+	}
+	return logs
+}
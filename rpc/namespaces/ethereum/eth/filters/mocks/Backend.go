@@ -10,6 +10,8 @@ import (
 
 	rpctypes "github.com/cosmos/evm/rpc/types"
 
+	evmtypes "github.com/cosmos/evm/types"
+
 	types "github.com/ethereum/go-ethereum/core/types"
 )
 
@@ -430,6 +432,53 @@ func (_c *Backend_HeaderByNumber_Call) RunAndReturn(run func(rpctypes.BlockNumbe
 	return _c
 }
 
+// LogIndexer provides a mock function with no fields
+func (_m *Backend) LogIndexer() evmtypes.EVMLogIndexer {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for LogIndexer")
+	}
+
+	var r0 evmtypes.EVMLogIndexer
+	if rf, ok := ret.Get(0).(func() evmtypes.EVMLogIndexer); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(evmtypes.EVMLogIndexer)
+		}
+	}
+
+	return r0
+}
+
+// Backend_LogIndexer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LogIndexer'
+type Backend_LogIndexer_Call struct {
+	*mock.Call
+}
+
+// LogIndexer is a helper method to define mock.On call
+func (_e *Backend_Expecter) LogIndexer() *Backend_LogIndexer_Call {
+	return &Backend_LogIndexer_Call{Call: _e.mock.On("LogIndexer")}
+}
+
+func (_c *Backend_LogIndexer_Call) Run(run func()) *Backend_LogIndexer_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Backend_LogIndexer_Call) Return(_a0 evmtypes.EVMLogIndexer) *Backend_LogIndexer_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Backend_LogIndexer_Call) RunAndReturn(run func() evmtypes.EVMLogIndexer) *Backend_LogIndexer_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // RPCBlockRangeCap provides a mock function with no fields
 func (_m *Backend) RPCBlockRangeCap() int32 {
 	ret := _m.Called()
@@ -0,0 +1,73 @@
+package ots
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/cosmos/evm/rpc/backend"
+	rpctypes "github.com/cosmos/evm/rpc/types"
+
+	"cosmossdk.io/log"
+)
+
+// API offers the Otterscan extensions used by Otterscan-like block explorers.
+type API struct {
+	logger  log.Logger
+	backend backend.EVMBackend
+}
+
+// NewAPI creates a new Otterscan API instance.
+func NewAPI(logger log.Logger, backend backend.EVMBackend) *API {
+	return &API{
+		logger:  logger.With("module", "ots"),
+		backend: backend,
+	}
+}
+
+// GetApiLevel returns the Otterscan API level implemented by the node.
+func (a *API) GetApiLevel() uint64 { //nolint:revive // name is defined by the Otterscan spec
+	a.logger.Debug("ots_getApiLevel")
+	return rpctypes.OtsAPILevel
+}
+
+// GetInternalOperations returns the ether transfers, contract creations and
+// self destructs performed by the internal calls of the given transaction.
+func (a *API) GetInternalOperations(hash common.Hash) ([]*rpctypes.OtsInternalOperation, error) {
+	a.logger.Debug("ots_getInternalOperations", "hash", hash)
+	return a.backend.OtsInternalOperations(hash)
+}
+
+// SearchTransactionsBefore returns a page of the transactions the address
+// appeared in before the given block, in descending order.
+func (a *API) SearchTransactionsBefore(address common.Address, blockNumber uint64, pageSize uint64) (*rpctypes.OtsTransactionsPage, error) {
+	a.logger.Debug("ots_searchTransactionsBefore", "address", address, "block", blockNumber, "page size", pageSize)
+	return a.backend.OtsSearchTransactionsBefore(address, blockNumber, pageSize)
+}
+
+// SearchTransactionsAfter returns a page of the transactions the address
+// appeared in after the given block, in descending order.
+func (a *API) SearchTransactionsAfter(address common.Address, blockNumber uint64, pageSize uint64) (*rpctypes.OtsTransactionsPage, error) {
+	a.logger.Debug("ots_searchTransactionsAfter", "address", address, "block", blockNumber, "page size", pageSize)
+	return a.backend.OtsSearchTransactionsAfter(address, blockNumber, pageSize)
+}
+
+// GetContractCreator returns the transaction and the account that created
+// the given contract, or null if it is not a contract or if its creation is
+// not kept by the node.
+func (a *API) GetContractCreator(address common.Address) (*rpctypes.OtsContractCreator, error) {
+	a.logger.Debug("ots_getContractCreator", "address", address)
+	return a.backend.OtsContractCreator(address)
+}
+
+// TraceTransaction returns the calls performed by the given transaction
+// annotated with their depth.
+func (a *API) TraceTransaction(hash common.Hash) ([]*rpctypes.OtsTrace, error) {
+	a.logger.Debug("ots_traceTransaction", "hash", hash)
+	return a.backend.OtsTraceTransaction(hash)
+}
+
+// GetTransactionError returns the raw revert output of the given transaction.
+func (a *API) GetTransactionError(hash common.Hash) (hexutil.Bytes, error) {
+	a.logger.Debug("ots_getTransactionError", "hash", hash)
+	return a.backend.OtsTransactionError(hash)
+}
@@ -0,0 +1,49 @@
+package trace
+
+import (
+	"errors"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/cosmos/evm/rpc/backend"
+	rpctypes "github.com/cosmos/evm/rpc/types"
+
+	"cosmossdk.io/log"
+)
+
+// API offers the parity-style flat call traces of the executed transactions.
+type API struct {
+	logger  log.Logger
+	backend backend.EVMBackend
+}
+
+// NewAPI creates a new trace API instance.
+func NewAPI(logger log.Logger, backend backend.EVMBackend) *API {
+	return &API{
+		logger:  logger.With("module", "trace"),
+		backend: backend,
+	}
+}
+
+// Block returns the flat call traces of all the transactions contained within
+// the given block.
+func (a *API) Block(blockNr rpctypes.BlockNumber) ([]*rpctypes.ParityTrace, error) {
+	a.logger.Debug("trace_block", "number", blockNr)
+	if blockNr == rpctypes.EthEarliestBlockNumber {
+		return nil, errors.New("genesis is not traceable")
+	}
+	return a.backend.TraceBlockFlat(blockNr)
+}
+
+// Transaction returns the flat call traces of the given transaction.
+func (a *API) Transaction(hash common.Hash) ([]*rpctypes.ParityTrace, error) {
+	a.logger.Debug("trace_transaction", "hash", hash)
+	return a.backend.TraceTransactionFlat(hash)
+}
+
+// Filter returns the flat call traces matching the given filter. The block
+// range of the filter is capped by the JSON-RPC block range cap.
+func (a *API) Filter(args rpctypes.TraceFilterArgs) ([]*rpctypes.ParityTrace, error) {
+	a.logger.Debug("trace_filter", "from", args.FromBlock, "to", args.ToBlock)
+	return a.backend.TraceFilter(args)
+}
@@ -31,8 +31,8 @@ func (api *PublicAPI) Content() (map[string]map[string]map[string]*types.RPCTran
 	return api.backend.Content()
 }
 
-// ContentFrom returns the transactions contained within the transaction pool
-func (api *PublicAPI) ContentFrom(address common.Address) (map[string]map[string]map[string]*types.RPCTransaction, error) {
+// ContentFrom returns the transactions sent by the given address contained within the transaction pool
+func (api *PublicAPI) ContentFrom(address common.Address) (map[string]map[string]*types.RPCTransaction, error) {
 	api.logger.Debug("txpool_contentFrom")
 	return api.backend.ContentFrom(address)
 }
@@ -78,6 +78,33 @@ func TestUnmarshalBlockNumberOrHash(t *testing.T) {
 			},
 			true,
 		},
+		{
+			"String input with block number safe",
+			[]byte("\"safe\""),
+			func() {
+				require.Equal(t, *bnh.BlockNumber, EthLatestBlockNumber)
+				require.Nil(t, bnh.BlockHash)
+			},
+			true,
+		},
+		{
+			"String input with block number finalized",
+			[]byte("\"finalized\""),
+			func() {
+				require.Equal(t, *bnh.BlockNumber, EthLatestBlockNumber)
+				require.Nil(t, bnh.BlockHash)
+			},
+			true,
+		},
+		{
+			"String input with block number pending",
+			[]byte("\"pending\""),
+			func() {
+				require.Equal(t, *bnh.BlockNumber, EthPendingBlockNumber)
+				require.Nil(t, bnh.BlockHash)
+			},
+			true,
+		},
 		{
 			"String input with block number overflow",
 			[]byte("\"0xffffffffffffffffffffffffffffffffffffff\""),
@@ -100,3 +127,24 @@ func TestUnmarshalBlockNumberOrHash(t *testing.T) {
 		}
 	}
 }
+
+func TestUnmarshalBlockNumber(t *testing.T) {
+	testCases := []struct {
+		input    string
+		expBlock BlockNumber
+	}{
+		{`"earliest"`, EthEarliestBlockNumber},
+		{`"latest"`, EthLatestBlockNumber},
+		{`"safe"`, EthLatestBlockNumber},
+		{`"finalized"`, EthLatestBlockNumber},
+		{`"pending"`, EthPendingBlockNumber},
+		{`"0x10"`, BlockNumber(16)},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.input, func(t *testing.T) {
+			var bn BlockNumber
+			require.NoError(t, bn.UnmarshalJSON([]byte(tc.input)))
+			require.Equal(t, tc.expBlock, bn)
+		})
+	}
+}
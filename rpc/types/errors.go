@@ -2,4 +2,7 @@ package types
 
 import "errors"
 
-var ErrProfilingDisabled = errors.New("profiling disabled in the debug namespace")
+var (
+	ErrProfilingDisabled = errors.New("profiling disabled in the debug namespace")
+	ErrIndexerDisabled   = errors.New("EVM tx indexer is not enabled")
+)
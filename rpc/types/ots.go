@@ -0,0 +1,149 @@
+package types
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// OtsAPILevel is the Otterscan API level implemented by the ots namespace.
+const OtsAPILevel = 8
+
+// Otterscan internal operation types
+const (
+	OtsOpTransfer     = 0
+	OtsOpSelfDestruct = 1
+	OtsOpCreate       = 2
+	OtsOpCreate2      = 3
+)
+
+// OtsInternalOperation is an ether transfer, contract creation or self
+// destruct performed by an internal call of a transaction.
+type OtsInternalOperation struct {
+	Type  int            `json:"type"`
+	From  common.Address `json:"from"`
+	To    common.Address `json:"to"`
+	Value *hexutil.Big   `json:"value"`
+}
+
+// OtsTrace is a call of a transaction call tree, annotated with its depth.
+type OtsTrace struct {
+	Type   string         `json:"type"`
+	Depth  int            `json:"depth"`
+	From   common.Address `json:"from"`
+	To     common.Address `json:"to"`
+	Value  *hexutil.Big   `json:"value"`
+	Input  hexutil.Bytes  `json:"input"`
+	Output hexutil.Bytes  `json:"output"`
+}
+
+// OtsContractCreator is the transaction and the account that created a
+// contract.
+type OtsContractCreator struct {
+	Hash    common.Hash    `json:"hash"`
+	Creator common.Address `json:"creator"`
+}
+
+// OtsTransactionsPage is a page of the transactions an address appeared in,
+// in descending order, with their receipts.
+type OtsTransactionsPage struct {
+	Txs       []*RPCTransaction        `json:"txs"`
+	Receipts  []map[string]interface{} `json:"receipts"`
+	FirstPage bool                     `json:"firstPage"`
+	LastPage  bool                     `json:"lastPage"`
+}
+
+// OtsInternalOperations returns the internal operations of the given
+// callTracer call tree. The top level call is not an internal operation.
+func OtsInternalOperations(frame CallFrame) []*OtsInternalOperation {
+	ops := []*OtsInternalOperation{}
+	var walk func(frame CallFrame)
+	walk = func(frame CallFrame) {
+		for _, call := range frame.Calls {
+			if call.Error == "" {
+				if op := newOtsInternalOperation(call); op != nil {
+					ops = append(ops, op)
+				}
+				walk(call)
+			}
+		}
+	}
+	walk(frame)
+	return ops
+}
+
+func newOtsInternalOperation(frame CallFrame) *OtsInternalOperation {
+	op := &OtsInternalOperation{From: frame.From, Value: frame.Value}
+	if frame.To != nil {
+		op.To = *frame.To
+	}
+	if op.Value == nil {
+		op.Value = new(hexutil.Big)
+	}
+
+	switch frame.Type {
+	case "CALL":
+		if op.Value.ToInt().Sign() == 0 {
+			return nil
+		}
+		op.Type = OtsOpTransfer
+	case "CREATE":
+		op.Type = OtsOpCreate
+	case "CREATE2":
+		op.Type = OtsOpCreate2
+	case "SELFDESTRUCT":
+		op.Type = OtsOpSelfDestruct
+	default:
+		return nil
+	}
+	return op
+}
+
+// OtsTraces flattens the given callTracer call tree in depth-first order.
+func OtsTraces(frame CallFrame) []*OtsTrace {
+	traces := []*OtsTrace{}
+	var walk func(frame CallFrame, depth int)
+	walk = func(frame CallFrame, depth int) {
+		trace := &OtsTrace{
+			Type:   frame.Type,
+			Depth:  depth,
+			From:   frame.From,
+			Value:  frame.Value,
+			Input:  frame.Input,
+			Output: frame.Output,
+		}
+		if frame.To != nil {
+			trace.To = *frame.To
+		}
+		switch frame.Type {
+		case "DELEGATECALL", "STATICCALL":
+			// no value is transferred by these calls
+			trace.Value = nil
+		default:
+			if trace.Value == nil {
+				trace.Value = new(hexutil.Big)
+			}
+		}
+		traces = append(traces, trace)
+
+		for _, call := range frame.Calls {
+			walk(call, depth+1)
+		}
+	}
+	walk(frame, 0)
+	return traces
+}
+
+// FindCreator returns the frame of the given callTracer call tree creating
+// the contract, or nil if it wasn't created in it.
+func FindCreator(frame CallFrame, contract common.Address) *CallFrame {
+	if (frame.Type == "CREATE" || frame.Type == "CREATE2") && frame.Error == "" &&
+		frame.To != nil && *frame.To == contract {
+		return &frame
+	}
+	for _, call := range frame.Calls {
+		if creator := FindCreator(call, contract); creator != nil {
+			return creator
+		}
+	}
+	return nil
+}
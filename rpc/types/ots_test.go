@@ -0,0 +1,98 @@
+package types
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOtsCallFrameHelpers(t *testing.T) {
+	// callTracer output of a call transferring value to an account, creating
+	// a contract, delegating a call and failing to transfer value
+	result := []byte(`{
+		"type": "CALL",
+		"from": "0x0000000000000000000000000000000000000001",
+		"to": "0x0000000000000000000000000000000000000002",
+		"value": "0x0",
+		"gas": "0x5208",
+		"gasUsed": "0x5000",
+		"input": "0x",
+		"calls": [
+			{
+				"type": "CALL",
+				"from": "0x0000000000000000000000000000000000000002",
+				"to": "0x0000000000000000000000000000000000000003",
+				"value": "0x10",
+				"gas": "0x100",
+				"gasUsed": "0x0",
+				"input": "0x"
+			},
+			{
+				"type": "CREATE2",
+				"from": "0x0000000000000000000000000000000000000002",
+				"to": "0x0000000000000000000000000000000000000004",
+				"value": "0x0",
+				"gas": "0x100",
+				"gasUsed": "0x80",
+				"input": "0x6000",
+				"output": "0x00",
+				"calls": [
+					{
+						"type": "DELEGATECALL",
+						"from": "0x0000000000000000000000000000000000000004",
+						"to": "0x0000000000000000000000000000000000000005",
+						"gas": "0x10",
+						"gasUsed": "0x10",
+						"input": "0x"
+					}
+				]
+			},
+			{
+				"type": "CALL",
+				"from": "0x0000000000000000000000000000000000000002",
+				"to": "0x0000000000000000000000000000000000000006",
+				"value": "0x20",
+				"gas": "0x100",
+				"gasUsed": "0x100",
+				"input": "0x",
+				"error": "out of gas"
+			}
+		]
+	}`)
+
+	var frame CallFrame
+	require.NoError(t, json.Unmarshal(result, &frame))
+
+	ops := OtsInternalOperations(frame)
+	require.Len(t, ops, 2)
+	expOps := []struct {
+		opType int
+		to     common.Address
+		value  int64
+	}{
+		{OtsOpTransfer, common.HexToAddress("0x03"), 0x10},
+		{OtsOpCreate2, common.HexToAddress("0x04"), 0},
+	}
+	for i, expOp := range expOps {
+		require.Equal(t, expOp.opType, ops[i].Type)
+		require.Equal(t, common.HexToAddress("0x02"), ops[i].From)
+		require.Equal(t, expOp.to, ops[i].To)
+		require.Equal(t, expOp.value, ops[i].Value.ToInt().Int64())
+	}
+
+	traces := OtsTraces(frame)
+	require.Len(t, traces, 5)
+	expDepths := []int{0, 1, 1, 2, 1}
+	for i, trace := range traces {
+		require.Equal(t, expDepths[i], trace.Depth)
+	}
+	require.Equal(t, "DELEGATECALL", traces[3].Type)
+	require.Nil(t, traces[3].Value)
+
+	creator := FindCreator(frame, common.HexToAddress("0x04"))
+	require.NotNil(t, creator)
+	require.Equal(t, common.HexToAddress("0x02"), creator.From)
+	require.Nil(t, FindCreator(frame, common.HexToAddress("0x03")))
+}
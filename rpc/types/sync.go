@@ -0,0 +1,234 @@
+package types
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	cmtrpcclient "github.com/cometbft/cometbft/rpc/client"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+
+	"cosmossdk.io/log"
+)
+
+// SyncProgress is the sync progress of a node that is catching up with the
+// chain, through block sync or state sync.
+type SyncProgress struct {
+	// StartingBlock is the first block of the node store, where the sync started
+	StartingBlock hexutil.Uint64 `json:"startingBlock"`
+	// CurrentBlock is the latest block synced by the node
+	CurrentBlock hexutil.Uint64 `json:"currentBlock"`
+	// HighestBlock is the highest block known from the node peers
+	HighestBlock hexutil.Uint64 `json:"highestBlock"`
+}
+
+// SyncingResult is the notification of the syncing subscription while the
+// node is syncing. A false value is sent instead once the node is synced.
+type SyncingResult struct {
+	Syncing bool          `json:"syncing"`
+	Status  *SyncProgress `json:"status"`
+}
+
+// peerState is the part of the consensus state of a peer used to find the
+// highest block of the chain.
+type peerState struct {
+	RoundState struct {
+		Height int64 `json:"height,string"`
+	} `json:"round_state"`
+}
+
+// GetSyncProgress returns the sync progress of the node, or nil if the node
+// is not catching up. The highest block is derived from the consensus state
+// of the peers when the client exposes it, and is the current block
+// otherwise.
+//
+// NOTE: dumping the consensus state is expensive, use a SyncTracker to serve
+// requests instead.
+func GetSyncProgress(ctx context.Context, client cmtrpcclient.StatusClient) (*SyncProgress, error) {
+	status, err := client.Status(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !status.SyncInfo.CatchingUp {
+		return nil, nil
+	}
+
+	highest := status.SyncInfo.LatestBlockHeight
+	if networkClient, ok := client.(cmtrpcclient.NetworkClient); ok {
+		if consensusState, err := networkClient.DumpConsensusState(ctx); err == nil {
+			highest = max(highest, HighestPeerBlock(consensusState))
+		}
+	}
+
+	return &SyncProgress{
+		StartingBlock: hexutil.Uint64(status.SyncInfo.EarliestBlockHeight), //#nosec G115 -- block height is always positive
+		CurrentBlock:  hexutil.Uint64(status.SyncInfo.LatestBlockHeight),   //#nosec G115 -- block height is always positive
+		HighestBlock:  hexutil.Uint64(highest),                             //#nosec G115 -- block height is always positive
+	}, nil
+}
+
+// HighestPeerBlock returns the highest block committed by the peers of the
+// consensus state, zero if unknown. Peers report the height they are working
+// on, which is the one after their last committed block.
+func HighestPeerBlock(consensusState *coretypes.ResultDumpConsensusState) int64 {
+	var highest int64
+	for _, peer := range consensusState.Peers {
+		var state peerState
+		if err := json.Unmarshal(peer.PeerState, &state); err != nil {
+			continue
+		}
+		highest = max(highest, state.RoundState.Height-1)
+	}
+	return highest
+}
+
+// EqualSyncProgress returns true if both sync progresses are the same, or if
+// the node is synced in both.
+func EqualSyncProgress(a, b *SyncProgress) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+// SyncPollInterval is the interval at which the sync progress of the node is
+// polled, eth_syncing serves the last polled progress within it.
+const SyncPollInterval = time.Second
+
+// SyncTracker tracks the sync progress of the node for all the eth_syncing
+// requests and syncing subscriptions. The progress is polled at most once per
+// interval, so that the consensus state of the peers is not dumped on every
+// request, and a single poller fans it out to all the subscribers while there
+// are any.
+type SyncTracker struct {
+	client   cmtrpcclient.StatusClient
+	logger   log.Logger
+	interval time.Duration
+
+	pollMux  sync.Mutex
+	polledAt time.Time
+	progress *SyncProgress
+
+	subsMux   sync.Mutex
+	subs      map[uint64]chan *SyncProgress
+	nextSubID uint64
+	stop      chan struct{}
+	published bool
+	latest    *SyncProgress
+}
+
+// NewSyncTracker creates a new sync tracker polling the given client at the
+// given interval.
+func NewSyncTracker(client cmtrpcclient.StatusClient, logger log.Logger, interval time.Duration) *SyncTracker {
+	return &SyncTracker{
+		client:   client,
+		logger:   logger,
+		interval: interval,
+		subs:     make(map[uint64]chan *SyncProgress),
+	}
+}
+
+// Progress returns the sync progress of the node, or nil if the node is not
+// catching up. The last polled progress is returned if it was polled within
+// the interval, concurrent callers wait for a single poll otherwise.
+func (t *SyncTracker) Progress(ctx context.Context) (*SyncProgress, error) {
+	t.pollMux.Lock()
+	defer t.pollMux.Unlock()
+
+	if !t.polledAt.IsZero() && time.Since(t.polledAt) < t.interval {
+		return t.progress, nil
+	}
+
+	progress, err := GetSyncProgress(ctx, t.client)
+	if err != nil {
+		return nil, err
+	}
+	t.progress, t.polledAt = progress, time.Now()
+	return progress, nil
+}
+
+// Subscribe returns a channel notified with the sync progress of the node
+// when it changes, starting with the current one. A nil progress is sent
+// when the node is synced. Only the latest progress is kept for subscribers
+// lagging behind. The channel is closed by the returned unsubscribe function.
+func (t *SyncTracker) Subscribe() (<-chan *SyncProgress, func()) {
+	t.subsMux.Lock()
+	defer t.subsMux.Unlock()
+
+	id := t.nextSubID
+	t.nextSubID++
+	ch := make(chan *SyncProgress, 1)
+	t.subs[id] = ch
+
+	if t.stop == nil {
+		t.stop = make(chan struct{})
+		go t.poll(t.stop)
+	} else if t.published {
+		ch <- t.latest
+	}
+
+	unsubscribe := func() {
+		t.subsMux.Lock()
+		defer t.subsMux.Unlock()
+
+		if _, ok := t.subs[id]; !ok {
+			return
+		}
+		close(ch)
+		delete(t.subs, id)
+
+		// stop polling once the last subscriber is gone
+		if len(t.subs) == 0 {
+			close(t.stop)
+			t.stop, t.published, t.latest = nil, false, nil
+		}
+	}
+
+	return ch, unsubscribe
+}
+
+// poll publishes the sync progress to the subscribers when it changes until
+// the stop channel is closed.
+func (t *SyncTracker) poll(stop chan struct{}) {
+	ticker := time.NewTicker(t.interval)
+	defer ticker.Stop()
+
+	for {
+		progress, err := t.Progress(context.Background())
+		if err != nil {
+			t.logger.Debug("failed to get the sync progress", "error", err.Error())
+		} else {
+			t.publish(stop, progress)
+		}
+
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// publish sends the sync progress to all the subscribers if it changed since
+// the last one published by the poller with the given stop channel.
+func (t *SyncTracker) publish(stop chan struct{}, progress *SyncProgress) {
+	t.subsMux.Lock()
+	defer t.subsMux.Unlock()
+
+	if t.stop != stop || (t.published && EqualSyncProgress(t.latest, progress)) {
+		return
+	}
+	t.published, t.latest = true, progress
+
+	for _, ch := range t.subs {
+		// replace the pending progress of lagging subscribers
+		select {
+		case <-ch:
+		default:
+		}
+		ch <- progress
+	}
+}
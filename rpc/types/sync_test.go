@@ -0,0 +1,154 @@
+package types
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/require"
+
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+
+	"cosmossdk.io/log"
+)
+
+func TestHighestPeerBlock(t *testing.T) {
+	testCases := []struct {
+		name       string
+		peerStates []string
+		expHighest int64
+	}{
+		{
+			"no peers",
+			nil,
+			0,
+		},
+		{
+			"single peer",
+			[]string{`{"round_state":{"height":"10"}}`},
+			9,
+		},
+		{
+			"highest of multiple peers",
+			[]string{
+				`{"round_state":{"height":"10"}}`,
+				`{"round_state":{"height":"25"}}`,
+				`{"round_state":{"height":"12"}}`,
+			},
+			24,
+		},
+		{
+			"invalid peer states are skipped",
+			[]string{
+				`{"round_state":{"height":10}}`,
+				`invalid`,
+				`{"round_state":{"height":"7"}}`,
+			},
+			6,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			consensusState := &coretypes.ResultDumpConsensusState{}
+			for _, peerState := range tc.peerStates {
+				consensusState.Peers = append(consensusState.Peers, coretypes.PeerStateInfo{PeerState: []byte(peerState)})
+			}
+			require.Equal(t, tc.expHighest, HighestPeerBlock(consensusState))
+		})
+	}
+}
+
+// statusClient is a status client of a node catching up, counting the status
+// requests.
+type statusClient struct {
+	mu       sync.Mutex
+	latest   int64
+	requests int
+}
+
+func (c *statusClient) Status(context.Context) (*coretypes.ResultStatus, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.requests++
+	status := &coretypes.ResultStatus{}
+	status.SyncInfo.CatchingUp = true
+	status.SyncInfo.EarliestBlockHeight = 1
+	status.SyncInfo.LatestBlockHeight = c.latest
+	return status, nil
+}
+
+func (c *statusClient) setLatest(latest int64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.latest = latest
+}
+
+func (c *statusClient) getRequests() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.requests
+}
+
+func TestSyncTrackerProgress(t *testing.T) {
+	client := &statusClient{latest: 5}
+	tracker := NewSyncTracker(client, log.NewNopLogger(), time.Hour)
+
+	expProgress := &SyncProgress{
+		StartingBlock: hexutil.Uint64(1),
+		CurrentBlock:  hexutil.Uint64(5),
+		HighestBlock:  hexutil.Uint64(5),
+	}
+	for i := 0; i < 3; i++ {
+		progress, err := tracker.Progress(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, expProgress, progress)
+	}
+	// the progress is only polled once per interval
+	require.Equal(t, 1, client.getRequests())
+}
+
+func TestSyncTrackerSubscribe(t *testing.T) {
+	client := &statusClient{latest: 5}
+	tracker := NewSyncTracker(client, log.NewNopLogger(), 10*time.Millisecond)
+
+	receive := func(ch <-chan *SyncProgress) *SyncProgress {
+		select {
+		case progress, ok := <-ch:
+			require.True(t, ok)
+			return progress
+		case <-time.After(5 * time.Second):
+			require.FailNow(t, "no sync progress received")
+			return nil
+		}
+	}
+
+	first, unsubFirst := tracker.Subscribe()
+	require.Equal(t, hexutil.Uint64(5), receive(first).CurrentBlock)
+
+	// late subscribers receive the current progress
+	second, unsubSecond := tracker.Subscribe()
+	require.Equal(t, hexutil.Uint64(5), receive(second).CurrentBlock)
+
+	// all the subscribers are notified of the changes
+	client.setLatest(6)
+	require.Equal(t, hexutil.Uint64(6), receive(first).CurrentBlock)
+	require.Equal(t, hexutil.Uint64(6), receive(second).CurrentBlock)
+
+	unsubFirst()
+	unsubFirst()
+	_, ok := <-first
+	require.False(t, ok)
+
+	unsubSecond()
+	_, ok = <-second
+	require.False(t, ok)
+
+	// the poller stops with the last subscriber
+	requests := client.getRequests()
+	time.Sleep(50 * time.Millisecond)
+	require.LessOrEqual(t, client.getRequests(), requests+1)
+}
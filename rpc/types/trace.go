@@ -0,0 +1,187 @@
+package types
+
+import (
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// Parity trace types and actions
+const (
+	TraceTypeCall    = "call"
+	TraceTypeCreate  = "create"
+	TraceTypeSuicide = "suicide"
+)
+
+// CallFrame is the result of the callTracer for a single call frame.
+// Duplicate struct definition since geth struct is in internal package
+// Ref: https://github.com/ethereum/go-ethereum/blob/v1.15.11/eth/tracers/native/call.go#L51
+type CallFrame struct {
+	Type    string          `json:"type"`
+	From    common.Address  `json:"from"`
+	Gas     hexutil.Uint64  `json:"gas"`
+	GasUsed hexutil.Uint64  `json:"gasUsed"`
+	To      *common.Address `json:"to,omitempty"`
+	Input   hexutil.Bytes   `json:"input"`
+	Output  hexutil.Bytes   `json:"output,omitempty"`
+	Error   string          `json:"error,omitempty"`
+	Calls   []CallFrame     `json:"calls,omitempty"`
+	Value   *hexutil.Big    `json:"value,omitempty"`
+}
+
+// ParityTrace is a flat call trace localized in a block, as returned by the
+// trace namespace.
+type ParityTrace struct {
+	Action              ParityTraceAction  `json:"action"`
+	BlockHash           common.Hash        `json:"blockHash"`
+	BlockNumber         uint64             `json:"blockNumber"`
+	Error               string             `json:"error,omitempty"`
+	Result              *ParityTraceResult `json:"result"`
+	Subtraces           int                `json:"subtraces"`
+	TraceAddress        []int              `json:"traceAddress"`
+	TransactionHash     common.Hash        `json:"transactionHash"`
+	TransactionPosition uint64             `json:"transactionPosition"`
+	Type                string             `json:"type"`
+}
+
+// ParityTraceAction is the action performed by a call, create or suicide trace.
+type ParityTraceAction struct {
+	CallType      string          `json:"callType,omitempty"`
+	From          *common.Address `json:"from,omitempty"`
+	To            *common.Address `json:"to,omitempty"`
+	Gas           *hexutil.Uint64 `json:"gas,omitempty"`
+	Input         *hexutil.Bytes  `json:"input,omitempty"`
+	Init          *hexutil.Bytes  `json:"init,omitempty"`
+	Value         *hexutil.Big    `json:"value,omitempty"`
+	Address       *common.Address `json:"address,omitempty"`
+	RefundAddress *common.Address `json:"refundAddress,omitempty"`
+	Balance       *hexutil.Big    `json:"balance,omitempty"`
+}
+
+// ParityTraceResult is the outcome of a successful call or create trace.
+type ParityTraceResult struct {
+	GasUsed hexutil.Uint64  `json:"gasUsed"`
+	Output  *hexutil.Bytes  `json:"output,omitempty"`
+	Address *common.Address `json:"address,omitempty"`
+	Code    *hexutil.Bytes  `json:"code,omitempty"`
+}
+
+// TraceFilterArgs are the arguments of the trace_filter query.
+type TraceFilterArgs struct {
+	FromBlock   *BlockNumber     `json:"fromBlock"`
+	ToBlock     *BlockNumber     `json:"toBlock"`
+	FromAddress []common.Address `json:"fromAddress"`
+	ToAddress   []common.Address `json:"toAddress"`
+	After       *hexutil.Uint64  `json:"after"`
+	Count       *hexutil.Uint64  `json:"count"`
+}
+
+// Matches returns true if the trace action sender is one of the filtered
+// from addresses and its recipient one of the filtered to addresses. Empty
+// address lists match any address.
+func (args TraceFilterArgs) Matches(trace *ParityTrace) bool {
+	from, to := trace.Action.From, trace.Action.To
+	switch trace.Type {
+	case TraceTypeCreate:
+		to = nil
+		if trace.Result != nil {
+			to = trace.Result.Address
+		}
+	case TraceTypeSuicide:
+		from, to = trace.Action.Address, trace.Action.RefundAddress
+	}
+	return containsAddress(args.FromAddress, from) && containsAddress(args.ToAddress, to)
+}
+
+func containsAddress(addrs []common.Address, addr *common.Address) bool {
+	if len(addrs) == 0 {
+		return true
+	}
+	if addr == nil {
+		return false
+	}
+	for _, a := range addrs {
+		if a == *addr {
+			return true
+		}
+	}
+	return false
+}
+
+// FlattenCallFrame converts the call tree of a callTracer result into the
+// list of flat traces of the given transaction, in depth-first order.
+func FlattenCallFrame(
+	frame CallFrame,
+	blockHash common.Hash,
+	blockNumber uint64,
+	txHash common.Hash,
+	txIndex uint64,
+) []*ParityTrace {
+	var traces []*ParityTrace
+	var flatten func(frame CallFrame, traceAddress []int)
+	flatten = func(frame CallFrame, traceAddress []int) {
+		trace := newParityTrace(frame)
+		trace.BlockHash = blockHash
+		trace.BlockNumber = blockNumber
+		trace.TransactionHash = txHash
+		trace.TransactionPosition = txIndex
+		trace.TraceAddress = traceAddress
+		traces = append(traces, trace)
+
+		for i, call := range frame.Calls {
+			// copy the address to not share the underlying array between siblings
+			childAddress := make([]int, len(traceAddress), len(traceAddress)+1)
+			copy(childAddress, traceAddress)
+			flatten(call, append(childAddress, i))
+		}
+	}
+	flatten(frame, []int{})
+	return traces
+}
+
+// newParityTrace converts a single call frame, without its children, into a
+// flat trace.
+func newParityTrace(frame CallFrame) *ParityTrace {
+	trace := &ParityTrace{
+		Subtraces: len(frame.Calls),
+		Error:     frame.Error,
+	}
+	from := frame.From
+	gas := frame.Gas
+	value := frame.Value
+	if value == nil {
+		value = new(hexutil.Big)
+	}
+
+	switch frame.Type {
+	case "CREATE", "CREATE2":
+		init := frame.Input
+		trace.Type = TraceTypeCreate
+		trace.Action = ParityTraceAction{From: &from, Gas: &gas, Init: &init, Value: value}
+		if frame.Error == "" {
+			code := frame.Output
+			trace.Result = &ParityTraceResult{GasUsed: frame.GasUsed, Address: frame.To, Code: &code}
+		}
+	case "SELFDESTRUCT":
+		trace.Type = TraceTypeSuicide
+		trace.Action = ParityTraceAction{Address: &from, RefundAddress: frame.To, Balance: value}
+	default:
+		input := frame.Input
+		trace.Type = TraceTypeCall
+		trace.Action = ParityTraceAction{
+			CallType: strings.ToLower(frame.Type),
+			From:     &from,
+			To:       frame.To,
+			Gas:      &gas,
+			Input:    &input,
+			Value:    value,
+		}
+		if frame.Error == "" {
+			output := frame.Output
+			trace.Result = &ParityTraceResult{GasUsed: frame.GasUsed, Output: &output}
+		}
+	}
+
+	return trace
+}
@@ -0,0 +1,137 @@
+package types
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFlattenCallFrame(t *testing.T) {
+	// callTracer output of a call creating a contract that self destructs,
+	// followed by a reverted static call
+	result := []byte(`{
+		"type": "CALL",
+		"from": "0x0000000000000000000000000000000000000001",
+		"to": "0x0000000000000000000000000000000000000002",
+		"value": "0x1",
+		"gas": "0x5208",
+		"gasUsed": "0x5000",
+		"input": "0x01",
+		"output": "0x02",
+		"calls": [
+			{
+				"type": "CREATE2",
+				"from": "0x0000000000000000000000000000000000000002",
+				"to": "0x0000000000000000000000000000000000000003",
+				"gas": "0x100",
+				"gasUsed": "0x80",
+				"input": "0x6000",
+				"output": "0x00",
+				"calls": [
+					{
+						"type": "SELFDESTRUCT",
+						"from": "0x0000000000000000000000000000000000000003",
+						"to": "0x0000000000000000000000000000000000000001",
+						"value": "0x0",
+						"gas": "0x0",
+						"gasUsed": "0x0",
+						"input": "0x"
+					}
+				]
+			},
+			{
+				"type": "STATICCALL",
+				"from": "0x0000000000000000000000000000000000000002",
+				"to": "0x0000000000000000000000000000000000000004",
+				"gas": "0x100",
+				"gasUsed": "0x100",
+				"input": "0x",
+				"error": "execution reverted"
+			}
+		]
+	}`)
+
+	var frame CallFrame
+	require.NoError(t, json.Unmarshal(result, &frame))
+
+	blockHash := common.HexToHash("0x01")
+	txHash := common.HexToHash("0x02")
+	traces := FlattenCallFrame(frame, blockHash, 10, txHash, 3)
+	require.Len(t, traces, 4)
+
+	for _, trace := range traces {
+		require.Equal(t, blockHash, trace.BlockHash)
+		require.Equal(t, uint64(10), trace.BlockNumber)
+		require.Equal(t, txHash, trace.TransactionHash)
+		require.Equal(t, uint64(3), trace.TransactionPosition)
+	}
+
+	root := traces[0]
+	require.Equal(t, TraceTypeCall, root.Type)
+	require.Equal(t, "call", root.Action.CallType)
+	require.Equal(t, []int{}, root.TraceAddress)
+	require.Equal(t, 2, root.Subtraces)
+	require.NotNil(t, root.Result)
+	require.Equal(t, []byte{0x02}, []byte(*root.Result.Output))
+
+	create := traces[1]
+	require.Equal(t, TraceTypeCreate, create.Type)
+	require.Equal(t, []int{0}, create.TraceAddress)
+	require.Equal(t, common.HexToAddress("0x03"), *create.Result.Address)
+	require.Equal(t, []byte{0x60, 0x00}, []byte(*create.Action.Init))
+
+	suicide := traces[2]
+	require.Equal(t, TraceTypeSuicide, suicide.Type)
+	require.Equal(t, []int{0, 0}, suicide.TraceAddress)
+	require.Equal(t, common.HexToAddress("0x03"), *suicide.Action.Address)
+	require.Equal(t, common.HexToAddress("0x01"), *suicide.Action.RefundAddress)
+
+	reverted := traces[3]
+	require.Equal(t, "staticcall", reverted.Action.CallType)
+	require.Equal(t, []int{1}, reverted.TraceAddress)
+	require.Equal(t, "execution reverted", reverted.Error)
+	require.Nil(t, reverted.Result)
+
+	testCases := []struct {
+		name      string
+		args      TraceFilterArgs
+		expTraces []*ParityTrace
+	}{
+		{
+			"no addresses",
+			TraceFilterArgs{},
+			traces,
+		},
+		{
+			"from address",
+			TraceFilterArgs{FromAddress: []common.Address{common.HexToAddress("0x02")}},
+			[]*ParityTrace{create, reverted},
+		},
+		{
+			"to created contract address",
+			TraceFilterArgs{ToAddress: []common.Address{common.HexToAddress("0x03")}},
+			[]*ParityTrace{create},
+		},
+		{
+			"from and to addresses of self destruct",
+			TraceFilterArgs{
+				FromAddress: []common.Address{common.HexToAddress("0x03")},
+				ToAddress:   []common.Address{common.HexToAddress("0x01")},
+			},
+			[]*ParityTrace{suicide},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var matched []*ParityTrace
+			for _, trace := range traces {
+				if tc.args.Matches(trace) {
+					matched = append(matched, trace)
+				}
+			}
+			require.Equal(t, tc.expTraces, matched)
+		})
+	}
+}
@@ -6,6 +6,8 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	evmtypes "github.com/cosmos/evm/x/vm/types"
 )
 
 // Copied the Account and StorageResult types since they are registered under an
@@ -20,6 +22,35 @@ type AccountResult struct {
 	Nonce        hexutil.Uint64  `json:"nonce"`
 	StorageHash  common.Hash     `json:"storageHash"`
 	StorageProof []StorageResult `json:"storageProof"`
+
+	// AppHashHeight is the height of the block whose header app hash commits
+	// the Cosmos store proofs of the result, i.e. the block after the queried one.
+	AppHashHeight hexutil.Uint64 `json:"appHashHeight"`
+	// StateProof holds the Cosmos store proofs of the account fields.
+	StateProof *AccountStateProof `json:"stateProof"`
+}
+
+// AccountStateProof holds the Cosmos store proofs of the fields of an account.
+// The account proof is the x/auth account, holding the nonce. The balance is
+// proven with the x/bank balance of the EVM coin, and the x/precisebank
+// fractional balance when the EVM coin has less than 18 decimals.
+type AccountStateProof struct {
+	Account           StoreProof  `json:"account"`
+	CodeHash          StoreProof  `json:"codeHash"`
+	Balance           StoreProof  `json:"balance"`
+	FractionalBalance *StoreProof `json:"fractionalBalance,omitempty"`
+}
+
+// StoreProof is the proof of a key of a Cosmos module store against the app
+// hash. The proof holds the hex encoded data of the IAVL commitment proof of
+// the key against the store root, followed by the simple merkle commitment
+// proof of the store root against the app hash. An empty value is proven to be
+// absent from the store.
+type StoreProof struct {
+	StoreKey string        `json:"storeKey"`
+	Key      hexutil.Bytes `json:"key"`
+	Value    hexutil.Bytes `json:"value"`
+	Proof    []string      `json:"proof"`
 }
 
 // StorageResult defines the format for storage proof return
@@ -31,43 +62,36 @@ type StorageResult struct {
 
 // RPCTransaction represents a transaction that will serialize to the RPC representation of a transaction
 type RPCTransaction struct {
-	BlockHash        *common.Hash         `json:"blockHash"`
-	BlockNumber      *hexutil.Big         `json:"blockNumber"`
-	From             common.Address       `json:"from"`
-	Gas              hexutil.Uint64       `json:"gas"`
-	GasPrice         *hexutil.Big         `json:"gasPrice"`
-	GasFeeCap        *hexutil.Big         `json:"maxFeePerGas,omitempty"`
-	GasTipCap        *hexutil.Big         `json:"maxPriorityFeePerGas,omitempty"`
-	Hash             common.Hash          `json:"hash"`
-	Input            hexutil.Bytes        `json:"input"`
-	Nonce            hexutil.Uint64       `json:"nonce"`
-	To               *common.Address      `json:"to"`
-	TransactionIndex *hexutil.Uint64      `json:"transactionIndex"`
-	Value            *hexutil.Big         `json:"value"`
-	Type             hexutil.Uint64       `json:"type"`
-	Accesses         *ethtypes.AccessList `json:"accessList,omitempty"`
-	ChainID          *hexutil.Big         `json:"chainId,omitempty"`
-	V                *hexutil.Big         `json:"v"`
-	R                *hexutil.Big         `json:"r"`
-	S                *hexutil.Big         `json:"s"`
+	BlockHash           *common.Hash                    `json:"blockHash"`
+	BlockNumber         *hexutil.Big                    `json:"blockNumber"`
+	From                common.Address                  `json:"from"`
+	Gas                 hexutil.Uint64                  `json:"gas"`
+	GasPrice            *hexutil.Big                    `json:"gasPrice"`
+	GasFeeCap           *hexutil.Big                    `json:"maxFeePerGas,omitempty"`
+	GasTipCap           *hexutil.Big                    `json:"maxPriorityFeePerGas,omitempty"`
+	Hash                common.Hash                     `json:"hash"`
+	Input               hexutil.Bytes                   `json:"input"`
+	Nonce               hexutil.Uint64                  `json:"nonce"`
+	To                  *common.Address                 `json:"to"`
+	TransactionIndex    *hexutil.Uint64                 `json:"transactionIndex"`
+	Value               *hexutil.Big                    `json:"value"`
+	Type                hexutil.Uint64                  `json:"type"`
+	Accesses            *ethtypes.AccessList            `json:"accessList,omitempty"`
+	ChainID             *hexutil.Big                    `json:"chainId,omitempty"`
+	BlobGasFeeCap       *hexutil.Big                    `json:"maxFeePerBlobGas,omitempty"`
+	BlobVersionedHashes []common.Hash                   `json:"blobVersionedHashes,omitempty"`
+	AuthorizationList   []ethtypes.SetCodeAuthorization `json:"authorizationList,omitempty"`
+	V                   *hexutil.Big                    `json:"v"`
+	R                   *hexutil.Big                    `json:"r"`
+	S                   *hexutil.Big                    `json:"s"`
 }
 
 // StateOverride is the collection of overridden accounts.
-type StateOverride map[common.Address]OverrideAccount
+type StateOverride = evmtypes.StateOverride
 
 // OverrideAccount indicates the overriding fields of account during the execution of
 // a message call.
-// Note, state and stateDiff can't be specified at the same time. If state is
-// set, message execution will only use the data in the given state. Otherwise
-// if statDiff is set, all diff will be applied first and then execute the call
-// message.
-type OverrideAccount struct {
-	Nonce     *hexutil.Uint64              `json:"nonce"`
-	Code      *hexutil.Bytes               `json:"code"`
-	Balance   **hexutil.Big                `json:"balance"`
-	State     *map[common.Hash]common.Hash `json:"state"`
-	StateDiff *map[common.Hash]common.Hash `json:"stateDiff"`
-}
+type OverrideAccount = evmtypes.OverrideAccount
 
 type FeeHistoryResult struct {
 	OldestBlock  *hexutil.Big     `json:"oldestBlock"`
@@ -87,3 +111,32 @@ type OneFeeHistory struct {
 	Reward               []*big.Int // each element of the array will have the tip provided to miners for the percentile given
 	GasUsedRatio         float64    // the ratio of gas used to the gas limit for each block
 }
+
+// Relations of an address to the transactions returned by
+// cosmos_getTransactionsByAddress
+const (
+	AddressTxTypeAll      = "all"
+	AddressTxTypeSent     = "sent"
+	AddressTxTypeReceived = "received"
+	AddressTxTypeCreated  = "created"
+)
+
+// TransactionsByAddressArgs are the arguments of cosmos_getTransactionsByAddress.
+type TransactionsByAddressArgs struct {
+	// Type selects the transactions sent, received or creating the address,
+	// all of them if empty
+	Type string `json:"type"`
+	// PageKey is the position of the first transaction of the page, as
+	// returned by the previous page
+	PageKey hexutil.Bytes `json:"pageKey"`
+	// PageSize is the maximum number of transactions of the page
+	PageSize hexutil.Uint64 `json:"pageSize"`
+	// Reverse returns the most recent transactions first
+	Reverse bool `json:"reverse"`
+}
+
+// TransactionsByAddressResult is a page of the transactions of an address.
+type TransactionsByAddressResult struct {
+	Transactions []*RPCTransaction `json:"transactions"`
+	NextPageKey  hexutil.Bytes     `json:"nextPageKey"`
+}
@@ -212,7 +212,7 @@ func NewRPCTransaction(
 		al := tx.AccessList()
 		result.Accesses = &al
 		result.ChainID = (*hexutil.Big)(tx.ChainId())
-	case ethtypes.DynamicFeeTxType:
+	case ethtypes.DynamicFeeTxType, ethtypes.BlobTxType, ethtypes.SetCodeTxType:
 		al := tx.AccessList()
 		result.Accesses = &al
 		result.ChainID = (*hexutil.Big)(tx.ChainId())
@@ -229,6 +229,13 @@ func NewRPCTransaction(
 		} else {
 			result.GasPrice = (*hexutil.Big)(tx.GasFeeCap())
 		}
+		if tx.Type() == ethtypes.BlobTxType {
+			result.BlobGasFeeCap = (*hexutil.Big)(tx.BlobGasFeeCap())
+			result.BlobVersionedHashes = tx.BlobHashes()
+		}
+		if tx.Type() == ethtypes.SetCodeTxType {
+			result.AuthorizationList = tx.SetCodeAuthorizations()
+		}
 	}
 
 	return result, nil
@@ -11,6 +11,7 @@ import (
 	"net/http"
 	"net/url"
 	"regexp"
+	"slices"
 	"strconv"
 	"strings"
 	"sync"
@@ -84,7 +85,15 @@ type websocketsServer struct {
 	logger         log.Logger
 }
 
-func NewWebsocketsServer(clientCtx client.Context, logger log.Logger, tmWSClient *rpcclient.WSClient, cfg *config.Config) WebsocketsServer {
+// NewWebsocketsServer creates the websocket server, serving the syncing
+// subscriptions from the sync tracker shared with the JSON-RPC backends.
+func NewWebsocketsServer(
+	clientCtx client.Context,
+	logger log.Logger,
+	tmWSClient *rpcclient.WSClient,
+	syncTracker *types.SyncTracker,
+	cfg *config.Config,
+) WebsocketsServer {
 	logger = logger.With("api", "websocket-server")
 	return &websocketsServer{
 		rpcAddr:        cfg.JSONRPC.Address,
@@ -92,7 +101,7 @@ func NewWebsocketsServer(clientCtx client.Context, logger log.Logger, tmWSClient
 		certFile:       cfg.TLS.CertificatePath,
 		keyFile:        cfg.TLS.KeyPath,
 		allowedOrigins: cfg.JSONRPC.WSOrigins,
-		api:            newPubSubAPI(clientCtx, logger, tmWSClient),
+		api:            newPubSubAPI(clientCtx, logger, tmWSClient, syncTracker),
 		logger:         logger,
 	}
 }
@@ -418,18 +427,25 @@ func (s *websocketsServer) tcpGetAndSendResponse(wsConn *wsConn, mb []byte) erro
 
 // pubSubAPI is the eth_ prefixed set of APIs in the Web3 JSON-RPC spec
 type pubSubAPI struct {
-	events    *rpcfilters.EventSystem
-	logger    log.Logger
-	clientCtx client.Context
+	events      *rpcfilters.EventSystem
+	syncTracker *types.SyncTracker
+	logger      log.Logger
+	clientCtx   client.Context
 }
 
 // newPubSubAPI creates an instance of the ethereum PubSub API.
-func newPubSubAPI(clientCtx client.Context, logger log.Logger, tmWSClient *rpcclient.WSClient) *pubSubAPI {
+func newPubSubAPI(
+	clientCtx client.Context,
+	logger log.Logger,
+	tmWSClient *rpcclient.WSClient,
+	syncTracker *types.SyncTracker,
+) *pubSubAPI {
 	logger = logger.With("module", "websocket-client")
 	return &pubSubAPI{
-		events:    rpcfilters.NewEventSystem(logger, tmWSClient),
-		logger:    logger,
-		clientCtx: clientCtx,
+		events:      rpcfilters.NewEventSystem(logger, tmWSClient),
+		syncTracker: syncTracker,
+		logger:      logger,
+		clientCtx:   clientCtx,
 	}
 }
 
@@ -449,7 +465,12 @@ func (api *pubSubAPI) subscribe(wsConn *wsConn, subID rpc.ID, params []interface
 		}
 		return api.subscribeLogs(wsConn, subID, nil)
 	case "newPendingTransactions":
-		return api.subscribePendingTransactions(wsConn, subID)
+		crit, err := parsePendingTxsCriteria(params[1:])
+		if err != nil {
+			api.logger.Debug("invalid pending transactions criteria", "error", err.Error())
+			return nil, err
+		}
+		return api.subscribePendingTransactions(wsConn, subID, crit)
 	case "syncing":
 		return api.subscribeSyncing(wsConn, subID)
 	default:
@@ -697,7 +718,79 @@ func (api *pubSubAPI) subscribeLogs(wsConn *wsConn, subID rpc.ID, extra interfac
 	return unsubFn, nil
 }
 
-func (api *pubSubAPI) subscribePendingTransactions(wsConn *wsConn, subID rpc.ID) (pubsub.UnsubscribeFunc, error) {
+// pendingTxsCriteria are the options of a pending transactions subscription.
+type pendingTxsCriteria struct {
+	// FullTx notifies the full transactions instead of their hashes
+	FullTx bool
+	// From filters the transactions sent by one of the addresses, if any
+	From []common.Address
+	// To filters the transactions sent to one of the addresses, if any
+	To []common.Address
+}
+
+// parsePendingTxsCriteria parses the parameters of a pending transactions
+// subscription: the geth full transactions flag and/or a filter object with
+// "from" and "to" addresses, e.g. [true, {"from": "0x...", "to": ["0x..."]}].
+func parsePendingTxsCriteria(params []interface{}) (pendingTxsCriteria, error) {
+	var crit pendingTxsCriteria
+	for _, param := range params {
+		switch param := param.(type) {
+		case nil:
+			continue
+		case bool:
+			crit.FullTx = param
+		case map[string]interface{}:
+			var err error
+			if crit.From, err = parseAddresses(param["from"]); err != nil {
+				return crit, errors.Wrap(err, "invalid from")
+			}
+			if crit.To, err = parseAddresses(param["to"]); err != nil {
+				return crit, errors.Wrap(err, "invalid to")
+			}
+		default:
+			return crit, errors.Errorf("invalid pending transactions parameter type %T", param)
+		}
+	}
+	return crit, nil
+}
+
+// parseAddresses parses a single address or an array of addresses.
+func parseAddresses(param interface{}) ([]common.Address, error) {
+	switch param := param.(type) {
+	case nil:
+		return nil, nil
+	case string:
+		if !common.IsHexAddress(param) {
+			return nil, errors.Errorf("invalid address %s", param)
+		}
+		return []common.Address{common.HexToAddress(param)}, nil
+	case []interface{}:
+		addresses := make([]common.Address, 0, len(param))
+		for _, addr := range param {
+			address, ok := addr.(string)
+			if !ok || !common.IsHexAddress(address) {
+				return nil, errors.Errorf("invalid address %v", addr)
+			}
+			addresses = append(addresses, common.HexToAddress(address))
+		}
+		return addresses, nil
+	default:
+		return nil, errors.New("must be address or array of addresses")
+	}
+}
+
+// matches returns true if the transaction passes the from and to filters.
+func (crit pendingTxsCriteria) matches(tx *types.RPCTransaction) bool {
+	if len(crit.From) > 0 && !slices.Contains(crit.From, tx.From) {
+		return false
+	}
+	if len(crit.To) > 0 && (tx.To == nil || !slices.Contains(crit.To, *tx.To)) {
+		return false
+	}
+	return true
+}
+
+func (api *pubSubAPI) subscribePendingTransactions(wsConn *wsConn, subID rpc.ID, crit pendingTxsCriteria) (pubsub.UnsubscribeFunc, error) {
 	sub, unsubFn, err := api.events.SubscribePendingTxs()
 	if err != nil {
 		return nil, errors.Wrap(err, "error creating block filter: %s")
@@ -708,7 +801,11 @@ func (api *pubSubAPI) subscribePendingTransactions(wsConn *wsConn, subID rpc.ID)
 		errCh := sub.Err()
 		for {
 			select {
-			case ev := <-txsCh:
+			case ev, ok := <-txsCh:
+				if !ok {
+					return
+				}
+
 				data, ok := ev.Data.(cmttypes.EventDataTx)
 				if !ok {
 					api.logger.Debug("event data type mismatch", "type", fmt.Sprintf("%T", ev.Data))
@@ -722,13 +819,27 @@ func (api *pubSubAPI) subscribePendingTransactions(wsConn *wsConn, subID rpc.ID)
 				}
 
 				for _, ethTx := range ethTxs {
+					rpcTx, err := types.NewRPCTransaction(ethTx, common.Hash{}, 0, 0, nil, ethTx.AsTransaction().ChainId())
+					if err != nil {
+						api.logger.Debug("failed to build pending transaction", "hash", ethTx.Hash, "error", err.Error())
+						continue
+					}
+					if !crit.matches(rpcTx) {
+						continue
+					}
+
+					var result interface{} = rpcTx.Hash
+					if crit.FullTx {
+						result = rpcTx
+					}
+
 					// write to ws conn
 					res := &SubscriptionNotification{
 						Jsonrpc: "2.0",
 						Method:  "eth_subscription",
 						Params: &SubscriptionResult{
 							Subscription: subID,
-							Result:       ethTx.Hash,
+							Result:       result,
 						},
 					}
 
@@ -755,8 +866,44 @@ func (api *pubSubAPI) subscribePendingTransactions(wsConn *wsConn, subID rpc.ID)
 	return unsubFn, nil
 }
 
-func (api *pubSubAPI) subscribeSyncing(_ *wsConn, _ rpc.ID) (pubsub.UnsubscribeFunc, error) {
-	return nil, errors.New("syncing subscription is not implemented")
+// subscribeSyncing notifies the sync progress of the node when it changes,
+// starting with the current one. The progress is polled by the sync tracker
+// shared by all the subscriptions, as CometBFT doesn't emit events while
+// syncing.
+func (api *pubSubAPI) subscribeSyncing(wsConn *wsConn, subID rpc.ID) (pubsub.UnsubscribeFunc, error) {
+	progressCh, unsubFn := api.syncTracker.Subscribe()
+
+	go func() {
+		for progress := range progressCh {
+			var result interface{} = false
+			if progress != nil {
+				result = &types.SyncingResult{Syncing: true, Status: progress}
+			}
+
+			// write to ws conn
+			res := &SubscriptionNotification{
+				Jsonrpc: "2.0",
+				Method:  "eth_subscription",
+				Params: &SubscriptionResult{
+					Subscription: subID,
+					Result:       result,
+				},
+			}
+
+			if err := wsConn.WriteJSON(res); err != nil {
+				api.logger.Debug("error writing sync progress, will drop peer", "error", err.Error())
+
+				try(func() {
+					if err != websocket.ErrCloseSent {
+						_ = wsConn.Close() // #nosec G703
+					}
+				}, api.logger, "closing websocket peer sub")
+				return
+			}
+		}
+	}()
+
+	return unsubFn, nil
 }
 
 // copy from github.com/ethereum/go-ethereum/rpc/json.go
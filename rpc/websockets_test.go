@@ -7,11 +7,13 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/gorilla/websocket"
 	"github.com/stretchr/testify/require"
 
 	rpcclient "github.com/cometbft/cometbft/rpc/jsonrpc/client"
 
+	"github.com/cosmos/evm/rpc/types"
 	"github.com/cosmos/evm/server/config"
 
 	"cosmossdk.io/log"
@@ -32,7 +34,7 @@ func newTestWebsocketServer() *websocketsServer {
 		wsAddr:         cfg.JSONRPC.WsAddress,
 		certFile:       cfg.TLS.CertificatePath,
 		keyFile:        cfg.TLS.KeyPath,
-		api:            newPubSubAPI(client.Context{}, log.NewNopLogger(), &rpcclient.WSClient{}),
+		api:            newPubSubAPI(client.Context{}, log.NewNopLogger(), &rpcclient.WSClient{}, types.NewSyncTracker(nil, log.NewNopLogger(), types.SyncPollInterval)),
 		logger:         log.NewNopLogger(),
 		allowedOrigins: []string{"*"},
 	}
@@ -215,3 +217,89 @@ func TestSanitizeOriginForLogging(t *testing.T) {
 		})
 	}
 }
+
+func TestParsePendingTxsCriteria(t *testing.T) {
+	addr1 := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	addr2 := common.HexToAddress("0x2000000000000000000000000000000000000002")
+
+	testCases := []struct {
+		name    string
+		params  []interface{}
+		expCrit pendingTxsCriteria
+		expPass bool
+	}{
+		{
+			"no params",
+			nil,
+			pendingTxsCriteria{},
+			true,
+		},
+		{
+			"full tx flag",
+			[]interface{}{true},
+			pendingTxsCriteria{FullTx: true},
+			true,
+		},
+		{
+			"full tx flag and filters",
+			[]interface{}{true, map[string]interface{}{
+				"from": addr1.Hex(),
+				"to":   []interface{}{addr1.Hex(), addr2.Hex()},
+			}},
+			pendingTxsCriteria{FullTx: true, From: []common.Address{addr1}, To: []common.Address{addr1, addr2}},
+			true,
+		},
+		{
+			"filters only",
+			[]interface{}{map[string]interface{}{"to": addr2.Hex()}},
+			pendingTxsCriteria{To: []common.Address{addr2}},
+			true,
+		},
+		{
+			"invalid from address",
+			[]interface{}{map[string]interface{}{"from": "0x1234"}},
+			pendingTxsCriteria{},
+			false,
+		},
+		{
+			"invalid to addresses",
+			[]interface{}{map[string]interface{}{"to": []interface{}{addr1.Hex(), 1}}},
+			pendingTxsCriteria{},
+			false,
+		},
+		{
+			"invalid param type",
+			[]interface{}{"full"},
+			pendingTxsCriteria{},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			crit, err := parsePendingTxsCriteria(tc.params)
+			if !tc.expPass {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expCrit, crit)
+		})
+	}
+}
+
+func TestPendingTxsCriteriaMatches(t *testing.T) {
+	addr1 := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	addr2 := common.HexToAddress("0x2000000000000000000000000000000000000002")
+
+	tx := &types.RPCTransaction{From: addr1, To: &addr2}
+	creation := &types.RPCTransaction{From: addr1}
+
+	require.True(t, pendingTxsCriteria{}.matches(tx))
+	require.True(t, pendingTxsCriteria{From: []common.Address{addr1}}.matches(tx))
+	require.False(t, pendingTxsCriteria{From: []common.Address{addr2}}.matches(tx))
+	require.True(t, pendingTxsCriteria{To: []common.Address{addr1, addr2}}.matches(tx))
+	require.False(t, pendingTxsCriteria{From: []common.Address{addr1}, To: []common.Address{addr1}}.matches(tx))
+	require.True(t, pendingTxsCriteria{From: []common.Address{addr1}}.matches(creation))
+	require.False(t, pendingTxsCriteria{To: []common.Address{addr2}}.matches(creation))
+}
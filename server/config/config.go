@@ -66,6 +66,15 @@ const (
 	// DefaultEVMChainID is the default EVM Chain ID if one is not provided
 	DefaultEVMChainID = 262144
 
+	// DefaultMempoolPriceBump is the default minimum price bump percentage to replace a transaction in the EVM mempool
+	DefaultMempoolPriceBump = 10
+
+	// DefaultMempoolMaxNonceGap is the default maximum nonce gap of a queued transaction in the EVM mempool
+	DefaultMempoolMaxNonceGap = 64
+
+	// DefaultEnableFeeGrant is the default value for EnableFeeGrant
+	DefaultEnableFeeGrant = false
+
 	// DefaultGasCap is the default cap on gas that can be used in eth_call/estimateGas
 	DefaultGasCap uint64 = 25_000_000
 
@@ -81,7 +90,7 @@ const (
 	// DefaultLogsCap is the default cap of results returned from single 'eth_getLogs' query
 	DefaultLogsCap int32 = 10000
 
-	// DefaultBlockRangeCap is the default cap of block range allowed for 'eth_getLogs' query
+	// DefaultBlockRangeCap is the default cap of block range allowed for 'eth_getLogs' and 'trace_filter' queries
 	DefaultBlockRangeCap int32 = 10000
 
 	// DefaultEVMTimeout is the default timeout for eth_call
@@ -141,6 +150,19 @@ type EVMConfig struct {
 	EnablePreimageRecording bool `mapstructure:"cache-preimage"`
 	// EVMChainID defines the EIP-155 replay-protection chain ID.
 	EVMChainID uint64 `mapstructure:"evm-chain-id"`
+	// MempoolPriceBump defines the minimum price bump percentage to replace an
+	// already existing transaction with the same nonce in the EVM mempool.
+	MempoolPriceBump uint64 `mapstructure:"mempool-price-bump"`
+	// MempoolMaxNonceGap defines the maximum distance between the nonce of a
+	// queued transaction and the next executable nonce of its sender in the EVM mempool.
+	MempoolMaxNonceGap uint64 `mapstructure:"mempool-max-nonce-gap"`
+	// EnableFeeGrant accepts a fee granter on the Cosmos transactions wrapping
+	// the EVM transactions, which then pays their fees. The fee granter is not
+	// covered by the signature of the EVM transaction, so anyone relaying a
+	// signed EVM transaction can wrap it with the sender as grantee: only the
+	// allowances restricted to messages including MsgEthereumTx are spent. It
+	// must have the same value on all the validators.
+	EnableFeeGrant bool `mapstructure:"enable-fee-grant"`
 }
 
 // JSONRPCConfig defines configuration for the EVM RPC server.
@@ -167,7 +189,7 @@ type JSONRPCConfig struct {
 	Enable bool `mapstructure:"enable"`
 	// LogsCap defines the max number of results can be returned from single `eth_getLogs` query.
 	LogsCap int32 `mapstructure:"logs-cap"`
-	// BlockRangeCap defines the max block range allowed for `eth_getLogs` query.
+	// BlockRangeCap defines the max block range allowed for `eth_getLogs` and `trace_filter` queries.
 	BlockRangeCap int32 `mapstructure:"block-range-cap"`
 	// HTTPTimeout is the read/write timeout of http json-rpc server.
 	HTTPTimeout time.Duration `mapstructure:"http-timeout"`
@@ -185,6 +207,9 @@ type JSONRPCConfig struct {
 	MaxOpenConnections int `mapstructure:"max-open-connections"`
 	// EnableIndexer defines if enable the custom indexer service.
 	EnableIndexer bool `mapstructure:"enable-indexer"`
+	// EnableLogIndexer defines if enable the log index, maintained by the
+	// indexer service, used to answer the ranged log queries.
+	EnableLogIndexer bool `mapstructure:"enable-log-indexer"`
 	// MetricsAddress defines the metrics server to listen on
 	MetricsAddress string `mapstructure:"metrics-address"`
 	// FixRevertGasRefundHeight defines the upgrade height for fix of revert gas refund logic when transaction reverted
@@ -210,6 +235,9 @@ func DefaultEVMConfig() *EVMConfig {
 		MaxTxGasWanted:          DefaultMaxTxGasWanted,
 		EVMChainID:              DefaultEVMChainID,
 		EnablePreimageRecording: DefaultEnablePreimageRecording,
+		MempoolPriceBump:        DefaultMempoolPriceBump,
+		MempoolMaxNonceGap:      DefaultMempoolMaxNonceGap,
+		EnableFeeGrant:          DefaultEnableFeeGrant,
 	}
 }
 
@@ -229,7 +257,7 @@ func GetDefaultAPINamespaces() []string {
 
 // GetAPINamespaces returns the all the available JSON-RPC API namespaces.
 func GetAPINamespaces() []string {
-	return []string{"web3", "eth", "personal", "net", "txpool", "debug", "miner"}
+	return []string{"web3", "eth", "personal", "net", "txpool", "debug", "miner", "trace", "ots", "cosmos"}
 }
 
 // GetDefaultWSOrigins returns the default WebSocket origins.
@@ -259,6 +287,7 @@ func DefaultJSONRPCConfig() *JSONRPCConfig {
 		BatchResponseMaxSize:     DefaultBatchResponseMaxSize,
 		MaxOpenConnections:       DefaultMaxOpenConnections,
 		EnableIndexer:            false,
+		EnableLogIndexer:         false,
 		MetricsAddress:           DefaultJSONRPCMetricsAddress,
 		FixRevertGasRefundHeight: DefaultFixRevertGasRefundHeight,
 		WSOrigins:                GetDefaultWSOrigins(),
@@ -312,6 +341,10 @@ func (c JSONRPCConfig) Validate() error {
 		return errors.New("JSON-RPC batch response max size cannot be negative")
 	}
 
+	if c.EnableLogIndexer && !c.EnableIndexer {
+		return errors.New("JSON-RPC log indexer cannot be enabled without the custom indexer")
+	}
+
 	// check for duplicates
 	seenAPIs := make(map[string]bool)
 	for _, api := range c.API {
@@ -22,6 +22,22 @@ cache-preimage = {{ .EVM.EnablePreimageRecording }}
 # EVMChainID is the EIP-155 compatible replay protection chain ID. This is separate from the Cosmos chain ID.
 evm-chain-id = {{ .EVM.EVMChainID }}
 
+# MempoolPriceBump is the minimum price bump percentage to replace an already existing transaction
+# with the same nonce in the EVM mempool. The EVM mempool is used when the app-side mempool is
+# enabled ('mempool.max-txs' >= 0).
+mempool-price-bump = {{ .EVM.MempoolPriceBump }}
+
+# MempoolMaxNonceGap is the maximum distance between the nonce of a queued (nonce-gapped) transaction
+# and the next executable nonce of its sender in the EVM mempool. Set to 0 to disable the limit.
+mempool-max-nonce-gap = {{ .EVM.MempoolMaxNonceGap }}
+
+# EnableFeeGrant accepts a fee granter on the Cosmos transactions wrapping EVM transactions, which
+# then pays their fees from the allowances granted to their sender. The fee granter is not covered
+# by the signature of the EVM transaction: anyone relaying a signed EVM transaction can wrap it with
+# a fee granter, so only the allowances restricted to messages including MsgEthereumTx can be spent.
+# It changes the execution of the transactions, so all the validators must use the same value.
+enable-fee-grant = {{ .EVM.EnableFeeGrant }}
+
 ###############################################################################
 ###                           JSON RPC Configuration                        ###
 ###############################################################################
@@ -66,7 +82,7 @@ feehistory-cap = {{ .JSONRPC.FeeHistoryCap }}
 # LogsCap defines the max number of results can be returned from single 'eth_getLogs' query.
 logs-cap = {{ .JSONRPC.LogsCap }}
 
-# BlockRangeCap defines the max block range allowed for 'eth_getLogs' query.
+# BlockRangeCap defines the max block range allowed for 'eth_getLogs' and 'trace_filter' queries.
 block-range-cap = {{ .JSONRPC.BlockRangeCap }}
 
 # HTTPTimeout is the read/write timeout of http json-rpc server.
@@ -86,6 +102,10 @@ max-open-connections = {{ .JSONRPC.MaxOpenConnections }}
 # EnableIndexer enables the custom transaction indexer for the EVM (ethereum transactions).
 enable-indexer = {{ .JSONRPC.EnableIndexer }}
 
+# EnableLogIndexer enables the index of the EVM logs by address and topics, maintained by the custom
+# indexer, so the cost of the ranged log queries grows with the number of matches. Requires enable-indexer.
+enable-log-indexer = {{ .JSONRPC.EnableLogIndexer }}
+
 # MetricsAddress defines the EVM Metrics server address to bind to. Pass --metrics in CLI to enable
 # Prometheus metrics path: /debug/metrics/prometheus
 metrics-address = "{{ .JSONRPC.MetricsAddress }}"
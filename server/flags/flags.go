@@ -54,6 +54,7 @@ const (
 	JSONRPCAllowUnprotectedTxs  = "json-rpc.allow-unprotected-txs"
 	JSONRPCMaxOpenConnections   = "json-rpc.max-open-connections"
 	JSONRPCEnableIndexer        = "json-rpc.enable-indexer"
+	JSONRPCEnableLogIndexer     = "json-rpc.enable-log-indexer"
 	JSONRPCBatchRequestLimit    = "json-rpc.batch-request-limit"
 	JSONRPCBatchResponseMaxSize = "json-rpc.batch-response-max-size"
 	JSONRPCEnableProfiling      = "json-rpc.enable-profiling"
@@ -70,6 +71,9 @@ const (
 	EVMMaxTxGasWanted          = "evm.max-tx-gas-wanted"
 	EVMEnablePreimageRecording = "evm.cache-preimage"
 	EVMChainID                 = "evm.evm-chain-id"
+	EVMMempoolPriceBump        = "evm.mempool-price-bump"
+	EVMMempoolMaxNonceGap      = "evm.mempool-max-nonce-gap"
+	EVMEnableFeeGrant          = "evm.enable-fee-grant"
 )
 
 // TLS flags
@@ -18,11 +18,12 @@ import (
 // NewIndexTxCmd creates a new Cobra command to index historical Ethereum transactions.
 func NewIndexTxCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "index-eth-tx [backward|forward]",
+		Use:   "index-eth-tx [backward|forward|reindex]",
 		Short: "Index historical eth txs",
 		Long: `Index historical eth txs, it only support two traverse direction to avoid creating gaps in the indexer db if using arbitrary block ranges:
 		- backward: index the blocks from the first indexed block to the earliest block in the chain, if indexer db is empty, start from the latest block.
 		- forward: index the blocks from the latest indexed block to latest block in the chain.
+		- reindex: index again the blocks indexed before the address index was added, from the start of the address index to the first indexed block.
 
 		When start the node, the indexer start from the latest indexed block to avoid creating gap.
         Backward mode should be used most of the time, so the latest indexed block is always up-to-date.
@@ -36,8 +37,8 @@ func NewIndexTxCmd() *cobra.Command {
 			}
 
 			direction := args[0]
-			if direction != "backward" && direction != "forward" {
-				return fmt.Errorf("unknown index direction, expect: backward|forward, got: %s", direction)
+			if direction != "backward" && direction != "forward" && direction != "reindex" {
+				return fmt.Errorf("unknown index direction, expect: backward|forward|reindex, got: %s", direction)
 			}
 
 			cfg := serverCtx.Config
@@ -110,6 +111,26 @@ func NewIndexTxCmd() *cobra.Command {
 						return err
 					}
 				}
+			case "reindex":
+				first, err := idxer.FirstIndexedBlock()
+				if err != nil {
+					return err
+				}
+				start, err := idxer.AddressIndexStart()
+				if err != nil {
+					return err
+				}
+				if start == -1 {
+					// nothing was indexed with the address index yet
+					start = blockStore.Height() + 1
+				}
+				// the address index is only extended by the block right
+				// before its start, so the blocks are indexed backward
+				for i := start - 1; first != -1 && i >= first; i-- {
+					if err := indexBlock(i); err != nil {
+						return err
+					}
+				}
 			default:
 				return fmt.Errorf("unknown direction %s", args[0])
 			}
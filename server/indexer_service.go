@@ -18,7 +18,8 @@ const (
 	NewBlockWaitTimeout = 60 * time.Second
 )
 
-// EVMIndexerService indexes transactions for json-rpc service.
+// EVMIndexerService indexes transactions for json-rpc service, and their logs
+// if the log index is enabled.
 type EVMIndexerService struct {
 	service.BaseService
 
@@ -82,6 +83,19 @@ func (eis *EVMIndexerService) OnStart() error {
 		lastBlock = latestBlock
 	}
 
+	logIdxr := eis.txIdxr.LogIndexer()
+	if logIdxr != nil {
+		// resume from the indexer that is behind, indexing a block again is a no-op
+		lastLogBlock, err := logIdxr.LastIndexedBlock()
+		if err != nil {
+			return err
+		}
+		if lastLogBlock == -1 {
+			lastLogBlock = latestBlock
+		}
+		lastBlock = min(lastBlock, lastLogBlock)
+	}
+
 	// blockErr indicates an error fetching an expected block or its results
 	var blockErr error
 
@@ -118,6 +132,11 @@ func (eis *EVMIndexerService) OnStart() error {
 			if err := eis.txIdxr.IndexBlock(block.Block, blockResult.TxsResults); err != nil {
 				eis.Logger.Error("failed to index block", "height", i, "err", err)
 			}
+			if logIdxr != nil {
+				if err := logIdxr.IndexBlock(block.Block, blockResult.TxsResults); err != nil {
+					eis.Logger.Error("failed to index block logs", "height", i, "err", err)
+				}
+			}
 			lastBlock = blockResult.Height
 		}
 	}
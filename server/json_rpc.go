@@ -10,7 +10,9 @@ import (
 	"github.com/rs/cors"
 	"golang.org/x/sync/errgroup"
 
+	evmmempool "github.com/cosmos/evm/mempool"
 	"github.com/cosmos/evm/rpc"
+	rpctypes "github.com/cosmos/evm/rpc/types"
 	serverconfig "github.com/cosmos/evm/server/config"
 	cosmosevmtypes "github.com/cosmos/evm/types"
 
@@ -27,6 +29,7 @@ func StartJSONRPC(
 	tmRPCAddr, tmEndpoint string,
 	config *serverconfig.Config,
 	indexer cosmosevmtypes.EVMTxIndexer,
+	mempool *evmmempool.EVMMempool,
 ) (*http.Server, error) {
 	logger := srvCtx.Logger.With("module", "geth")
 	tmWsClient := ConnectTmWS(tmRPCAddr, tmEndpoint, logger)
@@ -41,7 +44,9 @@ func StartJSONRPC(
 	allowUnprotectedTxs := config.JSONRPC.AllowUnprotectedTxs
 	rpcAPIArr := config.JSONRPC.API
 
-	apis := rpc.GetRPCAPIs(srvCtx, clientCtx, tmWsClient, allowUnprotectedTxs, indexer, rpcAPIArr)
+	// the sync progress is polled once for all the JSON-RPC and websocket requests
+	syncTracker := rpctypes.NewSyncTracker(clientCtx.Client, logger, rpctypes.SyncPollInterval)
+	apis := rpc.GetRPCAPIs(srvCtx, clientCtx, tmWsClient, allowUnprotectedTxs, indexer, mempool, syncTracker, rpcAPIArr)
 
 	for _, api := range apis {
 		if err := rpcServer.RegisterName(api.Namespace, api.Service); err != nil {
@@ -111,7 +116,7 @@ func StartJSONRPC(
 
 	// allocate separate WS connection to Tendermint
 	tmWsClient = ConnectTmWS(tmRPCAddr, tmEndpoint, logger)
-	wsSrv := rpc.NewWebsocketsServer(clientCtx, logger, tmWsClient, config)
+	wsSrv := rpc.NewWebsocketsServer(clientCtx, logger, tmWsClient, syncTracker, config)
 	wsSrv.Start()
 	return httpSrv, nil
 }
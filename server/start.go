@@ -197,6 +197,7 @@ which accepts a path for the resulting pprof file.
 	cmd.Flags().Int32(srvflags.JSONRPCBlockRangeCap, cosmosevmserverconfig.DefaultBlockRangeCap, "Sets the max block range allowed for `eth_getLogs` query")
 	cmd.Flags().Int(srvflags.JSONRPCMaxOpenConnections, cosmosevmserverconfig.DefaultMaxOpenConnections, "Sets the maximum number of simultaneous connections for the server listener") //nolint:lll
 	cmd.Flags().Bool(srvflags.JSONRPCEnableIndexer, false, "Enable the custom tx indexer for json-rpc")
+	cmd.Flags().Bool(srvflags.JSONRPCEnableLogIndexer, false, "Enable the log index of the custom indexer for json-rpc eth_getLogs queries")
 	cmd.Flags().Bool(srvflags.JSONRPCEnableMetrics, false, "Define if EVM rpc metrics server should be enabled")
 	cmd.Flags().Bool(srvflags.JSONRPCEnableProfiling, false, "Enables the profiling in the debug namespace")
 
@@ -204,6 +205,9 @@ which accepts a path for the resulting pprof file.
 	cmd.Flags().Uint64(srvflags.EVMMaxTxGasWanted, cosmosevmserverconfig.DefaultMaxTxGasWanted, "the gas wanted for each eth tx returned in ante handler in check tx mode")                                 //nolint:lll
 	cmd.Flags().Bool(srvflags.EVMEnablePreimageRecording, cosmosevmserverconfig.DefaultEnablePreimageRecording, "Enables tracking of SHA3 preimages in the EVM (not implemented yet)")                      //nolint:lll
 	cmd.Flags().Uint64(srvflags.EVMChainID, cosmosevmserverconfig.DefaultEVMChainID, "the EIP-155 compatible replay protection chain ID")
+	cmd.Flags().Uint64(srvflags.EVMMempoolPriceBump, cosmosevmserverconfig.DefaultMempoolPriceBump, "the minimum price bump percentage to replace a transaction with the same nonce in the EVM mempool") //nolint:lll
+	cmd.Flags().Uint64(srvflags.EVMMempoolMaxNonceGap, cosmosevmserverconfig.DefaultMempoolMaxNonceGap, "the maximum nonce gap of a queued transaction in the EVM mempool (0 to disable)")               //nolint:lll
+	cmd.Flags().Bool(srvflags.EVMEnableFeeGrant, cosmosevmserverconfig.DefaultEnableFeeGrant, "accept fee granters on the EVM txs, which are not signed by their senders")                               //nolint:lll
 
 	cmd.Flags().String(srvflags.TLSCertPath, "", "the cert.pem file path for the server TLS configuration")
 	cmd.Flags().String(srvflags.TLSKeyPath, "", "the key.pem file path for the server TLS configuration")
@@ -444,7 +448,11 @@ func startInProcess(svrCtx *server.Context, clientCtx client.Context, opts Start
 		}
 
 		idxLogger := svrCtx.Logger.With("indexer", "evm")
-		idxer = indexer.NewKVIndexer(idxDB, idxLogger, clientCtx)
+		kvIdxer := indexer.NewKVIndexer(idxDB, idxLogger, clientCtx)
+		if config.JSONRPC.EnableLogIndexer {
+			kvIdxer = kvIdxer.WithLogIndexer(indexer.NewKVLogIndexer(idxDB, idxLogger))
+		}
+		idxer = kvIdxer
 		indexerService := NewEVMIndexerService(idxer, clientCtx.Client.(rpcclient.Client))
 		indexerService.SetLogger(servercmtlog.CometLoggerWrapper{Logger: idxLogger})
 
@@ -476,7 +484,7 @@ func startInProcess(svrCtx *server.Context, clientCtx client.Context, opts Start
 
 	if config.JSONRPC.Enable {
 		cmtEndpoint := "/websocket"
-		_, err = StartJSONRPC(ctx, svrCtx, clientCtx, g, cmtEndpoint, cmtEndpoint, &config, idxer)
+		_, err = StartJSONRPC(ctx, svrCtx, clientCtx, g, cmtEndpoint, cmtEndpoint, &config, idxer, EVMMempool(app))
 		if err != nil {
 			return err
 		}
@@ -13,12 +13,14 @@ import (
 	tmcmd "github.com/cometbft/cometbft/cmd/cometbft/commands"
 	rpcclient "github.com/cometbft/cometbft/rpc/jsonrpc/client"
 
+	evmmempool "github.com/cosmos/evm/mempool"
 	"github.com/cosmos/evm/server/config"
 
 	"cosmossdk.io/log"
 
 	sdkserver "github.com/cosmos/cosmos-sdk/server"
 	"github.com/cosmos/cosmos-sdk/server/types"
+	sdkmempool "github.com/cosmos/cosmos-sdk/types/mempool"
 	"github.com/cosmos/cosmos-sdk/version"
 )
 
@@ -123,6 +125,17 @@ func MountGRPCWebServices(
 	}
 }
 
+// EVMMempool returns the EVM mempool used by the application, or nil if the
+// application doesn't use one.
+func EVMMempool(app types.Application) *evmmempool.EVMMempool {
+	withMempool, ok := app.(interface{ Mempool() sdkmempool.Mempool })
+	if !ok {
+		return nil
+	}
+	mempool, _ := withMempool.Mempool().(*evmmempool.EVMMempool)
+	return mempool
+}
+
 // Listen starts a net.Listener on the tcp network on the given address.
 // If there is a specified MaxOpenConnections in the config, it will also set the limitListener.
 func Listen(addr string, config *config.Config) (net.Listener, error) {
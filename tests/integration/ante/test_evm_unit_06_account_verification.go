@@ -244,6 +244,115 @@ func (s *EvmUnitAnteTestSuite) TestVerifyAccountBalance() {
 	}
 }
 
+func (s *EvmUnitAnteTestSuite) TestVerifyGrantedAccountBalance() {
+	// Setup
+	keyring := testkeyring.New(2)
+	unitNetwork := network.NewUnitTestNetwork(
+		s.create,
+		network.WithPreFundedAccounts(keyring.GetAllAccAddrs()...),
+		network.WithChainID(testconstants.ChainID{
+			ChainID:    s.ChainID,
+			EVMChainID: s.EvmChainID,
+		}),
+	)
+	grpcHandler := grpc.NewIntegrationHandler(unitNetwork)
+	txFactory := factory.New(unitNetwork, grpcHandler)
+	senderKey := keyring.GetKey(1)
+
+	testCases := []struct {
+		name                   string
+		expectedError          error
+		generateAccountAndArgs func() (*statedb.Account, evmtypes.EvmTxArgs)
+	}{
+		{
+			name:          "fail: sender is not EOA",
+			expectedError: errortypes.ErrInvalidType,
+			generateAccountAndArgs: func() (*statedb.Account, evmtypes.EvmTxArgs) {
+				statedbAccount := getDefaultStateDBAccount(unitNetwork, senderKey.Addr)
+				txArgs, err := txFactory.GenerateDefaultTxTypeArgs(senderKey.Addr, s.EthTxType)
+				s.Require().NoError(err)
+
+				statedbAccount.CodeHash = []byte("test")
+				return statedbAccount, txArgs
+			},
+		},
+		{
+			name:          "fail: sender balance is lower than the transaction value",
+			expectedError: errortypes.ErrInsufficientFunds,
+			generateAccountAndArgs: func() (*statedb.Account, evmtypes.EvmTxArgs) {
+				statedbAccount := getDefaultStateDBAccount(unitNetwork, senderKey.Addr)
+				txArgs, err := txFactory.GenerateDefaultTxTypeArgs(senderKey.Addr, s.EthTxType)
+				s.Require().NoError(err)
+
+				txArgs.Amount = new(big.Int).Add(statedbAccount.Balance.ToBig(), big.NewInt(1))
+				return statedbAccount, txArgs
+			},
+		},
+		{
+			name:          "fail: tx value is negative",
+			expectedError: errortypes.ErrInvalidCoins,
+			generateAccountAndArgs: func() (*statedb.Account, evmtypes.EvmTxArgs) {
+				statedbAccount := getDefaultStateDBAccount(unitNetwork, senderKey.Addr)
+				txArgs, err := txFactory.GenerateDefaultTxTypeArgs(senderKey.Addr, s.EthTxType)
+				s.Require().NoError(err)
+
+				txArgs.Amount = big.NewInt(-1)
+				return statedbAccount, txArgs
+			},
+		},
+		{
+			name:          "success: sender balance covers the transaction value but not the fees",
+			expectedError: nil,
+			generateAccountAndArgs: func() (*statedb.Account, evmtypes.EvmTxArgs) {
+				statedbAccount := getDefaultStateDBAccount(unitNetwork, senderKey.Addr)
+				txArgs, err := txFactory.GenerateDefaultTxTypeArgs(senderKey.Addr, s.EthTxType)
+				s.Require().NoError(err)
+
+				txArgs.Amount = statedbAccount.Balance.ToBig()
+				return statedbAccount, txArgs
+			},
+		},
+		{
+			name:          "success: account is created if its nil",
+			expectedError: nil,
+			generateAccountAndArgs: func() (*statedb.Account, evmtypes.EvmTxArgs) {
+				txArgs, err := txFactory.GenerateDefaultTxTypeArgs(senderKey.Addr, s.EthTxType)
+				s.Require().NoError(err)
+				return nil, txArgs
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(fmt.Sprintf("%v_%v_%v", evmtypes.GetTxTypeName(s.EthTxType), s.ChainID, tc.name), func() {
+			// Perform test logic
+			statedbAccount, txArgs := tc.generateAccountAndArgs()
+			txData, err := txArgs.ToTxData()
+			s.Require().NoError(err)
+
+			//  Function to be tested
+			err = evm.VerifyGrantedAccountBalance(
+				unitNetwork.GetContext(),
+				unitNetwork.App.GetAccountKeeper(),
+				statedbAccount,
+				senderKey.Addr,
+				txData,
+			)
+
+			if tc.expectedError != nil {
+				s.Require().Error(err)
+				s.Contains(err.Error(), tc.expectedError.Error())
+			} else {
+				s.Require().NoError(err)
+			}
+
+			// Clean block for next test
+			err = unitNetwork.NextBlock()
+			s.Require().NoError(err)
+		})
+	}
+}
+
 func getDefaultStateDBAccount(unitNetwork *network.UnitTestNetwork, addr common.Address) *statedb.Account {
 	statedb := unitNetwork.GetStateDB()
 	return statedb.Keeper().GetAccount(unitNetwork.GetContext(), addr)
@@ -13,6 +13,7 @@ import (
 	evmtypes "github.com/cosmos/evm/x/vm/types"
 
 	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/x/feegrant"
 
 	sdktypes "github.com/cosmos/cosmos-sdk/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
@@ -216,3 +217,139 @@ func (s *EvmUnitAnteTestSuite) TestConsumeGasAndEmitEvent() {
 		})
 	}
 }
+
+func (s *EvmUnitAnteTestSuite) TestConsumeGrantedFeesAndEmitEvent() {
+	keyring := testkeyring.New(1)
+	unitNetwork := network.NewUnitTestNetwork(
+		s.create,
+		network.WithChainID(testconstants.ChainID{
+			ChainID:    s.ChainID,
+			EVMChainID: s.EvmChainID,
+		}),
+		network.WithPreFundedAccounts(keyring.GetAllAccAddrs()...),
+	)
+	grpcHandler := grpc.NewIntegrationHandler(unitNetwork)
+	granter := keyring.GetKey(0).AccAddr
+	var grantee sdktypes.AccAddress
+
+	feesAmt := sdkmath.NewInt(1000).Mul(evmtypes.GetEVMCoinDecimals().ConversionFactor())
+	fees := sdktypes.NewCoins(sdktypes.NewCoin(unitNetwork.GetBaseDenom(), feesAmt))
+
+	// grantAllowance grants an allowance of the given limit restricted to the
+	// given messages
+	grantAllowance := func(limit sdktypes.Coins, allowedMsgs ...string) {
+		allowance, err := feegrant.NewAllowedMsgAllowance(&feegrant.BasicAllowance{SpendLimit: limit}, allowedMsgs)
+		s.Require().NoError(err)
+		err = unitNetwork.App.GetFeeGrantKeeper().GrantAllowance(unitNetwork.GetContext(), granter, grantee, allowance)
+		s.Require().NoError(err)
+	}
+
+	testCases := []struct {
+		name          string
+		expectedError string
+		malleate      func()
+	}{
+		{
+			name:          "fail: no allowance, event is NOT emitted",
+			expectedError: "fee-grant not found",
+			malleate:      func() {},
+		},
+		{
+			name:          "fail: allowance not restricted to messages, event is NOT emitted",
+			expectedError: "does not allow to pay fees for the EVM transactions",
+			malleate: func() {
+				limit := sdktypes.NewCoins(sdktypes.NewCoin(evmtypes.GetEVMCoinExtendedDenom(), feesAmt))
+				err := unitNetwork.App.GetFeeGrantKeeper().GrantAllowance(
+					unitNetwork.GetContext(), granter, grantee, &feegrant.BasicAllowance{SpendLimit: limit},
+				)
+				s.Require().NoError(err)
+			},
+		},
+		{
+			name:          "fail: allowance restricted to other messages, event is NOT emitted",
+			expectedError: "does not allow to pay fees for the EVM transactions",
+			malleate: func() {
+				limit := sdktypes.NewCoins(sdktypes.NewCoin(evmtypes.GetEVMCoinExtendedDenom(), feesAmt))
+				grantAllowance(limit, sdktypes.MsgTypeURL(&banktypes.MsgSend{}))
+			},
+		},
+		{
+			name:          "fail: fees exceed the allowance, event is NOT emitted",
+			expectedError: "fee limit exceeded",
+			malleate: func() {
+				limit := sdktypes.NewCoins(sdktypes.NewCoin(evmtypes.GetEVMCoinExtendedDenom(), feesAmt.SubRaw(1)))
+				grantAllowance(limit, sdktypes.MsgTypeURL(&evmtypes.MsgEthereumTx{}))
+			},
+		},
+		{
+			name: "success: fees are deducted from the granter and event emitted",
+			malleate: func() {
+				limit := sdktypes.NewCoins(sdktypes.NewCoin(evmtypes.GetEVMCoinExtendedDenom(), feesAmt))
+				grantAllowance(limit, sdktypes.MsgTypeURL(&evmtypes.MsgEthereumTx{}))
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(fmt.Sprintf("%v_%v_%v", evmtypes.GetTxTypeName(s.EthTxType), s.ChainID, tc.name), func() {
+			// use a new grantee without allowance
+			grantee = keyring.GetKey(keyring.AddKey()).AccAddr
+			tc.malleate()
+
+			resp, err := grpcHandler.GetBalanceFromEVM(granter)
+			s.Require().NoError(err)
+			prevGranterBalance, ok := sdkmath.NewIntFromString(resp.Balance)
+			s.Require().True(ok)
+
+			resp, err = grpcHandler.GetBalanceFromEVM(grantee)
+			s.Require().NoError(err)
+			prevGranteeBalance := resp.Balance
+
+			// Function under test
+			err = evmante.ConsumeGrantedFeesAndEmitEvent(
+				unitNetwork.GetContext(),
+				unitNetwork.App.GetFeeGrantKeeper(),
+				unitNetwork.App.GetEVMKeeper(),
+				fees,
+				granter,
+				grantee,
+				[]sdktypes.Msg{&evmtypes.MsgEthereumTx{}},
+			)
+
+			if tc.expectedError != "" {
+				s.Require().ErrorContains(err, tc.expectedError)
+
+				// Check the tx event is not present
+				for _, event := range unitNetwork.GetContext().EventManager().Events() {
+					s.Require().NotEqual(sdktypes.EventTypeTx, event.Type, "required no tx event to be emitted")
+				}
+			} else {
+				s.Require().NoError(err)
+
+				// Check fees are deducted from the granter only
+				resp, err := grpcHandler.GetBalanceFromEVM(granter)
+				s.Require().NoError(err)
+				afterGranterBalance, ok := sdkmath.NewIntFromString(resp.Balance)
+				s.Require().True(ok)
+				s.Require().True(prevGranterBalance.Sub(feesAmt).Equal(afterGranterBalance), "expected different granter balance after fees deduction")
+
+				resp, err = grpcHandler.GetBalanceFromEVM(grantee)
+				s.Require().NoError(err)
+				s.Require().Equal(prevGranteeBalance, resp.Balance)
+
+				// Event to be emitted
+				expectedEvent := sdktypes.NewEvent(
+					sdktypes.EventTypeTx,
+					sdktypes.NewAttribute(sdktypes.AttributeKeyFee, fees.String()),
+					sdktypes.NewAttribute(sdktypes.AttributeKeyFeePayer, granter.String()),
+				)
+				events := unitNetwork.GetContext().EventManager().Events()
+				s.Require().Contains(events, expectedEvent)
+			}
+
+			// Reset the context
+			err = unitNetwork.NextBlock()
+			s.Require().NoError(err)
+		})
+	}
+}
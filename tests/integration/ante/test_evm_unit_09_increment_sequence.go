@@ -1,11 +1,19 @@
 package ante
 
 import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+
 	"github.com/cosmos/evm/ante/evm"
+	"github.com/cosmos/evm/mempool"
+	"github.com/cosmos/evm/testutil"
 	testconstants "github.com/cosmos/evm/testutil/constants"
+	"github.com/cosmos/evm/testutil/integration/evm/factory"
 	"github.com/cosmos/evm/testutil/integration/evm/grpc"
 	"github.com/cosmos/evm/testutil/integration/evm/network"
 	testkeyring "github.com/cosmos/evm/testutil/keyring"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
 
 	sdktypes "github.com/cosmos/cosmos-sdk/types"
 	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
@@ -75,3 +83,233 @@ func (s *EvmUnitAnteTestSuite) TestIncrementSequence() {
 		})
 	}
 }
+
+// pendingTxPool is an EVM mempool holding a single pending transaction.
+type pendingTxPool struct {
+	from  common.Address
+	nonce uint64
+	ok    bool
+}
+
+func (p *pendingTxPool) HasPendingTx(from common.Address, nonce uint64) bool {
+	return p.ok && p.from == from && p.nonce == nonce
+}
+
+func (p *pendingTxPool) IsReplaced(common.Hash) bool {
+	return false
+}
+
+func (s *EvmUnitAnteTestSuite) TestMempoolNonceCheck() {
+	keyring := testkeyring.New(1)
+	unitNetwork := network.NewUnitTestNetwork(
+		s.create,
+		network.WithChainID(testconstants.ChainID{
+			ChainID:    s.ChainID,
+			EVMChainID: s.EvmChainID,
+		}),
+		network.WithPreFundedAccounts(keyring.GetAllAccAddrs()...),
+	)
+	grpcHandler := grpc.NewIntegrationHandler(unitNetwork)
+	txFactory := factory.New(unitNetwork, grpcHandler)
+	sender := keyring.GetKey(0)
+
+	pool := &pendingTxPool{from: sender.Addr}
+	decorator := evm.NewEVMMonoDecorator(
+		unitNetwork.App.GetAccountKeeper(),
+		unitNetwork.App.GetFeeMarketKeeper(),
+		unitNetwork.App.GetEVMKeeper(),
+		0,
+	).WithMempoolNonceCheck(true).WithPendingTxReplacement(pool)
+
+	testCases := []struct {
+		name          string
+		expectedError error
+		reCheckTx     bool
+		// pending is true if the mempool holds a pending tx with the tx nonce
+		pending bool
+		// nonceOffset is added to the account sequence to get the tx nonce
+		nonceOffset int64
+		// expIncrement is true if the account sequence is incremented
+		expIncrement bool
+	}{
+		{
+			name:         "success: nonce matches the sequence",
+			nonceOffset:  0,
+			expIncrement: true,
+		},
+		{
+			name:        "success: nonce gap is deferred to the mempool in CheckTx",
+			nonceOffset: 2,
+		},
+		{
+			name:          "fail: nonce gap on ReCheckTx",
+			expectedError: errortypes.ErrInvalidSequence,
+			reCheckTx:     true,
+			nonceOffset:   2,
+		},
+		{
+			name:          "fail: stale nonce in CheckTx",
+			expectedError: errortypes.ErrInvalidSequence,
+			nonceOffset:   -1,
+		},
+		{
+			name:        "success: replacement of a pending tx in CheckTx",
+			pending:     true,
+			nonceOffset: -1,
+		},
+		{
+			name:          "fail: replacement of a pending tx on ReCheckTx",
+			expectedError: errortypes.ErrInvalidSequence,
+			reCheckTx:     true,
+			pending:       true,
+			nonceOffset:   -1,
+		},
+		{
+			name:          "fail: stale nonce on ReCheckTx",
+			expectedError: errortypes.ErrInvalidSequence,
+			reCheckTx:     true,
+			nonceOffset:   -1,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			ctx := unitNetwork.GetContext().WithIsCheckTx(true).WithIsReCheckTx(tc.reCheckTx)
+			accountKeeper := unitNetwork.App.GetAccountKeeper()
+
+			// move the sequence forward so that stale nonces can be tested
+			account := accountKeeper.GetAccount(ctx, sender.AccAddr)
+			s.Require().NoError(account.SetSequence(account.GetSequence() + 1))
+			accountKeeper.SetAccount(ctx, account)
+			preSequence := account.GetSequence()
+
+			txArgs, err := txFactory.GenerateDefaultTxTypeArgs(sender.Addr, s.EthTxType)
+			s.Require().NoError(err)
+			txArgs.Nonce = uint64(int64(preSequence) + tc.nonceOffset) //nolint:gosec // G115
+			pool.nonce, pool.ok = txArgs.Nonce, tc.pending
+			tx, err := txFactory.GenerateSignedEthTx(sender.Priv, txArgs)
+			s.Require().NoError(err)
+
+			// Function under test
+			var newCtx sdktypes.Context
+			_, err = decorator.AnteHandle(ctx, tx, false, func(ctx sdktypes.Context, _ sdktypes.Tx, _ bool) (sdktypes.Context, error) {
+				newCtx = ctx
+				return ctx, nil
+			})
+
+			if tc.expectedError != nil {
+				s.Require().Error(err)
+				s.Contains(err.Error(), tc.expectedError.Error())
+				return
+			}
+			s.Require().NoError(err)
+
+			// the mempool validates the nonce against the sequence before the tx
+			sequence, ok := evmtypes.AccountSequenceFromContext(newCtx)
+			s.Require().True(ok)
+			s.Require().Equal(preSequence, sequence)
+
+			expSequence := preSequence
+			if tc.expIncrement {
+				expSequence++
+			}
+			s.Require().Equal(expSequence, accountKeeper.GetAccount(newCtx, sender.AccAddr).GetSequence())
+		})
+	}
+}
+
+func (s *EvmUnitAnteTestSuite) TestPendingTxReplacementReCheck() {
+	keyring := testkeyring.New(1)
+	unitNetwork := network.NewUnitTestNetwork(
+		s.create,
+		network.WithChainID(testconstants.ChainID{
+			ChainID:    s.ChainID,
+			EVMChainID: s.EvmChainID,
+		}),
+		network.WithPreFundedAccounts(keyring.GetAllAccAddrs()...),
+	)
+	grpcHandler := grpc.NewIntegrationHandler(unitNetwork)
+	txFactory := factory.New(unitNetwork, grpcHandler)
+	sender := keyring.GetKey(0)
+	evmKeeper := unitNetwork.App.GetEVMKeeper()
+	accountKeeper := unitNetwork.App.GetAccountKeeper()
+
+	pool := mempool.NewEVMMempool(evmKeeper, mempool.DefaultConfig())
+	decorator := evm.NewEVMMonoDecorator(
+		accountKeeper,
+		unitNetwork.App.GetFeeMarketKeeper(),
+		evmKeeper,
+		0,
+	).WithMempoolNonceCheck(true).WithPendingTxReplacement(pool)
+
+	// checkTx runs the ante handler and inserts the tx in the pool on success,
+	// as done by baseapp
+	checkTx := func(ctx sdktypes.Context, tx sdktypes.Tx) (sdktypes.Context, error) {
+		newCtx, err := decorator.AnteHandle(ctx, tx, false, testutil.NoOpNextFn)
+		if err != nil {
+			return ctx, err
+		}
+		if ctx.IsReCheckTx() {
+			return newCtx, nil
+		}
+		return newCtx, pool.Insert(newCtx, tx)
+	}
+	balance := func(ctx sdktypes.Context) *big.Int {
+		return evmKeeper.GetAccount(ctx, sender.Addr).Balance.ToBig()
+	}
+	bump := func(price *big.Int) *big.Int {
+		if price == nil {
+			return nil
+		}
+		return new(big.Int).Add(new(big.Int).Mul(price, big.NewInt(2)), big.NewInt(1))
+	}
+
+	committedCtx := unitNetwork.GetContext()
+	preSequence := accountKeeper.GetAccount(committedCtx, sender.AccAddr).GetSequence()
+	preBalance := balance(committedCtx)
+
+	txArgs, err := txFactory.GenerateDefaultTxTypeArgs(sender.Addr, s.EthTxType)
+	s.Require().NoError(err)
+	txArgs.Nonce = preSequence
+	replacedTx, err := txFactory.GenerateSignedEthTx(sender.Priv, txArgs)
+	s.Require().NoError(err)
+
+	txArgs.GasPrice, txArgs.GasFeeCap, txArgs.GasTipCap = bump(txArgs.GasPrice), bump(txArgs.GasFeeCap), bump(txArgs.GasTipCap)
+	replacementTx, err := txFactory.GenerateSignedEthTx(sender.Priv, txArgs)
+	s.Require().NoError(err)
+
+	// CheckTx of the tx and of its replacement, which doesn't pay the fees
+	// nor use the nonce a second time
+	checkCtx, _ := committedCtx.CacheContext()
+	checkCtx = checkCtx.WithIsCheckTx(true)
+	checkCtx, err = checkTx(checkCtx, replacedTx)
+	s.Require().NoError(err)
+	replacedBalance := balance(checkCtx)
+	s.Require().Equal(-1, replacedBalance.Cmp(preBalance))
+
+	checkCtx, err = checkTx(checkCtx, replacementTx)
+	s.Require().NoError(err)
+	s.Require().Equal(replacedBalance, balance(checkCtx))
+	s.Require().Equal(preSequence+1, accountKeeper.GetAccount(checkCtx, sender.AccAddr).GetSequence())
+	s.Require().Equal(1, pool.CountTx())
+
+	// ReCheckTx after a block without the txs, the CometBFT mempool still
+	// holds the replaced tx ahead of its replacement
+	reCheckCtx, _ := committedCtx.CacheContext()
+	reCheckCtx = reCheckCtx.WithIsCheckTx(true).WithIsReCheckTx(true)
+	pool.Sync(reCheckCtx)
+
+	_, err = checkTx(reCheckCtx, replacedTx)
+	s.Require().ErrorIs(err, errortypes.ErrConflict)
+	// baseapp removes the txs failing ReCheckTx from the pool
+	s.Require().NoError(pool.Remove(replacedTx))
+
+	reCheckCtx, err = checkTx(reCheckCtx, replacementTx)
+	s.Require().NoError(err)
+	s.Require().Equal(preSequence+1, accountKeeper.GetAccount(reCheckCtx, sender.AccAddr).GetSequence())
+	s.Require().Equal(-1, balance(reCheckCtx).Cmp(replacedBalance))
+
+	// the replacement is still executable
+	s.Require().True(pool.HasPendingTx(sender.Addr, preSequence))
+	s.Require().Equal(1, pool.CountTx())
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/stretchr/testify/require"
 
 	abci "github.com/cometbft/cometbft/abci/types"
@@ -17,6 +18,7 @@ import (
 	"github.com/cosmos/evm/testutil/constants"
 	"github.com/cosmos/evm/testutil/integration/evm/network"
 	utiltx "github.com/cosmos/evm/testutil/tx"
+	cosmosevmtypes "github.com/cosmos/evm/types"
 	"github.com/cosmos/evm/x/vm/types"
 
 	"cosmossdk.io/log"
@@ -186,7 +188,291 @@ func TestKVIndexer(t *testing.T, create network.CreateEvmApp, options ...network
 				res2, err := idxer.GetByBlockAndIndex(1, 0)
 				require.NoError(t, err)
 				require.Equal(t, res1, res2)
+
+				for _, addr := range []common.Address{from, to} {
+					hashes, hasMore, err := idxer.GetAddressAppearances(addr, 0, false, 10)
+					require.NoError(t, err)
+					require.False(t, hasMore)
+					require.Equal(t, []common.Hash{txHash}, hashes)
+				}
+			}
+		})
+	}
+}
+
+func TestKVIndexerAddressAppearances(t *testing.T, create network.CreateEvmApp, options ...network.ConfigOption) {
+	priv, err := ethsecp256k1.GenerateKey()
+	require.NoError(t, err)
+	from := common.BytesToAddress(priv.PubKey().Address().Bytes())
+
+	nw := network.New(create, options...)
+	encodingConfig := nw.GetEncodingConfig()
+	clientCtx := client.Context{}.WithTxConfig(encodingConfig.TxConfig).WithCodec(encodingConfig.Codec)
+
+	db := dbm.NewMemDB()
+	idxer := indexer.NewKVIndexer(db, log.NewNopLogger(), clientCtx)
+
+	// index one transfer per block in blocks 1 to 3
+	to := common.BigToAddress(big.NewInt(1))
+	txHashes := make([]common.Hash, 0, 3)
+	for height := int64(1); height <= 3; height++ {
+		txHash := indexEthTx(t, idxer, clientCtx, priv, height, &to)
+		txHashes = append(txHashes, txHash)
+	}
+
+	testCases := []struct {
+		name        string
+		address     common.Address
+		blockNumber int64
+		ascending   bool
+		limit       int
+		expHashes   []common.Hash
+		expHasMore  bool
+	}{
+		{
+			"all appearances of the sender, descending",
+			from, 0, false, 10,
+			[]common.Hash{txHashes[2], txHashes[1], txHashes[0]},
+			false,
+		},
+		{
+			"all appearances of the recipient, ascending",
+			to, 0, true, 10,
+			txHashes,
+			false,
+		},
+		{
+			"first page before the latest block",
+			from, 0, false, 1,
+			[]common.Hash{txHashes[2]},
+			true,
+		},
+		{
+			"page before block 3",
+			from, 3, false, 1,
+			[]common.Hash{txHashes[1]},
+			true,
+		},
+		{
+			"last page after block 2",
+			from, 2, true, 1,
+			[]common.Hash{txHashes[2]},
+			false,
+		},
+		{
+			"address without appearances",
+			common.BigToAddress(big.NewInt(2)), 0, false, 10,
+			nil,
+			false,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			hashes, hasMore, err := idxer.GetAddressAppearances(tc.address, tc.blockNumber, tc.ascending, tc.limit)
+			require.NoError(t, err)
+			require.Equal(t, tc.expHashes, hashes)
+			require.Equal(t, tc.expHasMore, hasMore)
+		})
+	}
+}
+
+func TestKVIndexerTxsByAddress(t *testing.T, create network.CreateEvmApp, options ...network.ConfigOption) {
+	priv, err := ethsecp256k1.GenerateKey()
+	require.NoError(t, err)
+	from := common.BytesToAddress(priv.PubKey().Address().Bytes())
+
+	nw := network.New(create, options...)
+	encodingConfig := nw.GetEncodingConfig()
+	clientCtx := client.Context{}.WithTxConfig(encodingConfig.TxConfig).WithCodec(encodingConfig.Codec)
+
+	db := dbm.NewMemDB()
+	idxer := indexer.NewKVIndexer(db, log.NewNopLogger(), clientCtx)
+
+	// index a transfer, a contract creation and a self transfer in blocks 1 to 3
+	to := common.BigToAddress(big.NewInt(1))
+	contract := crypto.CreateAddress(from, 1)
+	transfer := indexEthTx(t, idxer, clientCtx, priv, 1, &to)
+	creation := indexEthTx(t, idxer, clientCtx, priv, 2, nil)
+	selfTransfer := indexEthTx(t, idxer, clientCtx, priv, 3, &from)
+
+	all := cosmosevmtypes.AddressTxFilter{Sent: true, Received: true, Created: true}
+	testCases := []struct {
+		name       string
+		address    common.Address
+		filter     cosmosevmtypes.AddressTxFilter
+		start      []byte
+		limit      int
+		reverse    bool
+		expHashes  []common.Hash
+		expNextKey []byte
+		expPass    bool
+	}{
+		{
+			"all txs of the sender, without duplicates",
+			from, all, nil, 10, false,
+			[]common.Hash{transfer, creation, selfTransfer}, nil,
+			true,
+		},
+		{
+			"txs received by the sender",
+			from, cosmosevmtypes.AddressTxFilter{Received: true}, nil, 10, false,
+			[]common.Hash{selfTransfer}, nil,
+			true,
+		},
+		{
+			"all txs of the recipient",
+			to, all, nil, 10, false,
+			[]common.Hash{transfer}, nil,
+			true,
+		},
+		{
+			"tx creating the contract",
+			contract, cosmosevmtypes.AddressTxFilter{Created: true}, nil, 10, false,
+			[]common.Hash{creation}, nil,
+			true,
+		},
+		{
+			"no txs sent by the contract",
+			contract, cosmosevmtypes.AddressTxFilter{Sent: true}, nil, 10, false,
+			nil, nil,
+			true,
+		},
+		{
+			"first page",
+			from, all, nil, 2, false,
+			[]common.Hash{transfer, creation}, indexer.TxPosition(3, 0),
+			true,
+		},
+		{
+			"second page",
+			from, all, indexer.TxPosition(3, 0), 2, false,
+			[]common.Hash{selfTransfer}, nil,
+			true,
+		},
+		{
+			"first page in reverse order",
+			from, all, nil, 2, true,
+			[]common.Hash{selfTransfer, creation}, indexer.TxPosition(1, 0),
+			true,
+		},
+		{
+			"second page in reverse order",
+			from, all, indexer.TxPosition(1, 0), 2, true,
+			[]common.Hash{transfer}, nil,
+			true,
+		},
+		{
+			"fail - invalid page key",
+			from, all, []byte{1}, 2, false,
+			nil, nil,
+			false,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			hashes, nextKey, err := idxer.GetTxsByAddress(tc.address, tc.filter, tc.start, tc.limit, tc.reverse)
+			if !tc.expPass {
+				require.Error(t, err)
+				return
 			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expHashes, hashes)
+			require.Equal(t, tc.expNextKey, nextKey)
 		})
 	}
 }
+
+func TestKVIndexerAddressIndexStart(t *testing.T, create network.CreateEvmApp, options ...network.ConfigOption) {
+	priv, err := ethsecp256k1.GenerateKey()
+	require.NoError(t, err)
+	from := common.BytesToAddress(priv.PubKey().Address().Bytes())
+	all := cosmosevmtypes.AddressTxFilter{Sent: true, Received: true, Created: true}
+
+	nw := network.New(create, options...)
+	encodingConfig := nw.GetEncodingConfig()
+	clientCtx := client.Context{}.WithTxConfig(encodingConfig.TxConfig).WithCodec(encodingConfig.Codec)
+
+	db := dbm.NewMemDB()
+	idxer := indexer.NewKVIndexer(db, log.NewNopLogger(), clientCtx)
+
+	// an eth tx indexed in block 1 by an older indexer, without its address
+	// appearances
+	legacyTx := common.BigToHash(big.NewInt(1))
+	require.NoError(t, db.Set(indexer.TxIndexKey(1, 0), legacyTx.Bytes()))
+
+	to := common.BigToAddress(big.NewInt(1))
+	indexEthTx(t, idxer, clientCtx, priv, 3, &to)
+	start, err := idxer.AddressIndexStart()
+	require.NoError(t, err)
+	require.Equal(t, int64(3), start)
+
+	_, _, err = idxer.GetTxsByAddress(from, all, nil, 10, false)
+	require.ErrorIs(t, err, indexer.ErrAddressIndexIncomplete)
+	_, _, err = idxer.GetAddressAppearances(from, 0, false, 10)
+	require.ErrorIs(t, err, indexer.ErrAddressIndexIncomplete)
+
+	// a block indexed past a gap doesn't move the start
+	indexEthTx(t, idxer, clientCtx, priv, 5, &to)
+	start, err = idxer.AddressIndexStart()
+	require.NoError(t, err)
+	require.Equal(t, int64(3), start)
+
+	// re-indexing the blocks backward extends the address index down to the
+	// first indexed block
+	indexEthTx(t, idxer, clientCtx, priv, 2, &to)
+	transfer := indexEthTx(t, idxer, clientCtx, priv, 1, &to)
+	start, err = idxer.AddressIndexStart()
+	require.NoError(t, err)
+	require.Equal(t, int64(1), start)
+
+	hashes, _, err := idxer.GetTxsByAddress(from, all, nil, 1, false)
+	require.NoError(t, err)
+	require.Equal(t, []common.Hash{transfer}, hashes)
+}
+
+// indexEthTx indexes a block at the given height containing a successful eth
+// tx sent by the key to the recipient, or creating a contract if nil. The
+// block height is used as the tx nonce.
+func indexEthTx(
+	t *testing.T,
+	idxer *indexer.KVIndexer,
+	clientCtx client.Context,
+	priv *ethsecp256k1.PrivKey,
+	height int64,
+	to *common.Address,
+) common.Hash {
+	t.Helper()
+
+	tx := types.NewTx(&types.EvmTxArgs{
+		Nonce:    uint64(height - 1), //nolint:gosec // G115
+		To:       to,
+		Amount:   big.NewInt(1000),
+		GasLimit: 100000,
+	})
+	tx.From = common.BytesToAddress(priv.PubKey().Address().Bytes()).Bytes()
+	require.NoError(t, tx.Sign(ethtypes.LatestSignerForChainID(nil), utiltx.NewSigner(priv)))
+	txHash := tx.AsTransaction().Hash()
+
+	tmTx, err := tx.BuildTx(clientCtx.TxConfig.NewTxBuilder(), constants.ExampleAttoDenom)
+	require.NoError(t, err)
+	txBz, err := clientCtx.TxConfig.TxEncoder()(tmTx)
+	require.NoError(t, err)
+
+	block := &cmttypes.Block{Header: cmttypes.Header{Height: height}, Data: cmttypes.Data{Txs: []cmttypes.Tx{txBz}}}
+	blockResult := []*abci.ExecTxResult{
+		{
+			Code: 0,
+			Events: []abci.Event{
+				{Type: types.EventTypeEthereumTx, Attributes: []abci.EventAttribute{
+					{Key: "ethereumTxHash", Value: txHash.Hex()},
+					{Key: "txIndex", Value: "0"},
+					{Key: "amount", Value: "1000"},
+					{Key: "txGasUsed", Value: "21000"},
+					{Key: "txHash", Value: ""},
+				}},
+			},
+		},
+	}
+	require.NoError(t, idxer.IndexBlock(block, blockResult))
+	return txHash
+}
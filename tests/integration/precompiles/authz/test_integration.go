@@ -0,0 +1,179 @@
+package authz
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	//nolint:revive // dot imports are fine for Ginkgo
+	. "github.com/onsi/ginkgo/v2"
+	//nolint:revive // dot imports are fine for Ginkgo
+	. "github.com/onsi/gomega"
+
+	"github.com/cosmos/evm/precompiles/authz"
+	cmn "github.com/cosmos/evm/precompiles/common"
+	"github.com/cosmos/evm/precompiles/testutil"
+	"github.com/cosmos/evm/testutil/integration/evm/network"
+	testutiltx "github.com/cosmos/evm/testutil/tx"
+	testutiltypes "github.com/cosmos/evm/testutil/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+// General variables used for integration tests
+var (
+	// callArgs are the default arguments for calling the precompile
+	callArgs testutiltypes.CallArgs
+	// txArgs are the EVM transaction arguments to use in the transactions
+	txArgs evmtypes.EvmTxArgs
+	// defaultLogCheck instantiates a log check arguments struct with the precompile ABI events populated.
+	defaultLogCheck testutil.LogCheckArgs
+	// passCheck defines the arguments to check if the precompile returns no error
+	passCheck testutil.LogCheckArgs
+)
+
+func TestPrecompileIntegrationTestSuite(t *testing.T, create network.CreateEvmApp, options ...network.ConfigOption) {
+	_ = Describe("Calling authz precompile from EOA", func() {
+		var (
+			s         *PrecompileTestSuite
+			granter   common.Address
+			grantee   common.Address
+			recipient common.Address
+			amount    = big.NewInt(1e18)
+		)
+
+		BeforeEach(func() {
+			s = NewPrecompileTestSuite(create, options...)
+			s.SetupTest()
+
+			granter = s.keyring.GetAddr(0)
+			grantee = s.keyring.GetAddr(1)
+			recipient = testutiltx.GenerateAddress()
+
+			callArgs = testutiltypes.CallArgs{
+				ContractABI: s.precompile.ABI,
+			}
+			defaultLogCheck = testutil.LogCheckArgs{
+				ABIEvents: s.precompile.ABI.Events,
+			}
+			passCheck = defaultLogCheck.WithExpPass(true)
+
+			precompileAddr := s.precompile.Address()
+			txArgs = evmtypes.EvmTxArgs{
+				To:       &precompileAddr,
+				GasLimit: 300_000,
+			}
+		})
+
+		// granterGrants queries the grants of the granter through the precompile
+		granterGrants := func() authz.GrantsOutput {
+			args := callArgs
+			args.MethodName = authz.GranterGrantsMethod
+			args.Args = []interface{}{granter, query.PageRequest{}}
+
+			_, ethRes, err := s.factory.CallContractAndCheckLogs(s.keyring.GetPrivKey(2), txArgs, args, passCheck)
+			Expect(err).To(BeNil(), "error while calling the precompile")
+			Expect(s.network.NextBlock()).To(BeNil())
+
+			var out authz.GrantsOutput
+			Expect(s.precompile.UnpackIntoInterface(&out, authz.GranterGrantsMethod, ethRes.Ret)).To(BeNil())
+			return out
+		}
+
+		// balanceOf returns the bank balance of the address in the base denom
+		balanceOf := func(addr common.Address) *big.Int {
+			balance := s.network.App.GetBankKeeper().GetBalance(s.network.GetContext(), addr.Bytes(), s.network.GetBaseDenom())
+			return balance.Amount.BigInt()
+		}
+
+		Describe("with a send authorization", func() {
+			BeforeEach(func() {
+				callArgs.MethodName = authz.GrantSendMethod
+				callArgs.Args = []interface{}{granter, grantee, s.cmnCoins(amount), []common.Address{}, int64(0)}
+
+				check := passCheck.WithExpEvents(authz.EventTypeGrant)
+				_, _, err := s.factory.CallContractAndCheckLogs(s.keyring.GetPrivKey(0), txArgs, callArgs, check)
+				Expect(err).To(BeNil(), "error while calling the precompile")
+				Expect(s.network.NextBlock()).To(BeNil())
+			})
+
+			It("should return the grant", func() {
+				out := granterGrants()
+				Expect(out.Grants).To(HaveLen(1))
+				Expect(out.Grants[0].Grantee).To(Equal(grantee))
+				Expect(out.Grants[0].MsgTypeUrl).To(Equal(sendMsgTypeURL))
+				Expect(out.Grants[0].SpendLimit).To(Equal(s.cmnCoins(amount)))
+			})
+
+			It("should send the coins of the granter when the grantee executes a send", func() {
+				granterBalance := balanceOf(granter)
+
+				callArgs.MethodName = authz.ExecMethod
+				callArgs.Args = []interface{}{grantee, [][]byte{s.sendMsgJSON(granter, recipient, amount)}}
+
+				check := passCheck.WithExpEvents(authz.EventTypeExec)
+				_, _, err := s.factory.CallContractAndCheckLogs(s.keyring.GetPrivKey(1), txArgs, callArgs, check)
+				Expect(err).To(BeNil(), "error while calling the precompile")
+				Expect(s.network.NextBlock()).To(BeNil())
+
+				Expect(balanceOf(recipient)).To(Equal(amount))
+				Expect(balanceOf(granter)).To(Equal(new(big.Int).Sub(granterBalance, amount)))
+
+				// the spend limit is spent, so the grant is deleted
+				Expect(granterGrants().Grants).To(BeEmpty())
+			})
+
+			It("should not execute a send once the grant is revoked", func() {
+				callArgs.MethodName = authz.RevokeMethod
+				callArgs.Args = []interface{}{granter, grantee, sendMsgTypeURL}
+
+				check := passCheck.WithExpEvents(authz.EventTypeRevoke)
+				_, _, err := s.factory.CallContractAndCheckLogs(s.keyring.GetPrivKey(0), txArgs, callArgs, check)
+				Expect(err).To(BeNil(), "error while calling the precompile")
+				Expect(s.network.NextBlock()).To(BeNil())
+
+				callArgs.MethodName = authz.ExecMethod
+				callArgs.Args = []interface{}{grantee, [][]byte{s.sendMsgJSON(granter, recipient, amount)}}
+
+				check = defaultLogCheck.WithErrContains("authorization not found")
+				_, _, err = s.factory.CallContractAndCheckLogs(s.keyring.GetPrivKey(1), txArgs, callArgs, check)
+				Expect(err).To(BeNil(), "error while calling the precompile")
+				Expect(s.network.NextBlock()).To(BeNil())
+
+				Expect(balanceOf(recipient).Sign()).To(BeZero())
+			})
+
+			It("should not execute a send when the caller is not the grantee", func() {
+				callArgs.MethodName = authz.ExecMethod
+				callArgs.Args = []interface{}{grantee, [][]byte{s.sendMsgJSON(granter, recipient, amount)}}
+
+				check := defaultLogCheck.WithErrContains(
+					cmn.ErrRequesterIsNotMsgSender, s.keyring.GetAddr(2), grantee,
+				)
+				_, _, err := s.factory.CallContractAndCheckLogs(s.keyring.GetPrivKey(2), txArgs, callArgs, check)
+				Expect(err).To(BeNil(), "error while calling the precompile")
+				Expect(s.network.NextBlock()).To(BeNil())
+
+				Expect(balanceOf(recipient).Sign()).To(BeZero())
+			})
+		})
+
+		It("should not grant the execution of Ethereum transactions", func() {
+			callArgs.MethodName = authz.GrantMethod
+			callArgs.Args = []interface{}{granter, grantee, "/cosmos.evm.vm.v1.MsgEthereumTx", int64(0)}
+
+			check := defaultLogCheck.WithErrContains("found disabled msg type")
+			_, _, err := s.factory.CallContractAndCheckLogs(s.keyring.GetPrivKey(0), txArgs, callArgs, check)
+			Expect(err).To(BeNil(), "error while calling the precompile")
+			Expect(s.network.NextBlock()).To(BeNil())
+
+			Expect(granterGrants().Grants).To(BeEmpty())
+		})
+	})
+
+	// Run Ginkgo integration tests
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Authz Precompile Suite")
+}
@@ -0,0 +1,200 @@
+package authz
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/cosmos/evm/precompiles/authz"
+	cmn "github.com/cosmos/evm/precompiles/common"
+	"github.com/cosmos/evm/precompiles/testutil"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+	sdkauthz "github.com/cosmos/cosmos-sdk/x/authz"
+)
+
+func (s *PrecompileTestSuite) TestGrants() {
+	method := s.precompile.Methods[authz.GrantsMethod]
+	var granter, grantee common.Address
+	amount := big.NewInt(1000)
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		expError    bool
+		errContains string
+		expGrants   int
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 4, 0),
+			0,
+		},
+		{
+			"fail - invalid granter address",
+			func() []interface{} {
+				return []interface{}{common.Address{}, grantee, "", query.PageRequest{}}
+			},
+			true,
+			"invalid hex address",
+			0,
+		},
+		{
+			"fail - no grant of the msg type",
+			func() []interface{} {
+				return []interface{}{granter, grantee, delegateMsgTypeURL, query.PageRequest{}}
+			},
+			true,
+			sdkauthz.ErrNoAuthorizationFound.Error(),
+			0,
+		},
+		{
+			"success - no grants",
+			func() []interface{} {
+				return []interface{}{granter, grantee, "", query.PageRequest{}}
+			},
+			false,
+			"",
+			0,
+		},
+		{
+			"success - grant of the msg type",
+			func() []interface{} {
+				s.grantGeneric(granter, grantee, delegateMsgTypeURL)
+				s.grantSend(granter, grantee, amount)
+				return []interface{}{granter, grantee, sendMsgTypeURL, query.PageRequest{}}
+			},
+			false,
+			"",
+			1,
+		},
+		{
+			"success - all the grants",
+			func() []interface{} {
+				s.grantGeneric(granter, grantee, delegateMsgTypeURL)
+				s.grantSend(granter, grantee, amount)
+				return []interface{}{granter, grantee, "", query.PageRequest{}}
+			},
+			false,
+			"",
+			2,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			granter, grantee = s.keyring.GetAddr(0), s.keyring.GetAddr(1)
+
+			contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), granter, s.precompile.Address(), 200_000)
+
+			bz, err := s.precompile.Grants(ctx, contract, &method, tc.malleate())
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+			s.Require().NoError(err)
+
+			var out authz.GrantsOutput
+			s.Require().NoError(s.precompile.UnpackIntoInterface(&out, authz.GrantsMethod, bz))
+			s.Require().Len(out.Grants, tc.expGrants)
+			for _, grant := range out.Grants {
+				s.Require().Equal(granter, grant.Granter)
+				s.Require().Equal(grantee, grant.Grantee)
+				s.Require().Zero(grant.Expiration)
+				if grant.MsgTypeUrl == sendMsgTypeURL {
+					s.Require().Equal("/cosmos.bank.v1beta1.SendAuthorization", grant.AuthorizationType)
+					s.Require().Equal(s.cmnCoins(amount), grant.SpendLimit)
+				} else {
+					s.Require().Equal(delegateMsgTypeURL, grant.MsgTypeUrl)
+					s.Require().Equal("/cosmos.authz.v1beta1.GenericAuthorization", grant.AuthorizationType)
+					s.Require().Empty(grant.SpendLimit)
+				}
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestGranterGranteeGrants() {
+	var granter, grantee, other common.Address
+
+	testCases := []struct {
+		name       string
+		methodName string
+		malleate   func() []interface{}
+		expGrants  int
+	}{
+		{
+			"granter grants",
+			authz.GranterGrantsMethod,
+			func() []interface{} {
+				return []interface{}{granter, query.PageRequest{}}
+			},
+			2,
+		},
+		{
+			"granter grants - paginated",
+			authz.GranterGrantsMethod,
+			func() []interface{} {
+				return []interface{}{granter, query.PageRequest{Limit: 1, CountTotal: true}}
+			},
+			1,
+		},
+		{
+			"grantee grants",
+			authz.GranteeGrantsMethod,
+			func() []interface{} {
+				return []interface{}{grantee, query.PageRequest{}}
+			},
+			1,
+		},
+		{
+			"grantee grants - no grants",
+			authz.GranteeGrantsMethod,
+			func() []interface{} {
+				return []interface{}{granter, query.PageRequest{}}
+			},
+			0,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			granter, grantee, other = s.keyring.GetAddr(0), s.keyring.GetAddr(1), s.keyring.GetAddr(2)
+			s.grantGeneric(granter, grantee, delegateMsgTypeURL)
+			s.grantGeneric(granter, other, delegateMsgTypeURL)
+
+			method := s.precompile.Methods[tc.methodName]
+			contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), granter, s.precompile.Address(), 200_000)
+
+			var (
+				bz  []byte
+				err error
+			)
+			if tc.methodName == authz.GranterGrantsMethod {
+				bz, err = s.precompile.GranterGrants(ctx, contract, &method, tc.malleate())
+			} else {
+				bz, err = s.precompile.GranteeGrants(ctx, contract, &method, tc.malleate())
+			}
+			s.Require().NoError(err)
+
+			var out authz.GrantsOutput
+			s.Require().NoError(s.precompile.UnpackIntoInterface(&out, tc.methodName, bz))
+			s.Require().Len(out.Grants, tc.expGrants)
+			for _, grant := range out.Grants {
+				s.Require().Equal(granter, grant.Granter)
+				s.Require().Equal(delegateMsgTypeURL, grant.MsgTypeUrl)
+			}
+			if tc.methodName == authz.GranterGrantsMethod && tc.expGrants == 1 {
+				s.Require().Equal(uint64(2), out.PageResponse.Total)
+				s.Require().NotEmpty(out.PageResponse.NextKey)
+			}
+		})
+	}
+}
@@ -0,0 +1,410 @@
+package authz
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/cosmos/evm/precompiles/authz"
+	cmn "github.com/cosmos/evm/precompiles/common"
+	"github.com/cosmos/evm/precompiles/testutil"
+	utiltx "github.com/cosmos/evm/testutil/tx"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkauthz "github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func (s *PrecompileTestSuite) TestGrant() {
+	method := s.precompile.Methods[authz.GrantMethod]
+	var granter, grantee common.Address
+
+	testCases := []struct {
+		name          string
+		malleate      func() []interface{}
+		expError      bool
+		errContains   string
+		expExpiration int64
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 4, 0),
+			0,
+		},
+		{
+			"fail - invalid grantee address",
+			func() []interface{} {
+				return []interface{}{granter, common.Address{}, delegateMsgTypeURL, int64(0)}
+			},
+			true,
+			"invalid hex address",
+			0,
+		},
+		{
+			"fail - empty msg type URL",
+			func() []interface{} {
+				return []interface{}{granter, grantee, "", int64(0)}
+			},
+			true,
+			"invalid msg type URL",
+			0,
+		},
+		{
+			"fail - msg.sender address does not match the granter address",
+			func() []interface{} {
+				return []interface{}{utiltx.GenerateAddress(), grantee, delegateMsgTypeURL, int64(0)}
+			},
+			true,
+			"does not match the requester address",
+			0,
+		},
+		{
+			"fail - MsgEthereumTx cannot be granted",
+			func() []interface{} {
+				return []interface{}{granter, grantee, sdk.MsgTypeURL(&evmtypes.MsgEthereumTx{}), int64(0)}
+			},
+			true,
+			"found disabled msg type",
+			0,
+		},
+		{
+			"fail - expiration in the past",
+			func() []interface{} {
+				return []interface{}{granter, grantee, delegateMsgTypeURL, s.network.GetContext().BlockTime().Unix() - 1}
+			},
+			true,
+			"expiration must be after the current block time",
+			0,
+		},
+		{
+			"success - grant without expiration",
+			func() []interface{} {
+				return []interface{}{granter, grantee, delegateMsgTypeURL, int64(0)}
+			},
+			false,
+			"",
+			0,
+		},
+		{
+			"success - grant with expiration",
+			func() []interface{} {
+				return []interface{}{granter, grantee, delegateMsgTypeURL, s.network.GetContext().BlockTime().Unix() + 3600}
+			},
+			false,
+			"",
+			3600,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			granter, grantee = s.keyring.GetAddr(0), s.keyring.GetAddr(1)
+
+			contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), granter, s.precompile.Address(), 200_000)
+
+			res, err := s.precompile.Grant(ctx, contract, s.network.GetStateDB(), &method, tc.malleate())
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(cmn.TrueValue, res)
+
+			authorization, expiration := s.network.App.GetAuthzKeeper().GetAuthorization(ctx, grantee.Bytes(), granter.Bytes(), delegateMsgTypeURL)
+			s.Require().NotNil(authorization)
+			s.Require().IsType(&sdkauthz.GenericAuthorization{}, authorization)
+			if tc.expExpiration == 0 {
+				s.Require().Nil(expiration)
+			} else {
+				s.Require().Equal(ctx.BlockTime().Unix()+tc.expExpiration, expiration.Unix())
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestGrantSend() {
+	method := s.precompile.Methods[authz.GrantSendMethod]
+	var granter, grantee, recipient common.Address
+	amount := big.NewInt(1000)
+
+	testCases := []struct {
+		name         string
+		malleate     func() []interface{}
+		expError     bool
+		errContains  string
+		expAllowList bool
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 5, 0),
+			false,
+		},
+		{
+			"fail - invalid allow list address",
+			func() []interface{} {
+				return []interface{}{granter, grantee, s.cmnCoins(amount), []common.Address{{}}, int64(0)}
+			},
+			true,
+			"invalid hex address",
+			false,
+		},
+		{
+			"fail - empty spend limit",
+			func() []interface{} {
+				return []interface{}{granter, grantee, []cmn.Coin{}, []common.Address{}, int64(0)}
+			},
+			true,
+			"spend limit cannot be nil",
+			false,
+		},
+		{
+			"fail - granter is the grantee",
+			func() []interface{} {
+				return []interface{}{granter, granter, s.cmnCoins(amount), []common.Address{}, int64(0)}
+			},
+			true,
+			sdkauthz.ErrGranteeIsGranter.Error(),
+			false,
+		},
+		{
+			"success - send authorization to any recipient",
+			func() []interface{} {
+				return []interface{}{granter, grantee, s.cmnCoins(amount), []common.Address{}, int64(0)}
+			},
+			false,
+			"",
+			false,
+		},
+		{
+			"success - send authorization with an allow list",
+			func() []interface{} {
+				return []interface{}{granter, grantee, s.cmnCoins(amount), []common.Address{recipient}, int64(0)}
+			},
+			false,
+			"",
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			granter, grantee, recipient = s.keyring.GetAddr(0), s.keyring.GetAddr(1), s.keyring.GetAddr(2)
+
+			contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), granter, s.precompile.Address(), 200_000)
+
+			res, err := s.precompile.GrantSend(ctx, contract, s.network.GetStateDB(), &method, tc.malleate())
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(cmn.TrueValue, res)
+
+			authorization, _ := s.network.App.GetAuthzKeeper().GetAuthorization(ctx, grantee.Bytes(), granter.Bytes(), sendMsgTypeURL)
+			sendAuthz, ok := authorization.(*banktypes.SendAuthorization)
+			s.Require().True(ok)
+			s.Require().Equal(s.coins(amount), sendAuthz.SpendLimit)
+			if tc.expAllowList {
+				s.Require().Equal([]string{sdk.AccAddress(recipient.Bytes()).String()}, sendAuthz.AllowList)
+			} else {
+				s.Require().Empty(sendAuthz.AllowList)
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestRevoke() {
+	method := s.precompile.Methods[authz.RevokeMethod]
+	var granter, grantee common.Address
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 3, 0),
+		},
+		{
+			"fail - msg.sender address does not match the granter address",
+			func() []interface{} {
+				return []interface{}{grantee, granter, delegateMsgTypeURL}
+			},
+			true,
+			"does not match the requester address",
+		},
+		{
+			"fail - no authorization to revoke",
+			func() []interface{} {
+				return []interface{}{granter, grantee, delegateMsgTypeURL}
+			},
+			true,
+			sdkauthz.ErrNoAuthorizationFound.Error(),
+		},
+		{
+			"success - authorization revoked",
+			func() []interface{} {
+				s.grantGeneric(granter, grantee, delegateMsgTypeURL)
+				return []interface{}{granter, grantee, delegateMsgTypeURL}
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			granter, grantee = s.keyring.GetAddr(0), s.keyring.GetAddr(1)
+
+			contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), granter, s.precompile.Address(), 200_000)
+
+			res, err := s.precompile.Revoke(ctx, contract, s.network.GetStateDB(), &method, tc.malleate())
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(cmn.TrueValue, res)
+
+			authorization, _ := s.network.App.GetAuthzKeeper().GetAuthorization(ctx, grantee.Bytes(), granter.Bytes(), delegateMsgTypeURL)
+			s.Require().Nil(authorization)
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestExec() {
+	method := s.precompile.Methods[authz.ExecMethod]
+	var granter, grantee, recipient common.Address
+	amount := big.NewInt(1000)
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 2, 0),
+		},
+		{
+			"fail - no messages",
+			func() []interface{} {
+				return []interface{}{grantee, [][]byte{}}
+			},
+			true,
+			"invalid messages",
+		},
+		{
+			"fail - invalid message JSON",
+			func() []interface{} {
+				return []interface{}{grantee, [][]byte{[]byte("{}")}}
+			},
+			true,
+			"failed to decode message 0",
+		},
+		{
+			"fail - msg.sender address does not match the grantee address",
+			func() []interface{} {
+				return []interface{}{granter, [][]byte{s.sendMsgJSON(granter, recipient, amount)}}
+			},
+			true,
+			"does not match the requester address",
+		},
+		{
+			"fail - no authorization",
+			func() []interface{} {
+				return []interface{}{grantee, [][]byte{s.sendMsgJSON(granter, recipient, amount)}}
+			},
+			true,
+			sdkauthz.ErrNoAuthorizationFound.Error(),
+		},
+		{
+			"fail - message signed by the grantee",
+			func() []interface{} {
+				return []interface{}{grantee, [][]byte{s.sendMsgJSON(grantee, recipient, amount)}}
+			},
+			true,
+			"is signed by the grantee",
+		},
+		{
+			"fail - spend limit exceeded",
+			func() []interface{} {
+				s.grantSend(granter, grantee, amount)
+				return []interface{}{grantee, [][]byte{s.sendMsgJSON(granter, recipient, new(big.Int).Add(amount, big.NewInt(1)))}}
+			},
+			true,
+			"requested amount is more than spend limit",
+		},
+		{
+			"fail - MsgEthereumTx cannot be executed",
+			func() []interface{} {
+				msg := &evmtypes.MsgEthereumTx{From: granter.Bytes()}
+				bz, err := s.network.App.AppCodec().MarshalInterfaceJSON(msg)
+				s.Require().NoError(err)
+				return []interface{}{grantee, [][]byte{bz}}
+			},
+			true,
+			"found disabled msg type",
+		},
+		{
+			"success - send executed within the spend limit",
+			func() []interface{} {
+				s.grantSend(granter, grantee, amount)
+				return []interface{}{grantee, [][]byte{s.sendMsgJSON(granter, recipient, amount)}}
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			granter, grantee, recipient = s.keyring.GetAddr(0), s.keyring.GetAddr(1), utiltx.GenerateAddress()
+
+			contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), grantee, s.precompile.Address(), 200_000)
+
+			res, err := s.precompile.Exec(ctx, contract, s.network.GetStateDB(), &method, tc.malleate())
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+			s.Require().NoError(err)
+
+			var results [][]byte
+			s.Require().NoError(s.precompile.UnpackIntoInterface(&results, authz.ExecMethod, res))
+			s.Require().Len(results, 1)
+
+			balance := s.network.App.GetBankKeeper().GetBalance(ctx, recipient.Bytes(), s.network.GetBaseDenom())
+			s.Require().Equal(amount, balance.Amount.BigInt())
+
+			// the send authorization is spent and deleted
+			authorization, _ := s.network.App.GetAuthzKeeper().GetAuthorization(ctx, grantee.Bytes(), granter.Bytes(), sendMsgTypeURL)
+			s.Require().Nil(authorization)
+		})
+	}
+}
@@ -0,0 +1,65 @@
+package authz
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkauthz "github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+var (
+	// sendMsgTypeURL is the type URL of the bank MsgSend, allowed by send authorizations.
+	sendMsgTypeURL = sdk.MsgTypeURL(&banktypes.MsgSend{})
+	// delegateMsgTypeURL is the type URL of the staking MsgDelegate used for generic authorizations.
+	delegateMsgTypeURL = sdk.MsgTypeURL(&stakingtypes.MsgDelegate{})
+)
+
+// coins returns the given amount in the base denom of the network.
+func (s *PrecompileTestSuite) coins(amount *big.Int) sdk.Coins {
+	return sdk.NewCoins(sdk.NewCoin(s.network.GetBaseDenom(), sdkmath.NewIntFromBigInt(amount)))
+}
+
+// cmnCoins returns the given amount in the base denom of the network in the
+// precompile representation.
+func (s *PrecompileTestSuite) cmnCoins(amount *big.Int) []cmn.Coin {
+	return []cmn.Coin{{Denom: s.network.GetBaseDenom(), Amount: amount}}
+}
+
+// grantGeneric grants a generic authorization of the msg type from the granter
+// to the grantee.
+func (s *PrecompileTestSuite) grantGeneric(granter, grantee common.Address, msgTypeURL string) {
+	msg, err := sdkauthz.NewMsgGrant(granter.Bytes(), grantee.Bytes(), sdkauthz.NewGenericAuthorization(msgTypeURL), nil)
+	s.Require().NoError(err)
+	_, err = s.network.App.GetAuthzKeeper().Grant(s.network.GetContext(), msg)
+	s.Require().NoError(err)
+}
+
+// grantSend grants a send authorization of the amount from the granter to the
+// grantee.
+func (s *PrecompileTestSuite) grantSend(granter, grantee common.Address, amount *big.Int) {
+	authorization := banktypes.NewSendAuthorization(s.coins(amount), nil)
+	msg, err := sdkauthz.NewMsgGrant(granter.Bytes(), grantee.Bytes(), authorization, nil)
+	s.Require().NoError(err)
+	_, err = s.network.App.GetAuthzKeeper().Grant(s.network.GetContext(), msg)
+	s.Require().NoError(err)
+}
+
+// sendMsgJSON returns the protoJSON encoded bank MsgSend of the amount from
+// the sender to the recipient. It is also used by the Ginkgo tests, so it
+// panics instead of using the suite assertions.
+func (s *PrecompileTestSuite) sendMsgJSON(from, to common.Address, amount *big.Int) []byte {
+	msg := banktypes.NewMsgSend(from.Bytes(), to.Bytes(), s.coins(amount))
+	bz, err := s.network.App.AppCodec().MarshalInterfaceJSON(msg)
+	if err != nil {
+		panic(err)
+	}
+	return bz
+}
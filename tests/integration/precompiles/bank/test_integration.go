@@ -14,6 +14,7 @@ import (
 
 	bank2 "github.com/cosmos/evm/precompiles/bank"
 	"github.com/cosmos/evm/precompiles/bank/testdata"
+	cmn "github.com/cosmos/evm/precompiles/common"
 	"github.com/cosmos/evm/precompiles/testutil"
 	"github.com/cosmos/evm/testutil/integration/evm/factory"
 	"github.com/cosmos/evm/testutil/integration/evm/grpc"
@@ -295,6 +296,67 @@ func TestIntegrationSuite(t *testing.T, create network.CreateEvmApp, options ...
 			})
 		})
 
+		Context("Direct precompile transactions", func() {
+			var receiver common.Address
+
+			BeforeEach(func() {
+				// New account with 0 balances (does not exist on the chain yet)
+				receiver = utiltx.GenerateAddress()
+			})
+
+			Context("send", func() {
+				It("should send the base denom and update the EVM balances", func() {
+					balanceBefore, err := is.grpcHandler.GetBalanceFromBank(sender.AccAddr, is.bondDenom)
+					Expect(err).ToNot(HaveOccurred(), "failed to get balance")
+
+					txArgs, sendArgs := getTxAndCallArgs(directCall, contractData, bank2.SendMethod, receiver, is.bondDenom, amount)
+					sendCheck := passCheck.WithABIEvents(is.precompile.Events).WithExpEvents(bank2.EventTypeTransfer)
+					_, _, err = is.factory.CallContractAndCheckLogs(sender.Priv, txArgs, sendArgs, sendCheck)
+					Expect(err).ToNot(HaveOccurred(), "unexpected result calling contract")
+					Expect(is.network.NextBlock()).ToNot(HaveOccurred(), "error on NextBlock")
+
+					receiverBalance, err := is.grpcHandler.GetBalanceFromBank(receiver.Bytes(), is.bondDenom)
+					Expect(err).ToNot(HaveOccurred(), "failed to get balance")
+					Expect(receiverBalance.Balance.Amount.BigInt()).To(Equal(amount))
+
+					// the sent amount must not be restored to the sender when the EVM state is committed
+					balanceAfter, err := is.grpcHandler.GetBalanceFromBank(sender.AccAddr, is.bondDenom)
+					Expect(err).ToNot(HaveOccurred(), "failed to get balance")
+					spent := balanceBefore.Balance.Amount.Sub(balanceAfter.Balance.Amount)
+					Expect(spent.GT(math.NewIntFromBigInt(amount))).To(BeTrue(), "expected the amount and the fees to be spent")
+				})
+
+				It("should fail to send to a blocked address", func() {
+					txArgs, sendArgs := getTxAndCallArgs(directCall, contractData, bank2.SendMethod, is.precompile.Address(), is.tokenDenom, amount)
+					failCheck := testutil.LogCheckArgs{}.WithErrContains("is not allowed to receive funds")
+					_, _, err := is.factory.CallContractAndCheckLogs(sender.Priv, txArgs, sendArgs, failCheck)
+					Expect(err).ToNot(HaveOccurred(), "unexpected result calling contract")
+				})
+			})
+
+			Context("multiSend", func() {
+				It("should send the coins to every recipient", func() {
+					receiver2 := utiltx.GenerateAddress()
+					outputs := []bank2.Output{
+						{To: receiver, Coins: []cmn.Coin{{Denom: is.tokenDenom, Amount: amount}}},
+						{To: receiver2, Coins: []cmn.Coin{{Denom: is.tokenDenom, Amount: amount}}},
+					}
+
+					txArgs, multiSendArgs := getTxAndCallArgs(directCall, contractData, bank2.MultiSendMethod, outputs)
+					multiSendCheck := passCheck.WithABIEvents(is.precompile.Events).WithExpEvents(bank2.EventTypeTransfer, bank2.EventTypeTransfer)
+					_, _, err := is.factory.CallContractAndCheckLogs(sender.Priv, txArgs, multiSendArgs, multiSendCheck)
+					Expect(err).ToNot(HaveOccurred(), "unexpected result calling contract")
+					Expect(is.network.NextBlock()).ToNot(HaveOccurred(), "error on NextBlock")
+
+					for _, addr := range []common.Address{receiver, receiver2} {
+						balance, err := is.grpcHandler.GetBalanceFromBank(addr.Bytes(), is.tokenDenom)
+						Expect(err).ToNot(HaveOccurred(), "failed to get balance")
+						Expect(balance.Balance.Amount.BigInt()).To(Equal(amount))
+					}
+				})
+			})
+		})
+
 		Context("Calls from a contract", func() {
 			const (
 				BalancesFunction = "callBalances"
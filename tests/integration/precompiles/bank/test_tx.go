@@ -0,0 +1,287 @@
+package bank
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/cosmos/evm/precompiles/bank"
+	cmn "github.com/cosmos/evm/precompiles/common"
+	"github.com/cosmos/evm/precompiles/testutil"
+	cosmosevmutiltx "github.com/cosmos/evm/testutil/tx"
+	erc20types "github.com/cosmos/evm/x/erc20/types"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// fooDenom is a denom without a registered token pair.
+const fooDenom = "foo"
+
+func (s *PrecompileTestSuite) TestSend() {
+	var (
+		ctx      sdk.Context
+		sender   common.Address
+		receiver common.Address
+	)
+	amount := big.NewInt(1000)
+
+	testcases := []struct {
+		name        string
+		malleate    func() []interface{}
+		expPass     bool
+		errContains string
+		expLogAddr  func() *common.Address
+	}{
+		{
+			"fail - invalid number of arguments",
+			func() []interface{} {
+				return []interface{}{receiver, s.tokenDenom}
+			},
+			false,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 3, 2),
+			nil,
+		},
+		{
+			"fail - invalid denom",
+			func() []interface{} {
+				return []interface{}{receiver, "", amount}
+			},
+			false,
+			"invalid denom",
+			nil,
+		},
+		{
+			"fail - zero amount",
+			func() []interface{} {
+				return []interface{}{receiver, s.tokenDenom, big.NewInt(0)}
+			},
+			false,
+			"is not positive",
+			nil,
+		},
+		{
+			"fail - blocked recipient",
+			func() []interface{} {
+				return []interface{}{common.BytesToAddress(authtypes.NewModuleAddress(authtypes.FeeCollectorName)), s.tokenDenom, amount}
+			},
+			false,
+			"is not allowed to receive funds",
+			nil,
+		},
+		{
+			"fail - send disabled",
+			func() []interface{} {
+				s.network.App.GetBankKeeper().SetSendEnabled(ctx, s.tokenDenom, false)
+				return []interface{}{receiver, s.tokenDenom, amount}
+			},
+			false,
+			"transfers are currently disabled",
+			nil,
+		},
+		{
+			"fail - insufficient funds",
+			func() []interface{} {
+				return []interface{}{receiver, fooDenom, amount}
+			},
+			false,
+			"insufficient funds",
+			nil,
+		},
+		{
+			"pass - denom with a token pair",
+			func() []interface{} {
+				return []interface{}{receiver, s.tokenDenom, amount}
+			},
+			true,
+			"",
+			func() *common.Address { return &s.xmplAddr },
+		},
+		{
+			"pass - denom without a token pair",
+			func() []interface{} {
+				s.mintAndSendCoin(ctx, fooDenom, sender.Bytes(), math.NewIntFromBigInt(amount))
+				return []interface{}{receiver, fooDenom, amount}
+			},
+			true,
+			"",
+			func() *common.Address { return nil },
+		},
+		{
+			"pass - native ERC20 token pair without Transfer log",
+			func() []interface{} {
+				erc20Addr := cosmosevmutiltx.GenerateAddress()
+				denom := erc20types.CreateDenom(erc20Addr.String())
+				pair := erc20types.NewTokenPair(erc20Addr, denom, erc20types.OWNER_EXTERNAL)
+				s.Require().NoError(s.network.App.GetErc20Keeper().SetToken(ctx, pair))
+				s.mintAndSendCoin(ctx, denom, sender.Bytes(), math.NewIntFromBigInt(amount))
+				return []interface{}{receiver, denom, amount}
+			},
+			true,
+			"",
+			func() *common.Address { return nil },
+		},
+	}
+
+	for _, tc := range testcases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			ctx = s.network.GetContext()
+			sender = s.keyring.GetAddr(0)
+			receiver = cosmosevmutiltx.GenerateAddress()
+			method := s.precompile.Methods[bank.SendMethod]
+
+			args := tc.malleate()
+			contract, ctx := testutil.NewPrecompileContract(s.T(), ctx, sender, s.precompile.Address(), 200_000)
+			stateDB := s.network.GetStateDB()
+
+			bz, err := s.precompile.Send(ctx, contract, stateDB, &method, args)
+			if !tc.expPass {
+				s.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(cmn.TrueValue, bz)
+
+			denom := args[1].(string)
+			balance := s.network.App.GetBankKeeper().GetBalance(ctx, receiver.Bytes(), denom)
+			s.Require().Equal(amount, balance.Amount.BigInt())
+
+			logAddr := tc.expLogAddr()
+			if logAddr == nil {
+				s.Require().Empty(stateDB.Logs())
+				return
+			}
+			s.Require().Len(stateDB.Logs(), 1)
+			s.checkTransferLog(stateDB.Logs()[0].Address, *logAddr, stateDB.Logs()[0].Topics, sender, receiver)
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestMultiSend() {
+	var (
+		ctx       sdk.Context
+		sender    common.Address
+		receivers []common.Address
+	)
+	amount := big.NewInt(1000)
+
+	testcases := []struct {
+		name        string
+		malleate    func() []interface{}
+		expPass     bool
+		errContains string
+		expLogs     int
+	}{
+		{
+			"fail - invalid number of arguments",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			false,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 1, 0),
+			0,
+		},
+		{
+			"fail - no outputs",
+			func() []interface{} {
+				return []interface{}{[]bank.Output{}}
+			},
+			false,
+			bank.ErrNoOutputs,
+			0,
+		},
+		{
+			"fail - output without coins",
+			func() []interface{} {
+				return []interface{}{[]bank.Output{{To: receivers[0], Coins: []cmn.Coin{}}}}
+			},
+			false,
+			"no coins to send to",
+			0,
+		},
+		{
+			"fail - duplicated denom in an output",
+			func() []interface{} {
+				coins := []cmn.Coin{{Denom: s.tokenDenom, Amount: amount}, {Denom: s.tokenDenom, Amount: amount}}
+				return []interface{}{[]bank.Output{{To: receivers[0], Coins: coins}}}
+			},
+			false,
+			"duplicate denomination",
+			0,
+		},
+		{
+			"fail - blocked recipient in the second output",
+			func() []interface{} {
+				return []interface{}{[]bank.Output{
+					{To: receivers[0], Coins: []cmn.Coin{{Denom: s.tokenDenom, Amount: amount}}},
+					{To: s.precompile.Address(), Coins: []cmn.Coin{{Denom: s.tokenDenom, Amount: amount}}},
+				}}
+			},
+			false,
+			"is not allowed to receive funds",
+			0,
+		},
+		{
+			"pass - several outputs and denoms",
+			func() []interface{} {
+				s.mintAndSendCoin(ctx, fooDenom, sender.Bytes(), math.NewIntFromBigInt(amount).MulRaw(2))
+				coins := []cmn.Coin{{Denom: s.tokenDenom, Amount: amount}, {Denom: fooDenom, Amount: amount}}
+				return []interface{}{[]bank.Output{
+					{To: receivers[0], Coins: coins},
+					{To: receivers[1], Coins: coins},
+				}}
+			},
+			true,
+			"",
+			2,
+		},
+	}
+
+	for _, tc := range testcases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			ctx = s.network.GetContext()
+			sender = s.keyring.GetAddr(0)
+			receivers = []common.Address{cosmosevmutiltx.GenerateAddress(), cosmosevmutiltx.GenerateAddress()}
+			method := s.precompile.Methods[bank.MultiSendMethod]
+
+			args := tc.malleate()
+			contract, ctx := testutil.NewPrecompileContract(s.T(), ctx, sender, s.precompile.Address(), 200_000)
+			stateDB := s.network.GetStateDB()
+
+			bz, err := s.precompile.MultiSend(ctx, contract, stateDB, &method, args)
+			if !tc.expPass {
+				s.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(cmn.TrueValue, bz)
+
+			for _, receiver := range receivers {
+				for _, denom := range []string{s.tokenDenom, fooDenom} {
+					balance := s.network.App.GetBankKeeper().GetBalance(ctx, receiver.Bytes(), denom)
+					s.Require().Equal(amount, balance.Amount.BigInt())
+				}
+			}
+
+			logs := stateDB.Logs()
+			s.Require().Len(logs, tc.expLogs)
+			for i, log := range logs {
+				s.checkTransferLog(log.Address, s.xmplAddr, log.Topics, sender, receivers[i])
+			}
+		})
+	}
+}
+
+// checkTransferLog checks that the log is an ERC-20 Transfer event emitted by
+// the ERC-20 contract from the sender to the receiver.
+func (s *PrecompileTestSuite) checkTransferLog(logAddr, erc20Addr common.Address, topics []common.Hash, from, to common.Address) {
+	s.Require().Equal(erc20Addr, logAddr)
+	s.Require().Equal(s.precompile.Events[bank.EventTypeTransfer].ID, topics[0])
+	s.Require().Equal(common.BytesToHash(from.Bytes()), topics[1])
+	s.Require().Equal(common.BytesToHash(to.Bytes()), topics[2])
+}
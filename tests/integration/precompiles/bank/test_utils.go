@@ -52,6 +52,15 @@ func (s *PrecompileTestSuite) mintAndSendXMPLCoin(ctx sdk.Context, addr sdk.AccA
 	return ctx
 }
 
+// mintAndSendCoin is a helper function to mint and send a coin of any denom to a given address.
+func (s *PrecompileTestSuite) mintAndSendCoin(ctx sdk.Context, denom string, addr sdk.AccAddress, amount math.Int) {
+	coins := sdk.NewCoins(sdk.NewCoin(denom, amount))
+	err := s.network.App.GetBankKeeper().MintCoins(ctx, minttypes.ModuleName, coins)
+	s.Require().NoError(err)
+	err = s.network.App.GetBankKeeper().SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, addr, coins)
+	s.Require().NoError(err)
+}
+
 // mintAndSendXMPLCoin is a helper function to mint and send a coin to a given address.
 func (is *IntegrationTestSuite) mintAndSendXMPLCoin(addr sdk.AccAddress, amount math.Int) { //nolint:unused
 	coins := sdk.NewCoins(sdk.NewCoin(is.tokenDenom, amount))
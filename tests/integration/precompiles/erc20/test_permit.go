@@ -0,0 +1,564 @@
+package erc20
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	gethmath "github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+
+	"github.com/cosmos/evm/crypto/ethsecp256k1"
+	"github.com/cosmos/evm/precompiles/erc20"
+	"github.com/cosmos/evm/precompiles/testutil"
+	erc20types "github.com/cosmos/evm/x/erc20/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// xmplMetadata is the metadata of the token denomination, which is needed to
+// build the EIP-712 domain of the precompile.
+var xmplMetadata = banktypes.Metadata{
+	Description: "description",
+	Base:        tokenDenom,
+	DenomUnits: []*banktypes.DenomUnit{
+		{Denom: tokenDenom, Exponent: 0},
+	},
+	Name:    "Xmpl",
+	Symbol:  "XMPL",
+	Display: tokenDenom,
+}
+
+var eip712Types = apitypes.Types{
+	"EIP712Domain": {
+		{Name: "name", Type: "string"},
+		{Name: "version", Type: "string"},
+		{Name: "chainId", Type: "uint256"},
+		{Name: "verifyingContract", Type: "address"},
+	},
+	"Permit": {
+		{Name: "owner", Type: "address"},
+		{Name: "spender", Type: "address"},
+		{Name: "value", Type: "uint256"},
+		{Name: "nonce", Type: "uint256"},
+		{Name: "deadline", Type: "uint256"},
+	},
+	"TransferWithAuthorization": {
+		{Name: "from", Type: "address"},
+		{Name: "to", Type: "address"},
+		{Name: "value", Type: "uint256"},
+		{Name: "validAfter", Type: "uint256"},
+		{Name: "validBefore", Type: "uint256"},
+		{Name: "nonce", Type: "bytes32"},
+	},
+	"ReceiveWithAuthorization": {
+		{Name: "from", Type: "address"},
+		{Name: "to", Type: "address"},
+		{Name: "value", Type: "uint256"},
+		{Name: "validAfter", Type: "uint256"},
+		{Name: "validBefore", Type: "uint256"},
+		{Name: "nonce", Type: "bytes32"},
+	},
+	"CancelAuthorization": {
+		{Name: "authorizer", Type: "address"},
+		{Name: "nonce", Type: "bytes32"},
+	},
+}
+
+func (s *PrecompileTestSuite) TestDomainSeparator() {
+	method := s.precompile.Methods[erc20.DomainSeparatorMethod]
+
+	s.Run("fail - token without name", func() {
+		s.SetupTest()
+
+		bz, err := s.precompile.DomainSeparator(s.network.GetContext(), nil, nil, &method, []interface{}{})
+		s.requireOut(bz, err, method, false, "execution reverted", nil)
+	})
+
+	s.Run("pass", func() {
+		s.SetupTest()
+		s.network.App.GetBankKeeper().SetDenomMetaData(s.network.GetContext(), xmplMetadata)
+
+		typedData := s.typedData("", nil)
+		expDomainSeparator, err := typedData.HashStruct("EIP712Domain", typedData.Domain.Map())
+		s.Require().NoError(err)
+
+		bz, err := s.precompile.DomainSeparator(s.network.GetContext(), nil, nil, &method, []interface{}{})
+		s.requireOut(bz, err, method, true, "", [32]byte(common.BytesToHash(expDomainSeparator)))
+	})
+}
+
+func (s *PrecompileTestSuite) TestNonces() {
+	method := s.precompile.Methods[erc20.NoncesMethod]
+	owner := s.keyring.GetAddr(0)
+
+	s.Run("fail - invalid owner address", func() {
+		s.SetupTest()
+
+		bz, err := s.precompile.Nonces(s.network.GetContext(), nil, nil, &method, []interface{}{""})
+		s.requireOut(bz, err, method, false, "invalid owner address", nil)
+	})
+
+	s.Run("pass", func() {
+		s.SetupTest()
+		ctx := s.network.GetContext()
+		s.network.App.GetErc20Keeper().SetPermitNonce(ctx, s.precompile.Address(), owner, 3)
+
+		bz, err := s.precompile.Nonces(ctx, nil, nil, &method, []interface{}{owner})
+		s.requireOut(bz, err, method, true, "", big.NewInt(3))
+	})
+}
+
+func (s *PrecompileTestSuite) TestPermit() {
+	var (
+		ctx     sdk.Context
+		owner   common.Address
+		spender common.Address
+	)
+	method := s.precompile.Methods[erc20.PermitMethod]
+	value := big.NewInt(100)
+
+	// permitArgs returns the permit arguments signed by the key with the given index.
+	permitArgs := func(signer int, value *big.Int, nonce, deadline int64) []interface{} {
+		v, r, sig := s.signTypedData(signer, "Permit", apitypes.TypedDataMessage{
+			"owner":    owner.Hex(),
+			"spender":  spender.Hex(),
+			"value":    value.String(),
+			"nonce":    big.NewInt(nonce).String(),
+			"deadline": big.NewInt(deadline).String(),
+		})
+		return []interface{}{owner, spender, value, big.NewInt(deadline), v, r, sig}
+	}
+
+	testcases := []struct {
+		name        string
+		malleate    func() []interface{}
+		expPass     bool
+		errContains string
+	}{
+		{
+			"fail - invalid number of arguments",
+			func() []interface{} {
+				return []interface{}{owner, spender}
+			},
+			false,
+			"invalid number of arguments; expected 7; got: 2",
+		},
+		{
+			"fail - expired deadline",
+			func() []interface{} {
+				return permitArgs(0, value, 0, ctx.BlockTime().Unix()-1)
+			},
+			false,
+			erc20.ErrExpiredDeadline.Error(),
+		},
+		{
+			"fail - signed by another account",
+			func() []interface{} {
+				return permitArgs(1, value, 0, ctx.BlockTime().Unix()+100)
+			},
+			false,
+			erc20.ErrInvalidPermitSignature.Error(),
+		},
+		{
+			"fail - signed with a wrong nonce",
+			func() []interface{} {
+				return permitArgs(0, value, 1, ctx.BlockTime().Unix()+100)
+			},
+			false,
+			erc20.ErrInvalidPermitSignature.Error(),
+		},
+		{
+			"fail - signed permit with a different value",
+			func() []interface{} {
+				args := permitArgs(0, value, 0, ctx.BlockTime().Unix()+100)
+				args[2] = big.NewInt(1000)
+				return args
+			},
+			false,
+			erc20.ErrInvalidPermitSignature.Error(),
+		},
+		{
+			"fail - invalid v value",
+			func() []interface{} {
+				args := permitArgs(0, value, 0, ctx.BlockTime().Unix()+100)
+				args[4] = uint8(1)
+				return args
+			},
+			false,
+			erc20.ErrInvalidPermitSignature.Error(),
+		},
+		{
+			"fail - signed before an update of the token name",
+			func() []interface{} {
+				args := permitArgs(0, value, 0, ctx.BlockTime().Unix()+100)
+				// the token name is part of the signing domain
+				metadata := xmplMetadata
+				metadata.Name = "Xmpl v2"
+				s.network.App.GetBankKeeper().SetDenomMetaData(ctx, metadata)
+				return args
+			},
+			false,
+			erc20.ErrInvalidPermitSignature.Error(),
+		},
+		{
+			"pass",
+			func() []interface{} {
+				return permitArgs(0, value, 0, ctx.BlockTime().Unix())
+			},
+			true,
+			"",
+		},
+	}
+
+	for _, tc := range testcases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			ctx = s.network.GetContext()
+			s.network.App.GetBankKeeper().SetDenomMetaData(ctx, xmplMetadata)
+			owner = s.keyring.GetAddr(0)
+			spender = s.keyring.GetAddr(1)
+
+			// the permit can be submitted by any account, here the spender
+			contract, ctx := testutil.NewPrecompileContract(s.T(), ctx, spender, s.precompile.Address(), 200_000)
+			stateDB := s.network.GetStateDB()
+
+			_, err := s.precompile.Permit(ctx, contract, stateDB, &method, tc.malleate())
+			if !tc.expPass {
+				s.Require().ErrorContains(err, tc.errContains)
+				s.Require().Equal(uint64(0), s.network.App.GetErc20Keeper().GetPermitNonce(ctx, s.precompile.Address(), owner))
+				return
+			}
+
+			s.Require().NoError(err)
+			s.requireAllowance(s.precompile.Address(), owner, spender, value)
+			s.Require().Equal(uint64(1), s.network.App.GetErc20Keeper().GetPermitNonce(ctx, s.precompile.Address(), owner))
+
+			logs := stateDB.Logs()
+			s.Require().Len(logs, 1)
+			s.Require().Equal(s.precompile.Events[erc20.EventTypeApproval].ID, logs[0].Topics[0])
+
+			// the same permit can't be replayed
+			_, err = s.precompile.Permit(ctx, contract, stateDB, &method, permitArgs(0, value, 0, ctx.BlockTime().Unix()))
+			s.Require().ErrorContains(err, erc20.ErrInvalidPermitSignature.Error())
+
+			// a permit with the new nonce and zero value removes the allowance
+			_, err = s.precompile.Permit(ctx, contract, stateDB, &method, permitArgs(0, common.Big0, 1, ctx.BlockTime().Unix()))
+			s.Require().NoError(err)
+			s.requireAllowance(s.precompile.Address(), owner, spender, common.Big0)
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestTransferWithAuthorization() {
+	var (
+		ctx  sdk.Context
+		from common.Address
+	)
+	nonce := common.BytesToHash([]byte("nonce"))
+	value := big.NewInt(100)
+
+	// authorizationArgs returns the transfer authorization arguments signed by
+	// the key with the given index.
+	authorizationArgs := func(primaryType string, signer int, to common.Address, validAfter, validBefore int64) []interface{} {
+		v, r, sig := s.signTypedData(signer, primaryType, apitypes.TypedDataMessage{
+			"from":        from.Hex(),
+			"to":          to.Hex(),
+			"value":       value.String(),
+			"validAfter":  big.NewInt(validAfter).String(),
+			"validBefore": big.NewInt(validBefore).String(),
+			"nonce":       nonce.Hex(),
+		})
+		return []interface{}{from, to, value, big.NewInt(validAfter), big.NewInt(validBefore), [32]byte(nonce), v, r, sig}
+	}
+
+	testcases := []struct {
+		name        string
+		method      string
+		caller      func() common.Address
+		malleate    func() []interface{}
+		expPass     bool
+		errContains string
+	}{
+		{
+			"fail - invalid number of arguments",
+			erc20.TransferWithAuthorizationMethod,
+			func() common.Address { return s.keyring.GetAddr(1) },
+			func() []interface{} {
+				return []interface{}{from, toAddr, value}
+			},
+			false,
+			"invalid number of arguments; expected 9; got: 3",
+		},
+		{
+			"fail - authorization not yet valid",
+			erc20.TransferWithAuthorizationMethod,
+			func() common.Address { return s.keyring.GetAddr(1) },
+			func() []interface{} {
+				now := ctx.BlockTime().Unix()
+				return authorizationArgs("TransferWithAuthorization", 0, toAddr, now, now+100)
+			},
+			false,
+			erc20.ErrAuthorizationNotYetValid.Error(),
+		},
+		{
+			"fail - authorization expired",
+			erc20.TransferWithAuthorizationMethod,
+			func() common.Address { return s.keyring.GetAddr(1) },
+			func() []interface{} {
+				now := ctx.BlockTime().Unix()
+				return authorizationArgs("TransferWithAuthorization", 0, toAddr, 0, now)
+			},
+			false,
+			erc20.ErrAuthorizationExpired.Error(),
+		},
+		{
+			"fail - authorization already used",
+			erc20.TransferWithAuthorizationMethod,
+			func() common.Address { return s.keyring.GetAddr(1) },
+			func() []interface{} {
+				s.network.App.GetErc20Keeper().SetAuthorizationNonceUsed(ctx, s.precompile.Address(), from, nonce)
+				return authorizationArgs("TransferWithAuthorization", 0, toAddr, 0, ctx.BlockTime().Unix()+100)
+			},
+			false,
+			erc20.ErrAuthorizationUsed.Error(),
+		},
+		{
+			"fail - signed by another account",
+			erc20.TransferWithAuthorizationMethod,
+			func() common.Address { return s.keyring.GetAddr(1) },
+			func() []interface{} {
+				return authorizationArgs("TransferWithAuthorization", 1, toAddr, 0, ctx.BlockTime().Unix()+100)
+			},
+			false,
+			erc20.ErrInvalidAuthorizationSignature.Error(),
+		},
+		{
+			"fail - receive authorization used for a transfer",
+			erc20.TransferWithAuthorizationMethod,
+			func() common.Address { return s.keyring.GetAddr(1) },
+			func() []interface{} {
+				return authorizationArgs("ReceiveWithAuthorization", 0, toAddr, 0, ctx.BlockTime().Unix()+100)
+			},
+			false,
+			erc20.ErrInvalidAuthorizationSignature.Error(),
+		},
+		{
+			"fail - receive authorization submitted by another account than the payee",
+			erc20.ReceiveWithAuthorizationMethod,
+			func() common.Address { return s.keyring.GetAddr(1) },
+			func() []interface{} {
+				return authorizationArgs("ReceiveWithAuthorization", 0, toAddr, 0, ctx.BlockTime().Unix()+100)
+			},
+			false,
+			erc20.ErrCallerMustBePayee.Error(),
+		},
+		{
+			"fail - not enough balance",
+			erc20.TransferWithAuthorizationMethod,
+			func() common.Address { return s.keyring.GetAddr(1) },
+			func() []interface{} {
+				args := authorizationArgs("TransferWithAuthorization", 0, toAddr, 0, ctx.BlockTime().Unix()+100)
+				// spend the balance of the authorizer
+				err := s.network.App.GetBankKeeper().SendCoins(ctx, from.Bytes(), s.keyring.GetAccAddr(1), XMPLCoin)
+				s.Require().NoError(err)
+				return args
+			},
+			false,
+			erc20.ErrTransferAmountExceedsBalance.Error(),
+		},
+		{
+			"pass - transfer with authorization submitted by any account",
+			erc20.TransferWithAuthorizationMethod,
+			func() common.Address { return s.keyring.GetAddr(1) },
+			func() []interface{} {
+				return authorizationArgs("TransferWithAuthorization", 0, toAddr, 0, ctx.BlockTime().Unix()+100)
+			},
+			true,
+			"",
+		},
+		{
+			"pass - receive with authorization submitted by the payee",
+			erc20.ReceiveWithAuthorizationMethod,
+			func() common.Address { return s.keyring.GetAddr(1) },
+			func() []interface{} {
+				return authorizationArgs("ReceiveWithAuthorization", 0, s.keyring.GetAddr(1), 0, ctx.BlockTime().Unix()+100)
+			},
+			true,
+			"",
+		},
+	}
+
+	for _, tc := range testcases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			ctx = s.network.GetContext()
+			s.network.App.GetBankKeeper().SetDenomMetaData(ctx, xmplMetadata)
+			from = s.keyring.GetAddr(0)
+			s.mintXMPL(ctx, from)
+
+			method := s.precompile.Methods[tc.method]
+			contract, ctx := testutil.NewPrecompileContract(s.T(), ctx, tc.caller(), s.precompile.Address(), 200_000)
+			stateDB := s.network.GetStateDB()
+
+			args := tc.malleate()
+			var err error
+			if tc.method == erc20.ReceiveWithAuthorizationMethod {
+				_, err = s.precompile.ReceiveWithAuthorization(ctx, contract, stateDB, &method, args)
+			} else {
+				_, err = s.precompile.TransferWithAuthorization(ctx, contract, stateDB, &method, args)
+			}
+			if !tc.expPass {
+				s.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+
+			s.Require().NoError(err)
+
+			to := args[1].(common.Address)
+			balance := s.network.App.GetBankKeeper().GetBalance(ctx, to.Bytes(), tokenDenom)
+			s.Require().Equal(value, balance.Amount.BigInt())
+			s.Require().True(s.network.App.GetErc20Keeper().IsAuthorizationNonceUsed(ctx, s.precompile.Address(), from, nonce))
+
+			logs := stateDB.Logs()
+			s.Require().Len(logs, 2)
+			s.Require().Equal(s.precompile.Events[erc20.EventTypeAuthorizationUsed].ID, logs[0].Topics[0])
+			s.Require().Equal(common.BytesToHash(from.Bytes()), logs[0].Topics[1])
+			s.Require().Equal(nonce, logs[0].Topics[2])
+			s.Require().Equal(s.precompile.Events[erc20.EventTypeTransfer].ID, logs[1].Topics[0])
+
+			// the same authorization can't be replayed
+			_, err = s.precompile.TransferWithAuthorization(ctx, contract, stateDB, &method, args)
+			s.Require().ErrorContains(err, erc20.ErrAuthorizationUsed.Error())
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestCancelAuthorization() {
+	var (
+		ctx        sdk.Context
+		authorizer common.Address
+	)
+	method := s.precompile.Methods[erc20.CancelAuthorizationMethod]
+	nonce := common.BytesToHash([]byte("nonce"))
+
+	// cancelArgs returns the cancellation arguments signed by the key with the given index.
+	cancelArgs := func(signer int) []interface{} {
+		v, r, sig := s.signTypedData(signer, "CancelAuthorization", apitypes.TypedDataMessage{
+			"authorizer": authorizer.Hex(),
+			"nonce":      nonce.Hex(),
+		})
+		return []interface{}{authorizer, [32]byte(nonce), v, r, sig}
+	}
+
+	testcases := []struct {
+		name        string
+		malleate    func() []interface{}
+		expPass     bool
+		errContains string
+	}{
+		{
+			"fail - invalid number of arguments",
+			func() []interface{} {
+				return []interface{}{authorizer, [32]byte(nonce)}
+			},
+			false,
+			"invalid number of arguments; expected 5; got: 2",
+		},
+		{
+			"fail - authorization already used",
+			func() []interface{} {
+				s.network.App.GetErc20Keeper().SetAuthorizationNonceUsed(ctx, s.precompile.Address(), authorizer, nonce)
+				return cancelArgs(0)
+			},
+			false,
+			erc20.ErrAuthorizationUsed.Error(),
+		},
+		{
+			"fail - signed by another account",
+			func() []interface{} {
+				return cancelArgs(1)
+			},
+			false,
+			erc20.ErrInvalidAuthorizationSignature.Error(),
+		},
+		{
+			"pass",
+			func() []interface{} {
+				return cancelArgs(0)
+			},
+			true,
+			"",
+		},
+	}
+
+	for _, tc := range testcases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			ctx = s.network.GetContext()
+			s.network.App.GetBankKeeper().SetDenomMetaData(ctx, xmplMetadata)
+			authorizer = s.keyring.GetAddr(0)
+
+			contract, ctx := testutil.NewPrecompileContract(s.T(), ctx, s.keyring.GetAddr(1), s.precompile.Address(), 200_000)
+			stateDB := s.network.GetStateDB()
+
+			_, err := s.precompile.CancelAuthorization(ctx, contract, stateDB, &method, tc.malleate())
+			if !tc.expPass {
+				s.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+
+			s.Require().NoError(err)
+			s.Require().True(s.network.App.GetErc20Keeper().IsAuthorizationNonceUsed(ctx, s.precompile.Address(), authorizer, nonce))
+
+			logs := stateDB.Logs()
+			s.Require().Len(logs, 1)
+			s.Require().Equal(s.precompile.Events[erc20.EventTypeAuthorizationCanceled].ID, logs[0].Topics[0])
+
+			stateMethod := s.precompile.Methods[erc20.AuthorizationStateMethod]
+			bz, err := s.precompile.AuthorizationState(ctx, contract, stateDB, &stateMethod, []interface{}{authorizer, [32]byte(nonce)})
+			s.requireOut(bz, err, stateMethod, true, "", true)
+		})
+	}
+}
+
+// typedData returns the EIP-712 typed data of the given message in the signing
+// domain of the xmpl precompile.
+func (s *PrecompileTestSuite) typedData(primaryType string, message apitypes.TypedDataMessage) apitypes.TypedData {
+	return apitypes.TypedData{
+		Types:       eip712Types,
+		PrimaryType: primaryType,
+		Domain: apitypes.TypedDataDomain{
+			Name:              xmplMetadata.Name,
+			Version:           "1",
+			ChainId:           (*gethmath.HexOrDecimal256)(evmtypes.GetEthChainConfig().ChainID),
+			VerifyingContract: s.precompile.Address().Hex(),
+		},
+		Message: message,
+	}
+}
+
+// signTypedData signs the EIP-712 typed data of the given message with the key
+// of the given keyring index and returns the v, r and s signature values.
+func (s *PrecompileTestSuite) signTypedData(index int, primaryType string, message apitypes.TypedDataMessage) (uint8, [32]byte, [32]byte) {
+	digest, _, err := apitypes.TypedDataAndHash(s.typedData(primaryType, message))
+	s.Require().NoError(err, "failed to hash typed data")
+
+	key, err := s.keyring.GetPrivKey(index).(*ethsecp256k1.PrivKey).ToECDSA()
+	s.Require().NoError(err)
+
+	sig, err := crypto.Sign(digest, key)
+	s.Require().NoError(err, "failed to sign typed data")
+
+	return sig[64] + 27, [32]byte(sig[:32]), [32]byte(sig[32:64])
+}
+
+// mintXMPL mints the XMPL test coins to the given address.
+func (s *PrecompileTestSuite) mintXMPL(ctx sdk.Context, addr common.Address) {
+	err := s.network.App.GetBankKeeper().MintCoins(ctx, erc20types.ModuleName, XMPLCoin)
+	s.Require().NoError(err, "failed to mint coins")
+	err = s.network.App.GetBankKeeper().SendCoinsFromModuleToAccount(ctx, erc20types.ModuleName, addr.Bytes(), XMPLCoin)
+	s.Require().NoError(err, "failed to send coins from module to account")
+}
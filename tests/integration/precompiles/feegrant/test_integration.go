@@ -0,0 +1,267 @@
+package feegrant
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	//nolint:revive // dot imports are fine for Ginkgo
+	. "github.com/onsi/ginkgo/v2"
+	//nolint:revive // dot imports are fine for Ginkgo
+	. "github.com/onsi/gomega"
+
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+
+	"github.com/cosmos/evm/precompiles/feegrant"
+	"github.com/cosmos/evm/precompiles/testutil"
+	commonfactory "github.com/cosmos/evm/testutil/integration/base/factory"
+	"github.com/cosmos/evm/testutil/integration/evm/network"
+	testutiltx "github.com/cosmos/evm/testutil/tx"
+	testutiltypes "github.com/cosmos/evm/testutil/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	sdkfeegrant "cosmossdk.io/x/feegrant"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// General variables used for integration tests
+var (
+	// callArgs are the default arguments for calling the precompile
+	callArgs testutiltypes.CallArgs
+	// txArgs are the EVM transaction arguments to use in the transactions
+	txArgs evmtypes.EvmTxArgs
+	// defaultLogCheck instantiates a log check arguments struct with the precompile ABI events populated.
+	defaultLogCheck testutil.LogCheckArgs
+	// passCheck defines the arguments to check if the precompile returns no error
+	passCheck testutil.LogCheckArgs
+)
+
+func TestPrecompileIntegrationTestSuite(t *testing.T, create network.CreateEvmApp, options ...network.ConfigOption) {
+	_ = Describe("Calling feegrant precompile from EOA", func() {
+		var (
+			s          *PrecompileTestSuite
+			granter    common.Address
+			grantee    common.Address
+			spendLimit = big.NewInt(1e18)
+		)
+
+		BeforeEach(func() {
+			s = NewPrecompileTestSuite(create, options...)
+			s.SetupTest()
+
+			granter = s.keyring.GetAddr(0)
+			grantee = s.keyring.GetAddr(1)
+
+			callArgs = testutiltypes.CallArgs{
+				ContractABI: s.precompile.ABI,
+			}
+			defaultLogCheck = testutil.LogCheckArgs{
+				ABIEvents: s.precompile.ABI.Events,
+			}
+			passCheck = defaultLogCheck.WithExpPass(true)
+
+			precompileAddr := s.precompile.Address()
+			txArgs = evmtypes.EvmTxArgs{
+				To:       &precompileAddr,
+				GasLimit: 300_000,
+			}
+		})
+
+		// allowance queries the allowance of the granter to the grantee through the precompile
+		allowance := func() feegrant.Allowance {
+			args := callArgs
+			args.MethodName = feegrant.AllowanceMethod
+			args.Args = []interface{}{granter, grantee}
+
+			_, ethRes, err := s.factory.CallContractAndCheckLogs(s.keyring.GetPrivKey(2), txArgs, args, passCheck)
+			Expect(err).To(BeNil(), "error while calling the precompile")
+			Expect(s.network.NextBlock()).To(BeNil())
+
+			var out struct {
+				Allowance feegrant.Allowance `abi:"allowance"`
+			}
+			Expect(s.precompile.UnpackIntoInterface(&out, feegrant.AllowanceMethod, ethRes.Ret)).To(BeNil())
+			return out.Allowance
+		}
+
+		// grantBasicAllowance grants a basic allowance of the spend limit from
+		// the granter to the grantee through the precompile
+		grantBasicAllowance := func(spendLimit *big.Int) {
+			args := callArgs
+			args.MethodName = feegrant.GrantBasicAllowanceMethod
+			args.Args = []interface{}{granter, grantee, s.cmnCoins(spendLimit), int64(0)}
+
+			check := passCheck.WithExpEvents(feegrant.EventTypeGrantAllowance)
+			_, _, err := s.factory.CallContractAndCheckLogs(s.keyring.GetPrivKey(0), txArgs, args, check)
+			Expect(err).To(BeNil(), "error while calling the precompile")
+			Expect(s.network.NextBlock()).To(BeNil())
+		}
+
+		// balanceOf returns the bank balance of the address in the base denom
+		balanceOf := func(addr common.Address) *big.Int {
+			balance := s.network.App.GetBankKeeper().GetBalance(s.network.GetContext(), addr.Bytes(), s.network.GetBaseDenom())
+			return balance.Amount.BigInt()
+		}
+
+		It("should grant a basic allowance and return it", func() {
+			grantBasicAllowance(spendLimit)
+
+			out := allowance()
+			Expect(out.Granter).To(Equal(granter))
+			Expect(out.Grantee).To(Equal(grantee))
+			Expect(out.AllowanceType).To(Equal(basicAllowanceType))
+			Expect(out.SpendLimit).To(Equal(s.cmnCoins(spendLimit)))
+		})
+
+		It("should grant a periodic allowance and return it", func() {
+			callArgs.MethodName = feegrant.GrantPeriodicAllowanceMethod
+			callArgs.Args = []interface{}{granter, grantee, s.cmnCoins(spendLimit), int64(0), int64(3600), s.cmnCoins(big.NewInt(1e17))}
+
+			check := passCheck.WithExpEvents(feegrant.EventTypeGrantAllowance)
+			_, _, err := s.factory.CallContractAndCheckLogs(s.keyring.GetPrivKey(0), txArgs, callArgs, check)
+			Expect(err).To(BeNil(), "error while calling the precompile")
+			Expect(s.network.NextBlock()).To(BeNil())
+
+			out := allowance()
+			Expect(out.AllowanceType).To(Equal(periodicAllowanceType))
+			Expect(out.Period).To(Equal(int64(3600)))
+			Expect(out.PeriodSpendLimit).To(Equal(s.cmnCoins(big.NewInt(1e17))))
+		})
+
+		It("should revoke an allowance", func() {
+			grantBasicAllowance(spendLimit)
+
+			callArgs.MethodName = feegrant.RevokeAllowanceMethod
+			callArgs.Args = []interface{}{granter, grantee}
+
+			check := passCheck.WithExpEvents(feegrant.EventTypeRevokeAllowance)
+			_, _, err := s.factory.CallContractAndCheckLogs(s.keyring.GetPrivKey(0), txArgs, callArgs, check)
+			Expect(err).To(BeNil(), "error while calling the precompile")
+			Expect(s.network.NextBlock()).To(BeNil())
+
+			_, err = s.network.App.GetFeeGrantKeeper().GetAllowance(s.network.GetContext(), granter.Bytes(), grantee.Bytes())
+			Expect(err).To(HaveOccurred())
+		})
+
+		Context("paying the fees of the Ethereum transactions", func() {
+			var (
+				granteePriv cryptotypes.PrivKey
+				gasPrice    *big.Int
+			)
+
+			const gasLimit = uint64(50_000)
+
+			BeforeEach(func() {
+				// the grantee has no funds to pay the fees
+				grantee, granteePriv = testutiltx.NewAddrKey()
+
+				baseFeeRes, err := s.grpcHandler.GetEvmBaseFee()
+				Expect(err).To(BeNil())
+				gasPrice = baseFeeRes.BaseFee.BigInt()
+			})
+
+			// grantEVMAllowance grants a basic allowance of the spend limit
+			// restricted to the EVM transactions from the granter to the
+			// grantee, which the precompile can't grant
+			grantEVMAllowance := func(spendLimit *big.Int) {
+				allowance, err := sdkfeegrant.NewAllowedMsgAllowance(
+					&sdkfeegrant.BasicAllowance{SpendLimit: s.coins(spendLimit)},
+					[]string{sdk.MsgTypeURL(&evmtypes.MsgEthereumTx{})},
+				)
+				Expect(err).To(BeNil())
+				msg, err := sdkfeegrant.NewMsgGrantAllowance(allowance, granter.Bytes(), grantee.Bytes())
+				Expect(err).To(BeNil())
+
+				res, err := s.factory.CommitCosmosTx(s.keyring.GetPrivKey(0), commonfactory.CosmosTxArgs{
+					Msgs: []sdk.Msg{msg},
+				})
+				Expect(err).To(BeNil())
+				Expect(res.IsOK()).To(BeTrue(), "tx failed: %s", res.Log)
+			}
+
+			// executeGrantedTx executes a transfer of zero value of the grantee
+			// whose fees are paid by the granter
+			executeGrantedTx := func() abcitypes.ExecTxResult {
+				recipient := testutiltx.GenerateAddress()
+				tx, err := s.factory.GenerateSignedEthTx(granteePriv, evmtypes.EvmTxArgs{
+					To:       &recipient,
+					GasLimit: gasLimit,
+					GasPrice: gasPrice,
+				})
+				Expect(err).To(BeNil())
+
+				txConfig := s.network.GetEncodingConfig().TxConfig
+				builder, err := txConfig.WrapTxBuilder(tx)
+				Expect(err).To(BeNil())
+				builder.SetFeeGranter(granter.Bytes())
+
+				bz, err := txConfig.TxEncoder()(builder.GetTx())
+				Expect(err).To(BeNil())
+
+				res, err := s.network.BroadcastTxSync(bz)
+				Expect(err).To(BeNil())
+				Expect(s.network.NextBlock()).To(BeNil())
+				return res
+			}
+
+			It("should charge the fees to the granter within the allowance", func() {
+				grantEVMAllowance(spendLimit)
+				granterBalance := balanceOf(granter)
+
+				res := executeGrantedTx()
+				Expect(res.IsOK()).To(BeTrue(), "tx failed: %s", res.Log)
+
+				// the leftover gas is refunded to the granter
+				fees := new(big.Int).Mul(big.NewInt(res.GasUsed), gasPrice)
+				Expect(balanceOf(granter)).To(Equal(new(big.Int).Sub(granterBalance, fees)))
+				Expect(balanceOf(grantee).Sign()).To(BeZero())
+
+				// the allowance is spent by the fees of the whole gas limit
+				feeAllowance, err := s.network.App.GetFeeGrantKeeper().GetAllowance(s.network.GetContext(), granter.Bytes(), grantee.Bytes())
+				Expect(err).To(BeNil())
+				spent := new(big.Int).Mul(new(big.Int).SetUint64(gasLimit), gasPrice)
+				basicAllowance, err := feeAllowance.(*sdkfeegrant.AllowedMsgAllowance).GetAllowance()
+				Expect(err).To(BeNil())
+				Expect(basicAllowance.(*sdkfeegrant.BasicAllowance).SpendLimit).To(Equal(s.coins(new(big.Int).Sub(spendLimit, spent))))
+			})
+
+			It("should reject the transaction without an allowance", func() {
+				granterBalance := balanceOf(granter)
+
+				res := executeGrantedTx()
+				Expect(res.IsOK()).To(BeFalse())
+				Expect(res.Log).To(ContainSubstring("does not allow to pay fees"))
+
+				Expect(balanceOf(granter)).To(Equal(granterBalance))
+			})
+
+			It("should reject the transaction with an allowance not restricted to the EVM transactions", func() {
+				// the granter didn't opt in to pay the fees of the EVM
+				// transactions, which anyone relaying them can wrap with it
+				grantBasicAllowance(spendLimit)
+				granterBalance := balanceOf(granter)
+
+				res := executeGrantedTx()
+				Expect(res.IsOK()).To(BeFalse())
+				Expect(res.Log).To(ContainSubstring("does not allow to pay fees for the EVM transactions"))
+
+				Expect(balanceOf(granter)).To(Equal(granterBalance))
+			})
+
+			It("should reject the transaction when the allowance is exceeded", func() {
+				grantEVMAllowance(big.NewInt(1))
+
+				res := executeGrantedTx()
+				Expect(res.IsOK()).To(BeFalse())
+				Expect(res.Log).To(ContainSubstring("fee limit exceeded"))
+			})
+		})
+	})
+
+	// Run Ginkgo integration tests
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Feegrant Precompile Suite")
+}
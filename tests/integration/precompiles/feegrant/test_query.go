@@ -0,0 +1,329 @@
+package feegrant
+
+import (
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+	"github.com/cosmos/evm/precompiles/feegrant"
+	"github.com/cosmos/evm/precompiles/testutil"
+
+	sdkfeegrant "cosmossdk.io/x/feegrant"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+const (
+	basicAllowanceType    = "/cosmos.feegrant.v1beta1.BasicAllowance"
+	periodicAllowanceType = "/cosmos.feegrant.v1beta1.PeriodicAllowance"
+)
+
+func (s *PrecompileTestSuite) TestAllowance() {
+	method := s.precompile.Methods[feegrant.AllowanceMethod]
+	var granter, grantee common.Address
+	spendLimit := big.NewInt(1000)
+
+	testCases := []struct {
+		name         string
+		malleate     func() []interface{}
+		expError     bool
+		errContains  string
+		expAllowance func() feegrant.Allowance
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 2, 0),
+			nil,
+		},
+		{
+			"fail - invalid grantee address",
+			func() []interface{} {
+				return []interface{}{granter, common.Address{}}
+			},
+			true,
+			"invalid hex address",
+			nil,
+		},
+		{
+			"fail - no allowance",
+			func() []interface{} {
+				return []interface{}{granter, grantee}
+			},
+			true,
+			"fee-grant not found",
+			nil,
+		},
+		{
+			"success - basic allowance",
+			func() []interface{} {
+				s.grantBasic(granter, grantee, spendLimit)
+				return []interface{}{granter, grantee}
+			},
+			false,
+			"",
+			func() feegrant.Allowance {
+				return feegrant.Allowance{
+					Granter:          granter,
+					Grantee:          grantee,
+					AllowanceType:    basicAllowanceType,
+					SpendLimit:       s.cmnCoins(spendLimit),
+					PeriodSpendLimit: []cmn.Coin{},
+					PeriodCanSpend:   []cmn.Coin{},
+					AllowedMessages:  []string{},
+				}
+			},
+		},
+		{
+			"success - periodic allowance",
+			func() []interface{} {
+				s.grantPeriodic(granter, grantee, time.Hour, spendLimit)
+				return []interface{}{granter, grantee}
+			},
+			false,
+			"",
+			func() feegrant.Allowance {
+				return feegrant.Allowance{
+					Granter:          granter,
+					Grantee:          grantee,
+					AllowanceType:    periodicAllowanceType,
+					SpendLimit:       []cmn.Coin{},
+					Period:           3600,
+					PeriodSpendLimit: s.cmnCoins(spendLimit),
+					PeriodCanSpend:   s.cmnCoins(spendLimit),
+					PeriodReset:      s.network.GetContext().BlockTime().Add(time.Hour).Unix(),
+					AllowedMessages:  []string{},
+				}
+			},
+		},
+		{
+			"success - allowance restricted to some messages",
+			func() []interface{} {
+				basic := &sdkfeegrant.BasicAllowance{SpendLimit: s.coins(spendLimit)}
+				allowance, err := sdkfeegrant.NewAllowedMsgAllowance(basic, []string{ethereumTxTypeURL})
+				s.Require().NoError(err)
+				s.grantAllowance(granter, grantee, allowance)
+				return []interface{}{granter, grantee}
+			},
+			false,
+			"",
+			func() feegrant.Allowance {
+				return feegrant.Allowance{
+					Granter:          granter,
+					Grantee:          grantee,
+					AllowanceType:    "/cosmos.feegrant.v1beta1.AllowedMsgAllowance",
+					SpendLimit:       s.cmnCoins(spendLimit),
+					PeriodSpendLimit: []cmn.Coin{},
+					PeriodCanSpend:   []cmn.Coin{},
+					AllowedMessages:  []string{ethereumTxTypeURL},
+				}
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			granter, grantee = s.keyring.GetAddr(0), s.keyring.GetAddr(1)
+
+			contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), granter, s.precompile.Address(), 200_000)
+
+			bz, err := s.precompile.Allowance(ctx, contract, &method, tc.malleate())
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+			s.Require().NoError(err)
+
+			var out struct {
+				Allowance feegrant.Allowance `abi:"allowance"`
+			}
+			s.Require().NoError(s.precompile.UnpackIntoInterface(&out, feegrant.AllowanceMethod, bz))
+			s.Require().Equal(tc.expAllowance(), out.Allowance)
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestAllowances() {
+	method := s.precompile.Methods[feegrant.AllowancesMethod]
+	var granters []common.Address
+	var grantee common.Address
+
+	testCases := []struct {
+		name          string
+		malleate      func() []interface{}
+		expError      bool
+		errContains   string
+		expAllowances int
+		expTotal      uint64
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 2, 0),
+			0,
+			0,
+		},
+		{
+			"fail - invalid grantee address",
+			func() []interface{} {
+				return []interface{}{common.Address{}, query.PageRequest{}}
+			},
+			true,
+			"invalid hex address",
+			0,
+			0,
+		},
+		{
+			"success - no allowances",
+			func() []interface{} {
+				return []interface{}{grantee, query.PageRequest{}}
+			},
+			false,
+			"",
+			0,
+			0,
+		},
+		{
+			"success - allowances of all the granters",
+			func() []interface{} {
+				for _, granter := range granters {
+					s.grantBasic(granter, grantee, big.NewInt(1000))
+				}
+				return []interface{}{grantee, query.PageRequest{CountTotal: true}}
+			},
+			false,
+			"",
+			2,
+			2,
+		},
+		{
+			"success - paginated allowances",
+			func() []interface{} {
+				for _, granter := range granters {
+					s.grantBasic(granter, grantee, big.NewInt(1000))
+				}
+				return []interface{}{grantee, query.PageRequest{Limit: 1, CountTotal: true}}
+			},
+			false,
+			"",
+			1,
+			2,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			granters = []common.Address{s.keyring.GetAddr(0), s.keyring.GetAddr(1)}
+			grantee = s.keyring.GetAddr(2)
+
+			contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), grantee, s.precompile.Address(), 200_000)
+
+			bz, err := s.precompile.Allowances(ctx, contract, &method, tc.malleate())
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+			s.Require().NoError(err)
+
+			var out feegrant.AllowancesOutput
+			s.Require().NoError(s.precompile.UnpackIntoInterface(&out, feegrant.AllowancesMethod, bz))
+			s.Require().Len(out.Allowances, tc.expAllowances)
+			s.Require().Equal(tc.expTotal, out.PageResponse.Total)
+			for _, allowance := range out.Allowances {
+				s.Require().Contains(granters, allowance.Granter)
+				s.Require().Equal(grantee, allowance.Grantee)
+				s.Require().Equal(basicAllowanceType, allowance.AllowanceType)
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestAllowancesByGranter() {
+	method := s.precompile.Methods[feegrant.AllowancesByGranterMethod]
+	var granter common.Address
+	var grantees []common.Address
+
+	testCases := []struct {
+		name          string
+		malleate      func() []interface{}
+		expError      bool
+		errContains   string
+		expAllowances int
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 2, 0),
+			0,
+		},
+		{
+			"fail - invalid granter address",
+			func() []interface{} {
+				return []interface{}{common.Address{}, query.PageRequest{}}
+			},
+			true,
+			"invalid hex address",
+			0,
+		},
+		{
+			"success - no allowances",
+			func() []interface{} {
+				return []interface{}{granter, query.PageRequest{}}
+			},
+			false,
+			"",
+			0,
+		},
+		{
+			"success - allowances of all the grantees",
+			func() []interface{} {
+				for _, grantee := range grantees {
+					s.grantBasic(granter, grantee, big.NewInt(1000))
+				}
+				return []interface{}{granter, query.PageRequest{}}
+			},
+			false,
+			"",
+			2,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			granter = s.keyring.GetAddr(0)
+			grantees = []common.Address{s.keyring.GetAddr(1), s.keyring.GetAddr(2)}
+
+			contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), granter, s.precompile.Address(), 200_000)
+
+			bz, err := s.precompile.AllowancesByGranter(ctx, contract, &method, tc.malleate())
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+			s.Require().NoError(err)
+
+			var out feegrant.AllowancesOutput
+			s.Require().NoError(s.precompile.UnpackIntoInterface(&out, feegrant.AllowancesByGranterMethod, bz))
+			s.Require().Len(out.Allowances, tc.expAllowances)
+			for _, allowance := range out.Allowances {
+				s.Require().Equal(granter, allowance.Granter)
+				s.Require().Contains(grantees, allowance.Grantee)
+			}
+		})
+	}
+}
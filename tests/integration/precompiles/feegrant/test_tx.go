@@ -0,0 +1,312 @@
+package feegrant
+
+import (
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+	"github.com/cosmos/evm/precompiles/feegrant"
+	"github.com/cosmos/evm/precompiles/testutil"
+	utiltx "github.com/cosmos/evm/testutil/tx"
+
+	sdkfeegrant "cosmossdk.io/x/feegrant"
+)
+
+func (s *PrecompileTestSuite) TestGrantBasicAllowance() {
+	method := s.precompile.Methods[feegrant.GrantBasicAllowanceMethod]
+	var granter, grantee common.Address
+	spendLimit := big.NewInt(1000)
+
+	testCases := []struct {
+		name          string
+		malleate      func() []interface{}
+		expError      bool
+		errContains   string
+		expSpendLimit bool
+		expExpiration int64
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 4, 0),
+			false,
+			0,
+		},
+		{
+			"fail - invalid grantee address",
+			func() []interface{} {
+				return []interface{}{granter, common.Address{}, s.cmnCoins(spendLimit), int64(0)}
+			},
+			true,
+			"invalid hex address",
+			false,
+			0,
+		},
+		{
+			"fail - msg.sender address does not match the granter address",
+			func() []interface{} {
+				return []interface{}{utiltx.GenerateAddress(), grantee, s.cmnCoins(spendLimit), int64(0)}
+			},
+			true,
+			"does not match the requester address",
+			false,
+			0,
+		},
+		{
+			"fail - self grant",
+			func() []interface{} {
+				return []interface{}{granter, granter, s.cmnCoins(spendLimit), int64(0)}
+			},
+			true,
+			"cannot self-grant fee authorization",
+			false,
+			0,
+		},
+		{
+			"fail - zero spend limit",
+			func() []interface{} {
+				return []interface{}{granter, grantee, s.cmnCoins(big.NewInt(0)), int64(0)}
+			},
+			true,
+			"send amount is invalid",
+			false,
+			0,
+		},
+		{
+			"fail - expiration in the past",
+			func() []interface{} {
+				return []interface{}{granter, grantee, s.cmnCoins(spendLimit), s.network.GetContext().BlockTime().Unix() - 1}
+			},
+			true,
+			"expiration is before current block time",
+			false,
+			0,
+		},
+		{
+			"fail - allowance already exists",
+			func() []interface{} {
+				s.grantBasic(granter, grantee, spendLimit)
+				return []interface{}{granter, grantee, s.cmnCoins(spendLimit), int64(0)}
+			},
+			true,
+			"fee allowance already exists",
+			false,
+			0,
+		},
+		{
+			"success - allowance without spend limit",
+			func() []interface{} {
+				return []interface{}{granter, grantee, []cmn.Coin{}, int64(0)}
+			},
+			false,
+			"",
+			false,
+			0,
+		},
+		{
+			"success - allowance with spend limit and expiration",
+			func() []interface{} {
+				return []interface{}{granter, grantee, s.cmnCoins(spendLimit), s.network.GetContext().BlockTime().Unix() + 3600}
+			},
+			false,
+			"",
+			true,
+			3600,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			granter, grantee = s.keyring.GetAddr(0), s.keyring.GetAddr(1)
+
+			contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), granter, s.precompile.Address(), 200_000)
+
+			res, err := s.precompile.GrantBasicAllowance(ctx, contract, s.network.GetStateDB(), &method, tc.malleate())
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(cmn.TrueValue, res)
+
+			allowance, err := s.network.App.GetFeeGrantKeeper().GetAllowance(ctx, granter.Bytes(), grantee.Bytes())
+			s.Require().NoError(err)
+			basic, ok := allowance.(*sdkfeegrant.BasicAllowance)
+			s.Require().True(ok)
+			if tc.expSpendLimit {
+				s.Require().Equal(s.coins(spendLimit), basic.SpendLimit)
+			} else {
+				s.Require().Nil(basic.SpendLimit)
+			}
+			if tc.expExpiration == 0 {
+				s.Require().Nil(basic.Expiration)
+			} else {
+				s.Require().Equal(ctx.BlockTime().Unix()+tc.expExpiration, basic.Expiration.Unix())
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestGrantPeriodicAllowance() {
+	method := s.precompile.Methods[feegrant.GrantPeriodicAllowanceMethod]
+	var granter, grantee common.Address
+	spendLimit := big.NewInt(1000)
+	periodSpendLimit := big.NewInt(100)
+	period := int64(3600)
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 6, 0),
+		},
+		{
+			"fail - zero period",
+			func() []interface{} {
+				return []interface{}{granter, grantee, s.cmnCoins(spendLimit), int64(0), int64(0), s.cmnCoins(periodSpendLimit)}
+			},
+			true,
+			fmt.Sprintf(feegrant.ErrInvalidPeriod, 0),
+		},
+		{
+			"fail - msg.sender address does not match the granter address",
+			func() []interface{} {
+				return []interface{}{utiltx.GenerateAddress(), grantee, s.cmnCoins(spendLimit), int64(0), period, s.cmnCoins(periodSpendLimit)}
+			},
+			true,
+			"does not match the requester address",
+		},
+		{
+			"fail - period spend limit in another denom than the spend limit",
+			func() []interface{} {
+				return []interface{}{granter, grantee, s.cmnCoins(spendLimit), int64(0), period, []cmn.Coin{{Denom: "other", Amount: periodSpendLimit}}}
+			},
+			true,
+			"period spend limit has different currency than basic spend limit",
+		},
+		{
+			"success - periodic allowance",
+			func() []interface{} {
+				return []interface{}{granter, grantee, s.cmnCoins(spendLimit), int64(0), period, s.cmnCoins(periodSpendLimit)}
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			granter, grantee = s.keyring.GetAddr(0), s.keyring.GetAddr(1)
+
+			contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), granter, s.precompile.Address(), 200_000)
+
+			res, err := s.precompile.GrantPeriodicAllowance(ctx, contract, s.network.GetStateDB(), &method, tc.malleate())
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(cmn.TrueValue, res)
+
+			allowance, err := s.network.App.GetFeeGrantKeeper().GetAllowance(ctx, granter.Bytes(), grantee.Bytes())
+			s.Require().NoError(err)
+			periodic, ok := allowance.(*sdkfeegrant.PeriodicAllowance)
+			s.Require().True(ok)
+			s.Require().Equal(s.coins(spendLimit), periodic.Basic.SpendLimit)
+			s.Require().Equal(time.Duration(period)*time.Second, periodic.Period)
+			s.Require().Equal(s.coins(periodSpendLimit), periodic.PeriodSpendLimit)
+			s.Require().Equal(s.coins(periodSpendLimit), periodic.PeriodCanSpend)
+			s.Require().Equal(ctx.BlockTime().Unix()+period, periodic.PeriodReset.Unix())
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestRevokeAllowance() {
+	method := s.precompile.Methods[feegrant.RevokeAllowanceMethod]
+	var granter, grantee common.Address
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 2, 0),
+		},
+		{
+			"fail - invalid granter address",
+			func() []interface{} {
+				return []interface{}{common.Address{}, grantee}
+			},
+			true,
+			"invalid hex address",
+		},
+		{
+			"fail - msg.sender address does not match the granter address",
+			func() []interface{} {
+				return []interface{}{utiltx.GenerateAddress(), grantee}
+			},
+			true,
+			"does not match the requester address",
+		},
+		{
+			"fail - no allowance",
+			func() []interface{} {
+				return []interface{}{granter, grantee}
+			},
+			true,
+			"fee-grant not found",
+		},
+		{
+			"success - revoke the allowance",
+			func() []interface{} {
+				s.grantBasic(granter, grantee, big.NewInt(1000))
+				return []interface{}{granter, grantee}
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			granter, grantee = s.keyring.GetAddr(0), s.keyring.GetAddr(1)
+
+			contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), granter, s.precompile.Address(), 200_000)
+
+			res, err := s.precompile.RevokeAllowance(ctx, contract, s.network.GetStateDB(), &method, tc.malleate())
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(cmn.TrueValue, res)
+
+			_, err = s.network.App.GetFeeGrantKeeper().GetAllowance(ctx, granter.Bytes(), grantee.Bytes())
+			s.Require().ErrorContains(err, "fee-grant not found")
+		})
+	}
+}
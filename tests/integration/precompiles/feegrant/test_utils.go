@@ -0,0 +1,57 @@
+package feegrant
+
+import (
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	sdkmath "cosmossdk.io/math"
+	sdkfeegrant "cosmossdk.io/x/feegrant"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ethereumTxTypeURL is the type URL of the MsgEthereumTx, whose fees can be
+// paid by a fee granter.
+var ethereumTxTypeURL = sdk.MsgTypeURL(&evmtypes.MsgEthereumTx{})
+
+// coins returns the given amount in the base denom of the network.
+func (s *PrecompileTestSuite) coins(amount *big.Int) sdk.Coins {
+	return sdk.NewCoins(sdk.NewCoin(s.network.GetBaseDenom(), sdkmath.NewIntFromBigInt(amount)))
+}
+
+// cmnCoins returns the given amount in the base denom of the network in the
+// precompile representation.
+func (s *PrecompileTestSuite) cmnCoins(amount *big.Int) []cmn.Coin {
+	return []cmn.Coin{{Denom: s.network.GetBaseDenom(), Amount: amount}}
+}
+
+// grantBasic grants a basic allowance of the spend limit, without expiration,
+// from the granter to the grantee.
+func (s *PrecompileTestSuite) grantBasic(granter, grantee common.Address, spendLimit *big.Int) {
+	s.grantAllowance(granter, grantee, &sdkfeegrant.BasicAllowance{SpendLimit: s.coins(spendLimit)})
+}
+
+// grantPeriodic grants a periodic allowance of the period spend limit, without
+// total spend limit, from the granter to the grantee.
+func (s *PrecompileTestSuite) grantPeriodic(granter, grantee common.Address, period time.Duration, periodSpendLimit *big.Int) {
+	s.grantAllowance(granter, grantee, &sdkfeegrant.PeriodicAllowance{
+		Period:           period,
+		PeriodSpendLimit: s.coins(periodSpendLimit),
+		PeriodCanSpend:   s.coins(periodSpendLimit),
+		PeriodReset:      s.network.GetContext().BlockTime().Add(period),
+	})
+}
+
+// grantAllowance grants the fee allowance from the granter to the grantee. It
+// is also used by the Ginkgo tests, so it panics instead of using the suite
+// assertions.
+func (s *PrecompileTestSuite) grantAllowance(granter, grantee common.Address, allowance sdkfeegrant.FeeAllowanceI) {
+	if err := s.network.App.GetFeeGrantKeeper().GrantAllowance(s.network.GetContext(), granter.Bytes(), grantee.Bytes(), allowance); err != nil {
+		panic(err)
+	}
+}
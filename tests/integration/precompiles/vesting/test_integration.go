@@ -0,0 +1,177 @@
+package vesting
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	//nolint:revive // dot imports are fine for Ginkgo
+	. "github.com/onsi/ginkgo/v2"
+	//nolint:revive // dot imports are fine for Ginkgo
+	. "github.com/onsi/gomega"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+	"github.com/cosmos/evm/precompiles/testutil"
+	"github.com/cosmos/evm/precompiles/vesting"
+	"github.com/cosmos/evm/testutil/integration/evm/network"
+	testutiltx "github.com/cosmos/evm/testutil/tx"
+	testutiltypes "github.com/cosmos/evm/testutil/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+)
+
+// General variables used for integration tests
+var (
+	// callArgs are the default arguments for calling the precompile
+	callArgs testutiltypes.CallArgs
+	// txArgs are the EVM transaction arguments to use in the transactions
+	txArgs evmtypes.EvmTxArgs
+	// defaultLogCheck instantiates a log check arguments struct with the precompile ABI events populated.
+	defaultLogCheck testutil.LogCheckArgs
+	// passCheck defines the arguments to check if the precompile returns no error
+	passCheck testutil.LogCheckArgs
+)
+
+func TestPrecompileIntegrationTestSuite(t *testing.T, create network.CreateEvmApp, options ...network.ConfigOption) {
+	_ = Describe("Calling vesting precompile from EOA", func() {
+		var (
+			s           *PrecompileTestSuite
+			funder      common.Address
+			vestingAddr common.Address
+			amount      = big.NewInt(1e18)
+		)
+
+		BeforeEach(func() {
+			s = NewPrecompileTestSuite(create, options...)
+			s.SetupTest()
+
+			funder = s.keyring.GetAddr(0)
+			vestingAddr = s.keyring.GetAddr(1)
+
+			callArgs = testutiltypes.CallArgs{
+				ContractABI: s.precompile.ABI,
+			}
+			defaultLogCheck = testutil.LogCheckArgs{
+				ABIEvents: s.precompile.ABI.Events,
+			}
+			passCheck = defaultLogCheck.WithExpPass(true)
+
+			precompileAddr := s.precompile.Address()
+			txArgs = evmtypes.EvmTxArgs{
+				To:       &precompileAddr,
+				GasLimit: 300_000,
+			}
+		})
+
+		// balances queries the balances of the vesting account through the precompile
+		balances := func() vesting.BalancesOutput {
+			args := callArgs
+			args.MethodName = vesting.BalancesMethod
+			args.Args = []interface{}{vestingAddr}
+
+			_, ethRes, err := s.factory.CallContractAndCheckLogs(s.keyring.GetPrivKey(2), txArgs, args, passCheck)
+			Expect(err).To(BeNil(), "error while calling the precompile")
+			Expect(s.network.NextBlock()).To(BeNil())
+
+			var out vesting.BalancesOutput
+			Expect(s.precompile.UnpackIntoInterface(&out, vesting.BalancesMethod, ethRes.Ret)).To(BeNil())
+			return out
+		}
+
+		// balanceOf returns the bank balance of the address in the base denom
+		balanceOf := func(addr common.Address) *big.Int {
+			balance := s.network.App.GetBankKeeper().GetBalance(s.network.GetContext(), addr.Bytes(), s.network.GetBaseDenom())
+			return balance.Amount.BigInt()
+		}
+
+		Describe("with a clawback vesting account", func() {
+			var initialBalance *big.Int
+
+			BeforeEach(func() {
+				callArgs.MethodName = vesting.CreateClawbackVestingAccountMethod
+				callArgs.Args = []interface{}{funder, vestingAddr}
+
+				check := passCheck.WithExpEvents(vesting.EventTypeCreateClawbackVestingAccount)
+				_, _, err := s.factory.CallContractAndCheckLogs(s.keyring.GetPrivKey(1), txArgs, callArgs, check)
+				Expect(err).To(BeNil(), "error while calling the precompile")
+				Expect(s.network.NextBlock()).To(BeNil())
+
+				// the vesting account paid the fees of its conversion
+				initialBalance = balanceOf(vestingAddr)
+
+				callArgs.MethodName = vesting.FundVestingAccountMethod
+				callArgs.Args = []interface{}{funder, vestingAddr, s.startTime(), s.periods(amount)}
+
+				check = passCheck.WithExpEvents(vesting.EventTypeFundVestingAccount)
+				_, _, err = s.factory.CallContractAndCheckLogs(s.keyring.GetPrivKey(0), txArgs, callArgs, check)
+				Expect(err).To(BeNil(), "error while calling the precompile")
+				Expect(s.network.NextBlock()).To(BeNil())
+			})
+
+			It("should lock the funded coins until they vest", func() {
+				out := balances()
+				Expect(toSdkCoins(out.Locked).String()).To(Equal(s.coins(amount).String()))
+				Expect(toSdkCoins(out.Unvested).String()).To(Equal(s.coins(amount).String()))
+				Expect(out.Vested).To(BeEmpty())
+
+				Expect(balanceOf(vestingAddr)).To(Equal(new(big.Int).Add(initialBalance, amount)))
+			})
+
+			It("should claw back the unvested coins to the funder", func() {
+				callArgs.MethodName = vesting.ClawbackMethod
+				callArgs.Args = []interface{}{funder, vestingAddr, common.Address{}}
+
+				check := passCheck.WithExpEvents(vesting.EventTypeClawback)
+				_, ethRes, err := s.factory.CallContractAndCheckLogs(s.keyring.GetPrivKey(0), txArgs, callArgs, check)
+				Expect(err).To(BeNil(), "error while calling the precompile")
+				Expect(s.network.NextBlock()).To(BeNil())
+
+				var coins []cmn.Coin
+				Expect(s.precompile.UnpackIntoInterface(&coins, vesting.ClawbackMethod, ethRes.Ret)).To(BeNil())
+				Expect(toSdkCoins(coins).String()).To(Equal(s.coins(amount).String()))
+
+				out := balances()
+				Expect(out.Locked).To(BeEmpty())
+				Expect(out.Unvested).To(BeEmpty())
+
+				Expect(balanceOf(vestingAddr)).To(Equal(initialBalance))
+			})
+
+			It("should not claw back when the caller is not the funder", func() {
+				callArgs.MethodName = vesting.ClawbackMethod
+				callArgs.Args = []interface{}{funder, vestingAddr, common.Address{}}
+
+				check := defaultLogCheck.WithErrContains(
+					cmn.ErrRequesterIsNotMsgSender, s.keyring.GetAddr(2), funder,
+				)
+				_, _, err := s.factory.CallContractAndCheckLogs(s.keyring.GetPrivKey(2), txArgs, callArgs, check)
+				Expect(err).To(BeNil(), "error while calling the precompile")
+				Expect(s.network.NextBlock()).To(BeNil())
+
+				out := balances()
+				Expect(toSdkCoins(out.Unvested).String()).To(Equal(s.coins(amount).String()))
+			})
+		})
+
+		It("should create a periodic vesting account funded by the caller", func() {
+			vestingAddr = testutiltx.GenerateAddress()
+
+			callArgs.MethodName = vesting.CreatePeriodicVestingAccountMethod
+			callArgs.Args = []interface{}{funder, vestingAddr, s.startTime(), s.periods(amount)}
+
+			check := passCheck.WithExpEvents(vesting.EventTypeCreatePeriodicVestingAccount)
+			_, _, err := s.factory.CallContractAndCheckLogs(s.keyring.GetPrivKey(0), txArgs, callArgs, check)
+			Expect(err).To(BeNil(), "error while calling the precompile")
+			Expect(s.network.NextBlock()).To(BeNil())
+
+			Expect(balanceOf(vestingAddr)).To(Equal(amount))
+
+			out := balances()
+			Expect(toSdkCoins(out.Unvested).String()).To(Equal(s.coins(amount).String()))
+		})
+	})
+
+	// Run Ginkgo integration tests
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Vesting Precompile Suite")
+}
@@ -0,0 +1,113 @@
+package vesting
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+	"github.com/cosmos/evm/precompiles/testutil"
+	"github.com/cosmos/evm/precompiles/vesting"
+	utiltx "github.com/cosmos/evm/testutil/tx"
+	vestingtypes "github.com/cosmos/evm/x/vesting/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (s *PrecompileTestSuite) TestBalances() {
+	method := s.precompile.Methods[vesting.BalancesMethod]
+	var funder, vestingAddr common.Address
+	amount := big.NewInt(1000)
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		expError    bool
+		errContains string
+		expUnvested sdk.Coins
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 1, 0),
+			nil,
+		},
+		{
+			"fail - invalid address",
+			func() []interface{} {
+				return []interface{}{common.Address{}}
+			},
+			true,
+			"invalid hex address",
+			nil,
+		},
+		{
+			"fail - not a vesting account",
+			func() []interface{} {
+				return []interface{}{utiltx.GenerateAddress()}
+			},
+			true,
+			vestingtypes.ErrNotVestingAccount.Error(),
+			nil,
+		},
+		{
+			"success - account not funded",
+			func() []interface{} {
+				s.createClawbackVestingAccount(funder, vestingAddr)
+				return []interface{}{vestingAddr}
+			},
+			false,
+			"",
+			sdk.NewCoins(),
+		},
+		{
+			"success - funded account",
+			func() []interface{} {
+				s.createClawbackVestingAccount(funder, vestingAddr)
+				s.fundVestingAccount(funder, vestingAddr, amount)
+				return []interface{}{vestingAddr}
+			},
+			false,
+			"",
+			s.coins(amount),
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			funder, vestingAddr = s.keyring.GetAddr(0), s.keyring.GetAddr(1)
+
+			args := tc.malleate()
+			contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(2), s.precompile.Address(), 200_000)
+
+			bz, err := s.precompile.Balances(ctx, contract, &method, args)
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+			s.Require().NoError(err)
+
+			var out vesting.BalancesOutput
+			s.Require().NoError(s.precompile.UnpackIntoInterface(&out, vesting.BalancesMethod, bz))
+
+			s.Require().Equal(tc.expUnvested.String(), toSdkCoins(out.Unvested).String())
+			s.Require().Equal(tc.expUnvested.String(), toSdkCoins(out.Locked).String())
+			s.Require().Empty(out.Vested)
+		})
+	}
+}
+
+// toSdkCoins converts the coins returned by the precompile into sdk.Coins.
+func toSdkCoins(coins []cmn.Coin) sdk.Coins {
+	res := sdk.NewCoins()
+	for _, coin := range coins {
+		res = res.Add(coin.ToSDKType())
+	}
+	return res
+}
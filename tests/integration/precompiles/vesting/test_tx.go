@@ -0,0 +1,420 @@
+package vesting
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+	"github.com/cosmos/evm/precompiles/testutil"
+	"github.com/cosmos/evm/precompiles/vesting"
+	utiltx "github.com/cosmos/evm/testutil/tx"
+	vestingtypes "github.com/cosmos/evm/x/vesting/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkvesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+)
+
+func (s *PrecompileTestSuite) TestCreateClawbackVestingAccount() {
+	method := s.precompile.Methods[vesting.CreateClawbackVestingAccountMethod]
+	var funder, vestingAddr common.Address
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 2, 0),
+		},
+		{
+			"fail - invalid funder address",
+			func() []interface{} {
+				return []interface{}{common.Address{}, vestingAddr}
+			},
+			true,
+			"invalid hex address",
+		},
+		{
+			"fail - msg.sender address does not match the vesting address",
+			func() []interface{} {
+				return []interface{}{funder, utiltx.GenerateAddress()}
+			},
+			true,
+			"does not match the requester address",
+		},
+		{
+			"fail - account already converted",
+			func() []interface{} {
+				s.createClawbackVestingAccount(funder, vestingAddr)
+				return []interface{}{funder, vestingAddr}
+			},
+			true,
+			vestingtypes.ErrNotEligibleAccount.Error(),
+		},
+		{
+			"success - account converted",
+			func() []interface{} {
+				return []interface{}{funder, vestingAddr}
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			funder, vestingAddr = s.keyring.GetAddr(0), s.keyring.GetAddr(1)
+
+			contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), vestingAddr, s.precompile.Address(), 200_000)
+
+			res, err := s.precompile.CreateClawbackVestingAccount(ctx, contract, s.network.GetStateDB(), &method, tc.malleate())
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(cmn.TrueValue, res)
+
+			acc, err := s.network.App.GetVestingKeeper().GetClawbackVestingAccount(s.network.GetContext(), vestingAddr.Bytes())
+			s.Require().NoError(err)
+			s.Require().Equal(sdk.AccAddress(funder.Bytes()).String(), acc.FunderAddress)
+			s.Require().True(acc.OriginalVesting.IsZero())
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestFundVestingAccount() {
+	method := s.precompile.Methods[vesting.FundVestingAccountMethod]
+	var funder, vestingAddr common.Address
+	amount := big.NewInt(1000)
+
+	testCases := []struct {
+		name        string
+		callerIdx   int
+		malleate    func() []interface{}
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			0,
+			func() []interface{} {
+				return []interface{}{}
+			},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 4, 0),
+		},
+		{
+			"fail - msg.sender address does not match the funder address",
+			2,
+			func() []interface{} {
+				return []interface{}{funder, vestingAddr, s.startTime(), s.periods(amount)}
+			},
+			true,
+			"does not match the requester address",
+		},
+		{
+			"fail - not the funder of the vesting account",
+			2,
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(2), vestingAddr, s.startTime(), s.periods(amount)}
+			},
+			true,
+			vestingtypes.ErrNotFunder.Error(),
+		},
+		{
+			"fail - not a clawback vesting account",
+			0,
+			func() []interface{} {
+				return []interface{}{funder, s.keyring.GetAddr(2), s.startTime(), s.periods(amount)}
+			},
+			true,
+			vestingtypes.ErrNotClawbackVestingAccount.Error(),
+		},
+		{
+			"fail - empty vesting periods",
+			0,
+			func() []interface{} {
+				return []interface{}{funder, vestingAddr, s.startTime(), []vesting.Period{}}
+			},
+			true,
+			vestingtypes.ErrInvalidVestingPeriods.Error(),
+		},
+		{
+			"success - account funded",
+			0,
+			func() []interface{} {
+				return []interface{}{funder, vestingAddr, s.startTime(), s.periods(amount)}
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			funder, vestingAddr = s.keyring.GetAddr(0), s.keyring.GetAddr(1)
+			s.createClawbackVestingAccount(funder, vestingAddr)
+
+			caller := s.keyring.GetAddr(tc.callerIdx)
+			contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), caller, s.precompile.Address(), 200_000)
+
+			res, err := s.precompile.FundVestingAccount(ctx, contract, s.network.GetStateDB(), &method, tc.malleate())
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(cmn.TrueValue, res)
+
+			acc, err := s.network.App.GetVestingKeeper().GetClawbackVestingAccount(s.network.GetContext(), vestingAddr.Bytes())
+			s.Require().NoError(err)
+			s.Require().Equal(s.coins(amount).String(), acc.OriginalVesting.String())
+			s.Require().Len(acc.VestingPeriods, 2)
+			s.Require().Equal(s.coins(amount).String(), acc.LockedCoins(s.network.GetContext().BlockTime()).String())
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestClawback() {
+	method := s.precompile.Methods[vesting.ClawbackMethod]
+	var funder, vestingAddr common.Address
+	dest := utiltx.GenerateAddress()
+	amount := big.NewInt(1000)
+
+	testCases := []struct {
+		name        string
+		callerIdx   int
+		malleate    func() []interface{}
+		expError    bool
+		errContains string
+		expReceiver func() common.Address
+	}{
+		{
+			"fail - empty input args",
+			0,
+			func() []interface{} {
+				return []interface{}{}
+			},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 3, 0),
+			nil,
+		},
+		{
+			"fail - msg.sender address does not match the funder address",
+			2,
+			func() []interface{} {
+				return []interface{}{funder, vestingAddr, common.Address{}}
+			},
+			true,
+			"does not match the requester address",
+			nil,
+		},
+		{
+			"fail - not the funder of the vesting account",
+			2,
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(2), vestingAddr, common.Address{}}
+			},
+			true,
+			vestingtypes.ErrNotFunder.Error(),
+			nil,
+		},
+		{
+			"success - unvested coins sent to the funder",
+			0,
+			func() []interface{} {
+				return []interface{}{funder, vestingAddr, common.Address{}}
+			},
+			false,
+			"",
+			func() common.Address { return funder },
+		},
+		{
+			"success - unvested coins sent to the destination",
+			0,
+			func() []interface{} {
+				return []interface{}{funder, vestingAddr, dest}
+			},
+			false,
+			"",
+			func() common.Address { return dest },
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			funder, vestingAddr = s.keyring.GetAddr(0), s.keyring.GetAddr(1)
+			s.createClawbackVestingAccount(funder, vestingAddr)
+			s.fundVestingAccount(funder, vestingAddr, amount)
+
+			caller := s.keyring.GetAddr(tc.callerIdx)
+			contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), caller, s.precompile.Address(), 200_000)
+
+			if tc.expError {
+				_, err := s.precompile.Clawback(ctx, contract, s.network.GetStateDB(), &method, tc.malleate())
+				s.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+
+			bankKeeper := s.network.App.GetBankKeeper()
+			denom := s.network.GetBaseDenom()
+			receiver := tc.expReceiver()
+			vestingBalance := bankKeeper.GetBalance(ctx, vestingAddr.Bytes(), denom)
+			receiverBalance := bankKeeper.GetBalance(ctx, receiver.Bytes(), denom)
+
+			res, err := s.precompile.Clawback(ctx, contract, s.network.GetStateDB(), &method, tc.malleate())
+			s.Require().NoError(err)
+
+			var out []cmn.Coin
+			s.Require().NoError(s.precompile.UnpackIntoInterface(&out, vesting.ClawbackMethod, res))
+			s.Require().Equal(s.coins(amount).String(), toSdkCoins(out).String())
+
+			ctx = s.network.GetContext()
+			s.Require().Equal(
+				vestingBalance.Amount.Sub(s.coins(amount)[0].Amount).String(),
+				bankKeeper.GetBalance(ctx, vestingAddr.Bytes(), denom).Amount.String(),
+			)
+			s.Require().Equal(
+				receiverBalance.Amount.Add(s.coins(amount)[0].Amount).String(),
+				bankKeeper.GetBalance(ctx, receiver.Bytes(), denom).Amount.String(),
+			)
+
+			acc, err := s.network.App.GetVestingKeeper().GetClawbackVestingAccount(ctx, vestingAddr.Bytes())
+			s.Require().NoError(err)
+			s.Require().True(acc.OriginalVesting.IsZero())
+			s.Require().Empty(acc.VestingPeriods)
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestCreateContinuousVestingAccount() {
+	method := s.precompile.Methods[vesting.CreateContinuousVestingAccountMethod]
+	amount := big.NewInt(1000)
+
+	testCases := []struct {
+		name        string
+		vestingAddr func() common.Address
+		callerIdx   int
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - msg.sender address does not match the funder address",
+			utiltx.GenerateAddress,
+			2,
+			true,
+			"does not match the requester address",
+		},
+		{
+			"fail - account already exists",
+			func() common.Address { return s.keyring.GetAddr(1) },
+			0,
+			true,
+			"already exists",
+		},
+		{
+			"success - account created",
+			utiltx.GenerateAddress,
+			0,
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			funder := s.keyring.GetAddr(0)
+
+			vestingAddr := tc.vestingAddr()
+			endTime := s.network.GetContext().BlockTime().Unix() + 100
+			args := []interface{}{funder, vestingAddr, s.cmnCoins(amount), endTime}
+
+			caller := s.keyring.GetAddr(tc.callerIdx)
+			contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), caller, s.precompile.Address(), 200_000)
+
+			res, err := s.precompile.CreateContinuousVestingAccount(ctx, contract, s.network.GetStateDB(), &method, args)
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(cmn.TrueValue, res)
+
+			acc := s.network.App.GetAccountKeeper().GetAccount(s.network.GetContext(), vestingAddr.Bytes())
+			continuousAcc, ok := acc.(*sdkvesting.ContinuousVestingAccount)
+			s.Require().True(ok, "expected a continuous vesting account, got %T", acc)
+			s.Require().Equal(endTime, continuousAcc.EndTime)
+			s.Require().Equal(s.coins(amount).String(), continuousAcc.OriginalVesting.String())
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestCreatePeriodicVestingAccount() {
+	method := s.precompile.Methods[vesting.CreatePeriodicVestingAccountMethod]
+	amount := big.NewInt(1000)
+
+	testCases := []struct {
+		name        string
+		callerIdx   int
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - msg.sender address does not match the funder address",
+			2,
+			true,
+			"does not match the requester address",
+		},
+		{
+			"success - account created",
+			0,
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			funder := s.keyring.GetAddr(0)
+
+			vestingAddr := utiltx.GenerateAddress()
+			args := []interface{}{funder, vestingAddr, s.startTime(), s.periods(amount)}
+
+			caller := s.keyring.GetAddr(tc.callerIdx)
+			contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), caller, s.precompile.Address(), 200_000)
+
+			res, err := s.precompile.CreatePeriodicVestingAccount(ctx, contract, s.network.GetStateDB(), &method, args)
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(cmn.TrueValue, res)
+
+			acc := s.network.App.GetAccountKeeper().GetAccount(s.network.GetContext(), vestingAddr.Bytes())
+			periodicAcc, ok := acc.(*sdkvesting.PeriodicVestingAccount)
+			s.Require().True(ok, "expected a periodic vesting account, got %T", acc)
+			s.Require().Equal(s.startTime(), periodicAcc.StartTime)
+			s.Require().Len(periodicAcc.VestingPeriods, 2)
+			s.Require().Equal(s.coins(amount).String(), periodicAcc.OriginalVesting.String())
+		})
+	}
+}
@@ -0,0 +1,68 @@
+package vesting
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+	"github.com/cosmos/evm/precompiles/vesting"
+	vestingtypes "github.com/cosmos/evm/x/vesting/types"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkvesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+)
+
+// startTime returns the start time of the vesting schedules of the tests, which
+// is the time of the current block.
+func (s *PrecompileTestSuite) startTime() int64 {
+	return s.network.GetContext().BlockTime().Unix()
+}
+
+// coins returns the given amount in the base denom of the network.
+func (s *PrecompileTestSuite) coins(amount *big.Int) sdk.Coins {
+	return sdk.NewCoins(sdk.NewCoin(s.network.GetBaseDenom(), sdkmath.NewIntFromBigInt(amount)))
+}
+
+// cmnCoins returns the given amount in the base denom of the network in the
+// precompile representation.
+func (s *PrecompileTestSuite) cmnCoins(amount *big.Int) []cmn.Coin {
+	return []cmn.Coin{{Denom: s.network.GetBaseDenom(), Amount: amount}}
+}
+
+// periods returns two vesting periods of an hour, each one vesting half of the
+// given amount.
+func (s *PrecompileTestSuite) periods(amount *big.Int) []vesting.Period {
+	half := new(big.Int).Quo(amount, big.NewInt(2))
+	return []vesting.Period{
+		{Length: 3600, Amount: s.cmnCoins(half)},
+		{Length: 3600, Amount: s.cmnCoins(new(big.Int).Sub(amount, half))},
+	}
+}
+
+// createClawbackVestingAccount converts the account of the vesting address
+// into a clawback vesting account funded by the funder.
+func (s *PrecompileTestSuite) createClawbackVestingAccount(funder, vestingAddr common.Address) {
+	msg := vestingtypes.NewMsgCreateClawbackVestingAccount(funder.Bytes(), vestingAddr.Bytes())
+	_, err := s.network.App.GetVestingKeeper().CreateClawbackVestingAccount(s.network.GetContext(), msg)
+	s.Require().NoError(err)
+}
+
+// fundVestingAccount funds the clawback vesting account with the vesting
+// periods of the given amount, starting at the current block time.
+func (s *PrecompileTestSuite) fundVestingAccount(funder, vestingAddr common.Address, amount *big.Int) {
+	half := new(big.Int).Quo(amount, big.NewInt(2))
+	msg := &vestingtypes.MsgFundVestingAccount{
+		FunderAddress:  sdk.AccAddress(funder.Bytes()).String(),
+		VestingAddress: sdk.AccAddress(vestingAddr.Bytes()).String(),
+		StartTime:      s.startTime(),
+		VestingPeriods: []sdkvesting.Period{
+			{Length: 3600, Amount: s.coins(half)},
+			{Length: 3600, Amount: s.coins(new(big.Int).Sub(amount, half))},
+		},
+	}
+	_, err := s.network.App.GetVestingKeeper().FundVestingAccount(s.network.GetContext(), msg)
+	s.Require().NoError(err)
+}
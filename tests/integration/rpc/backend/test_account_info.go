@@ -11,12 +11,14 @@ import (
 	"github.com/cometbft/cometbft/libs/bytes"
 	cmtrpcclient "github.com/cometbft/cometbft/rpc/client"
 
+	"github.com/cosmos/evm/lightclient"
 	"github.com/cosmos/evm/rpc/backend/mocks"
 	rpctypes "github.com/cosmos/evm/rpc/types"
 	utiltx "github.com/cosmos/evm/testutil/tx"
 	evmtypes "github.com/cosmos/evm/x/vm/types"
 
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
 func (s *TestSuite) TestGetCode() {
@@ -150,6 +152,7 @@ func (s *TestSuite) TestGetProof() {
 					bytes.HexBytes(append(authtypes.AddressStoreKeyPrefix, address1.Bytes()...)),
 					cmtrpcclient.ABCIQueryOptions{Height: iavlHeight, Prove: true},
 				)
+				RegisterAccountStateProof(client, iavlHeight, address1)
 			},
 			true,
 			&rpctypes.AccountResult{
@@ -166,6 +169,8 @@ func (s *TestSuite) TestGetProof() {
 						Proof: []string{""},
 					},
 				},
+				AppHashHeight: 5,
+				StateProof:    expAccountStateProof(address1),
 			},
 		},
 		{
@@ -200,6 +205,7 @@ func (s *TestSuite) TestGetProof() {
 					bytes.HexBytes(append(authtypes.AddressStoreKeyPrefix, address1.Bytes()...)),
 					cmtrpcclient.ABCIQueryOptions{Height: iavlHeight, Prove: true},
 				)
+				RegisterAccountStateProof(client, iavlHeight, address1)
 			},
 			true,
 			&rpctypes.AccountResult{
@@ -216,6 +222,8 @@ func (s *TestSuite) TestGetProof() {
 						Proof: []string{""},
 					},
 				},
+				AppHashHeight: 5,
+				StateProof:    expAccountStateProof(address1),
 			},
 		},
 	}
@@ -236,6 +244,24 @@ func (s *TestSuite) TestGetProof() {
 	}
 }
 
+// expAccountStateProof returns the state proof of the account built from the
+// mocked store queries.
+func expAccountStateProof(address common.Address) *rpctypes.AccountStateProof {
+	balanceKey, err := lightclient.BalanceKey(address, evmtypes.GetEVMCoinDenom())
+	if err != nil {
+		panic(err)
+	}
+	storeProof := func(storeKey string, key []byte) rpctypes.StoreProof {
+		return rpctypes.StoreProof{StoreKey: storeKey, Key: key, Value: []byte{2}, Proof: []string{""}}
+	}
+
+	return &rpctypes.AccountStateProof{
+		Account:  storeProof(authtypes.StoreKey, lightclient.AccountKey(address)),
+		CodeHash: storeProof(evmtypes.StoreKey, lightclient.CodeHashKey(address)),
+		Balance:  storeProof(banktypes.StoreKey, balanceKey),
+	}
+}
+
 func (s *TestSuite) TestGetStorageAt() {
 	blockNr := rpctypes.NewBlockNumber(big.NewInt(1))
 
@@ -225,6 +225,58 @@ func (s *TestSuite) TestGetBlockByNumber() {
 	}
 }
 
+func (s *TestSuite) TestGetPendingBlock() {
+	msgEthereumTx, bz := s.buildEthereumTx()
+	baseFee := math.NewInt(1)
+	validator := sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+
+	testCases := []struct {
+		name   string
+		fullTx bool
+		txs    []cmttypes.Tx
+	}{
+		{"pass - empty mempool", false, nil},
+		{"pass - with tx hash", false, []cmttypes.Tx{bz}},
+		{"pass - with full tx", true, []cmttypes.Tx{bz}},
+	}
+	for _, tc := range testCases {
+		s.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			s.SetupTest() // reset test and queries
+			var header metadata.MD
+			client := s.backend.ClientCtx.Client.(*mocks.Client)
+			QueryClient := s.backend.QueryClient.QueryClient.(*mocks.EVMQueryClient)
+			RegisterParams(QueryClient, &header, 1)
+			resBlock, _ := RegisterBlock(client, 1, nil)
+			_, err := RegisterBlockResults(client, 1)
+			s.Require().NoError(err)
+			RegisterConsensusParams(client, 1)
+			RegisterBaseFee(QueryClient, baseFee)
+			RegisterValidatorAccount(QueryClient, validator)
+			RegisterUnconfirmedTxs(client, nil, tc.txs)
+
+			block, err := s.backend.GetBlockByNumber(ethrpc.EthPendingBlockNumber, tc.fullTx)
+			s.Require().NoError(err)
+			s.Require().Equal(hexutil.Uint64(2), block["number"])
+			s.Require().Equal(common.BytesToHash(resBlock.Block.Hash()), block["parentHash"])
+			s.Require().Nil(block["hash"])
+			s.Require().Nil(block["miner"])
+
+			txs := block["transactions"].([]interface{})
+			s.Require().Len(txs, len(tc.txs))
+			if len(tc.txs) == 0 {
+				s.Require().Equal(ethtypes.EmptyRootHash, block["transactionsRoot"])
+				return
+			}
+			s.Require().Equal((*hexutil.Big)(new(big.Int).SetUint64(msgEthereumTx.GetGas())), block["gasUsed"])
+			if tc.fullTx {
+				s.Require().Equal(msgEthereumTx.AsTransaction().Hash(), txs[0].(*ethrpc.RPCTransaction).Hash)
+			} else {
+				s.Require().Equal(msgEthereumTx.AsTransaction().Hash(), txs[0])
+			}
+		})
+	}
+}
+
 func (s *TestSuite) TestGetBlockByHash() {
 	var (
 		blockRes *cmtrpctypes.ResultBlockResults
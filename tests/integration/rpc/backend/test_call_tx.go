@@ -9,13 +9,16 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto/kzg4844"
 	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/holiman/uint256"
 	"google.golang.org/grpc/metadata"
 
 	"github.com/cosmos/evm/rpc/backend/mocks"
 	rpctypes "github.com/cosmos/evm/rpc/types"
 	"github.com/cosmos/evm/testutil/constants"
 	utiltx "github.com/cosmos/evm/testutil/tx"
+	feemarkettypes "github.com/cosmos/evm/x/feemarket/types"
 	evmtypes "github.com/cosmos/evm/x/vm/types"
 
 	"cosmossdk.io/math"
@@ -302,6 +305,29 @@ func (s *TestSuite) TestSendRawTransaction() {
 	rlpEncodedBz, _ := rlp.EncodeToBytes(ethTx.AsTransaction())
 	evmDenom := evmtypes.GetEVMCoinDenom()
 
+	// blob transaction submitted with its blob sidecar
+	_, blobPriv := utiltx.NewAddrKey()
+	blobKey, err := blobPriv.ToECDSA()
+	s.Require().NoError(err)
+	blobTx, err := ethtypes.SignNewTx(blobKey, ethtypes.LatestSignerForChainID(s.backend.EvmChainID), &ethtypes.BlobTx{
+		ChainID:    uint256.MustFromBig(s.backend.EvmChainID),
+		GasTipCap:  uint256.NewInt(1),
+		GasFeeCap:  uint256.NewInt(1),
+		Gas:        100000,
+		To:         common.Address{},
+		Value:      uint256.NewInt(0),
+		BlobFeeCap: uint256.NewInt(1),
+		BlobHashes: []common.Hash{{0x01}},
+		Sidecar: &ethtypes.BlobTxSidecar{
+			Blobs:       []kzg4844.Blob{{}},
+			Commitments: []kzg4844.Commitment{{}},
+			Proofs:      []kzg4844.Proof{{}},
+		},
+	})
+	s.Require().NoError(err)
+	blobTxBz, err := blobTx.MarshalBinary()
+	s.Require().NoError(err)
+
 	testCases := []struct {
 		name         string
 		registerMock func()
@@ -376,6 +402,40 @@ func (s *TestSuite) TestSendRawTransaction() {
 			errortypes.ErrInvalidRequest.Error(),
 			false,
 		},
+		{
+			"fail - blob sidecar rejected",
+			func() {
+				feeMarketClient := s.backend.QueryClient.FeeMarket.(*mocks.FeeMarketQueryClient)
+				params := feemarkettypes.DefaultParams()
+				params.RejectBlobSidecars = true
+				feeMarketClient.On("Params", rpctypes.ContextWithHeight(1), &feemarkettypes.QueryParamsRequest{}).
+					Return(&feemarkettypes.QueryParamsResponse{Params: params}, nil)
+			},
+			func() []byte { return blobTxBz },
+			common.Hash{},
+			"blob transactions with a blob sidecar are not accepted",
+			false,
+		},
+		{
+			"pass - blob sidecar discarded",
+			func() {
+				msg := &evmtypes.MsgEthereumTx{}
+				err := msg.FromSignedEthereumTx(blobTx.WithoutBlobTxSidecar(), ethtypes.LatestSignerForChainID(s.backend.EvmChainID))
+				s.Require().NoError(err)
+				cosmosTx, _ := msg.BuildTx(s.backend.ClientCtx.TxConfig.NewTxBuilder(), evmDenom)
+				txBytes, _ := s.backend.ClientCtx.TxConfig.TxEncoder()(cosmosTx)
+
+				client := s.backend.ClientCtx.Client.(*mocks.Client)
+				feeMarketClient := s.backend.QueryClient.FeeMarket.(*mocks.FeeMarketQueryClient)
+				s.backend.AllowUnprotectedTxs = true
+				RegisterFeeMarketParams(feeMarketClient, 1)
+				RegisterBroadcastTx(client, txBytes)
+			},
+			func() []byte { return blobTxBz },
+			blobTx.Hash(),
+			"",
+			true,
+		},
 		{
 			"pass - Gets the correct transaction hash of the eth transaction",
 			func() {
@@ -474,7 +534,7 @@ func (s *TestSuite) TestDoCall() {
 			s.SetupTest() // reset test and queries
 			tc.registerMock()
 
-			msgEthTx, err := s.backend.DoCall(tc.callArgs, tc.blockNum)
+			msgEthTx, err := s.backend.DoCall(tc.callArgs, tc.blockNum, nil)
 
 			if tc.expPass {
 				s.Require().Equal(tc.expEthTx, msgEthTx)
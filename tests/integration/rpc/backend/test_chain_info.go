@@ -155,6 +155,94 @@ func (s *TestSuite) TestBaseFee() {
 	}
 }
 
+func (s *TestSuite) TestBlobBaseFee() {
+	blobBaseFee := sdkmath.NewInt(1000)
+
+	testCases := []struct {
+		name           string
+		blockRes       *tmrpctypes.ResultBlockResults
+		expBlobBaseFee *big.Int
+		expPass        bool
+	}{
+		{
+			"fail - no feemarket block event",
+			&tmrpctypes.ResultBlockResults{
+				Height: 1,
+				FinalizeBlockEvents: []types.Event{
+					{
+						Type: evmtypes.EventTypeBlockBloom,
+					},
+				},
+			},
+			nil,
+			false,
+		},
+		{
+			"fail - feemarket block event without blob base fee",
+			&tmrpctypes.ResultBlockResults{
+				Height: 1,
+				FinalizeBlockEvents: []types.Event{
+					{
+						Type: evmtypes.EventTypeFeeMarket,
+						Attributes: []types.EventAttribute{
+							{Key: evmtypes.AttributeKeyBaseFee, Value: "1"},
+						},
+					},
+				},
+			},
+			nil,
+			false,
+		},
+		{
+			"fail - feemarket block event with wrong blob base fee",
+			&tmrpctypes.ResultBlockResults{
+				Height: 1,
+				FinalizeBlockEvents: []types.Event{
+					{
+						Type: evmtypes.EventTypeFeeMarket,
+						Attributes: []types.EventAttribute{
+							{Key: evmtypes.AttributeKeyBlobBaseFee, Value: "/1"},
+						},
+					},
+				},
+			},
+			nil,
+			false,
+		},
+		{
+			"pass",
+			&tmrpctypes.ResultBlockResults{
+				Height: 1,
+				FinalizeBlockEvents: []types.Event{
+					{
+						Type: evmtypes.EventTypeFeeMarket,
+						Attributes: []types.EventAttribute{
+							{Key: evmtypes.AttributeKeyBaseFee, Value: "1"},
+							{Key: evmtypes.AttributeKeyBlobBaseFee, Value: blobBaseFee.String()},
+						},
+					},
+				},
+			},
+			blobBaseFee.BigInt(),
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		s.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			s.SetupTest() // reset test and queries
+
+			blobBaseFee, err := s.backend.BlobBaseFee(tc.blockRes)
+
+			if tc.expPass {
+				s.Require().NoError(err)
+				s.Require().Equal(tc.expBlobBaseFee, blobBaseFee)
+			} else {
+				s.Require().Error(err)
+			}
+		})
+	}
+}
+
 func (s *TestSuite) TestChainID() {
 	expChainID := (*hexutil.Big)(big.NewInt(int64(constants.ExampleChainID.EVMChainID))) //nolint:gosec // G115
 	testCases := []struct {
@@ -2,6 +2,7 @@ package backend
 
 import (
 	"context"
+	"fmt"
 	"testing"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -16,6 +17,7 @@ import (
 	"github.com/cometbft/cometbft/types"
 	"github.com/cometbft/cometbft/version"
 
+	"github.com/cosmos/evm/lightclient"
 	"github.com/cosmos/evm/rpc/backend/mocks"
 	rpc "github.com/cosmos/evm/rpc/types"
 	evmtypes "github.com/cosmos/evm/x/vm/types"
@@ -85,6 +87,19 @@ func RegisterStatus(client *mocks.Client) {
 		Return(&cmtrpctypes.ResultStatus{}, nil)
 }
 
+// RegisterDumpConsensusState registers a consensus state with peers working
+// on the given heights
+func RegisterDumpConsensusState(client *mocks.Client, peerHeights ...int64) {
+	peers := make([]cmtrpctypes.PeerStateInfo, len(peerHeights))
+	for i, height := range peerHeights {
+		peers[i] = cmtrpctypes.PeerStateInfo{
+			PeerState: []byte(fmt.Sprintf(`{"round_state":{"height":"%d"}}`, height)),
+		}
+	}
+	client.On("DumpConsensusState", rpc.ContextWithHeight(1)).
+		Return(&cmtrpctypes.ResultDumpConsensusState{Peers: peers}, nil)
+}
+
 func RegisterStatusError(client *mocks.Client) {
 	client.On("Status", rpc.ContextWithHeight(1)).
 		Return(nil, errortypes.ErrInvalidRequest)
@@ -313,6 +328,19 @@ func RegisterABCIQueryWithOptions(client *mocks.Client, height int64, path strin
 		}, nil)
 }
 
+// RegisterAccountStateProof registers the store queries of the code hash and
+// balance proofs of the account.
+func RegisterAccountStateProof(client *mocks.Client, height int64, address common.Address) {
+	opts := cmtrpcclient.ABCIQueryOptions{Height: height, Prove: true}
+	RegisterABCIQueryWithOptions(client, height, "store/evm/key", lightclient.CodeHashKey(address), opts)
+
+	balanceKey, err := lightclient.BalanceKey(address, evmtypes.GetEVMCoinDenom())
+	if err != nil {
+		panic(err)
+	}
+	RegisterABCIQueryWithOptions(client, height, "store/bank/key", balanceKey, opts)
+}
+
 func RegisterABCIQueryWithOptionsError(clients *mocks.Client, path string, data bytes.HexBytes, opts cmtrpcclient.ABCIQueryOptions) {
 	clients.On("ABCIQueryWithOptions", context.Background(), path, data, opts).
 		Return(nil, errortypes.ErrInvalidRequest)
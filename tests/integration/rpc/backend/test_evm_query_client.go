@@ -80,6 +80,19 @@ func RegisterParams(queryClient *mocks.EVMQueryClient, header *metadata.MD, heig
 		})
 }
 
+// RegisterParamsWithLatestHeight registers the Params query of the backend
+// context returning the given latest block height in the header
+func RegisterParamsWithLatestHeight(queryClient *mocks.EVMQueryClient, header *metadata.MD, height int64) {
+	queryClient.On("Params", rpc.ContextWithHeight(1), &evmtypes.QueryParamsRequest{}, grpc.Header(header)).
+		Return(&evmtypes.QueryParamsResponse{}, nil).
+		Run(func(args mock.Arguments) {
+			arg := args.Get(2).(grpc.HeaderCallOption)
+			h := metadata.MD{}
+			h.Set(grpctypes.GRPCBlockHeightHeader, fmt.Sprint(height))
+			*arg.HeaderAddr = h
+		})
+}
+
 func RegisterParamsWithoutHeader(queryClient *mocks.EVMQueryClient, height int64) {
 	queryClient.On("Params", rpc.ContextWithHeight(height), &evmtypes.QueryParamsRequest{}).
 		Return(&evmtypes.QueryParamsResponse{Params: evmtypes.DefaultParams()}, nil)
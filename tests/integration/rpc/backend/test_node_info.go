@@ -13,6 +13,7 @@ import (
 
 	"github.com/cosmos/evm/crypto/ethsecp256k1"
 	"github.com/cosmos/evm/rpc/backend/mocks"
+	rpctypes "github.com/cosmos/evm/rpc/types"
 	"github.com/cosmos/evm/server/config"
 	"github.com/cosmos/evm/testutil/constants"
 	evmtypes "github.com/cosmos/evm/x/vm/types"
@@ -198,10 +199,32 @@ func (s *TestSuite) TestSyncing() {
 				RegisterStatus(client)
 				status, _ := client.Status(s.backend.Ctx)
 				status.SyncInfo.CatchingUp = true
+				status.SyncInfo.EarliestBlockHeight = 1
+				status.SyncInfo.LatestBlockHeight = 5
+				RegisterDumpConsensusState(client)
 			},
-			map[string]interface{}{
-				"startingBlock": hexutil.Uint64(0),
-				"currentBlock":  hexutil.Uint64(0),
+			&rpctypes.SyncProgress{
+				StartingBlock: hexutil.Uint64(1),
+				CurrentBlock:  hexutil.Uint64(5),
+				HighestBlock:  hexutil.Uint64(5),
+			},
+			true,
+		},
+		{
+			"pass - Node is catching up with peers ahead",
+			func() {
+				client := s.backend.ClientCtx.Client.(*mocks.Client)
+				RegisterStatus(client)
+				status, _ := client.Status(s.backend.Ctx)
+				status.SyncInfo.CatchingUp = true
+				status.SyncInfo.EarliestBlockHeight = 1
+				status.SyncInfo.LatestBlockHeight = 5
+				RegisterDumpConsensusState(client, 8, 11)
+			},
+			&rpctypes.SyncProgress{
+				StartingBlock: hexutil.Uint64(1),
+				CurrentBlock:  hexutil.Uint64(5),
+				HighestBlock:  hexutil.Uint64(10),
 			},
 			true,
 		},
@@ -0,0 +1,154 @@
+package backend
+
+import (
+	"fmt"
+
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/metadata"
+
+	cmtrpctypes "github.com/cometbft/cometbft/rpc/core/types"
+	"github.com/cometbft/cometbft/types"
+
+	"github.com/cosmos/evm/rpc/backend/mocks"
+	rpctypes "github.com/cosmos/evm/rpc/types"
+	utiltx "github.com/cosmos/evm/testutil/tx"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	errorsmod "cosmossdk.io/errors"
+
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func (s *TestSuite) TestOtsContractCreator() {
+	contract := utiltx.GenerateAddress()
+	latest := int64(10)
+
+	// registerCode registers the code of the contract in the state of the
+	// given blocks, the contract having code in the blocks for which hasCode
+	// returns true and the state being pruned up to the given block.
+	registerCode := func(hasCode func(height int64) bool, prunedUntil int64, heights ...int64) {
+		var header metadata.MD
+		queryClient := s.backend.QueryClient.QueryClient.(*mocks.EVMQueryClient)
+		RegisterParamsWithLatestHeight(queryClient, &header, latest)
+		for _, height := range heights {
+			req := &evmtypes.QueryCodeRequest{Address: contract.String()}
+			if height <= prunedUntil {
+				err := errorsmod.Wrapf(errortypes.ErrNotFound,
+					"failed to load state at height %d; version does not exist (latest height: %d)", height, latest)
+				queryClient.On("Code", rpctypes.ContextWithHeight(height), req).Return(nil, err).Once()
+				continue
+			}
+			var code []byte
+			if hasCode(height) {
+				code = []byte{0x1}
+			}
+			queryClient.On("Code", rpctypes.ContextWithHeight(height), req).
+				Return(&evmtypes.QueryCodeResponse{Code: code}, nil).Once()
+		}
+		RegisterStatus(s.backend.ClientCtx.Client.(*mocks.Client))
+	}
+	// createdAt returns the code of a contract created at the given block
+	createdAt := func(createdAt int64) func(height int64) bool {
+		return func(height int64) bool { return height >= createdAt }
+	}
+	// registerCreationBlock registers the block without eth txs expected to
+	// be traced for the creation of the contract
+	registerCreationBlock := func(height int64) {
+		isCreationBlock := mock.MatchedBy(func(h *int64) bool { return *h == height })
+		block := types.MakeBlock(height, []types.Tx{}, nil, nil)
+		client := s.backend.ClientCtx.Client.(*mocks.Client)
+		client.On("Block", rpctypes.ContextWithHeight(1), isCreationBlock).
+			Return(&cmtrpctypes.ResultBlock{Block: block}, nil)
+		client.On("BlockResults", rpctypes.ContextWithHeight(1), isCreationBlock).
+			Return(&cmtrpctypes.ResultBlockResults{Height: height}, nil)
+	}
+
+	testCases := []struct {
+		name         string
+		registerMock func()
+		expPass      bool
+	}{
+		{
+			"fail - can't get the status",
+			func() {
+				var header metadata.MD
+				queryClient := s.backend.QueryClient.QueryClient.(*mocks.EVMQueryClient)
+				RegisterParamsWithLatestHeight(queryClient, &header, latest)
+				RegisterStatusError(s.backend.ClientCtx.Client.(*mocks.Client))
+			},
+			false,
+		},
+		{
+			"pass - not a contract",
+			func() {
+				registerCode(createdAt(latest+1), 0, latest)
+			},
+			true,
+		},
+		{
+			"fail - can't get the code",
+			func() {
+				registerCode(createdAt(6), 0, latest)
+				queryClient := s.backend.QueryClient.QueryClient.(*mocks.EVMQueryClient)
+				queryClient.On("Code", rpctypes.ContextWithHeight(5), &evmtypes.QueryCodeRequest{Address: contract.String()}).
+					Return(nil, errortypes.ErrInvalidRequest)
+			},
+			false,
+		},
+		{
+			"pass - creation block without eth txs",
+			func() {
+				// the first block with the contract code is found with a
+				// binary search, and its txs are traced
+				registerCode(createdAt(6), 0, latest, 5, 8, 7, 6, 5)
+				registerCreationBlock(6)
+			},
+			true,
+		},
+		{
+			"pass - creation block with a pruned state",
+			func() {
+				// the pruned states have no code for the search, and the
+				// creation is not available in the earliest state kept
+				registerCode(createdAt(3), 4, latest, 5, 3, 4, 4)
+			},
+			true,
+		},
+		{
+			"pass - creation block after a pruned state",
+			func() {
+				registerCode(createdAt(7), 4, latest, 5, 8, 7, 6, 6)
+				registerCreationBlock(7)
+			},
+			true,
+		},
+		{
+			"pass - recreated contract",
+			func() {
+				// the contract is self-destructed at block 4 and recreated
+				// at block 7
+				recreated := func(height int64) bool {
+					return (height >= 2 && height < 4) || height >= 7
+				}
+				registerCode(recreated, 0, latest, 5, 8, 7, 6, 6)
+				registerCreationBlock(7)
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(fmt.Sprintf("case %s", tc.name), func() {
+			s.SetupTest() // reset test and queries
+			tc.registerMock()
+
+			creator, err := s.backend.OtsContractCreator(contract)
+			if tc.expPass {
+				s.Require().NoError(err)
+				s.Require().Nil(creator)
+			} else {
+				s.Require().Error(err)
+			}
+		})
+	}
+}
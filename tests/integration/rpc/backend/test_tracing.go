@@ -6,6 +6,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	mock "github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/metadata"
 
 	abci "github.com/cometbft/cometbft/abci/types"
 	tmrpctypes "github.com/cometbft/cometbft/rpc/core/types"
@@ -15,6 +16,7 @@ import (
 	"github.com/cosmos/evm/crypto/ethsecp256k1"
 	"github.com/cosmos/evm/indexer"
 	"github.com/cosmos/evm/rpc/backend/mocks"
+	rpctypes "github.com/cosmos/evm/rpc/types"
 	evmtypes "github.com/cosmos/evm/x/vm/types"
 
 	"cosmossdk.io/log"
@@ -298,3 +300,78 @@ func (s *TestSuite) TestTraceBlock() {
 		})
 	}
 }
+
+func (s *TestSuite) TestTraceFilter() {
+	blockNumber := func(n int64) *rpctypes.BlockNumber {
+		blockNr := rpctypes.BlockNumber(n)
+		return &blockNr
+	}
+
+	testCases := []struct {
+		name          string
+		registerMock  func()
+		args          rpctypes.TraceFilterArgs
+		blockRangeCap int32
+		expTraces     []*rpctypes.ParityTrace
+		expPass       bool
+	}{
+		{
+			"fail - from block after to block",
+			func() {},
+			rpctypes.TraceFilterArgs{FromBlock: blockNumber(3), ToBlock: blockNumber(2)},
+			0,
+			nil,
+			false,
+		},
+		{
+			"fail - to block after latest block",
+			func() {},
+			rpctypes.TraceFilterArgs{FromBlock: blockNumber(1), ToBlock: blockNumber(11)},
+			0,
+			nil,
+			false,
+		},
+		{
+			"fail - block range cap exceeded",
+			func() {},
+			rpctypes.TraceFilterArgs{FromBlock: blockNumber(1), ToBlock: blockNumber(5)},
+			2,
+			nil,
+			false,
+		},
+		{
+			"pass - block without transactions returning empty array",
+			func() {
+				client := s.backend.ClientCtx.Client.(*mocks.Client)
+				_, err := RegisterBlock(client, 1, nil)
+				s.Require().NoError(err)
+				_, err = RegisterBlockResults(client, 1)
+				s.Require().NoError(err)
+			},
+			rpctypes.TraceFilterArgs{FromBlock: blockNumber(1), ToBlock: blockNumber(1)},
+			2,
+			[]*rpctypes.ParityTrace{},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(fmt.Sprintf("case %s", tc.name), func() {
+			s.SetupTest() // reset test and queries
+			var header metadata.MD
+			queryClient := s.backend.QueryClient.QueryClient.(*mocks.EVMQueryClient)
+			RegisterParamsWithLatestHeight(queryClient, &header, 10)
+			s.backend.Cfg.JSONRPC.BlockRangeCap = tc.blockRangeCap
+			tc.registerMock()
+
+			traces, err := s.backend.TraceFilter(tc.args)
+
+			if tc.expPass {
+				s.Require().NoError(err)
+				s.Require().Equal(tc.expTraces, traces)
+			} else {
+				s.Require().Error(err)
+			}
+		})
+	}
+}
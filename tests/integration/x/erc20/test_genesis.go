@@ -1,6 +1,7 @@
 package erc20
 
 import (
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/suite"
 
 	"github.com/cosmos/evm/testutil/integration/evm/network"
@@ -108,6 +109,18 @@ func (s *GenesisTestSuite) TestERC20InitGenesis() {
 				},
 			),
 		},
+		{
+			name: "custom genesis with permit and authorization nonces",
+			genesisState: types.GenesisState{
+				Params: types.DefaultParams(),
+				PermitNonces: []types.PermitNonce{
+					types.NewPermitNonce(common.HexToAddress(osmoERC20ContractAddr), utiltx.GenerateAddress(), 3),
+				},
+				AuthorizationNonces: []types.AuthorizationNonce{
+					types.NewAuthorizationNonce(common.HexToAddress(osmoERC20ContractAddr), utiltx.GenerateAddress(), common.BytesToHash([]byte("nonce"))),
+				},
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -136,6 +149,20 @@ func (s *GenesisTestSuite) TestERC20InitGenesis() {
 		} else {
 			s.Require().Len(tc.genesisState.Allowances, 0, tc.name)
 		}
+
+		permitNonces := nw.App.GetErc20Keeper().GetPermitNonces(nw.GetContext())
+		if len(permitNonces) > 0 {
+			s.Require().Equal(tc.genesisState.PermitNonces, permitNonces, tc.name)
+		} else {
+			s.Require().Len(tc.genesisState.PermitNonces, 0, tc.name)
+		}
+
+		authorizationNonces := nw.App.GetErc20Keeper().GetAuthorizationNonces(nw.GetContext())
+		if len(authorizationNonces) > 0 {
+			s.Require().Equal(tc.genesisState.AuthorizationNonces, authorizationNonces, tc.name)
+		} else {
+			s.Require().Len(tc.genesisState.AuthorizationNonces, 0, tc.name)
+		}
 	}
 }
 
@@ -211,6 +238,9 @@ func (s *GenesisTestSuite) TestErc20ExportGenesis() {
 			} else {
 				s.Require().Len(genesisExported.TokenPairs, 0)
 			}
+
+			s.Require().Equal(s.network.App.GetErc20Keeper().GetPermitNonces(s.network.GetContext()), genesisExported.PermitNonces)
+			s.Require().Equal(s.network.App.GetErc20Keeper().GetAuthorizationNonces(s.network.GetContext()), genesisExported.AuthorizationNonces)
 		})
 	}
 }
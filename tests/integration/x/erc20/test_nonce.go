@@ -0,0 +1,59 @@
+package erc20
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+
+	utiltx "github.com/cosmos/evm/testutil/tx"
+	"github.com/cosmos/evm/x/erc20/types"
+)
+
+func (s *KeeperTestSuite) TestPermitNonce() {
+	s.SetupTest()
+	ctx := s.network.GetContext()
+	erc20Keeper := s.network.App.GetErc20Keeper()
+
+	erc20Addr := utiltx.GenerateAddress()
+	owner := utiltx.GenerateAddress()
+
+	s.Require().Equal(uint64(0), erc20Keeper.GetPermitNonce(ctx, erc20Addr, owner))
+	s.Require().Empty(erc20Keeper.GetPermitNonces(ctx))
+
+	erc20Keeper.SetPermitNonce(ctx, erc20Addr, owner, 2)
+	s.Require().Equal(uint64(2), erc20Keeper.GetPermitNonce(ctx, erc20Addr, owner))
+	s.Require().Equal(uint64(0), erc20Keeper.GetPermitNonce(ctx, utiltx.GenerateAddress(), owner))
+	s.Require().Equal(
+		[]types.PermitNonce{types.NewPermitNonce(erc20Addr, owner, 2)},
+		erc20Keeper.GetPermitNonces(ctx),
+	)
+
+	// the nonce is kept after the token pair is deleted to prevent replays
+	pair := types.NewTokenPair(erc20Addr, "coin", types.OWNER_MODULE)
+	s.Require().NoError(erc20Keeper.SetToken(ctx, pair))
+	erc20Keeper.DeleteTokenPair(ctx, pair)
+	s.Require().Equal(uint64(2), erc20Keeper.GetPermitNonce(ctx, erc20Addr, owner))
+
+	erc20Keeper.SetPermitNonce(ctx, erc20Addr, owner, 0)
+	s.Require().Empty(erc20Keeper.GetPermitNonces(ctx))
+}
+
+func (s *KeeperTestSuite) TestAuthorizationNonce() {
+	s.SetupTest()
+	ctx := s.network.GetContext()
+	erc20Keeper := s.network.App.GetErc20Keeper()
+
+	erc20Addr := utiltx.GenerateAddress()
+	authorizer := utiltx.GenerateAddress()
+	nonce := common.BytesToHash([]byte("nonce"))
+
+	s.Require().False(erc20Keeper.IsAuthorizationNonceUsed(ctx, erc20Addr, authorizer, nonce))
+	s.Require().Empty(erc20Keeper.GetAuthorizationNonces(ctx))
+
+	erc20Keeper.SetAuthorizationNonceUsed(ctx, erc20Addr, authorizer, nonce)
+	s.Require().True(erc20Keeper.IsAuthorizationNonceUsed(ctx, erc20Addr, authorizer, nonce))
+	s.Require().False(erc20Keeper.IsAuthorizationNonceUsed(ctx, erc20Addr, authorizer, common.BytesToHash([]byte("other"))))
+	s.Require().False(erc20Keeper.IsAuthorizationNonceUsed(ctx, erc20Addr, utiltx.GenerateAddress(), nonce))
+	s.Require().Equal(
+		[]types.AuthorizationNonce{types.NewAuthorizationNonce(erc20Addr, authorizer, nonce)},
+		erc20Keeper.GetAuthorizationNonces(ctx),
+	)
+}
@@ -0,0 +1,225 @@
+package feemarket
+
+import (
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	"github.com/cosmos/evm/testutil/integration/evm/network"
+	"github.com/cosmos/evm/x/feemarket/types"
+
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// strategyBlockMaxGas is the block max gas of the base fee strategy tests, with
+// a default gas target of 5M gas.
+const strategyBlockMaxGas = 10_000_000
+
+func (s *KeeperTestSuite) TestBaseFeeStrategies() {
+	initialBaseFee := math.LegacyNewDec(1_000_000_000)
+
+	testCases := []struct {
+		name     string
+		malleate func(params *types.Params)
+		// gas wanted by the successive blocks
+		blockGas []uint64
+		// base fee of the block following each block
+		expBaseFees []math.LegacyDec
+	}{
+		{
+			"EIP-1559 - moves by 1/8 at most with the default target",
+			func(*types.Params) {},
+			[]uint64{10_000_000, 5_000_000, 0},
+			[]math.LegacyDec{
+				math.LegacyNewDec(1_125_000_000),
+				math.LegacyNewDec(1_125_000_000),
+				math.LegacyNewDec(984_375_000),
+			},
+		},
+		{
+			"EIP-1559 - explicit gas target",
+			func(params *types.Params) {
+				params.GasTarget = 1_000_000
+			},
+			[]uint64{10_000_000, 1_000_000, 0},
+			[]math.LegacyDec{
+				math.LegacyNewDec(2_125_000_000),
+				math.LegacyNewDec(2_125_000_000),
+				math.LegacyNewDec(1_859_375_000),
+			},
+		},
+		{
+			"EIP-1559 - max base fee",
+			func(params *types.Params) {
+				params.MaxBaseFee = math.LegacyNewDec(1_200_000_000)
+			},
+			[]uint64{10_000_000, 10_000_000, 0},
+			[]math.LegacyDec{
+				math.LegacyNewDec(1_125_000_000),
+				math.LegacyNewDec(1_200_000_000),
+				math.LegacyNewDec(1_050_000_000),
+			},
+		},
+		{
+			"clamped EIP-1559 - bounded change with an explicit gas target",
+			func(params *types.Params) {
+				params.BaseFeeStrategy = types.BaseFeeStrategyClampedEIP1559
+				params.GasTarget = 1_000_000
+				params.MaxBaseFeeChange = math.LegacyNewDecWithPrec(1, 1)
+			},
+			[]uint64{10_000_000, 1_100_000, 0},
+			[]math.LegacyDec{
+				math.LegacyNewDec(1_100_000_000),
+				math.LegacyNewDec(1_113_750_000),
+				math.LegacyNewDec(1_002_375_000),
+			},
+		},
+		{
+			"clamped EIP-1559 - min gas price lower bound",
+			func(params *types.Params) {
+				params.BaseFeeStrategy = types.BaseFeeStrategyClampedEIP1559
+				params.MaxBaseFeeChange = math.LegacyNewDecWithPrec(5, 2)
+				params.MinGasPrice = math.LegacyNewDec(990_000_000)
+			},
+			[]uint64{0, 0},
+			[]math.LegacyDec{
+				math.LegacyNewDec(990_000_000),
+				math.LegacyNewDec(990_000_000),
+			},
+		},
+		{
+			"exponential - grows with the excess gas and falls back to the min gas price",
+			func(params *types.Params) {
+				params.BaseFeeStrategy = types.BaseFeeStrategyExponential
+				params.BaseFeeUpdateFraction = 5_000_000
+				params.MinGasPrice = math.LegacyNewDec(1_000_000_000)
+			},
+			[]uint64{5_000_000, 10_000_000, 10_000_000, 5_000_000, 0, 0},
+			[]math.LegacyDec{
+				math.LegacyNewDec(1_000_000_000),
+				// e ~ 2.718
+				math.LegacyNewDec(2_718_281_828),
+				// e^2 ~ 7.389
+				math.LegacyNewDec(7_389_056_098),
+				math.LegacyNewDec(7_389_056_098),
+				math.LegacyNewDec(2_718_281_828),
+				math.LegacyNewDec(1_000_000_000),
+			},
+		},
+		{
+			"exponential - max base fee",
+			func(params *types.Params) {
+				params.BaseFeeStrategy = types.BaseFeeStrategyExponential
+				params.BaseFeeUpdateFraction = 5_000_000
+				params.MinGasPrice = math.LegacyNewDec(1_000_000_000)
+				params.MaxBaseFee = math.LegacyNewDec(5_000_000_000)
+			},
+			[]uint64{10_000_000, 10_000_000},
+			[]math.LegacyDec{
+				math.LegacyNewDec(2_718_281_828),
+				math.LegacyNewDec(5_000_000_000),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			nw := network.NewUnitTestNetwork(s.create, s.options...)
+			k := nw.App.GetFeeMarketKeeper()
+			ctx := nw.GetContext().WithConsensusParams(tmproto.ConsensusParams{
+				Block: &tmproto.BlockParams{MaxGas: strategyBlockMaxGas, MaxBytes: 10},
+			})
+
+			params := k.GetParams(ctx)
+			params.NoBaseFee = false
+			params.EnableHeight = 0
+			params.BaseFee = initialBaseFee
+			params.MinGasPrice = math.LegacyZeroDec()
+			tc.malleate(&params)
+			s.Require().NoError(params.Validate())
+			s.Require().NoError(k.SetParams(ctx, params))
+
+			s.Require().Len(tc.expBaseFees, len(tc.blockGas))
+			for i, gas := range tc.blockGas {
+				ctx = s.nextBlock(ctx, nw, gas)
+				baseFee := k.GetBaseFee(ctx)
+				// the exponential base fees are approximated to the unit
+				s.Require().Equal(tc.expBaseFees[i], baseFee.TruncateDec(), "block %d: %s", i, baseFee)
+			}
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestExponentialBaseFeeHugeExcessGas() {
+	testCases := []struct {
+		name       string
+		maxBaseFee math.LegacyDec
+		// expMaxExcessGas is the excess gas after a block with a huge excess gas
+		expMaxExcessGas uint64
+	}{
+		{
+			"bounded by the max base fee",
+			math.LegacyNewDec(5_000_000_000),
+			// 5M * (ln(5) + 1)
+			13_047_190,
+		},
+		{
+			"unbounded max base fee",
+			math.LegacyZeroDec(),
+			// 5M * (ln(2^128 / 1e9) + 1)
+			344_997_867,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			nw := network.NewUnitTestNetwork(s.create, s.options...)
+			k := nw.App.GetFeeMarketKeeper()
+			ctx := nw.GetContext().WithConsensusParams(tmproto.ConsensusParams{
+				Block: &tmproto.BlockParams{MaxGas: strategyBlockMaxGas, MaxBytes: 10},
+			})
+
+			params := k.GetParams(ctx)
+			params.NoBaseFee = false
+			params.EnableHeight = 0
+			params.BaseFeeStrategy = types.BaseFeeStrategyExponential
+			params.BaseFeeUpdateFraction = 5_000_000
+			params.MinGasPrice = math.LegacyNewDec(1_000_000_000)
+			params.MaxBaseFee = tc.maxBaseFee
+			s.Require().NoError(params.Validate())
+			s.Require().NoError(k.SetParams(ctx, params))
+
+			// the base fee calculation doesn't iterate over the whole excess gas
+			k.SetExcessGas(ctx, ^uint64(0))
+			s.Require().NoError(k.BeginBlock(ctx))
+			baseFee := k.GetBaseFee(ctx)
+			s.Require().True(baseFee.GT(params.MinGasPrice), "base fee %s", baseFee)
+			if tc.maxBaseFee.IsPositive() {
+				s.Require().Equal(tc.maxBaseFee, baseFee)
+			}
+
+			// the excess gas is capped, so it decreases on the next empty block
+			ctx = s.nextBlock(ctx, nw, strategyBlockMaxGas)
+			s.Require().Equal(tc.expMaxExcessGas, k.GetExcessGas(ctx))
+			ctx = s.nextBlock(ctx, nw, 0)
+			s.Require().Equal(tc.expMaxExcessGas-5_000_000, k.GetExcessGas(ctx))
+		})
+	}
+}
+
+// nextBlock ends the block of the context with the given gas used, and begins
+// the next block.
+func (s *KeeperTestSuite) nextBlock(ctx sdk.Context, nw *network.UnitTestNetwork, gasUsed uint64) sdk.Context {
+	k := nw.App.GetFeeMarketKeeper()
+
+	meter := storetypes.NewGasMeter(strategyBlockMaxGas)
+	meter.ConsumeGas(gasUsed, "block gas")
+	ctx = ctx.WithBlockGasMeter(meter)
+	k.SetTransientBlockGasWanted(ctx, gasUsed)
+	s.Require().NoError(k.EndBlock(ctx))
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	s.Require().NoError(k.BeginBlock(ctx))
+	return ctx
+}
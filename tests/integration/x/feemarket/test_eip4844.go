@@ -0,0 +1,73 @@
+package feemarket
+
+import (
+	"github.com/ethereum/go-ethereum/params"
+
+	"github.com/cosmos/evm/testutil/integration/evm/network"
+
+	"cosmossdk.io/math"
+)
+
+func (s *KeeperTestSuite) TestCalculateExcessBlobGas() {
+	nw := network.NewUnitTestNetwork(s.create, s.options...)
+	ctx := nw.GetContext()
+	k := nw.App.GetFeeMarketKeeper()
+	target := k.GetParams(ctx).TargetBlobGasPerBlock
+
+	testCases := []struct {
+		name          string
+		excessBlobGas uint64
+		blobGasUsed   uint64
+		expExcess     uint64
+	}{
+		{"below target", 0, target - params.BlobTxBlobGasPerBlob, 0},
+		{"at target", 0, target, 0},
+		{"above target", 0, target + params.BlobTxBlobGasPerBlob, params.BlobTxBlobGasPerBlob},
+		{"excess consumed by blocks below target", params.BlobTxBlobGasPerBlob, 0, 0},
+		{"excess accumulated", target, target + params.BlobTxBlobGasPerBlob, target + params.BlobTxBlobGasPerBlob},
+	}
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			k.SetExcessBlobGas(ctx, tc.excessBlobGas)
+			s.Require().Equal(tc.expExcess, k.CalculateExcessBlobGas(ctx, tc.blobGasUsed))
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestGetBlobBaseFee() {
+	nw := network.NewUnitTestNetwork(s.create, s.options...)
+	ctx := nw.GetContext()
+	k := nw.App.GetFeeMarketKeeper()
+	minBlobBaseFee := k.GetParams(ctx).MinBlobBaseFee
+
+	// no excess blob gas, the blob base fee is the minimum
+	s.Require().Equal(minBlobBaseFee, k.GetBlobBaseFee(ctx))
+
+	// an excess of one update fraction multiplies the fee by e
+	k.SetExcessBlobGas(ctx, k.GetParams(ctx).BlobBaseFeeUpdateFraction)
+	fee := k.GetBlobBaseFee(ctx)
+	s.Require().True(fee.GT(minBlobBaseFee.MulInt64(2)), fee.String())
+	s.Require().True(fee.LT(minBlobBaseFee.MulInt64(3)), fee.String())
+
+	// params stored without update fraction keep the minimum fee
+	fmParams := k.GetParams(ctx)
+	fmParams.BlobBaseFeeUpdateFraction = 0
+	s.Require().NoError(k.SetParams(ctx, fmParams))
+	s.Require().Equal(minBlobBaseFee, k.GetBlobBaseFee(ctx))
+
+	fmParams.MinBlobBaseFee = math.LegacyDec{}
+	s.Require().NoError(k.SetParams(ctx, fmParams))
+	s.Require().True(k.GetBlobBaseFee(ctx).IsZero())
+}
+
+func (s *KeeperTestSuite) TestEndBlockExcessBlobGas() {
+	nw := network.NewUnitTestNetwork(s.create, s.options...)
+	ctx := nw.GetContext()
+	k := nw.App.GetFeeMarketKeeper()
+	target := k.GetParams(ctx).TargetBlobGasPerBlock
+
+	_, err := k.AddTransientBlobGasUsed(ctx, target+params.BlobTxBlobGasPerBlob)
+	s.Require().NoError(err)
+	s.Require().NoError(k.EndBlock(ctx))
+	s.Require().Equal(uint64(params.BlobTxBlobGasPerBlob), k.GetExcessBlobGas(ctx))
+}
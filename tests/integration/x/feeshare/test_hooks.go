@@ -0,0 +1,163 @@
+package feeshare
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+
+	"github.com/cosmos/evm/contracts"
+	"github.com/cosmos/evm/testutil/integration/base/factory"
+	"github.com/cosmos/evm/testutil/integration/evm/utils"
+	utiltx "github.com/cosmos/evm/testutil/tx"
+	testutiltypes "github.com/cosmos/evm/testutil/types"
+	"github.com/cosmos/evm/x/feeshare/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (s *KeeperTestSuite) TestPostTxProcessing() {
+	var (
+		contract common.Address
+		nonce    uint64
+		burn     bool
+	)
+	withdrawer := sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expShare bool
+	}{
+		{
+			"no fee share - contract not registered",
+			func() {},
+			false,
+		},
+		{
+			"no fee share - fee share disabled",
+			func() {
+				s.registerContract(contract, nonce, withdrawer)
+
+				params := types.DefaultParams()
+				params.EnableFeeShare = false
+				err := utils.UpdateFeeShareParams(utils.UpdateParamsInput{
+					Tf:      s.factory,
+					Network: s.network,
+					Pk:      s.keyring.GetPrivKey(0),
+					Params:  params,
+				})
+				s.Require().NoError(err)
+			},
+			false,
+		},
+		{
+			"fee share sent to the withdrawer",
+			func() {
+				s.registerContract(contract, nonce, withdrawer)
+			},
+			true,
+		},
+		{
+			"fee share sent to the withdrawer and the rest of the base fee burnt",
+			func() {
+				s.registerContract(contract, nonce, withdrawer)
+
+				params := s.network.App.GetEVMKeeper().GetParams(s.network.GetContext())
+				params.FeeDistribution.BurnBaseFee = true
+				err := utils.UpdateEvmParams(utils.UpdateParamsInput{
+					Tf:      s.factory,
+					Network: s.network,
+					Pk:      s.keyring.GetPrivKey(0),
+					Params:  params,
+				})
+				s.Require().NoError(err)
+				burn = true
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			burn = false
+			contract, nonce = s.DeployContract()
+
+			tc.malleate()
+
+			baseFeeRes, err := s.handler.GetEvmBaseFee()
+			s.Require().NoError(err)
+			baseFee := baseFeeRes.BaseFee.BigInt()
+			gasPrice := new(big.Int).Mul(baseFee, big.NewInt(2))
+
+			res, err := s.factory.ExecuteContractCall(
+				s.keyring.GetPrivKey(0),
+				evmtypes.EvmTxArgs{To: &contract, GasPrice: gasPrice},
+				testutiltypes.CallArgs{
+					ContractABI: contracts.ERC20MinterBurnerDecimalsContract.ABI,
+					MethodName:  "mint",
+					Args:        []interface{}{s.keyring.GetAddr(1), big.NewInt(1000)},
+				},
+			)
+			s.Require().NoError(err)
+			s.Require().NoError(s.network.NextBlock())
+
+			ethRes, err := evmtypes.DecodeTxResponse(res.Data)
+			s.Require().NoError(err)
+
+			gasUsed := new(big.Int).SetUint64(ethRes.GasUsed)
+			fees := sdkmath.NewIntFromBigInt(new(big.Int).Mul(gasUsed, gasPrice))
+
+			expShare := sdkmath.ZeroInt()
+			if tc.expShare {
+				expShare = types.DefaultDeveloperShares.MulInt(fees).TruncateInt()
+				s.Require().True(expShare.IsPositive())
+			}
+
+			ctx := s.network.GetContext()
+			balance := s.network.App.GetPreciseBankKeeper().GetBalance(ctx, withdrawer, evmtypes.GetEVMCoinExtendedDenom())
+			s.Require().Equal(expShare.String(), balance.Amount.String())
+
+			// only the fees left to the fee collector are burnt and sent to the proposer
+			expBurnt := sdkmath.ZeroInt()
+			if burn {
+				var burnEvent *abcitypes.Event
+				for i, event := range res.Events {
+					if event.Type == evmtypes.EventTypeFeeBurn {
+						burnEvent = &res.Events[i]
+					}
+				}
+				s.Require().NotNil(burnEvent)
+
+				attrs := make(map[string]sdkmath.Int, len(burnEvent.Attributes))
+				for _, attr := range burnEvent.Attributes {
+					if amount, ok := sdkmath.NewIntFromString(attr.Value); ok {
+						attrs[attr.Key] = amount
+					}
+				}
+				expBurnt = attrs[evmtypes.AttributeKeyBurntFee]
+				s.Require().True(expBurnt.IsPositive())
+				s.Require().Equal(
+					fees.String(),
+					expBurnt.Add(attrs[evmtypes.AttributeKeyPriorityTip]).Add(expShare).String(),
+				)
+			}
+			s.Require().Equal(expBurnt.String(), s.network.App.GetEVMKeeper().GetBurntFees(ctx).String())
+		})
+	}
+}
+
+// registerContract registers the contract deployed by the first account of the
+// keyring with the given nonce and withdrawer.
+func (s *KeeperTestSuite) registerContract(contract common.Address, nonce uint64, withdrawer sdk.AccAddress) {
+	msg := types.NewMsgRegisterFeeShare(contract, s.keyring.GetAccAddr(0), withdrawer, []types.DerivationStep{{Nonce: nonce}})
+	res, err := s.factory.ExecuteCosmosTx(s.keyring.GetPrivKey(0), factory.CosmosTxArgs{Msgs: []sdk.Msg{msg}})
+	s.Require().NoError(err)
+	s.Require().True(res.IsOK(), res.Log)
+	s.Require().NoError(s.network.NextBlock())
+}
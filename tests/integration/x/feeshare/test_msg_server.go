@@ -0,0 +1,330 @@
+package feeshare
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	utiltx "github.com/cosmos/evm/testutil/tx"
+	"github.com/cosmos/evm/x/feeshare/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+func (s *KeeperTestSuite) TestRegisterFeeShare() {
+	var (
+		contract common.Address
+		nonce    uint64
+		msg      *types.MsgRegisterFeeShare
+	)
+	withdrawer := sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+
+	testCases := []struct {
+		name          string
+		malleate      func()
+		expPass       bool
+		expWithdrawer func() sdk.AccAddress
+	}{
+		{
+			"fail - fee share disabled",
+			func() {
+				params := types.DefaultParams()
+				params.EnableFeeShare = false
+				s.Require().NoError(s.network.App.GetFeeShareKeeper().SetParams(s.network.GetContext(), params))
+			},
+			false,
+			nil,
+		},
+		{
+			"fail - contract already registered",
+			func() {
+				_, err := s.network.App.GetFeeShareKeeper().RegisterFeeShare(s.network.GetContext(), msg)
+				s.Require().NoError(err)
+			},
+			false,
+			nil,
+		},
+		{
+			"fail - not the deployer of the contract",
+			func() {
+				msg.DeployerAddress = s.keyring.GetAccAddr(1).String()
+			},
+			false,
+			nil,
+		},
+		{
+			"fail - blocked withdrawer",
+			func() {
+				msg.WithdrawerAddress = authtypes.NewModuleAddress(authtypes.FeeCollectorName).String()
+			},
+			false,
+			nil,
+		},
+		{
+			"fail - no contract at the derived address",
+			func() {
+				msg.ContractAddress = crypto.CreateAddress(s.keyring.GetAddr(0), nonce+10).Hex()
+				msg.DerivationPath = []types.DerivationStep{{Nonce: nonce + 10}}
+			},
+			false,
+			nil,
+		},
+		{
+			"pass - withdrawer defaults to the deployer",
+			func() {
+				msg.WithdrawerAddress = ""
+			},
+			true,
+			func() sdk.AccAddress { return s.keyring.GetAccAddr(0) },
+		},
+		{
+			"pass - custom withdrawer",
+			func() {},
+			true,
+			func() sdk.AccAddress { return withdrawer },
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			contract, nonce = s.DeployContract()
+			msg = types.NewMsgRegisterFeeShare(contract, s.keyring.GetAccAddr(0), withdrawer, []types.DerivationStep{{Nonce: nonce}})
+
+			tc.malleate()
+
+			ctx := s.network.GetContext()
+			_, err := s.network.App.GetFeeShareKeeper().RegisterFeeShare(ctx, msg)
+			if !tc.expPass {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			feeShare, found := s.network.App.GetFeeShareKeeper().GetFeeShare(ctx, contract)
+			s.Require().True(found)
+			s.Require().Equal(types.NewFeeShare(contract, s.keyring.GetAccAddr(0), tc.expWithdrawer()), feeShare)
+
+			res, err := s.network.App.GetFeeShareKeeper().DeployerFeeShares(ctx, &types.QueryDeployerFeeSharesRequest{
+				DeployerAddress: s.keyring.GetAccAddr(0).String(),
+			})
+			s.Require().NoError(err)
+			s.Require().Equal([]string{contract.Hex()}, res.ContractAddresses)
+
+			withdrawerRes, err := s.network.App.GetFeeShareKeeper().WithdrawerFeeShares(ctx, &types.QueryWithdrawerFeeSharesRequest{
+				WithdrawerAddress: tc.expWithdrawer().String(),
+			})
+			s.Require().NoError(err)
+			s.Require().Equal([]string{contract.Hex()}, withdrawerRes.ContractAddresses)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestUpdateFeeShare() {
+	var (
+		contract common.Address
+		msg      *types.MsgUpdateFeeShare
+	)
+	withdrawer := sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"fail - fee share disabled",
+			func() {
+				params := types.DefaultParams()
+				params.EnableFeeShare = false
+				s.Require().NoError(s.network.App.GetFeeShareKeeper().SetParams(s.network.GetContext(), params))
+			},
+			false,
+		},
+		{
+			"fail - contract not registered",
+			func() {
+				msg.ContractAddress = utiltx.GenerateAddress().Hex()
+			},
+			false,
+		},
+		{
+			"fail - not the deployer of the contract",
+			func() {
+				msg.DeployerAddress = s.keyring.GetAccAddr(1).String()
+			},
+			false,
+		},
+		{
+			"fail - withdrawer unchanged",
+			func() {
+				msg.WithdrawerAddress = s.keyring.GetAccAddr(0).String()
+			},
+			false,
+		},
+		{
+			"pass",
+			func() {},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			var nonce uint64
+			contract, nonce = s.DeployContract()
+			ctx := s.network.GetContext()
+			_, err := s.network.App.GetFeeShareKeeper().RegisterFeeShare(
+				ctx,
+				types.NewMsgRegisterFeeShare(contract, s.keyring.GetAccAddr(0), nil, []types.DerivationStep{{Nonce: nonce}}),
+			)
+			s.Require().NoError(err)
+
+			msg = &types.MsgUpdateFeeShare{
+				ContractAddress:   contract.Hex(),
+				DeployerAddress:   s.keyring.GetAccAddr(0).String(),
+				WithdrawerAddress: withdrawer.String(),
+			}
+
+			tc.malleate()
+
+			_, err = s.network.App.GetFeeShareKeeper().UpdateFeeShare(ctx, msg)
+			if !tc.expPass {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			feeShare, found := s.network.App.GetFeeShareKeeper().GetFeeShare(ctx, contract)
+			s.Require().True(found)
+			s.Require().Equal(withdrawer.String(), feeShare.WithdrawerAddress)
+
+			// the contract is only indexed under the new withdrawer
+			res, err := s.network.App.GetFeeShareKeeper().WithdrawerFeeShares(ctx, &types.QueryWithdrawerFeeSharesRequest{
+				WithdrawerAddress: s.keyring.GetAccAddr(0).String(),
+			})
+			s.Require().NoError(err)
+			s.Require().Empty(res.ContractAddresses)
+
+			res, err = s.network.App.GetFeeShareKeeper().WithdrawerFeeShares(ctx, &types.QueryWithdrawerFeeSharesRequest{
+				WithdrawerAddress: withdrawer.String(),
+			})
+			s.Require().NoError(err)
+			s.Require().Equal([]string{contract.Hex()}, res.ContractAddresses)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestCancelFeeShare() {
+	var (
+		contract common.Address
+		msg      *types.MsgCancelFeeShare
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"fail - contract not registered",
+			func() {
+				msg.ContractAddress = utiltx.GenerateAddress().Hex()
+			},
+			false,
+		},
+		{
+			"fail - not the deployer of the contract",
+			func() {
+				msg.DeployerAddress = s.keyring.GetAccAddr(1).String()
+			},
+			false,
+		},
+		{
+			"pass - fee share disabled",
+			func() {
+				params := types.DefaultParams()
+				params.EnableFeeShare = false
+				s.Require().NoError(s.network.App.GetFeeShareKeeper().SetParams(s.network.GetContext(), params))
+			},
+			true,
+		},
+		{
+			"pass",
+			func() {},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			var nonce uint64
+			contract, nonce = s.DeployContract()
+			ctx := s.network.GetContext()
+			_, err := s.network.App.GetFeeShareKeeper().RegisterFeeShare(
+				ctx,
+				types.NewMsgRegisterFeeShare(contract, s.keyring.GetAccAddr(0), nil, []types.DerivationStep{{Nonce: nonce}}),
+			)
+			s.Require().NoError(err)
+
+			msg = &types.MsgCancelFeeShare{
+				ContractAddress: contract.Hex(),
+				DeployerAddress: s.keyring.GetAccAddr(0).String(),
+			}
+
+			tc.malleate()
+
+			_, err = s.network.App.GetFeeShareKeeper().CancelFeeShare(ctx, msg)
+			if !tc.expPass {
+				s.Require().Error(err)
+				s.Require().True(s.network.App.GetFeeShareKeeper().IsFeeShareRegistered(ctx, contract))
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().False(s.network.App.GetFeeShareKeeper().IsFeeShareRegistered(ctx, contract))
+
+			res, err := s.network.App.GetFeeShareKeeper().DeployerFeeShares(ctx, &types.QueryDeployerFeeSharesRequest{
+				DeployerAddress: s.keyring.GetAccAddr(0).String(),
+			})
+			s.Require().NoError(err)
+			s.Require().Empty(res.ContractAddresses)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestUpdateParams() {
+	testCases := []struct {
+		name    string
+		request *types.MsgUpdateParams
+		expPass bool
+	}{
+		{
+			"fail - invalid authority",
+			&types.MsgUpdateParams{Authority: "foobar"},
+			false,
+		},
+		{
+			"pass - valid Update msg",
+			&types.MsgUpdateParams{
+				Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+				Params:    types.DefaultParams(),
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			_, err := s.network.App.GetFeeShareKeeper().UpdateParams(s.network.GetContext(), tc.request)
+			if tc.expPass {
+				s.Require().NoError(err)
+			} else {
+				s.Require().Error(err)
+			}
+		})
+	}
+}
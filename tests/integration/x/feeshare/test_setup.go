@@ -0,0 +1,71 @@
+package feeshare
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cosmos/evm/contracts"
+	"github.com/cosmos/evm/testutil/integration/evm/factory"
+	"github.com/cosmos/evm/testutil/integration/evm/grpc"
+	"github.com/cosmos/evm/testutil/integration/evm/network"
+	"github.com/cosmos/evm/testutil/keyring"
+	testutiltypes "github.com/cosmos/evm/testutil/types"
+	"github.com/cosmos/evm/x/feeshare/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	create  network.CreateEvmApp
+	options []network.ConfigOption
+	network *network.UnitTestNetwork
+	handler grpc.Handler
+	keyring keyring.Keyring
+	factory factory.TxFactory
+}
+
+func NewKeeperTestSuite(create network.CreateEvmApp, options ...network.ConfigOption) *KeeperTestSuite {
+	return &KeeperTestSuite{
+		create:  create,
+		options: options,
+	}
+}
+
+func (s *KeeperTestSuite) SetupTest() {
+	keys := keyring.New(2)
+
+	feeShareGenesis := types.DefaultGenesisState()
+	feeShareGenesis.Params.EnableFeeShare = true
+
+	options := []network.ConfigOption{
+		network.WithPreFundedAccounts(keys.GetAllAccAddrs()...),
+		network.WithCustomGenesis(network.CustomGenesisState{types.ModuleName: feeShareGenesis}),
+	}
+	options = append(options, s.options...)
+	nw := network.NewUnitTestNetwork(s.create, options...)
+	gh := grpc.NewIntegrationHandler(nw)
+	tf := factory.New(nw, gh)
+
+	s.network = nw
+	s.factory = tf
+	s.handler = gh
+	s.keyring = keys
+}
+
+// DeployContract deploys an ERC20 contract from the first account of the
+// keyring and returns its address with the nonce of the deployment.
+func (s *KeeperTestSuite) DeployContract() (common.Address, uint64) {
+	nonce := s.network.App.GetEVMKeeper().GetNonce(s.network.GetContext(), s.keyring.GetAddr(0))
+	contract, err := s.factory.DeployContract(
+		s.keyring.GetPrivKey(0),
+		evmtypes.EvmTxArgs{},
+		testutiltypes.ContractDeploymentData{
+			Contract:        contracts.ERC20MinterBurnerDecimalsContract,
+			ConstructorArgs: []interface{}{"coin", "token", uint8(18)},
+		},
+	)
+	s.Require().NoError(err)
+	s.Require().NoError(s.network.NextBlock())
+	return contract, nonce
+}
@@ -0,0 +1,151 @@
+package vm
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/core"
+
+	"github.com/cosmos/evm/testutil/integration/evm/network"
+	utiltx "github.com/cosmos/evm/testutil/tx"
+	"github.com/cosmos/evm/x/vm/types"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+)
+
+func (s *KeeperTestSuite) TestDistributeFees() {
+	// the fee collector holds the fees of the gas used by the transaction
+	baseFee := big.NewInt(1e9)
+	gasUsed := uint64(100_000)
+	gasPrice := big.NewInt(3e9)
+	fees := sdkmath.NewInt(3e14)
+
+	recipient := utiltx.GenerateAddress().Bytes()
+	denom := types.GetEVMCoinExtendedDenom()
+
+	testCases := []struct {
+		name            string
+		feeDistribution types.FeeDistribution
+		hookFees        sdkmath.Int
+		noProposer      bool
+		expBurnt        sdkmath.Int
+		expShare        sdkmath.Int
+		expTip          sdkmath.Int
+	}{
+		{
+			"fees left to the fee collector",
+			types.DefaultFeeDistribution,
+			sdkmath.ZeroInt(),
+			false,
+			sdkmath.ZeroInt(),
+			sdkmath.ZeroInt(),
+			sdkmath.ZeroInt(),
+		},
+		{
+			"base fee burnt and tip sent to the proposer",
+			types.FeeDistribution{BurnBaseFee: true, BaseFeeShare: sdkmath.LegacyZeroDec()},
+			sdkmath.ZeroInt(),
+			false,
+			sdkmath.NewInt(1e14),
+			sdkmath.ZeroInt(),
+			sdkmath.NewInt(2e14),
+		},
+		{
+			"base fee share sent to the recipient",
+			types.FeeDistribution{
+				BurnBaseFee:      true,
+				BaseFeeShare:     sdkmath.LegacyNewDecWithPrec(25, 2),
+				BaseFeeRecipient: sdk.AccAddress(recipient).String(),
+			},
+			sdkmath.ZeroInt(),
+			false,
+			sdkmath.NewInt(75e12),
+			sdkmath.NewInt(25e12),
+			sdkmath.NewInt(2e14),
+		},
+		{
+			"base fee share burnt with a blocked recipient",
+			types.FeeDistribution{
+				BurnBaseFee:      true,
+				BaseFeeShare:     sdkmath.LegacyNewDecWithPrec(25, 2),
+				BaseFeeRecipient: authtypes.NewModuleAddress(distrtypes.ModuleName).String(),
+			},
+			sdkmath.ZeroInt(),
+			false,
+			sdkmath.NewInt(1e14),
+			sdkmath.ZeroInt(),
+			sdkmath.NewInt(2e14),
+		},
+		{
+			"tip left to the fee collector without proposer",
+			types.FeeDistribution{BurnBaseFee: true, BaseFeeShare: sdkmath.LegacyZeroDec()},
+			sdkmath.ZeroInt(),
+			true,
+			sdkmath.NewInt(1e14),
+			sdkmath.ZeroInt(),
+			sdkmath.ZeroInt(),
+		},
+		{
+			"hook fees taken from the base fee and the tip",
+			types.FeeDistribution{BurnBaseFee: true, BaseFeeShare: sdkmath.LegacyZeroDec()},
+			sdkmath.NewInt(15e13),
+			false,
+			sdkmath.NewInt(5e13),
+			sdkmath.ZeroInt(),
+			sdkmath.NewInt(1e14),
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			bankGenesis := banktypes.DefaultGenesisState()
+			bankGenesis.Balances = []banktypes.Balance{
+				{
+					Address: authtypes.NewModuleAddress(authtypes.FeeCollectorName).String(),
+					Coins:   sdk.NewCoins(sdk.NewCoin(types.GetEVMCoinDenom(), fees)),
+				},
+			}
+			unitNetwork := network.NewUnitTestNetwork(
+				s.Create,
+				network.WithCustomGenesis(network.CustomGenesisState{banktypes.ModuleName: bankGenesis}),
+			)
+			ctx := unitNetwork.GetContext()
+			if tc.noProposer {
+				header := ctx.BlockHeader()
+				header.ProposerAddress = []byte{}
+				ctx = ctx.WithBlockHeader(header)
+			}
+
+			evmKeeper := unitNetwork.App.GetEVMKeeper()
+			bankKeeper := unitNetwork.App.GetBankKeeper()
+
+			operator, err := sdk.ValAddressFromBech32(unitNetwork.GetValidators()[0].OperatorAddress)
+			s.Require().NoError(err)
+			feeCollector := authtypes.NewModuleAddress(authtypes.FeeCollectorName)
+
+			operatorBalance := bankKeeper.GetBalance(ctx, sdk.AccAddress(operator), denom).Amount
+			supply := bankKeeper.GetSupply(ctx, denom).Amount
+
+			msg := core.Message{GasPrice: gasPrice}
+			err = evmKeeper.DistributeFees(ctx, msg, gasUsed, baseFee, tc.hookFees, tc.feeDistribution)
+			s.Require().NoError(err)
+
+			s.Require().Equal(tc.expBurnt.String(), evmKeeper.GetBurntFees(ctx).String())
+			s.Require().Equal(supply.Sub(tc.expBurnt).String(), bankKeeper.GetSupply(ctx, denom).Amount.String())
+			s.Require().Equal(tc.expShare.String(), bankKeeper.GetBalance(ctx, recipient, denom).Amount.String())
+			s.Require().Equal(operatorBalance.Add(tc.expTip).String(), bankKeeper.GetBalance(ctx, sdk.AccAddress(operator), denom).Amount.String())
+			s.Require().Equal(
+				fees.Sub(tc.expBurnt).Sub(tc.expShare).Sub(tc.expTip).String(),
+				bankKeeper.GetBalance(ctx, feeCollector, denom).Amount.String(),
+			)
+
+			res, err := evmKeeper.BurntFees(ctx, &types.QueryBurntFeesRequest{})
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expBurnt.String(), res.BurntFees.String())
+		})
+	}
+}
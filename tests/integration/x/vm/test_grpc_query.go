@@ -9,13 +9,17 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/tracing"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/eth/tracers"
 	ethlogger "github.com/ethereum/go-ethereum/eth/tracers/logger"
 	ethparams "github.com/ethereum/go-ethereum/params"
 	"github.com/holiman/uint256"
 	"github.com/stretchr/testify/require"
 
+	"github.com/cosmos/evm/precompiles/staking"
 	"github.com/cosmos/evm/server/config"
 	testconstants "github.com/cosmos/evm/testutil/constants"
 	"github.com/cosmos/evm/testutil/integration/evm/factory"
@@ -34,6 +38,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 // Not valid Ethereum address
@@ -1326,6 +1331,183 @@ func (s *KeeperTestSuite) TestTraceTx() {
 	}
 }
 
+func (s *KeeperTestSuite) TestTraceTxWithRegisteredTracer() {
+	s.SetupTest()
+
+	// opcodeCounter is a native tracer counting the executions of the opcode
+	// set on its config
+	registry := types.NewTracerRegistry()
+	err := registry.Register("opcodeCounter", func(_ *tracers.Context, cfg json.RawMessage, _ *ethparams.ChainConfig) (*tracers.Tracer, error) {
+		var config struct {
+			Op string `json:"op"`
+		}
+		if err := json.Unmarshal(cfg, &config); err != nil {
+			return nil, err
+		}
+		count := 0
+		return &tracers.Tracer{
+			Hooks: &tracing.Hooks{
+				OnOpcode: func(_ uint64, op byte, _, _ uint64, _ tracing.OpContext, _ []byte, _ int, _ error) {
+					if vm.OpCode(op).String() == config.Op {
+						count++
+					}
+				},
+			},
+			GetResult: func() (json.RawMessage, error) { return json.Marshal(count) },
+			Stop:      func(error) {},
+		}, nil
+	})
+	s.Require().NoError(err)
+	s.Network.App.GetEVMKeeper().WithTracers(registry)
+
+	senderKey := s.Keyring.GetKey(0)
+	contractAddr, err := deployErc20Contract(senderKey, s.Factory)
+	s.Require().NoError(err)
+	s.Require().NoError(s.Network.NextBlock())
+
+	msgToTrace, err := executeTransferCall(
+		transferParams{
+			senderKey:     senderKey,
+			contractAddr:  contractAddr,
+			recipientAddr: common.HexToAddress("0xC6Fe5D33615a1C52c08018c47E8Bc53646A0E101"),
+		},
+		s.Factory,
+	)
+	s.Require().NoError(err)
+	s.Require().NoError(s.Network.NextBlock())
+
+	testCases := []struct {
+		msg         string
+		traceConfig *types.TraceConfig
+		expPass     bool
+		expTrace    string
+	}{
+		{
+			"registered tracer with its config",
+			&types.TraceConfig{Tracer: "opcodeCounter", TracerJsonConfig: `{"op":"SSTORE"}`},
+			true,
+			"2",
+		},
+		{
+			"registered tracer with an invalid config",
+			&types.TraceConfig{Tracer: "opcodeCounter"},
+			false,
+			"",
+		},
+		{
+			"built-in tracer still available",
+			&types.TraceConfig{Tracer: "4byteTracer"},
+			true,
+			`{"0xa9059cbb-64":1}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.msg, func() {
+			traceReq := getDefaultTraceTxRequest(s.Network)
+			traceReq.TraceConfig = tc.traceConfig
+			traceReq.Msg = msgToTrace
+
+			res, err := s.Network.GetEvmClient().TraceTx(s.Network.GetContext(), traceReq)
+			if !tc.expPass {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expTrace, string(res.Data))
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestTraceTxPrecompileCalls() {
+	s.SetupTest()
+
+	stakingABI, err := staking.LoadABI()
+	s.Require().NoError(err)
+
+	senderKey := s.Keyring.GetKey(0)
+	validator := s.Network.GetValidators()[0].OperatorAddress
+	stakingAddr := common.HexToAddress(types.StakingPrecompileAddress)
+	callArgs := testutiltypes.CallArgs{
+		ContractABI: stakingABI,
+		MethodName:  staking.DelegateMethod,
+		Args:        []interface{}{senderKey.Addr, validator, big.NewInt(1e18)},
+	}
+	input, err := factory.GenerateContractCallArgs(callArgs)
+	s.Require().NoError(err)
+
+	txArgs := types.EvmTxArgs{To: &stakingAddr, Input: input, GasLimit: 500_000}
+	signedTx, err := s.Factory.GenerateSignedEthTx(senderKey.Priv, txArgs)
+	s.Require().NoError(err)
+	msgToTrace, ok := signedTx.GetMsgs()[0].(*types.MsgEthereumTx)
+	s.Require().True(ok)
+
+	testCases := []struct {
+		msg         string
+		traceConfig *types.TraceConfig
+		expPass     bool
+	}{
+		{
+			"call tracer with precompile calls",
+			&types.TraceConfig{Tracer: "callTracer", TracePrecompiles: true},
+			true,
+		},
+		{
+			"precompile calls not supported by other tracers",
+			&types.TraceConfig{Tracer: "prestateTracer", TracePrecompiles: true},
+			false,
+		},
+		{
+			"precompile calls not supported by the struct logger",
+			&types.TraceConfig{TracePrecompiles: true},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.msg, func() {
+			traceReq := getDefaultTraceTxRequest(s.Network)
+			traceReq.TraceConfig = tc.traceConfig
+			traceReq.Msg = msgToTrace
+
+			res, err := s.Network.GetEvmClient().TraceTx(s.Network.GetContext(), traceReq)
+			if !tc.expPass {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			var frame struct {
+				To         common.Address       `json:"to"`
+				Error      string               `json:"error"`
+				Precompile types.PrecompileCall `json:"precompile"`
+			}
+			s.Require().NoError(json.Unmarshal(res.Data, &frame))
+			s.Require().Empty(frame.Error)
+			s.Require().Equal(stakingAddr, frame.To)
+
+			call := frame.Precompile
+			s.Require().Equal(staking.DelegateMethod, call.Method)
+			s.Require().Equal(validator, call.Args["validatorAddress"])
+			delegator, ok := call.Args["delegatorAddress"].(string)
+			s.Require().True(ok)
+			s.Require().Equal(senderKey.Addr, common.HexToAddress(delegator))
+
+			s.Require().Len(call.Messages, 1)
+			var msg map[string]interface{}
+			s.Require().NoError(json.Unmarshal(call.Messages[0], &msg))
+			s.Require().Equal(sdk.MsgTypeURL(&stakingtypes.MsgDelegate{}), msg["@type"])
+			s.Require().Equal(validator, msg["validator_address"])
+
+			eventTypes := make([]string, 0, len(call.Events))
+			for _, event := range call.Events {
+				eventTypes = append(eventTypes, event.Type)
+			}
+			s.Require().Contains(eventTypes, stakingtypes.EventTypeDelegate)
+		})
+	}
+}
+
 func (s *KeeperTestSuite) TestTraceBlock() {
 	s.EnableFeemarket = true
 	defer func() { s.EnableFeemarket = false }()
@@ -1778,6 +1960,396 @@ func (s *KeeperTestSuite) TestEthCall() {
 	}
 }
 
+func (s *KeeperTestSuite) TestEthCallStateOverrides() {
+	s.SetupTest()
+
+	sender := s.Keyring.GetAddr(0)
+	contract := common.HexToAddress("0x1111111111111111111111111111111111111111")
+	// runtime code returning the value stored in the slot 0
+	code := hexutil.Bytes(common.FromHex("0x60005460005260206000f3"))
+	value := common.BigToHash(big.NewInt(42))
+
+	args, err := json.Marshal(&types.TransactionArgs{From: &sender, To: &contract})
+	s.Require().NoError(err)
+
+	testCases := []struct {
+		name      string
+		overrides types.StateOverride
+		expPass   bool
+		expRet    []byte
+	}{
+		{
+			"no overrides - no code",
+			nil,
+			true,
+			nil,
+		},
+		{
+			"override code and state diff",
+			types.StateOverride{
+				contract: {
+					Code:      &code,
+					StateDiff: &map[common.Hash]common.Hash{{}: value},
+				},
+			},
+			true,
+			value.Bytes(),
+		},
+		{
+			"override code and state",
+			types.StateOverride{
+				contract: {
+					Code:  &code,
+					State: &map[common.Hash]common.Hash{{}: value},
+				},
+			},
+			true,
+			value.Bytes(),
+		},
+		{
+			"fail - both state and state diff",
+			types.StateOverride{
+				contract: {
+					State:     &map[common.Hash]common.Hash{},
+					StateDiff: &map[common.Hash]common.Hash{},
+				},
+			},
+			false,
+			nil,
+		},
+	}
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			req := &types.EthCallRequest{Args: args, GasCap: config.DefaultGasCap}
+			if tc.overrides != nil {
+				req.Overrides, err = json.Marshal(tc.overrides)
+				s.Require().NoError(err)
+			}
+
+			res, err := s.Network.GetEvmClient().EthCall(s.Network.GetContext(), req)
+			if !tc.expPass {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Empty(res.VmError)
+			s.Require().Equal(tc.expRet, res.Ret)
+
+			gasRes, err := s.Network.GetEvmClient().EstimateGas(s.Network.GetContext(), req)
+			s.Require().NoError(err)
+			s.Require().GreaterOrEqual(gasRes.Gas, ethparams.TxGas)
+
+			// overrides are never persisted
+			codeHash := s.Network.App.GetEVMKeeper().GetCodeHash(s.Network.GetContext(), contract)
+			s.Require().True(types.IsEmptyCodeHash(codeHash.Bytes()))
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestSimulateV1() {
+	s.SetupTest()
+
+	sender := s.Keyring.GetAddr(0)
+	counter := common.HexToAddress("0x1111111111111111111111111111111111111111")
+	reverter := common.HexToAddress("0x2222222222222222222222222222222222222222")
+	// runtime code incrementing the value stored in the slot 0, logging and
+	// returning the new value
+	counterCode := hexutil.Bytes(common.FromHex("0x6000546001018060005560005260206000a060206000f3"))
+	// runtime code reverting without data
+	reverterCode := hexutil.Bytes(common.FromHex("0x60006000fd"))
+	number := hexutil.Big(*big.NewInt(s.Network.GetContext().BlockHeight() + 10))
+	timestamp := hexutil.Uint64(s.Network.GetContext().BlockTime().Unix() + 100) //nolint:gosec // G115
+	gasLimit := hexutil.Uint64(ethparams.TxGas + ethparams.TxGas/2)
+
+	call := types.TransactionArgs{From: &sender, To: &counter}
+	transfer := types.TransactionArgs{From: &sender, To: &reverter}
+	tooManyCalls := make([]types.TransactionArgs, types.MaxSimulateCalls+1)
+	for i := range tooManyCalls {
+		tooManyCalls[i] = transfer
+	}
+
+	testCases := []struct {
+		name     string
+		opts     types.SimOpts
+		gasCap   uint64
+		expPass  bool
+		malleate func(results []*types.SimBlockResult)
+	}{
+		{
+			"fail - no blocks",
+			types.SimOpts{},
+			config.DefaultGasCap,
+			false,
+			nil,
+		},
+		{
+			"fail - too many calls",
+			types.SimOpts{
+				BlockStateCalls: []types.SimBlock{{Calls: tooManyCalls}},
+			},
+			config.DefaultGasCap,
+			false,
+			nil,
+		},
+		{
+			"fail - invalid block number override",
+			types.SimOpts{
+				BlockStateCalls: []types.SimBlock{
+					{BlockOverrides: &types.BlockOverrides{Number: (*hexutil.Big)(big.NewInt(-1))}},
+				},
+			},
+			config.DefaultGasCap,
+			false,
+			nil,
+		},
+		{
+			"fail - gas budget exhausted by the previous calls",
+			types.SimOpts{
+				BlockStateCalls: []types.SimBlock{
+					{Calls: []types.TransactionArgs{transfer, transfer}},
+					{Calls: []types.TransactionArgs{transfer}},
+				},
+			},
+			2*ethparams.TxGas + ethparams.TxGas/2,
+			false,
+			nil,
+		},
+		{
+			"calls share the gas budget",
+			types.SimOpts{
+				BlockStateCalls: []types.SimBlock{
+					{Calls: []types.TransactionArgs{transfer}},
+					{Calls: []types.TransactionArgs{transfer}},
+				},
+			},
+			2*ethparams.TxGas + ethparams.TxGas/2,
+			true,
+			func(results []*types.SimBlockResult) {
+				s.Require().Len(results, 2)
+				var gasUsed uint64
+				for _, result := range results {
+					s.Require().Len(result.Calls, 1)
+					s.Require().Nil(result.Calls[0].Error)
+					gasUsed += uint64(result.GasUsed)
+				}
+				s.Require().LessOrEqual(gasUsed, 2*ethparams.TxGas+ethparams.TxGas/2)
+			},
+		},
+		{
+			"block gas limit override",
+			types.SimOpts{
+				BlockStateCalls: []types.SimBlock{
+					{
+						BlockOverrides: &types.BlockOverrides{GasLimit: &gasLimit},
+						Calls:          []types.TransactionArgs{transfer},
+					},
+				},
+			},
+			config.DefaultGasCap,
+			true,
+			func(results []*types.SimBlockResult) {
+				s.Require().Len(results, 1)
+				s.Require().Equal(gasLimit, results[0].GasLimit)
+				s.Require().Len(results[0].Calls, 1)
+				s.Require().Nil(results[0].Calls[0].Error)
+			},
+		},
+		{
+			"fail - calls exceeding the overridden block gas limit",
+			types.SimOpts{
+				BlockStateCalls: []types.SimBlock{
+					{
+						BlockOverrides: &types.BlockOverrides{GasLimit: &gasLimit},
+						Calls:          []types.TransactionArgs{transfer, transfer},
+					},
+				},
+			},
+			config.DefaultGasCap,
+			false,
+			nil,
+		},
+		{
+			"fail - block numbers out of order",
+			types.SimOpts{
+				BlockStateCalls: []types.SimBlock{
+					{BlockOverrides: &types.BlockOverrides{Number: &number}},
+					{BlockOverrides: &types.BlockOverrides{Number: &number}},
+				},
+			},
+			config.DefaultGasCap,
+			false,
+			nil,
+		},
+		{
+			"calls see the state changes of the previous ones",
+			types.SimOpts{
+				BlockStateCalls: []types.SimBlock{
+					{
+						StateOverrides: &types.StateOverride{
+							counter:  {Code: &counterCode},
+							reverter: {Code: &reverterCode},
+						},
+						Calls: []types.TransactionArgs{call, call, {From: &sender, To: &reverter}},
+					},
+					{
+						BlockOverrides: &types.BlockOverrides{Number: &number, Time: &timestamp},
+						Calls:          []types.TransactionArgs{call},
+					},
+				},
+			},
+			config.DefaultGasCap,
+			true,
+			func(results []*types.SimBlockResult) {
+				s.Require().Len(results, 2)
+
+				first := results[0]
+				s.Require().Equal(uint64(s.Network.GetContext().BlockHeight()+1), uint64(first.Number))
+				s.Require().Len(first.Calls, 3)
+				for i, expValue := range []int64{1, 2} {
+					res := first.Calls[i]
+					s.Require().Nil(res.Error)
+					s.Require().Equal(hexutil.Uint64(ethtypes.ReceiptStatusSuccessful), res.Status)
+					s.Require().Equal(common.BigToHash(big.NewInt(expValue)).Bytes(), []byte(res.ReturnValue))
+					s.Require().Len(res.Logs, 1)
+					s.Require().Equal(first.Hash, res.Logs[0].BlockHash)
+					s.Require().Equal(uint(i), res.Logs[0].Index)
+				}
+				// each call increments the sender nonce
+				s.Require().NotEqual(first.Transactions[0], first.Transactions[1])
+
+				reverted := first.Calls[2]
+				s.Require().Equal(hexutil.Uint64(ethtypes.ReceiptStatusFailed), reverted.Status)
+				s.Require().NotNil(reverted.Error)
+				s.Require().Equal(types.SimulateErrCodeReverted, reverted.Error.Code)
+
+				second := results[1]
+				s.Require().Equal(number.ToInt().Uint64(), uint64(second.Number))
+				s.Require().Equal(timestamp, second.Timestamp)
+				s.Require().Equal(first.Hash, second.ParentHash)
+				s.Require().Equal(common.BigToHash(big.NewInt(3)).Bytes(), []byte(second.Calls[0].ReturnValue))
+			},
+		},
+	}
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			opts, err := json.Marshal(tc.opts)
+			s.Require().NoError(err)
+
+			res, err := s.Network.GetEvmClient().SimulateV1(
+				s.Network.GetContext(),
+				&types.SimulateV1Request{Opts: opts, GasCap: tc.gasCap},
+			)
+			if !tc.expPass {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			var results []*types.SimBlockResult
+			s.Require().NoError(json.Unmarshal(res.Data, &results))
+			tc.malleate(results)
+
+			// simulations are never persisted
+			codeHash := s.Network.App.GetEVMKeeper().GetCodeHash(s.Network.GetContext(), counter)
+			s.Require().True(types.IsEmptyCodeHash(codeHash.Bytes()))
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestTraceCall() {
+	s.SetupTest()
+
+	sender := s.Keyring.GetAddr(0)
+	counter := common.HexToAddress("0x1111111111111111111111111111111111111111")
+	// runtime code incrementing the value stored in the slot 0, logging and
+	// returning the new value
+	counterCode := hexutil.Bytes(common.FromHex("0x6000546001018060005560005260206000a060206000f3"))
+	number := hexutil.Big(*big.NewInt(s.Network.GetContext().BlockHeight() + 10))
+
+	testCases := []struct {
+		name           string
+		traceConfig    *types.TraceConfig
+		stateOverrides *types.StateOverride
+		blockOverrides *types.BlockOverrides
+		expPass        bool
+		expectedTrace  string
+	}{
+		{
+			"fail - negative limit",
+			&types.TraceConfig{Limit: -1},
+			nil,
+			nil,
+			false,
+			"",
+		},
+		{
+			"fail - invalid block number override",
+			&types.TraceConfig{},
+			nil,
+			&types.BlockOverrides{Number: (*hexutil.Big)(big.NewInt(-1))},
+			false,
+			"",
+		},
+		{
+			"trace call to an account without code",
+			&types.TraceConfig{},
+			nil,
+			nil,
+			true,
+			"{\"gas\":50000,\"failed\":false,\"returnValue\":\"0x\",\"structLogs\":[]}",
+		},
+		{
+			"trace call with state and block overrides",
+			&types.TraceConfig{DisableStack: true, DisableStorage: true},
+			&types.StateOverride{
+				counter: {
+					Code:      &counterCode,
+					StateDiff: &map[common.Hash]common.Hash{{}: common.BigToHash(big.NewInt(41))},
+				},
+			},
+			&types.BlockOverrides{Number: &number},
+			true,
+			"{\"gas\":50000,\"failed\":false," +
+				"\"returnValue\":\"0x000000000000000000000000000000000000000000000000000000000000002a\"," +
+				"\"structLogs\":[{\"pc\":0,\"op\":\"PUSH1\",\"gas",
+		},
+	}
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			gas := hexutil.Uint64(100_000)
+			args, err := json.Marshal(&types.TransactionArgs{From: &sender, To: &counter, Gas: &gas})
+			s.Require().NoError(err)
+
+			req := &types.QueryTraceCallRequest{
+				Args:        args,
+				GasCap:      config.DefaultGasCap,
+				TraceConfig: tc.traceConfig,
+				ChainId:     s.Network.GetEIP155ChainID().Int64(),
+			}
+			if tc.stateOverrides != nil {
+				req.StateOverrides, err = json.Marshal(tc.stateOverrides)
+				s.Require().NoError(err)
+			}
+			if tc.blockOverrides != nil {
+				req.BlockOverrides, err = json.Marshal(tc.blockOverrides)
+				s.Require().NoError(err)
+			}
+
+			res, err := s.Network.GetEvmClient().TraceCall(s.Network.GetContext(), req)
+			if !tc.expPass {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expectedTrace, string(res.Data[:min(len(res.Data), len(tc.expectedTrace))]))
+
+			// overrides are never persisted
+			codeHash := s.Network.App.GetEVMKeeper().GetCodeHash(s.Network.GetContext(), counter)
+			s.Require().True(types.IsEmptyCodeHash(codeHash.Bytes()))
+		})
+	}
+}
+
 func (s *KeeperTestSuite) TestBalance() {
 	testCases := []struct {
 		name        string
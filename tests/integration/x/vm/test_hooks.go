@@ -64,7 +64,7 @@ func (s *KeeperTestSuite) TestEvmHooks() {
 	for _, tc := range testCases {
 		s.SetupTest()
 		hook := tc.setupHook()
-		s.Network.App.GetEVMKeeper().SetHooks(keeper.NewMultiEvmHooks(hook))
+		s.Network.App.GetEVMKeeper().CleanHooks().SetHooks(keeper.NewMultiEvmHooks(hook))
 
 		k := s.Network.App.GetEVMKeeper()
 		ctx := s.Network.GetContext()
@@ -96,7 +96,7 @@ func (s *KeeperTestSuite) TestPostTxProcessingFailureLogReversion() {
 
 	// Set up the failing hook
 	hook := &FailureHook{}
-	s.Network.App.GetEVMKeeper().SetHooks(keeper.NewMultiEvmHooks(hook))
+	s.Network.App.GetEVMKeeper().CleanHooks().SetHooks(keeper.NewMultiEvmHooks(hook))
 
 	k := s.Network.App.GetEVMKeeper()
 	ctx := s.Network.GetContext()
@@ -6,7 +6,10 @@ import (
 	"github.com/cosmos/evm/testutil/integration/evm/utils"
 	"github.com/cosmos/evm/x/vm/types"
 
+	"cosmossdk.io/math"
+
 	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
@@ -93,6 +96,22 @@ func (s *KeeperTestSuite) TestUpdateParams() {
 			},
 			expectedErr: govtypes.ErrInvalidSigner,
 		},
+		{
+			name: "fail - blocked base fee recipient",
+			getMsg: func() *types.MsgUpdateParams {
+				params := types.DefaultParams()
+				params.FeeDistribution = types.FeeDistribution{
+					BurnBaseFee:      true,
+					BaseFeeShare:     math.LegacyNewDecWithPrec(25, 2),
+					BaseFeeRecipient: authtypes.NewModuleAddress(authtypes.FeeCollectorName).String(),
+				}
+				return &types.MsgUpdateParams{
+					Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+					Params:    params,
+				}
+			},
+			expectedErr: errortypes.ErrUnauthorized,
+		},
 		{
 			name: "pass - valid Update msg",
 			getMsg: func() *types.MsgUpdateParams {
@@ -9,6 +9,7 @@ import (
 	"github.com/ethereum/go-ethereum/core"
 	gethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/params"
+	"github.com/holiman/uint256"
 
 	"github.com/cometbft/cometbft/crypto/tmhash"
 	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
@@ -926,3 +927,76 @@ func (s *KeeperTestSuite) TestApplyMessageWithNegativeAmount() {
 	s.Require().Equal(balance0Before, balance0After)
 	s.Require().Equal(balance1Before, balance1After)
 }
+
+func (s *KeeperTestSuite) TestApplyMessageWithSetCodeAuthorizations() {
+	s.EnableFeemarket = true
+	defer func() { s.EnableFeemarket = false }()
+	s.SetupTest()
+
+	sender := s.Keyring.GetKey(0)
+	recipient := s.Keyring.GetAddr(1)
+	authorityAddr, authorityPriv := utiltx.NewAddrKey()
+	authorityKey, err := authorityPriv.ToECDSA()
+	s.Require().NoError(err)
+	target := utiltx.GenerateAddress()
+	chainID := types.GetEthChainConfig().ChainID
+
+	signAuth := func(chainID *big.Int, address common.Address, nonce uint64) gethtypes.SetCodeAuthorization {
+		auth, err := gethtypes.SignSetCode(authorityKey, gethtypes.SetCodeAuthorization{
+			ChainID: *uint256.MustFromBig(chainID),
+			Address: address,
+			Nonce:   nonce,
+		})
+		s.Require().NoError(err)
+		return auth
+	}
+	applyAuthorizations := func(authList ...gethtypes.SetCodeAuthorization) {
+		coreMsg, err := s.Factory.GenerateGethCoreMsg(sender.Priv, types.EvmTxArgs{
+			To:                &recipient,
+			GasLimit:          100_000,
+			AuthorizationList: authList,
+		})
+		s.Require().NoError(err)
+		res, err := s.Network.App.GetEVMKeeper().ApplyMessage(s.Network.GetContext(), *coreMsg, nil, true, false)
+		s.Require().NoError(err)
+		s.Require().False(res.Failed(), res.VmError)
+	}
+
+	ctx := s.Network.GetContext()
+	nonce := s.Network.App.GetEVMKeeper().GetNonce(ctx, authorityAddr)
+
+	// the authorizations of another chain or with a wrong nonce are skipped
+	applyAuthorizations(
+		signAuth(big.NewInt(1), target, nonce),
+		signAuth(chainID, target, nonce+1),
+	)
+	s.Require().Empty(s.Network.App.GetEVMKeeper().GetCode(ctx, s.Network.App.GetEVMKeeper().GetCodeHash(ctx, authorityAddr)))
+	s.Require().Equal(nonce, s.Network.App.GetEVMKeeper().GetNonce(ctx, authorityAddr))
+
+	// a valid authorization installs the delegation and bumps the nonce
+	applyAuthorizations(signAuth(chainID, target, nonce))
+	codeHash := s.Network.App.GetEVMKeeper().GetCodeHash(ctx, authorityAddr)
+	s.Require().Equal(gethtypes.AddressToDelegation(target), s.Network.App.GetEVMKeeper().GetCode(ctx, codeHash))
+	s.Require().Equal(nonce+1, s.Network.App.GetEVMKeeper().GetNonce(ctx, authorityAddr))
+
+	// an authorization valid on any chain to the zero address clears the delegation
+	applyAuthorizations(signAuth(common.Big0, common.Address{}, nonce+1))
+	s.Require().True(types.IsEmptyCodeHash(s.Network.App.GetEVMKeeper().GetCodeHash(ctx, authorityAddr).Bytes()))
+	s.Require().Equal(nonce+2, s.Network.App.GetEVMKeeper().GetNonce(ctx, authorityAddr))
+
+	// set code messages cannot create contracts nor have an empty authorization list
+	coreMsg, err := s.Factory.GenerateGethCoreMsg(sender.Priv, types.EvmTxArgs{
+		To:                &recipient,
+		GasLimit:          100_000,
+		AuthorizationList: []gethtypes.SetCodeAuthorization{signAuth(chainID, target, nonce+2)},
+	})
+	s.Require().NoError(err)
+	createMsg := *coreMsg
+	createMsg.To = nil
+	_, err = s.Network.App.GetEVMKeeper().ApplyMessage(ctx, createMsg, nil, true, false)
+	s.Require().ErrorIs(err, core.ErrSetCodeTxCreate)
+	emptyMsg := *coreMsg
+	emptyMsg.SetCodeAuthorizations = []gethtypes.SetCodeAuthorization{}
+	_, err = s.Network.App.GetEVMKeeper().ApplyMessage(ctx, emptyMsg, nil, true, false)
+	s.Require().ErrorIs(err, core.ErrEmptyAuthList)
+}
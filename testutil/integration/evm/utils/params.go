@@ -7,6 +7,7 @@ import (
 	"github.com/cosmos/evm/testutil/integration/evm/network"
 	erc20types "github.com/cosmos/evm/x/erc20/types"
 	feemarkettypes "github.com/cosmos/evm/x/feemarket/types"
+	feesharetypes "github.com/cosmos/evm/x/feeshare/types"
 	evmtypes "github.com/cosmos/evm/x/vm/types"
 
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
@@ -49,6 +50,12 @@ func UpdateERC20Params(input UpdateParamsInput) error {
 	return updateModuleParams[erc20types.Params](input, erc20types.ModuleName)
 }
 
+// UpdateFeeShareParams helper function to update the feeshare module parameters
+// It submits an update params proposal, votes for it, and waits till it passes
+func UpdateFeeShareParams(input UpdateParamsInput) error {
+	return updateModuleParams[feesharetypes.Params](input, feesharetypes.ModuleName)
+}
+
 // updateModuleParams helper function to update module parameters
 // It submits an update params proposal, votes for it, and waits till it passes
 func updateModuleParams[T interface{}](input UpdateParamsInput, moduleName string) error {
@@ -79,6 +86,8 @@ func createProposalMsg(params interface{}, name string) sdk.Msg {
 		return &feemarkettypes.MsgUpdateParams{Authority: authority, Params: params.(feemarkettypes.Params)}
 	case erc20types.ModuleName:
 		return &erc20types.MsgUpdateParams{Authority: authority, Params: params.(erc20types.Params)}
+	case feesharetypes.ModuleName:
+		return &feesharetypes.MsgUpdateParams{Authority: authority, Params: params.(feesharetypes.Params)}
 	default:
 		return nil
 	}
@@ -2,6 +2,7 @@ package types
 
 import (
 	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 
 	abci "github.com/cometbft/cometbft/abci/types"
 	cmttypes "github.com/cometbft/cometbft/types"
@@ -17,4 +18,38 @@ type EVMTxIndexer interface {
 	GetByTxHash(common.Hash) (*TxResult, error)
 	// GetByBlockAndIndex returns nil if tx not found.
 	GetByBlockAndIndex(int64, int32) (*TxResult, error)
+	// GetAddressAppearances returns the hashes of the txs the address appeared
+	// in before or after the given block, and whether there are more left.
+	GetAddressAppearances(address common.Address, blockNumber int64, ascending bool, limit int) ([]common.Hash, bool, error)
+	// GetTxsByAddress returns a page of the hashes of the txs sent from, sent
+	// to or creating the address, and the position of the next page.
+	GetTxsByAddress(address common.Address, filter AddressTxFilter, start []byte, limit int, reverse bool) ([]common.Hash, []byte, error)
+	// LogIndexer returns the log index maintained along the txs, nil if disabled.
+	LogIndexer() EVMLogIndexer
+}
+
+// EVMLogIndexer defines the interface of the eth log index, keyed by the log
+// address and topics.
+type EVMLogIndexer interface {
+	// FirstIndexedBlock returns -1 if indexer db is empty
+	FirstIndexedBlock() (int64, error)
+	// LastIndexedBlock returns -1 if indexer db is empty
+	LastIndexedBlock() (int64, error)
+	IndexBlock(*cmttypes.Block, []*abci.ExecTxResult) error
+
+	// GetLogs returns at most limit logs of the [from, to] block range that
+	// match the addresses and the positional topics, in chain order. The range
+	// must be covered by the indexed blocks.
+	GetLogs(from, to int64, addresses []common.Address, topics [][]common.Hash, limit int) ([]*ethtypes.Log, error)
+}
+
+// AddressTxFilter selects the relations of an address to the txs returned by
+// the indexer.
+type AddressTxFilter struct {
+	// Sent selects the txs sent by the address
+	Sent bool
+	// Received selects the txs sent to the address
+	Received bool
+	// Created selects the tx that created the address contract
+	Created bool
 }
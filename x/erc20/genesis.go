@@ -58,15 +58,29 @@ func InitGenesis(
 			panic(fmt.Errorf("error setting allowance %s", err))
 		}
 	}
+
+	for _, nonce := range data.PermitNonces {
+		erc20 := common.HexToAddress(nonce.Erc20Address)
+		owner := common.HexToAddress(nonce.Owner)
+		k.SetPermitNonce(ctx, erc20, owner, nonce.Nonce)
+	}
+
+	for _, nonce := range data.AuthorizationNonces {
+		erc20 := common.HexToAddress(nonce.Erc20Address)
+		authorizer := common.HexToAddress(nonce.Authorizer)
+		k.SetAuthorizationNonceUsed(ctx, erc20, authorizer, common.HexToHash(nonce.Nonce))
+	}
 }
 
 // ExportGenesis export module status
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	return &types.GenesisState{
-		Params:             k.GetParams(ctx),
-		TokenPairs:         k.GetTokenPairs(ctx),
-		Allowances:         k.GetAllowances(ctx),
-		NativePrecompiles:  k.GetNativePrecompiles(ctx),
-		DynamicPrecompiles: k.GetDynamicPrecompiles(ctx),
+		Params:              k.GetParams(ctx),
+		TokenPairs:          k.GetTokenPairs(ctx),
+		Allowances:          k.GetAllowances(ctx),
+		NativePrecompiles:   k.GetNativePrecompiles(ctx),
+		DynamicPrecompiles:  k.GetDynamicPrecompiles(ctx),
+		PermitNonces:        k.GetPermitNonces(ctx),
+		AuthorizationNonces: k.GetAuthorizationNonces(ctx),
 	}
 }
@@ -0,0 +1,145 @@
+package keeper
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/cosmos/evm/x/erc20/types"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetPermitNonce returns the current EIP-2612 permit nonce of the given owner
+// on the given erc20 precompile address.
+func (k Keeper) GetPermitNonce(
+	ctx sdk.Context,
+	erc20 common.Address,
+	owner common.Address,
+) uint64 {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPermitNonce)
+
+	bz := store.Get(types.PermitNonceKey(erc20, owner))
+	if bz == nil {
+		return 0
+	}
+
+	var nonce types.PermitNonce
+	k.cdc.MustUnmarshal(bz, &nonce)
+
+	return nonce.Nonce
+}
+
+// SetPermitNonce sets the EIP-2612 permit nonce of the given owner
+// on the given erc20 precompile address.
+//
+// NOTE: nonces are not removed when the token pair is deleted, so that
+// signed permits cannot be replayed if the token pair is registered again.
+func (k Keeper) SetPermitNonce(
+	ctx sdk.Context,
+	erc20 common.Address,
+	owner common.Address,
+	nonce uint64,
+) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPermitNonce)
+	key := types.PermitNonceKey(erc20, owner)
+
+	if nonce == 0 {
+		store.Delete(key)
+		return
+	}
+
+	permitNonce := types.NewPermitNonce(erc20, owner, nonce)
+	store.Set(key, k.cdc.MustMarshal(&permitNonce))
+}
+
+// GetPermitNonces returns all the permit nonces stored on the module.
+func (k Keeper) GetPermitNonces(ctx sdk.Context) []types.PermitNonce {
+	nonces := []types.PermitNonce{}
+
+	k.IteratePermitNonces(ctx, func(nonce types.PermitNonce) (stop bool) {
+		nonces = append(nonces, nonce)
+		return false
+	})
+
+	return nonces
+}
+
+// IteratePermitNonces iterates through all the permit nonces stored on the module.
+func (k Keeper) IteratePermitNonces(
+	ctx sdk.Context,
+	cb func(nonce types.PermitNonce) (stop bool),
+) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.KeyPrefixPermitNonce)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var nonce types.PermitNonce
+		k.cdc.MustUnmarshal(iterator.Value(), &nonce)
+
+		if cb(nonce) {
+			break
+		}
+	}
+}
+
+// IsAuthorizationNonceUsed returns true if the given EIP-3009 authorization
+// nonce of the authorizer has already been used or canceled on the given
+// erc20 precompile address.
+func (k Keeper) IsAuthorizationNonceUsed(
+	ctx sdk.Context,
+	erc20 common.Address,
+	authorizer common.Address,
+	nonce common.Hash,
+) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixAuthorizationNonce)
+	return store.Has(types.AuthorizationNonceKey(erc20, authorizer, nonce))
+}
+
+// SetAuthorizationNonceUsed marks the given EIP-3009 authorization nonce of
+// the authorizer as used on the given erc20 precompile address.
+func (k Keeper) SetAuthorizationNonceUsed(
+	ctx sdk.Context,
+	erc20 common.Address,
+	authorizer common.Address,
+	nonce common.Hash,
+) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixAuthorizationNonce)
+
+	authorizationNonce := types.NewAuthorizationNonce(erc20, authorizer, nonce)
+	store.Set(types.AuthorizationNonceKey(erc20, authorizer, nonce), k.cdc.MustMarshal(&authorizationNonce))
+}
+
+// GetAuthorizationNonces returns all the used authorization nonces stored on the module.
+func (k Keeper) GetAuthorizationNonces(ctx sdk.Context) []types.AuthorizationNonce {
+	nonces := []types.AuthorizationNonce{}
+
+	k.IterateAuthorizationNonces(ctx, func(nonce types.AuthorizationNonce) (stop bool) {
+		nonces = append(nonces, nonce)
+		return false
+	})
+
+	return nonces
+}
+
+// IterateAuthorizationNonces iterates through all the used authorization nonces
+// stored on the module.
+func (k Keeper) IterateAuthorizationNonces(
+	ctx sdk.Context,
+	cb func(nonce types.AuthorizationNonce) (stop bool),
+) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.KeyPrefixAuthorizationNonce)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var nonce types.AuthorizationNonce
+		k.cdc.MustUnmarshal(iterator.Value(), &nonce)
+
+		if cb(nonce) {
+			break
+		}
+	}
+}
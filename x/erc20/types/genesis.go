@@ -17,9 +17,11 @@ func NewGenesisState(params Params, pairs []TokenPair, allowances []Allowance) G
 // default params and chain config values.
 func DefaultGenesisState() *GenesisState {
 	return &GenesisState{
-		Params:     DefaultParams(),
-		TokenPairs: []TokenPair{},
-		Allowances: []Allowance{},
+		Params:              DefaultParams(),
+		TokenPairs:          []TokenPair{},
+		Allowances:          []Allowance{},
+		PermitNonces:        []PermitNonce{},
+		AuthorizationNonces: []AuthorizationNonce{},
 	}
 }
 
@@ -73,6 +75,34 @@ func (gs GenesisState) Validate() error {
 		seenAllowance[a.Erc20Address+a.Owner+a.Spender] = true
 	}
 
+	// Check if nonces are valid. Nonces don't require a token pair since
+	// they are kept after a token pair is deleted to prevent replays.
+	seenPermitNonce := make(map[string]bool)
+	for _, n := range gs.PermitNonces {
+		if seenPermitNonce[n.Erc20Address+n.Owner] {
+			return fmt.Errorf("duplicated permit nonce on genesis: %s", n.Erc20Address+n.Owner)
+		}
+
+		if err := n.Validate(); err != nil {
+			return fmt.Errorf("invalid permit nonce on genesis: %w", err)
+		}
+
+		seenPermitNonce[n.Erc20Address+n.Owner] = true
+	}
+
+	seenAuthorizationNonce := make(map[string]bool)
+	for _, n := range gs.AuthorizationNonces {
+		if seenAuthorizationNonce[n.Erc20Address+n.Authorizer+n.Nonce] {
+			return fmt.Errorf("duplicated authorization nonce on genesis: %s", n.Erc20Address+n.Authorizer+n.Nonce)
+		}
+
+		if err := n.Validate(); err != nil {
+			return fmt.Errorf("invalid authorization nonce on genesis: %w", err)
+		}
+
+		seenAuthorizationNonce[n.Erc20Address+n.Authorizer+n.Nonce] = true
+	}
+
 	return nil
 }
 
@@ -5,13 +5,12 @@ package types
 
 import (
 	fmt "fmt"
-	io "io"
-	math "math"
-	math_bits "math/bits"
-
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -37,6 +36,11 @@ type GenesisState struct {
 	NativePrecompiles []string `protobuf:"bytes,4,rep,name=native_precompiles,json=nativePrecompiles,proto3" json:"native_precompiles,omitempty"`
 	// dynamic_precompiles is a slice of registered dynamic precompiles at genesis
 	DynamicPrecompiles []string `protobuf:"bytes,5,rep,name=dynamic_precompiles,json=dynamicPrecompiles,proto3" json:"dynamic_precompiles,omitempty"`
+	// permit_nonces is a slice of the EIP-2612 permit nonces at genesis
+	PermitNonces []PermitNonce `protobuf:"bytes,6,rep,name=permit_nonces,json=permitNonces,proto3" json:"permit_nonces"`
+	// authorization_nonces is a slice of the used or canceled EIP-3009
+	// authorization nonces at genesis
+	AuthorizationNonces []AuthorizationNonce `protobuf:"bytes,7,rep,name=authorization_nonces,json=authorizationNonces,proto3" json:"authorization_nonces"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -107,6 +111,20 @@ func (m *GenesisState) GetDynamicPrecompiles() []string {
 	return nil
 }
 
+func (m *GenesisState) GetPermitNonces() []PermitNonce {
+	if m != nil {
+		return m.PermitNonces
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAuthorizationNonces() []AuthorizationNonce {
+	if m != nil {
+		return m.AuthorizationNonces
+	}
+	return nil
+}
+
 // Params defines the erc20 module params
 type Params struct {
 	// enable_erc20 is the parameter to enable the conversion of Cosmos coins <-->
@@ -172,33 +190,36 @@ func init() {
 func init() { proto.RegisterFile("cosmos/evm/erc20/v1/genesis.proto", fileDescriptor_e964b7a0cc2cbbd5) }
 
 var fileDescriptor_e964b7a0cc2cbbd5 = []byte{
-	// 401 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0xcf, 0xaa, 0xd3, 0x40,
-	0x14, 0xc6, 0x93, 0xe6, 0xde, 0xd2, 0x4e, 0xba, 0xb0, 0x53, 0x17, 0xa1, 0x85, 0xf4, 0x8f, 0x9b,
-	0xe2, 0x22, 0xb1, 0x75, 0x23, 0x82, 0x8a, 0x05, 0x11, 0xbb, 0x2a, 0xd5, 0x95, 0x9b, 0x30, 0x8d,
-	0x87, 0x38, 0x98, 0x99, 0x09, 0x33, 0x63, 0xb4, 0x6f, 0xe1, 0x63, 0xb8, 0xf4, 0x31, 0xba, 0xec,
-	0x52, 0x10, 0x44, 0xda, 0x85, 0xaf, 0x21, 0x9d, 0x69, 0x69, 0x2a, 0xe5, 0x6e, 0xc2, 0xf0, 0xf1,
-	0xfb, 0x7d, 0x39, 0x1c, 0x0e, 0x1a, 0xa6, 0x42, 0x31, 0xa1, 0x62, 0x28, 0x59, 0x0c, 0x32, 0x9d,
-	0x3e, 0x8a, 0xcb, 0x49, 0x9c, 0x01, 0x07, 0x45, 0x55, 0x54, 0x48, 0xa1, 0x05, 0xee, 0x58, 0x24,
-	0x82, 0x92, 0x45, 0x06, 0x89, 0xca, 0x49, 0xb7, 0x4d, 0x18, 0xe5, 0x22, 0x36, 0x5f, 0xcb, 0x75,
-	0xfb, 0xd7, 0xaa, 0xac, 0x60, 0x81, 0xfb, 0x99, 0xc8, 0x84, 0x79, 0xc6, 0x87, 0x97, 0x4d, 0x47,
-	0xbf, 0x6a, 0xa8, 0xf5, 0xda, 0xfe, 0xf0, 0xad, 0x26, 0x1a, 0xf0, 0x73, 0x54, 0x2f, 0x88, 0x24,
-	0x4c, 0x05, 0xee, 0xc0, 0x1d, 0xfb, 0xd3, 0x5e, 0x74, 0x65, 0x80, 0x68, 0x61, 0x90, 0x59, 0x73,
-	0xf3, 0xbb, 0xef, 0x7c, 0xff, 0xfb, 0xe3, 0xa1, 0xbb, 0x3c, 0x5a, 0x78, 0x8e, 0x7c, 0x2d, 0x3e,
-	0x01, 0x4f, 0x0a, 0x42, 0xa5, 0x0a, 0x6a, 0x03, 0x6f, 0xec, 0x4f, 0xc3, 0xab, 0x25, 0xef, 0x0e,
-	0xdc, 0x82, 0x50, 0x59, 0xed, 0x41, 0xfa, 0x94, 0x2a, 0xfc, 0x06, 0x21, 0x92, 0xe7, 0xe2, 0x0b,
-	0xe1, 0x29, 0xa8, 0xc0, 0xbb, 0xa3, 0xea, 0xe5, 0x09, 0xbb, 0xa8, 0x3a, 0xcb, 0xf8, 0x09, 0xc2,
-	0x9c, 0x68, 0x5a, 0x42, 0x52, 0x48, 0x48, 0x05, 0x2b, 0x68, 0x0e, 0x2a, 0xb8, 0x19, 0x78, 0xe3,
-	0xa6, 0x51, 0x5c, 0xab, 0xb4, 0x2d, 0xb4, 0x38, 0x33, 0xf8, 0x29, 0xea, 0x7c, 0x58, 0x73, 0xc2,
-	0x68, 0x7a, 0xa1, 0xde, 0xfe, 0xaf, 0xe2, 0x23, 0x55, 0x71, 0x47, 0x12, 0xd5, 0xed, 0xa6, 0xf0,
-	0x10, 0xb5, 0x80, 0x93, 0x55, 0x0e, 0x89, 0x99, 0xd9, 0x2c, 0xb7, 0xb1, 0xf4, 0x6d, 0xf6, 0xea,
-	0x10, 0xe1, 0x17, 0xa8, 0x57, 0x80, 0x64, 0x54, 0x29, 0x2a, 0x78, 0x0e, 0x4a, 0x25, 0x12, 0x32,
-	0xaa, 0xb4, 0x24, 0x9a, 0x0a, 0x1e, 0xdc, 0x1a, 0xa3, 0x7b, 0x89, 0x2c, 0x2b, 0xc4, 0xfc, 0xa6,
-	0x51, 0xbb, 0xe7, 0xcd, 0x9e, 0x6d, 0x76, 0xa1, 0xbb, 0xdd, 0x85, 0xee, 0x9f, 0x5d, 0xe8, 0x7e,
-	0xdb, 0x87, 0xce, 0x76, 0x1f, 0x3a, 0x3f, 0xf7, 0xa1, 0xf3, 0xfe, 0x41, 0x46, 0xf5, 0xc7, 0xcf,
-	0xab, 0x28, 0x15, 0x2c, 0xae, 0x5c, 0xcb, 0xd7, 0xe3, 0xbd, 0xe8, 0x75, 0x01, 0x6a, 0x55, 0x37,
-	0x77, 0xf1, 0xf8, 0x5f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x94, 0x48, 0x19, 0xb7, 0x9b, 0x02, 0x00,
-	0x00,
+	// 455 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0x3f, 0x6f, 0xd3, 0x40,
+	0x18, 0xc6, 0xe3, 0x26, 0x0d, 0xed, 0x25, 0x48, 0xf4, 0xd2, 0xc1, 0x4a, 0x25, 0x37, 0x2d, 0x03,
+	0x11, 0x83, 0x4d, 0xc3, 0x82, 0x90, 0x00, 0x51, 0x09, 0x21, 0x3a, 0xa0, 0x28, 0x30, 0xb1, 0x58,
+	0x17, 0xf3, 0xca, 0x3d, 0xe1, 0xfb, 0xa3, 0x7b, 0xaf, 0x86, 0xf2, 0x29, 0x18, 0xf8, 0x10, 0x8c,
+	0x7c, 0x8c, 0x8e, 0x1d, 0x99, 0x10, 0x4a, 0x06, 0xbe, 0x06, 0xca, 0x5d, 0xa2, 0x38, 0xad, 0xc5,
+	0x62, 0x9d, 0x1e, 0xff, 0x9e, 0x9f, 0xef, 0xce, 0x2f, 0x39, 0xca, 0x14, 0x0a, 0x85, 0x09, 0x94,
+	0x22, 0x01, 0x93, 0x8d, 0x1e, 0x25, 0xe5, 0x49, 0x92, 0x83, 0x04, 0xe4, 0x18, 0x6b, 0xa3, 0xac,
+	0xa2, 0x3d, 0x8f, 0xc4, 0x50, 0x8a, 0xd8, 0x21, 0x71, 0x79, 0xd2, 0xdf, 0x63, 0x82, 0x4b, 0x95,
+	0xb8, 0xa7, 0xe7, 0xfa, 0x87, 0x75, 0x2a, 0x5f, 0xf0, 0xc0, 0x7e, 0xae, 0x72, 0xe5, 0x96, 0xc9,
+	0x62, 0xe5, 0xd3, 0xe3, 0xef, 0x2d, 0xd2, 0x7d, 0xed, 0x3f, 0xf8, 0xce, 0x32, 0x0b, 0xf4, 0x39,
+	0x69, 0x6b, 0x66, 0x98, 0xc0, 0x30, 0x18, 0x04, 0xc3, 0xce, 0xe8, 0x20, 0xae, 0xd9, 0x40, 0x3c,
+	0x76, 0xc8, 0xe9, 0xee, 0xd5, 0xef, 0xc3, 0xc6, 0x8f, 0xbf, 0x3f, 0x1f, 0x06, 0x93, 0x65, 0x8b,
+	0x9e, 0x91, 0x8e, 0x55, 0x9f, 0x40, 0xa6, 0x9a, 0x71, 0x83, 0xe1, 0xd6, 0xa0, 0x39, 0xec, 0x8c,
+	0xa2, 0x5a, 0xc9, 0xfb, 0x05, 0x37, 0x66, 0xdc, 0x54, 0x3d, 0xc4, 0xae, 0x52, 0xa4, 0x6f, 0x08,
+	0x61, 0x45, 0xa1, 0x3e, 0x33, 0x99, 0x01, 0x86, 0xcd, 0xff, 0xa8, 0x5e, 0xae, 0xb0, 0x0d, 0xd5,
+	0xba, 0x4c, 0x9f, 0x10, 0x2a, 0x99, 0xe5, 0x25, 0xa4, 0xda, 0x40, 0xa6, 0x84, 0xe6, 0x05, 0x60,
+	0xd8, 0x1a, 0x34, 0x87, 0xbb, 0xae, 0x12, 0xf8, 0xca, 0x9e, 0x87, 0xc6, 0x6b, 0x86, 0x3e, 0x25,
+	0xbd, 0x8f, 0x97, 0x92, 0x09, 0x9e, 0x6d, 0x54, 0xb7, 0x6f, 0x56, 0xe9, 0x92, 0xaa, 0x76, 0xc7,
+	0xe4, 0xae, 0x06, 0x23, 0xb8, 0x4d, 0xa5, 0x72, 0x67, 0x68, 0xbb, 0x33, 0x0c, 0xea, 0xef, 0xd4,
+	0x91, 0x6f, 0xd5, 0x8d, 0x53, 0x74, 0xf5, 0x3a, 0x47, 0x0a, 0x64, 0x9f, 0x5d, 0xd8, 0x73, 0x65,
+	0xf8, 0x57, 0x66, 0xb9, 0x92, 0x2b, 0xf1, 0x1d, 0x27, 0x7e, 0x50, 0x7f, 0x39, 0xd5, 0xc2, 0x2d,
+	0x7f, 0x8f, 0xdd, 0x7a, 0x8d, 0xc7, 0x86, 0xb4, 0xfd, 0x2f, 0xa6, 0x47, 0xa4, 0x0b, 0x92, 0x4d,
+	0x0b, 0x48, 0x9d, 0xcf, 0x4d, 0xc5, 0xce, 0xa4, 0xe3, 0xb3, 0x57, 0x8b, 0x88, 0xbe, 0x20, 0x07,
+	0x6e, 0x8f, 0x88, 0x5c, 0xc9, 0x02, 0x10, 0x53, 0x03, 0x39, 0x47, 0x6b, 0x9c, 0x31, 0xdc, 0x76,
+	0x8d, 0xfe, 0x26, 0x32, 0xa9, 0x10, 0x67, 0xad, 0x9d, 0xad, 0x7b, 0xcd, 0xd3, 0x67, 0x57, 0xb3,
+	0x28, 0xb8, 0x9e, 0x45, 0xc1, 0x9f, 0x59, 0x14, 0x7c, 0x9b, 0x47, 0x8d, 0xeb, 0x79, 0xd4, 0xf8,
+	0x35, 0x8f, 0x1a, 0x1f, 0xee, 0xe7, 0xdc, 0x9e, 0x5f, 0x4c, 0xe3, 0x4c, 0x89, 0xa4, 0x32, 0xe6,
+	0x5f, 0x96, 0x83, 0x6e, 0x2f, 0x35, 0xe0, 0xb4, 0xed, 0x06, 0xfa, 0xf1, 0xbf, 0x01, 0x00, 0x5e,
+	0x9b, 0xc6, 0x57, 0x54, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -221,6 +242,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.AuthorizationNonces) > 0 {
+		for iNdEx := len(m.AuthorizationNonces) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AuthorizationNonces[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.PermitNonces) > 0 {
+		for iNdEx := len(m.PermitNonces) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PermitNonces[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if len(m.DynamicPrecompiles) > 0 {
 		for iNdEx := len(m.DynamicPrecompiles) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.DynamicPrecompiles[iNdEx])
@@ -366,6 +415,18 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.PermitNonces) > 0 {
+		for _, e := range m.PermitNonces {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AuthorizationNonces) > 0 {
+		for _, e := range m.AuthorizationNonces {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -584,6 +645,74 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			}
 			m.DynamicPrecompiles = append(m.DynamicPrecompiles, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PermitNonces", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PermitNonces = append(m.PermitNonces, PermitNonce{})
+			if err := m.PermitNonces[len(m.PermitNonces)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthorizationNonces", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AuthorizationNonces = append(m.AuthorizationNonces, AuthorizationNonce{})
+			if err := m.AuthorizationNonces[len(m.AuthorizationNonces)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -3,6 +3,7 @@ package types_test
 import (
 	"testing"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/suite"
 
 	testconstants "github.com/cosmos/evm/testutil/constants"
@@ -23,6 +24,7 @@ func TestGenesisTestSuite(t *testing.T) {
 }
 
 func (suite *GenesisTestSuite) TestValidateGenesis() {
+	authorizationNonce := common.BytesToHash([]byte("nonce")).Hex()
 	newGen := types.NewGenesisState(types.DefaultParams(), testconstants.ExampleTokenPairs, testconstants.ExampleAllowances)
 
 	testCases := []struct {
@@ -311,6 +313,93 @@ func (suite *GenesisTestSuite) TestValidateGenesis() {
 			},
 			expPass: false,
 		},
+		{
+			name: "valid genesis - with nonces without token pair",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				PermitNonces: []types.PermitNonce{
+					{
+						Erc20Address: testconstants.WEVMOSContractMainnet,
+						Owner:        testconstants.ExampleEvmAddressAlice,
+						Nonce:        1,
+					},
+				},
+				AuthorizationNonces: []types.AuthorizationNonce{
+					{
+						Erc20Address: testconstants.WEVMOSContractMainnet,
+						Authorizer:   testconstants.ExampleEvmAddressAlice,
+						Nonce:        authorizationNonce,
+					},
+				},
+			},
+			expPass: true,
+		},
+		{
+			name: "invalid genesis - duplicated permit nonces",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				PermitNonces: []types.PermitNonce{
+					{
+						Erc20Address: testconstants.WEVMOSContractMainnet,
+						Owner:        testconstants.ExampleEvmAddressAlice,
+						Nonce:        1,
+					},
+					{
+						Erc20Address: testconstants.WEVMOSContractMainnet,
+						Owner:        testconstants.ExampleEvmAddressAlice,
+						Nonce:        2,
+					},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis - zero permit nonce",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				PermitNonces: []types.PermitNonce{
+					{
+						Erc20Address: testconstants.WEVMOSContractMainnet,
+						Owner:        testconstants.ExampleEvmAddressAlice,
+						Nonce:        0,
+					},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis - duplicated authorization nonces",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				AuthorizationNonces: []types.AuthorizationNonce{
+					{
+						Erc20Address: testconstants.WEVMOSContractMainnet,
+						Authorizer:   testconstants.ExampleEvmAddressAlice,
+						Nonce:        authorizationNonce,
+					},
+					{
+						Erc20Address: testconstants.WEVMOSContractMainnet,
+						Authorizer:   testconstants.ExampleEvmAddressAlice,
+						Nonce:        authorizationNonce,
+					},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis - invalid authorization nonce",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				AuthorizationNonces: []types.AuthorizationNonce{
+					{
+						Erc20Address: testconstants.WEVMOSContractMainnet,
+						Authorizer:   testconstants.ExampleEvmAddressAlice,
+						Nonce:        "0x01",
+					},
+				},
+			},
+			expPass: false,
+		},
 		{
 			// Voting period cant be zero
 			name:     "empty genesis",
@@ -0,0 +1,59 @@
+package types
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	errorsmod "cosmossdk.io/errors"
+
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func NewPermitNonce(erc20 common.Address, owner common.Address, nonce uint64) PermitNonce {
+	return PermitNonce{
+		Erc20Address: erc20.Hex(),
+		Owner:        owner.Hex(),
+		Nonce:        nonce,
+	}
+}
+
+func (n PermitNonce) Validate() error {
+	if !common.IsHexAddress(n.Erc20Address) {
+		return errorsmod.Wrapf(errortypes.ErrInvalidAddress, "invalid erc20 hex address %s", n.Erc20Address)
+	}
+
+	if !common.IsHexAddress(n.Owner) {
+		return errorsmod.Wrapf(errortypes.ErrInvalidAddress, "invalid owner hex address %s", n.Owner)
+	}
+
+	if n.Nonce == 0 {
+		return errorsmod.Wrap(ErrInvalidNonce, "invalid permit nonce value")
+	}
+
+	return nil
+}
+
+func NewAuthorizationNonce(erc20 common.Address, authorizer common.Address, nonce common.Hash) AuthorizationNonce {
+	return AuthorizationNonce{
+		Erc20Address: erc20.Hex(),
+		Authorizer:   authorizer.Hex(),
+		Nonce:        nonce.Hex(),
+	}
+}
+
+func (n AuthorizationNonce) Validate() error {
+	if !common.IsHexAddress(n.Erc20Address) {
+		return errorsmod.Wrapf(errortypes.ErrInvalidAddress, "invalid erc20 hex address %s", n.Erc20Address)
+	}
+
+	if !common.IsHexAddress(n.Authorizer) {
+		return errorsmod.Wrapf(errortypes.ErrInvalidAddress, "invalid authorizer hex address %s", n.Authorizer)
+	}
+
+	bz, err := hexutil.Decode(n.Nonce)
+	if err != nil || len(bz) != common.HashLength {
+		return errorsmod.Wrapf(ErrInvalidNonce, "invalid authorization nonce %s", n.Nonce)
+	}
+
+	return nil
+}
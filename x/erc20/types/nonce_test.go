@@ -0,0 +1,102 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/suite"
+
+	utiltx "github.com/cosmos/evm/testutil/tx"
+	"github.com/cosmos/evm/x/erc20/types"
+)
+
+type NonceTestSuite struct {
+	suite.Suite
+}
+
+func TestNonceTestSuite(t *testing.T) {
+	suite.Run(t, new(NonceTestSuite))
+}
+
+func (s *NonceTestSuite) TestPermitNonceValidate() {
+	testCases := []struct {
+		msg        string
+		nonce      types.PermitNonce
+		expectPass bool
+	}{
+		{
+			msg:        "valid permit nonce",
+			nonce:      types.NewPermitNonce(utiltx.GenerateAddress(), utiltx.GenerateAddress(), 1),
+			expectPass: true,
+		},
+		{
+			msg:        "invalid erc20 address",
+			nonce:      types.PermitNonce{Erc20Address: "bad", Owner: utiltx.GenerateAddress().Hex(), Nonce: 1},
+			expectPass: false,
+		},
+		{
+			msg:        "invalid owner address",
+			nonce:      types.PermitNonce{Erc20Address: utiltx.GenerateAddress().Hex(), Owner: "bad", Nonce: 1},
+			expectPass: false,
+		},
+		{
+			msg:        "zero nonce",
+			nonce:      types.NewPermitNonce(utiltx.GenerateAddress(), utiltx.GenerateAddress(), 0),
+			expectPass: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		err := tc.nonce.Validate()
+		if tc.expectPass {
+			s.Require().NoError(err, "valid test %s failed: %s", tc.msg, err)
+		} else {
+			s.Require().Error(err, "invalid test %s passed: %s", tc.msg, err)
+		}
+	}
+}
+
+func (s *NonceTestSuite) TestAuthorizationNonceValidate() {
+	nonce := common.BytesToHash([]byte("nonce"))
+
+	testCases := []struct {
+		msg        string
+		nonce      types.AuthorizationNonce
+		expectPass bool
+	}{
+		{
+			msg:        "valid authorization nonce",
+			nonce:      types.NewAuthorizationNonce(utiltx.GenerateAddress(), utiltx.GenerateAddress(), nonce),
+			expectPass: true,
+		},
+		{
+			msg:        "invalid erc20 address",
+			nonce:      types.AuthorizationNonce{Erc20Address: "bad", Authorizer: utiltx.GenerateAddress().Hex(), Nonce: nonce.Hex()},
+			expectPass: false,
+		},
+		{
+			msg:        "invalid authorizer address",
+			nonce:      types.AuthorizationNonce{Erc20Address: utiltx.GenerateAddress().Hex(), Authorizer: "bad", Nonce: nonce.Hex()},
+			expectPass: false,
+		},
+		{
+			msg:        "nonce is not hex",
+			nonce:      types.AuthorizationNonce{Erc20Address: utiltx.GenerateAddress().Hex(), Authorizer: utiltx.GenerateAddress().Hex(), Nonce: "nonce"},
+			expectPass: false,
+		},
+		{
+			msg:        "nonce is not 32 bytes",
+			nonce:      types.AuthorizationNonce{Erc20Address: utiltx.GenerateAddress().Hex(), Authorizer: utiltx.GenerateAddress().Hex(), Nonce: "0x0102"},
+			expectPass: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		err := tc.nonce.Validate()
+		if tc.expectPass {
+			s.Require().NoError(err, "valid test %s failed: %s", tc.msg, err)
+		} else {
+			s.Require().Error(err, "invalid test %s passed: %s", tc.msg, err)
+		}
+	}
+}
@@ -23,6 +23,8 @@ func InitGenesis(
 	}
 
 	k.SetBlockGasWanted(ctx, data.BlockGas)
+	k.SetExcessBlobGas(ctx, data.ExcessBlobGas)
+	k.SetExcessGas(ctx, data.ExcessGas)
 
 	return []abci.ValidatorUpdate{}
 }
@@ -30,7 +32,9 @@ func InitGenesis(
 // ExportGenesis exports genesis state of the fee market module
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	return &types.GenesisState{
-		Params:   k.GetParams(ctx),
-		BlockGas: k.GetBlockGasWanted(ctx),
+		Params:        k.GetParams(ctx),
+		BlockGas:      k.GetBlockGasWanted(ctx),
+		ExcessBlobGas: k.GetExcessBlobGas(ctx),
+		ExcessGas:     k.GetExcessGas(ctx),
 	}
 }
@@ -44,7 +44,7 @@ func (k *Keeper) BeginBlock(ctx sdk.Context) error {
 	return nil
 }
 
-// EndBlock update block gas wanted.
+// EndBlock update block gas wanted, excess gas and excess blob gas.
 // The EVM end block logic doesn't update the validator set, thus it returns
 // an empty slice.
 func (k *Keeper) EndBlock(ctx sdk.Context) error {
@@ -77,6 +77,8 @@ func (k *Keeper) EndBlock(ctx sdk.Context) error {
 	limitedGasWanted := math.LegacyNewDec(gasWanted.Int64()).Mul(minGasMultiplier)
 	updatedGasWanted := math.LegacyMaxDec(limitedGasWanted, math.LegacyNewDec(gasUsed.Int64())).TruncateInt().Uint64()
 	k.SetBlockGasWanted(ctx, updatedGasWanted)
+	k.SetExcessBlobGas(ctx, k.CalculateExcessBlobGas(ctx, k.GetTransientBlobGasUsed(ctx)))
+	k.SetExcessGas(ctx, k.CalculateExcessGas(ctx, updatedGasWanted))
 
 	defer func() {
 		telemetry.SetGauge(float32(updatedGasWanted), "feemarket", "block_gas")
@@ -2,6 +2,9 @@ package keeper
 
 import (
 	"math"
+	"math/big"
+
+	"github.com/cosmos/evm/x/feemarket/types"
 
 	sdkmath "cosmossdk.io/math"
 
@@ -20,8 +23,6 @@ func (k Keeper) CalculateBaseFee(ctx sdk.Context) sdkmath.LegacyDec {
 		return sdkmath.LegacyDec{}
 	}
 
-	consParams := ctx.ConsensusParams()
-
 	// If the current block is the first EIP-1559 block, return the base fee
 	// defined in the parameters (DefaultBaseFee if it hasn't been changed by
 	// governance).
@@ -38,18 +39,28 @@ func (k Keeper) CalculateBaseFee(ctx sdk.Context) sdkmath.LegacyDec {
 		return sdkmath.LegacyDec{}
 	}
 
-	parentGasUsed := k.GetBlockGasWanted(ctx)
-
-	gasLimit := sdkmath.NewIntFromUint64(math.MaxUint64)
+	var baseFee sdkmath.LegacyDec
+	switch params.BaseFeeStrategy {
+	case types.BaseFeeStrategyExponential:
+		baseFee = k.calculateExponentialBaseFee(ctx, params)
+	default:
+		baseFee = k.calculateEIP1559BaseFee(ctx, params, parentBaseFee)
+	}
 
-	// NOTE: a MaxGas equal to -1 means that block gas is unlimited
-	if consParams.Block != nil && consParams.Block.MaxGas > -1 {
-		gasLimit = sdkmath.NewInt(consParams.Block.MaxGas)
+	// Set the max base fee as upper bound of the base fee, if any.
+	if baseFee.IsNil() || params.MaxBaseFee.IsNil() || !params.MaxBaseFee.IsPositive() {
+		return baseFee
 	}
+	return sdkmath.LegacyMinDec(baseFee, params.MaxBaseFee)
+}
 
-	// CONTRACT: ElasticityMultiplier cannot be 0 as it's checked in the params
-	// validation
-	parentGasTargetInt := gasLimit.Quo(sdkmath.NewIntFromUint64(uint64(params.ElasticityMultiplier)))
+// calculateEIP1559BaseFee moves the parent base fee towards the gas target by
+// the EIP-1559 formula. With the clamped strategy, the change is bounded by the
+// max base fee change.
+func (k Keeper) calculateEIP1559BaseFee(ctx sdk.Context, params types.Params, parentBaseFee sdkmath.LegacyDec) sdkmath.LegacyDec {
+	parentGasUsed := k.GetBlockGasWanted(ctx)
+
+	parentGasTargetInt := k.blockGasTarget(ctx, params)
 	if !parentGasTargetInt.IsUint64() {
 		return sdkmath.LegacyDec{}
 	}
@@ -78,7 +89,7 @@ func (k Keeper) CalculateBaseFee(ctx sdk.Context) sdkmath.LegacyDec {
 			sdkmath.LegacyOneDec(),
 		)
 
-		return parentBaseFee.Add(baseFeeDelta)
+		return parentBaseFee.Add(clampBaseFeeDelta(params, parentBaseFee, baseFeeDelta))
 	}
 
 	// Otherwise if the parent block used less gas than its target, the baseFee
@@ -86,9 +97,119 @@ func (k Keeper) CalculateBaseFee(ctx sdk.Context) sdkmath.LegacyDec {
 	gasUsedDelta := sdkmath.NewIntFromUint64(parentGasTarget - parentGasUsed)
 	x := parentBaseFee.MulInt(gasUsedDelta)
 	y := x.QuoInt(parentGasTargetInt)
-	baseFeeDelta := y.QuoInt(baseFeeChangeDenominator)
+	baseFeeDelta := clampBaseFeeDelta(params, parentBaseFee, y.QuoInt(baseFeeChangeDenominator))
 
 	// Set global min gas price as lower bound of the base fee, transactions below
 	// the min gas price don't even reach the mempool.
 	return sdkmath.LegacyMaxDec(parentBaseFee.Sub(baseFeeDelta), params.MinGasPrice)
 }
+
+// clampBaseFeeDelta bounds the base fee change to the max base fee change of
+// the parent base fee, with the clamped strategy.
+func clampBaseFeeDelta(params types.Params, parentBaseFee, baseFeeDelta sdkmath.LegacyDec) sdkmath.LegacyDec {
+	if params.BaseFeeStrategy != types.BaseFeeStrategyClampedEIP1559 {
+		return baseFeeDelta
+	}
+	return sdkmath.LegacyMinDec(baseFeeDelta, parentBaseFee.Mul(params.MaxBaseFeeChange))
+}
+
+// maxExponentialBaseFee bounds the exponential base fee when the max base fee
+// is unbounded, so that it always fits the decimal and uint256 representations
+// of the fees.
+const maxExponentialBaseFee = 1 << 128
+
+// calculateExponentialBaseFee derives the base fee from the excess gas of the
+// previous blocks. The base fee grows exponentially while the blocks use more
+// gas than the target, and falls back to the min gas price otherwise.
+func (k Keeper) calculateExponentialBaseFee(ctx sdk.Context, params types.Params) sdkmath.LegacyDec {
+	// CONTRACT: the update fraction cannot be 0 with the exponential strategy
+	// as it's checked in the params validation
+	if params.BaseFeeUpdateFraction == 0 {
+		return params.MinGasPrice
+	}
+
+	// NOTE: the excess gas is bounded as the number of iterations of the
+	// exponential grows with its ratio to the update fraction
+	excessGas := k.GetExcessGas(ctx)
+	if maxExcess := maxExcessGas(params); excessGas > maxExcess {
+		excessGas = maxExcess
+	}
+
+	// compute the exponential on the decimal representation to keep the
+	// precision of fractional min gas prices
+	fee := fakeExponential(
+		params.MinGasPrice.BigInt(),
+		new(big.Int).SetUint64(excessGas),
+		new(big.Int).SetUint64(params.BaseFeeUpdateFraction),
+	)
+	return sdkmath.LegacyNewDecFromBigIntWithPrec(fee, sdkmath.LegacyPrecision)
+}
+
+// maxExcessGas returns the excess gas past which the exponential base fee
+// doesn't need to grow anymore, which is the excess gas at which it reaches the
+// max base fee (or maxExponentialBaseFee if it is unbounded), plus one update
+// fraction to make up for the rounding.
+func maxExcessGas(params types.Params) uint64 {
+	if !params.MinGasPrice.IsPositive() {
+		// the base fee is always zero
+		return math.MaxUint64
+	}
+
+	maxBaseFee := float64(maxExponentialBaseFee)
+	if !params.MaxBaseFee.IsNil() && params.MaxBaseFee.IsPositive() {
+		maxBaseFee, _ = params.MaxBaseFee.Float64()
+	}
+	minGasPrice, _ := params.MinGasPrice.Float64()
+
+	// minGasPrice * e^(excessGas / fraction) = maxBaseFee
+	ratio := math.Max(math.Log(maxBaseFee/minGasPrice), 0) + 1
+	excessGas := math.Ceil(ratio * float64(params.BaseFeeUpdateFraction))
+	if excessGas >= math.MaxUint64 {
+		return math.MaxUint64
+	}
+	return uint64(excessGas)
+}
+
+// blockGasTarget returns the block gas the base fee calculation targets.
+func (k Keeper) blockGasTarget(ctx sdk.Context, params types.Params) sdkmath.Int {
+	// NOTE: a MaxGas equal to -1 means that block gas is unlimited
+	maxGas := int64(-1)
+	if consParams := ctx.ConsensusParams(); consParams.Block != nil {
+		maxGas = consParams.Block.MaxGas
+	}
+	return params.BlockGasTarget(maxGas)
+}
+
+// SetExcessGas sets the excess gas of the next block to the store.
+// CONTRACT: this should be only called during EndBlock or InitGenesis.
+func (k Keeper) SetExcessGas(ctx sdk.Context, excessGas uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyPrefixExcessGas, sdk.Uint64ToBigEndian(excessGas))
+}
+
+// GetExcessGas returns the excess gas of the current block from the store.
+func (k Keeper) GetExcessGas(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	return sdk.BigEndianToUint64(store.Get(types.KeyPrefixExcessGas))
+}
+
+// CalculateExcessGas returns the excess gas of the next block, given the gas
+// wanted by the current one. With the exponential strategy, the excess gas is
+// capped where the base fee stops growing, so that it decreases as soon as the
+// blocks use less gas than the target.
+func (k Keeper) CalculateExcessGas(ctx sdk.Context, gasWanted uint64) uint64 {
+	params := k.GetParams(ctx)
+	target := k.blockGasTarget(ctx, params)
+	excessGas := sdkmath.NewIntFromUint64(k.GetExcessGas(ctx)).Add(sdkmath.NewIntFromUint64(gasWanted))
+	if excessGas.LTE(target) {
+		return 0
+	}
+	excessGas = excessGas.Sub(target)
+	if params.BaseFeeStrategy == types.BaseFeeStrategyExponential {
+		excessGas = sdkmath.MinInt(excessGas, sdkmath.NewIntFromUint64(maxExcessGas(params)))
+	}
+	if !excessGas.IsUint64() {
+		return math.MaxUint64
+	}
+	return excessGas.Uint64()
+}
@@ -0,0 +1,97 @@
+package keeper
+
+import (
+	"math/big"
+
+	"github.com/cosmos/evm/x/feemarket/types"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ----------------------------------------------------------------------------
+// Excess Blob Gas
+// Required by EIP4844 blob base fee calculation.
+// ----------------------------------------------------------------------------
+
+// SetExcessBlobGas sets the excess blob gas of the next block to the store.
+// CONTRACT: this should be only called during EndBlock or InitGenesis.
+func (k Keeper) SetExcessBlobGas(ctx sdk.Context, excessBlobGas uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyPrefixExcessBlobGas, sdk.Uint64ToBigEndian(excessBlobGas))
+}
+
+// GetExcessBlobGas returns the excess blob gas of the current block from the store.
+func (k Keeper) GetExcessBlobGas(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	return sdk.BigEndianToUint64(store.Get(types.KeyPrefixExcessBlobGas))
+}
+
+// GetTransientBlobGasUsed returns the blob gas used in the current block from transient store.
+func (k Keeper) GetTransientBlobGasUsed(ctx sdk.Context) uint64 {
+	store := ctx.TransientStore(k.transientKey)
+	return sdk.BigEndianToUint64(store.Get(types.KeyPrefixTransientBlobGasUsed))
+}
+
+// AddTransientBlobGasUsed adds the cumulative blob gas used in the transient store
+func (k Keeper) AddTransientBlobGasUsed(ctx sdk.Context, blobGasUsed uint64) (uint64, error) {
+	result := k.GetTransientBlobGasUsed(ctx) + blobGasUsed
+	store := ctx.TransientStore(k.transientKey)
+	store.Set(types.KeyPrefixTransientBlobGasUsed, sdk.Uint64ToBigEndian(result))
+	return result, nil
+}
+
+// GetBlobBaseFee returns the blob base fee of the current block, derived from
+// its excess blob gas. The fee grows exponentially while the blocks use more
+// blob gas than the target, and falls back to the minimum otherwise.
+// NOTE: This code is inspired from the go-ethereum EIP4844 implementation. For
+// the canonical code refer to: https://github.com/ethereum/go-ethereum/blob/master/consensus/misc/eip4844/eip4844.go
+func (k Keeper) GetBlobBaseFee(ctx sdk.Context) sdkmath.LegacyDec {
+	params := k.GetParams(ctx)
+	if params.MinBlobBaseFee.IsNil() {
+		return sdkmath.LegacyZeroDec()
+	}
+	// the params stored before the blob gas parameters were introduced have
+	// no update fraction
+	if params.BlobBaseFeeUpdateFraction == 0 {
+		return params.MinBlobBaseFee
+	}
+
+	// compute the exponential on the decimal representation to keep the
+	// precision of fractional minimum fees
+	fee := fakeExponential(
+		params.MinBlobBaseFee.BigInt(),
+		new(big.Int).SetUint64(k.GetExcessBlobGas(ctx)),
+		new(big.Int).SetUint64(params.BlobBaseFeeUpdateFraction),
+	)
+	return sdkmath.LegacyNewDecFromBigIntWithPrec(fee, sdkmath.LegacyPrecision)
+}
+
+// CalculateExcessBlobGas returns the excess blob gas of the next block, given
+// the blob gas used in the current one.
+func (k Keeper) CalculateExcessBlobGas(ctx sdk.Context, blobGasUsed uint64) uint64 {
+	target := k.GetParams(ctx).TargetBlobGasPerBlock
+	excessBlobGas := k.GetExcessBlobGas(ctx) + blobGasUsed
+	if excessBlobGas < target {
+		return 0
+	}
+	return excessBlobGas - target
+}
+
+// fakeExponential approximates factor * e ** (numerator / denominator) using
+// Taylor expansion.
+func fakeExponential(factor, numerator, denominator *big.Int) *big.Int {
+	var (
+		output = new(big.Int)
+		accum  = new(big.Int).Mul(factor, denominator)
+	)
+	for i := 1; accum.Sign() > 0; i++ {
+		output.Add(output, accum)
+
+		accum.Mul(accum, numerator)
+		accum.Div(accum, denominator)
+		accum.Div(accum, big.NewInt(int64(i)))
+	}
+	return output.Div(output, denominator)
+}
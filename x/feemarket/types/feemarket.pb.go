@@ -25,6 +25,42 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// BaseFeeStrategy defines the algorithms updating the base fee between blocks
+type BaseFeeStrategy int32
+
+const (
+	// BASE_FEE_STRATEGY_EIP1559 moves the base fee by the EIP-1559 formula,
+	// towards the gas target.
+	BaseFeeStrategyEIP1559 BaseFeeStrategy = 0
+	// BASE_FEE_STRATEGY_CLAMPED_EIP1559 moves the base fee by the EIP-1559
+	// formula, with a change bounded by the max base fee change.
+	BaseFeeStrategyClampedEIP1559 BaseFeeStrategy = 1
+	// BASE_FEE_STRATEGY_EXPONENTIAL derives the base fee from the gas used
+	// above the gas target by the previous blocks, like the EIP-4844 blob base
+	// fee, with the min gas price as the minimum.
+	BaseFeeStrategyExponential BaseFeeStrategy = 2
+)
+
+var BaseFeeStrategy_name = map[int32]string{
+	0: "BASE_FEE_STRATEGY_EIP1559",
+	1: "BASE_FEE_STRATEGY_CLAMPED_EIP1559",
+	2: "BASE_FEE_STRATEGY_EXPONENTIAL",
+}
+
+var BaseFeeStrategy_value = map[string]int32{
+	"BASE_FEE_STRATEGY_EIP1559":         0,
+	"BASE_FEE_STRATEGY_CLAMPED_EIP1559": 1,
+	"BASE_FEE_STRATEGY_EXPONENTIAL":     2,
+}
+
+func (x BaseFeeStrategy) String() string {
+	return proto.EnumName(BaseFeeStrategy_name, int32(x))
+}
+
+func (BaseFeeStrategy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_0fc4153d77de08e0, []int{0}
+}
+
 // Params defines the EVM module parameters
 type Params struct {
 	// no_base_fee forces the EIP-1559 base fee to 0 (needed for 0 price calls)
@@ -46,6 +82,34 @@ type Params struct {
 	// min_gas_multiplier bounds the minimum gas used to be charged
 	// to senders based on gas limit
 	MinGasMultiplier cosmossdk_io_math.LegacyDec `protobuf:"bytes,8,opt,name=min_gas_multiplier,json=minGasMultiplier,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"min_gas_multiplier"`
+	// min_blob_base_fee is the minimum EIP-4844 blob base fee, reached when the
+	// blocks don't use more blob gas than the target.
+	MinBlobBaseFee cosmossdk_io_math.LegacyDec `protobuf:"bytes,9,opt,name=min_blob_base_fee,json=minBlobBaseFee,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"min_blob_base_fee"`
+	// target_blob_gas_per_block is the blob gas a block can use without
+	// increasing the blob base fee.
+	TargetBlobGasPerBlock uint64 `protobuf:"varint,10,opt,name=target_blob_gas_per_block,json=targetBlobGasPerBlock,proto3" json:"target_blob_gas_per_block,omitempty"`
+	// max_blob_gas_per_block is the maximum blob gas of the transactions of a
+	// block.
+	MaxBlobGasPerBlock uint64 `protobuf:"varint,11,opt,name=max_blob_gas_per_block,json=maxBlobGasPerBlock,proto3" json:"max_blob_gas_per_block,omitempty"`
+	// blob_base_fee_update_fraction bounds the amount the blob base fee can
+	// change between blocks.
+	BlobBaseFeeUpdateFraction uint64 `protobuf:"varint,12,opt,name=blob_base_fee_update_fraction,json=blobBaseFeeUpdateFraction,proto3" json:"blob_base_fee_update_fraction,omitempty"`
+	// reject_blob_sidecars rejects the blob transactions submitted with their
+	// blob sidecar instead of discarding the sidecar.
+	RejectBlobSidecars bool `protobuf:"varint,13,opt,name=reject_blob_sidecars,json=rejectBlobSidecars,proto3" json:"reject_blob_sidecars,omitempty"`
+	// base_fee_strategy is the algorithm updating the base fee between blocks.
+	BaseFeeStrategy BaseFeeStrategy `protobuf:"varint,14,opt,name=base_fee_strategy,json=baseFeeStrategy,proto3,enum=cosmos.evm.feemarket.v1.BaseFeeStrategy" json:"base_fee_strategy,omitempty"`
+	// gas_target is the block gas the base fee calculation targets. Zero
+	// defaults to the block max gas divided by the elasticity multiplier.
+	GasTarget uint64 `protobuf:"varint,15,opt,name=gas_target,json=gasTarget,proto3" json:"gas_target,omitempty"`
+	// max_base_fee_change bounds the relative change of the base fee between
+	// blocks with the clamped EIP-1559 strategy, e.g. 0.125 for 12.5%.
+	MaxBaseFeeChange cosmossdk_io_math.LegacyDec `protobuf:"bytes,16,opt,name=max_base_fee_change,json=maxBaseFeeChange,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_base_fee_change"`
+	// max_base_fee is the upper bound of the base fee. Zero means unbounded.
+	MaxBaseFee cosmossdk_io_math.LegacyDec `protobuf:"bytes,17,opt,name=max_base_fee,json=maxBaseFee,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_base_fee"`
+	// base_fee_update_fraction bounds the change of the base fee with the
+	// exponential strategy, as the excess gas multiplying the base fee by e.
+	BaseFeeUpdateFraction uint64 `protobuf:"varint,18,opt,name=base_fee_update_fraction,json=baseFeeUpdateFraction,proto3" json:"base_fee_update_fraction,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -109,7 +173,57 @@ func (m *Params) GetEnableHeight() int64 {
 	return 0
 }
 
+func (m *Params) GetTargetBlobGasPerBlock() uint64 {
+	if m != nil {
+		return m.TargetBlobGasPerBlock
+	}
+	return 0
+}
+
+func (m *Params) GetMaxBlobGasPerBlock() uint64 {
+	if m != nil {
+		return m.MaxBlobGasPerBlock
+	}
+	return 0
+}
+
+func (m *Params) GetBlobBaseFeeUpdateFraction() uint64 {
+	if m != nil {
+		return m.BlobBaseFeeUpdateFraction
+	}
+	return 0
+}
+
+func (m *Params) GetRejectBlobSidecars() bool {
+	if m != nil {
+		return m.RejectBlobSidecars
+	}
+	return false
+}
+
+func (m *Params) GetBaseFeeStrategy() BaseFeeStrategy {
+	if m != nil {
+		return m.BaseFeeStrategy
+	}
+	return BaseFeeStrategyEIP1559
+}
+
+func (m *Params) GetGasTarget() uint64 {
+	if m != nil {
+		return m.GasTarget
+	}
+	return 0
+}
+
+func (m *Params) GetBaseFeeUpdateFraction() uint64 {
+	if m != nil {
+		return m.BaseFeeUpdateFraction
+	}
+	return 0
+}
+
 func init() {
+	proto.RegisterEnum("cosmos.evm.feemarket.v1.BaseFeeStrategy", BaseFeeStrategy_name, BaseFeeStrategy_value)
 	proto.RegisterType((*Params)(nil), "cosmos.evm.feemarket.v1.Params")
 }
 
@@ -118,34 +232,55 @@ func init() {
 }
 
 var fileDescriptor_0fc4153d77de08e0 = []byte{
-	// 423 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x52, 0xb1, 0x6e, 0xd4, 0x40,
-	0x10, 0xf5, 0x72, 0xc9, 0xc5, 0xd9, 0x70, 0xd2, 0xb1, 0x0a, 0xc2, 0x4a, 0x84, 0x63, 0x85, 0x22,
-	0x56, 0x0a, 0x5b, 0x51, 0x3a, 0x24, 0x0a, 0x2e, 0x11, 0x20, 0x14, 0xa4, 0xc8, 0x05, 0x05, 0xcd,
-	0x6a, 0xed, 0x4c, 0xec, 0x55, 0xbc, 0xbb, 0x96, 0x77, 0x63, 0x71, 0xbf, 0x40, 0xc5, 0x67, 0x50,
-	0xe6, 0x33, 0x52, 0xa6, 0x44, 0x14, 0x11, 0xba, 0x2b, 0x8e, 0xcf, 0x40, 0xe7, 0xe5, 0xce, 0xd7,
-	0x50, 0x5c, 0x63, 0x8d, 0xdf, 0x7b, 0xf3, 0x34, 0x3b, 0xf3, 0xf0, 0x51, 0xa6, 0xb4, 0x50, 0x3a,
-	0x86, 0x46, 0xc4, 0xd7, 0x00, 0x82, 0xd5, 0x37, 0x60, 0xe2, 0xe6, 0xa4, 0xfb, 0x89, 0xaa, 0x5a,
-	0x19, 0x45, 0x5e, 0x58, 0x61, 0x04, 0x8d, 0x88, 0x3a, 0xae, 0x39, 0xd9, 0x7b, 0xc6, 0x04, 0x97,
-	0x2a, 0x6e, 0xbf, 0x56, 0xbb, 0xb7, 0x9b, 0xab, 0x5c, 0xb5, 0x65, 0x3c, 0xaf, 0x2c, 0x7a, 0xf8,
-	0xa7, 0x87, 0xfb, 0x97, 0xac, 0x66, 0x42, 0x13, 0x1f, 0xef, 0x48, 0x45, 0x53, 0xa6, 0x81, 0x5e,
-	0x03, 0x78, 0x28, 0x40, 0xa1, 0x9b, 0x6c, 0x4b, 0x35, 0x62, 0x1a, 0xde, 0x01, 0x90, 0x37, 0x78,
-	0x7f, 0x41, 0xd2, 0xac, 0x60, 0x32, 0x07, 0x7a, 0x05, 0x52, 0x09, 0x2e, 0x99, 0x51, 0xb5, 0xf7,
-	0x24, 0x40, 0xe1, 0x20, 0xf1, 0x52, 0xab, 0x3e, 0x6b, 0x05, 0xe7, 0x1d, 0x4f, 0x4e, 0xf1, 0x73,
-	0x28, 0x99, 0x36, 0x3c, 0xe3, 0x66, 0x4c, 0xc5, 0x6d, 0x69, 0x78, 0x55, 0x72, 0xa8, 0xbd, 0x5e,
-	0xdb, 0xb8, 0xdb, 0x91, 0x9f, 0x96, 0x1c, 0x79, 0x85, 0x07, 0x20, 0x59, 0x5a, 0x02, 0x2d, 0x80,
-	0xe7, 0x85, 0xf1, 0x36, 0x03, 0x14, 0xf6, 0x92, 0xa7, 0x16, 0xfc, 0xd0, 0x62, 0xe4, 0x0c, 0xbb,
-	0xcb, 0xa9, 0xfb, 0x01, 0x0a, 0xb7, 0x47, 0xe1, 0xfd, 0xe3, 0x81, 0xf3, 0xeb, 0xf1, 0x60, 0xdf,
-	0xee, 0x47, 0x5f, 0xdd, 0x44, 0x5c, 0xc5, 0x82, 0x99, 0x22, 0xba, 0x80, 0x9c, 0x65, 0xe3, 0x73,
-	0xc8, 0x7e, 0xcc, 0xee, 0x8e, 0x51, 0xb2, 0xf5, 0x6f, 0x5e, 0x72, 0x81, 0x07, 0x82, 0x4b, 0x9a,
-	0x33, 0x4d, 0xab, 0x9a, 0x67, 0xe0, 0x6d, 0xad, 0xe9, 0xb4, 0x23, 0xb8, 0x7c, 0xcf, 0xf4, 0xe5,
-	0xbc, 0x99, 0x7c, 0xc6, 0x64, 0xe1, 0xb6, 0xf2, 0x52, 0x77, 0x4d, 0xcb, 0xa1, 0xb5, 0xec, 0xf6,
-	0xf1, 0xfa, 0xf0, 0xdb, 0xec, 0xee, 0xf8, 0xe5, 0x4a, 0x3c, 0xbe, 0xae, 0x04, 0xc4, 0xde, 0xf1,
-	0xe3, 0x86, 0xbb, 0x31, 0xdc, 0x4c, 0x86, 0x5c, 0x72, 0xc3, 0x59, 0xb9, 0x3c, 0xe8, 0xe8, 0xed,
-	0xfd, 0xc4, 0x47, 0x0f, 0x13, 0x1f, 0xfd, 0x9e, 0xf8, 0xe8, 0xfb, 0xd4, 0x77, 0x1e, 0xa6, 0xbe,
-	0xf3, 0x73, 0xea, 0x3b, 0x5f, 0x8e, 0x72, 0x6e, 0x8a, 0xdb, 0x34, 0xca, 0x94, 0x88, 0xff, 0xe3,
-	0x6d, 0xc6, 0x15, 0xe8, 0xb4, 0xdf, 0x86, 0xe6, 0xf4, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x82,
-	0x59, 0x74, 0x66, 0xa1, 0x02, 0x00, 0x00,
+	// 759 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0x4d, 0x4f, 0xe3, 0x46,
+	0x18, 0x8e, 0x77, 0xb3, 0x6c, 0x32, 0x10, 0x48, 0xa6, 0xec, 0xd6, 0x78, 0x15, 0xaf, 0x77, 0x7b,
+	0x58, 0x8b, 0x83, 0x53, 0x76, 0xb5, 0x6a, 0xa9, 0x54, 0xa9, 0x49, 0x08, 0x5f, 0x0a, 0x34, 0x72,
+	0xd2, 0xcf, 0x8b, 0x35, 0x76, 0x5e, 0x9c, 0x29, 0x1e, 0x4f, 0x64, 0x0f, 0x51, 0xf2, 0x0f, 0xaa,
+	0x9c, 0xfa, 0x07, 0x38, 0xf5, 0xd2, 0x23, 0x3f, 0x83, 0x23, 0xc7, 0xaa, 0x07, 0x5a, 0xc1, 0x81,
+	0x7b, 0x7f, 0x41, 0x65, 0x3b, 0x5f, 0xa4, 0x70, 0xc8, 0xc5, 0xb2, 0xdf, 0xe7, 0x63, 0x9e, 0x79,
+	0x5f, 0xcf, 0xa0, 0x77, 0x0e, 0x0f, 0x19, 0x0f, 0x4b, 0xd0, 0x63, 0xa5, 0x13, 0x00, 0x46, 0x82,
+	0x53, 0x10, 0xa5, 0xde, 0xd6, 0xf4, 0xc3, 0xe8, 0x06, 0x5c, 0x70, 0xfc, 0x69, 0x42, 0x34, 0xa0,
+	0xc7, 0x8c, 0x29, 0xd6, 0xdb, 0x52, 0x0a, 0x84, 0x51, 0x9f, 0x97, 0xe2, 0x67, 0xc2, 0x55, 0xd6,
+	0x5d, 0xee, 0xf2, 0xf8, 0xb5, 0x14, 0xbd, 0x25, 0xd5, 0xb7, 0xff, 0x66, 0xd0, 0x52, 0x83, 0x04,
+	0x84, 0x85, 0x58, 0x45, 0xcb, 0x3e, 0xb7, 0x6c, 0x12, 0x82, 0x75, 0x02, 0x20, 0x4b, 0x9a, 0xa4,
+	0x67, 0xcc, 0xac, 0xcf, 0x2b, 0x24, 0x84, 0x5d, 0x00, 0xfc, 0x35, 0x7a, 0x35, 0x06, 0x2d, 0xa7,
+	0x43, 0x7c, 0x17, 0xac, 0x36, 0xf8, 0x9c, 0x51, 0x9f, 0x08, 0x1e, 0xc8, 0x4f, 0x34, 0x49, 0xcf,
+	0x99, 0xb2, 0x9d, 0xb0, 0xab, 0x31, 0x61, 0x67, 0x8a, 0xe3, 0x0f, 0xe8, 0x05, 0x78, 0x24, 0x14,
+	0xd4, 0xa1, 0x62, 0x60, 0xb1, 0x33, 0x4f, 0xd0, 0xae, 0x47, 0x21, 0x90, 0x9f, 0xc6, 0xc2, 0xf5,
+	0x29, 0x78, 0x34, 0xc1, 0xf0, 0x67, 0x28, 0x07, 0x3e, 0xb1, 0x3d, 0xb0, 0x3a, 0x40, 0xdd, 0x8e,
+	0x90, 0x9f, 0x69, 0x92, 0xfe, 0xd4, 0x5c, 0x49, 0x8a, 0xfb, 0x71, 0x0d, 0x57, 0x51, 0x66, 0x92,
+	0x7a, 0x49, 0x93, 0xf4, 0x6c, 0x45, 0xbf, 0xbc, 0x7e, 0x9d, 0xfa, 0xeb, 0xfa, 0xf5, 0xab, 0xa4,
+	0x3f, 0x61, 0xfb, 0xd4, 0xa0, 0xbc, 0xc4, 0x88, 0xe8, 0x18, 0x75, 0x70, 0x89, 0x33, 0xd8, 0x01,
+	0xe7, 0x8f, 0xbb, 0x8b, 0x4d, 0xc9, 0x7c, 0x3e, 0xca, 0x8b, 0xeb, 0x28, 0xc7, 0xa8, 0x6f, 0xb9,
+	0x24, 0xb4, 0xba, 0x01, 0x75, 0x40, 0x7e, 0xbe, 0xa0, 0xd3, 0x32, 0xa3, 0xfe, 0x1e, 0x09, 0x1b,
+	0x91, 0x18, 0x7f, 0x8f, 0xf0, 0xd8, 0x6d, 0x66, 0xa7, 0x99, 0x05, 0x2d, 0xf3, 0x89, 0xe5, 0x4c,
+	0x3f, 0x9a, 0xa8, 0x10, 0xf9, 0xda, 0x1e, 0xb7, 0xa7, 0x93, 0xca, 0x2e, 0x68, 0xbb, 0xca, 0xa8,
+	0x5f, 0xf1, 0xb8, 0x3d, 0x1e, 0xec, 0x97, 0x68, 0x43, 0x90, 0xc0, 0x05, 0x91, 0xf8, 0xc6, 0x2d,
+	0x80, 0x20, 0xfa, 0x70, 0x4e, 0x65, 0xa4, 0x49, 0x7a, 0xda, 0x7c, 0x91, 0x10, 0x22, 0x55, 0xb4,
+	0x47, 0x08, 0x2a, 0x11, 0x88, 0xdf, 0xa3, 0x97, 0x8c, 0xf4, 0x1f, 0x92, 0x2d, 0xc7, 0x32, 0xcc,
+	0x48, 0x7f, 0x5e, 0xf3, 0x0d, 0x2a, 0xde, 0x8b, 0x6f, 0x9d, 0x75, 0xdb, 0x44, 0x80, 0x75, 0x12,
+	0x10, 0x47, 0x50, 0xee, 0xcb, 0x2b, 0xb1, 0x74, 0xc3, 0x9e, 0x26, 0xfc, 0x2e, 0x66, 0xec, 0x8e,
+	0x08, 0xf8, 0x73, 0xb4, 0x1e, 0xc0, 0x2f, 0xe0, 0x8c, 0xf2, 0x86, 0xb4, 0x0d, 0x0e, 0x09, 0x42,
+	0x39, 0x17, 0xff, 0xb1, 0x38, 0xc1, 0xa2, 0x65, 0x9b, 0x23, 0x04, 0xb7, 0x50, 0x61, 0xb2, 0x5c,
+	0x28, 0x02, 0x22, 0xc0, 0x1d, 0xc8, 0xab, 0x9a, 0xa4, 0xaf, 0xbe, 0xd7, 0x8d, 0x47, 0xce, 0x90,
+	0x31, 0x5a, 0xbc, 0x39, 0xe2, 0x9b, 0x6b, 0xf6, 0xfd, 0x02, 0x2e, 0x22, 0x14, 0x6d, 0x3a, 0x69,
+	0x8d, 0xbc, 0x16, 0xc7, 0xce, 0xba, 0x24, 0x6c, 0xc5, 0x05, 0xfc, 0x03, 0xfa, 0x24, 0x6e, 0xce,
+	0xfd, 0x33, 0x23, 0xe7, 0x17, 0xfe, 0x09, 0x48, 0xbf, 0x32, 0x7b, 0xa8, 0xf0, 0x21, 0x5a, 0x99,
+	0x35, 0x96, 0x0b, 0x0b, 0x3a, 0xa2, 0xa9, 0x23, 0xfe, 0x02, 0xc9, 0x8f, 0x0e, 0x02, 0x27, 0xa3,
+	0xb7, 0x1f, 0x1a, 0xc2, 0x57, 0x6f, 0x87, 0x77, 0x17, 0x9b, 0xc5, 0x99, 0x8b, 0xaa, 0x3f, 0x73,
+	0x55, 0x25, 0x37, 0xca, 0x61, 0x3a, 0x93, 0xce, 0x3f, 0x33, 0xf3, 0xd4, 0xa7, 0x82, 0x12, 0x6f,
+	0x12, 0x78, 0xf3, 0x6f, 0x09, 0xad, 0xcd, 0x75, 0x17, 0x6f, 0xa3, 0x8d, 0x4a, 0xb9, 0x59, 0xb3,
+	0x76, 0x6b, 0x35, 0xab, 0xd9, 0x32, 0xcb, 0xad, 0xda, 0xde, 0x4f, 0x56, 0xed, 0xa0, 0xb1, 0xf5,
+	0xf1, 0xe3, 0x76, 0x3e, 0xa5, 0x28, 0xc3, 0x73, 0xed, 0xe5, 0x9c, 0x66, 0x84, 0xe2, 0x7d, 0xf4,
+	0xe6, 0xff, 0xd2, 0x6a, 0xbd, 0x7c, 0xd4, 0xa8, 0xed, 0x4c, 0x2c, 0x24, 0xe5, 0xcd, 0xf0, 0x5c,
+	0x2b, 0xce, 0x59, 0x54, 0x3d, 0xc2, 0xba, 0xd0, 0x1e, 0x3b, 0x95, 0x51, 0xf1, 0x81, 0x10, 0x3f,
+	0x36, 0xbe, 0x3d, 0xae, 0x1d, 0xb7, 0x0e, 0xca, 0xf5, 0xfc, 0x13, 0x45, 0x1d, 0x9e, 0x6b, 0xca,
+	0x7c, 0x90, 0x7e, 0x97, 0xfb, 0xe0, 0x47, 0x9b, 0x54, 0xd2, 0xbf, 0xfe, 0xae, 0xa6, 0x2a, 0xe5,
+	0xcb, 0x1b, 0x55, 0xba, 0xba, 0x51, 0xa5, 0x7f, 0x6e, 0x54, 0xe9, 0xb7, 0x5b, 0x35, 0x75, 0x75,
+	0xab, 0xa6, 0xfe, 0xbc, 0x55, 0x53, 0x3f, 0xbf, 0x73, 0xa9, 0xe8, 0x9c, 0xd9, 0x86, 0xc3, 0x59,
+	0xe9, 0x91, 0xee, 0x89, 0x41, 0x17, 0x42, 0x7b, 0x29, 0xbe, 0xa0, 0x3f, 0xfc, 0x37, 0x00, 0x8d,
+	0xd2, 0x1b, 0x64, 0x0d, 0x06, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -168,6 +303,82 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.BaseFeeUpdateFraction != 0 {
+		i = encodeVarintFeemarket(dAtA, i, uint64(m.BaseFeeUpdateFraction))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	{
+		size := m.MaxBaseFee.Size()
+		i -= size
+		if _, err := m.MaxBaseFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x8a
+	{
+		size := m.MaxBaseFeeChange.Size()
+		i -= size
+		if _, err := m.MaxBaseFeeChange.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x82
+	if m.GasTarget != 0 {
+		i = encodeVarintFeemarket(dAtA, i, uint64(m.GasTarget))
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.BaseFeeStrategy != 0 {
+		i = encodeVarintFeemarket(dAtA, i, uint64(m.BaseFeeStrategy))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.RejectBlobSidecars {
+		i--
+		if m.RejectBlobSidecars {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.BlobBaseFeeUpdateFraction != 0 {
+		i = encodeVarintFeemarket(dAtA, i, uint64(m.BlobBaseFeeUpdateFraction))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.MaxBlobGasPerBlock != 0 {
+		i = encodeVarintFeemarket(dAtA, i, uint64(m.MaxBlobGasPerBlock))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.TargetBlobGasPerBlock != 0 {
+		i = encodeVarintFeemarket(dAtA, i, uint64(m.TargetBlobGasPerBlock))
+		i--
+		dAtA[i] = 0x50
+	}
+	{
+		size := m.MinBlobBaseFee.Size()
+		i -= size
+		if _, err := m.MinBlobBaseFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
 	{
 		size := m.MinGasMultiplier.Size()
 		i -= size
@@ -261,6 +472,33 @@ func (m *Params) Size() (n int) {
 	n += 1 + l + sovFeemarket(uint64(l))
 	l = m.MinGasMultiplier.Size()
 	n += 1 + l + sovFeemarket(uint64(l))
+	l = m.MinBlobBaseFee.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	if m.TargetBlobGasPerBlock != 0 {
+		n += 1 + sovFeemarket(uint64(m.TargetBlobGasPerBlock))
+	}
+	if m.MaxBlobGasPerBlock != 0 {
+		n += 1 + sovFeemarket(uint64(m.MaxBlobGasPerBlock))
+	}
+	if m.BlobBaseFeeUpdateFraction != 0 {
+		n += 1 + sovFeemarket(uint64(m.BlobBaseFeeUpdateFraction))
+	}
+	if m.RejectBlobSidecars {
+		n += 2
+	}
+	if m.BaseFeeStrategy != 0 {
+		n += 1 + sovFeemarket(uint64(m.BaseFeeStrategy))
+	}
+	if m.GasTarget != 0 {
+		n += 1 + sovFeemarket(uint64(m.GasTarget))
+	}
+	l = m.MaxBaseFeeChange.Size()
+	n += 2 + l + sovFeemarket(uint64(l))
+	l = m.MaxBaseFee.Size()
+	n += 2 + l + sovFeemarket(uint64(l))
+	if m.BaseFeeUpdateFraction != 0 {
+		n += 2 + sovFeemarket(uint64(m.BaseFeeUpdateFraction))
+	}
 	return n
 }
 
@@ -478,6 +716,242 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinBlobBaseFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinBlobBaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetBlobGasPerBlock", wireType)
+			}
+			m.TargetBlobGasPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TargetBlobGasPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBlobGasPerBlock", wireType)
+			}
+			m.MaxBlobGasPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBlobGasPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlobBaseFeeUpdateFraction", wireType)
+			}
+			m.BlobBaseFeeUpdateFraction = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlobBaseFeeUpdateFraction |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RejectBlobSidecars", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RejectBlobSidecars = bool(v != 0)
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFeeStrategy", wireType)
+			}
+			m.BaseFeeStrategy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BaseFeeStrategy |= BaseFeeStrategy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasTarget", wireType)
+			}
+			m.GasTarget = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasTarget |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBaseFeeChange", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxBaseFeeChange.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBaseFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxBaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFeeUpdateFraction", wireType)
+			}
+			m.BaseFeeUpdateFraction = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BaseFeeUpdateFraction |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipFeemarket(dAtA[iNdEx:])
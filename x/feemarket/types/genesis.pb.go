@@ -31,6 +31,12 @@ type GenesisState struct {
 	// block_gas is the amount of gas wanted on the last block before the upgrade.
 	// Zero by default.
 	BlockGas uint64 `protobuf:"varint,3,opt,name=block_gas,json=blockGas,proto3" json:"block_gas,omitempty"`
+	// excess_blob_gas is the EIP-4844 excess blob gas on the last block before
+	// the upgrade. Zero by default.
+	ExcessBlobGas uint64 `protobuf:"varint,4,opt,name=excess_blob_gas,json=excessBlobGas,proto3" json:"excess_blob_gas,omitempty"`
+	// excess_gas is the gas used above the gas target by the blocks before the
+	// upgrade, used by the exponential base fee strategy. Zero by default.
+	ExcessGas uint64 `protobuf:"varint,5,opt,name=excess_gas,json=excessGas,proto3" json:"excess_gas,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -80,6 +86,20 @@ func (m *GenesisState) GetBlockGas() uint64 {
 	return 0
 }
 
+func (m *GenesisState) GetExcessBlobGas() uint64 {
+	if m != nil {
+		return m.ExcessBlobGas
+	}
+	return 0
+}
+
+func (m *GenesisState) GetExcessGas() uint64 {
+	if m != nil {
+		return m.ExcessGas
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "cosmos.evm.feemarket.v1.GenesisState")
 }
@@ -89,24 +109,26 @@ func init() {
 }
 
 var fileDescriptor_07c64d3a2a89a388 = []byte{
-	// 259 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4d, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x4f, 0x2d, 0xcb, 0xd5, 0x4f, 0x4b, 0x4d, 0xcd, 0x4d, 0x2c, 0xca, 0x4e, 0x2d,
-	0xd1, 0x2f, 0x33, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f,
-	0xc9, 0x17, 0x12, 0x87, 0x28, 0xd3, 0x4b, 0x2d, 0xcb, 0xd5, 0x83, 0x2b, 0xd3, 0x2b, 0x33, 0x94,
-	0x12, 0x4c, 0xcc, 0xcd, 0xcc, 0xcb, 0xd7, 0x07, 0x93, 0x10, 0xb5, 0x52, 0xea, 0xb8, 0x8c, 0x44,
-	0x68, 0x84, 0x28, 0x14, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0x33, 0xf5, 0x41, 0x2c, 0x88, 0xa8, 0x52,
-	0x3d, 0x17, 0x8f, 0x3b, 0xc4, 0xee, 0xe0, 0x92, 0xc4, 0x92, 0x54, 0x21, 0x27, 0x2e, 0xb6, 0x82,
-	0xc4, 0xa2, 0xc4, 0xdc, 0x62, 0x09, 0x46, 0x05, 0x46, 0x0d, 0x6e, 0x23, 0x79, 0x3d, 0x1c, 0x6e,
-	0xd1, 0x0b, 0x00, 0x2b, 0x73, 0xe2, 0x3c, 0x71, 0x4f, 0x9e, 0x61, 0xc5, 0xf3, 0x0d, 0x5a, 0x8c,
-	0x41, 0x50, 0x9d, 0x42, 0xd2, 0x5c, 0x9c, 0x49, 0x39, 0xf9, 0xc9, 0xd9, 0xf1, 0xe9, 0x89, 0xc5,
-	0x12, 0xcc, 0x0a, 0x8c, 0x1a, 0x2c, 0x41, 0x1c, 0x60, 0x01, 0xf7, 0xc4, 0x62, 0x2f, 0x16, 0x0e,
-	0x26, 0x01, 0xe6, 0x20, 0x8e, 0xa4, 0xc4, 0xe2, 0xd4, 0xf8, 0xb4, 0xd4, 0x54, 0x27, 0xc7, 0x13,
-	0x8f, 0xe4, 0x18, 0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39, 0x86,
-	0x0b, 0x8f, 0xe5, 0x18, 0x6e, 0x3c, 0x96, 0x63, 0x88, 0x52, 0x4f, 0xcf, 0x2c, 0xc9, 0x28, 0x4d,
-	0xd2, 0x4b, 0xce, 0xcf, 0xd5, 0x47, 0xf2, 0x64, 0x05, 0x92, 0x37, 0x4b, 0x2a, 0x0b, 0x52, 0x8b,
-	0x93, 0xd8, 0xc0, 0x5e, 0x31, 0x06, 0x04, 0x00, 0x00, 0xff, 0xff, 0x8e, 0xbd, 0xe4, 0x86, 0x5e,
-	0x01, 0x00, 0x00,
+	// 302 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0xd0, 0x41, 0x4a, 0x33, 0x31,
+	0x14, 0x07, 0xf0, 0xc9, 0xd7, 0x7e, 0xa5, 0x8d, 0x8a, 0x3a, 0x08, 0x96, 0x8a, 0x69, 0x11, 0xb4,
+	0xc5, 0x45, 0x42, 0xf5, 0x04, 0xce, 0xa6, 0xe0, 0x4a, 0xea, 0xce, 0x4d, 0x49, 0x86, 0xd7, 0xb1,
+	0xb4, 0x69, 0x4a, 0x5f, 0x1c, 0xea, 0x2d, 0x3c, 0x86, 0x4b, 0x4f, 0x21, 0x5d, 0x76, 0xe9, 0x4a,
+	0x64, 0x66, 0xe1, 0x35, 0x64, 0x92, 0xa2, 0xdd, 0x74, 0x13, 0x1e, 0x7f, 0x7e, 0x2f, 0x09, 0x7f,
+	0x7a, 0x1e, 0x1b, 0xd4, 0x06, 0x05, 0xa4, 0x5a, 0x0c, 0x01, 0xb4, 0x9c, 0x8f, 0xc1, 0x8a, 0xb4,
+	0x2b, 0x12, 0x98, 0x02, 0x8e, 0x90, 0xcf, 0xe6, 0xc6, 0x9a, 0xf0, 0xd8, 0x33, 0x0e, 0xa9, 0xe6,
+	0xbf, 0x8c, 0xa7, 0xdd, 0xc6, 0xa1, 0xd4, 0xa3, 0xa9, 0x11, 0xee, 0xf4, 0xb6, 0xd1, 0xde, 0x76,
+	0xe5, 0xdf, 0xa2, 0x87, 0x47, 0x89, 0x49, 0x8c, 0x1b, 0x45, 0x31, 0xf9, 0xf4, 0xec, 0x9d, 0xd0,
+	0xdd, 0x9e, 0x7f, 0xfc, 0xde, 0x4a, 0x0b, 0x61, 0x44, 0x2b, 0x33, 0x39, 0x97, 0x1a, 0xeb, 0xa4,
+	0x45, 0x3a, 0x3b, 0x57, 0x4d, 0xbe, 0xe5, 0x33, 0xfc, 0xce, 0xb1, 0xa8, 0xb6, 0xfc, 0x6c, 0x06,
+	0xaf, 0xdf, 0x6f, 0x97, 0xa4, 0xbf, 0xde, 0x0c, 0x4f, 0x68, 0x4d, 0x4d, 0x4c, 0x3c, 0x1e, 0x24,
+	0x12, 0xeb, 0xa5, 0x16, 0xe9, 0x94, 0xfb, 0x55, 0x17, 0xf4, 0x24, 0x86, 0x17, 0x74, 0x1f, 0x16,
+	0x31, 0x20, 0x0e, 0xd4, 0xc4, 0x28, 0x47, 0xca, 0x8e, 0xec, 0xf9, 0x38, 0x9a, 0x18, 0x55, 0xb8,
+	0x53, 0x4a, 0xd7, 0xae, 0x20, 0xff, 0x1d, 0xa9, 0xf9, 0xa4, 0x27, 0xf1, 0xb6, 0x5c, 0xfd, 0x77,
+	0x50, 0xea, 0x57, 0x95, 0x44, 0x18, 0x0c, 0x01, 0xa2, 0x9b, 0x65, 0xc6, 0xc8, 0x2a, 0x63, 0xe4,
+	0x2b, 0x63, 0xe4, 0x25, 0x67, 0xc1, 0x2a, 0x67, 0xc1, 0x47, 0xce, 0x82, 0x87, 0x76, 0x32, 0xb2,
+	0x8f, 0x4f, 0x8a, 0xc7, 0x46, 0x8b, 0x8d, 0xb2, 0x16, 0x1b, 0x75, 0xd9, 0xe7, 0x19, 0xa0, 0xaa,
+	0xb8, 0x4a, 0xae, 0x7f, 0x06, 0x00, 0xcf, 0x16, 0x53, 0x20, 0xa6, 0x01, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -129,6 +151,16 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ExcessGas != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.ExcessGas))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ExcessBlobGas != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.ExcessBlobGas))
+		i--
+		dAtA[i] = 0x20
+	}
 	if m.BlockGas != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.BlockGas))
 		i--
@@ -169,6 +201,12 @@ func (m *GenesisState) Size() (n int) {
 	if m.BlockGas != 0 {
 		n += 1 + sovGenesis(uint64(m.BlockGas))
 	}
+	if m.ExcessBlobGas != 0 {
+		n += 1 + sovGenesis(uint64(m.ExcessBlobGas))
+	}
+	if m.ExcessGas != 0 {
+		n += 1 + sovGenesis(uint64(m.ExcessGas))
+	}
 	return n
 }
 
@@ -259,6 +297,44 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExcessBlobGas", wireType)
+			}
+			m.ExcessBlobGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExcessBlobGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExcessGas", wireType)
+			}
+			m.ExcessGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExcessGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
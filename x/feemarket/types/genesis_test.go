@@ -30,6 +30,8 @@ func (suite *GenesisTestSuite) TestValidateGenesis() {
 			&GenesisState{
 				DefaultParams(),
 				uint64(1),
+				uint64(1),
+				uint64(1),
 			},
 			true,
 		},
@@ -20,18 +20,24 @@ const (
 const (
 	prefixBlockGasWanted    = iota + 1
 	deprecatedPrefixBaseFee // unused
+	prefixExcessBlobGas
+	prefixExcessGas
 )
 
 const (
 	prefixTransientBlockGasUsed = iota + 1
+	prefixTransientBlobGasUsed
 )
 
 // KVStore key prefixes
 var (
 	KeyPrefixBlockGasWanted = []byte{prefixBlockGasWanted}
+	KeyPrefixExcessBlobGas  = []byte{prefixExcessBlobGas}
+	KeyPrefixExcessGas      = []byte{prefixExcessGas}
 )
 
 // Transient Store key prefixes
 var (
 	KeyPrefixTransientBlockGasWanted = []byte{prefixTransientBlockGasUsed}
+	KeyPrefixTransientBlobGasUsed    = []byte{prefixTransientBlobGasUsed}
 )
@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	gomath "math"
 
 	"github.com/ethereum/go-ethereum/params"
 
@@ -19,11 +20,32 @@ var (
 	DefaultEnableHeight = int64(0)
 	// DefaultNoBaseFee is false
 	DefaultNoBaseFee = false
+	// DefaultMinBlobBaseFee is the Ethereum minimum blob gas price
+	DefaultMinBlobBaseFee = math.LegacyNewDec(params.BlobTxMinBlobGasprice)
+	// DefaultTargetBlobGasPerBlock is the Cancun target of 3 blobs per block
+	DefaultTargetBlobGasPerBlock = uint64(params.DefaultCancunBlobConfig.Target) * params.BlobTxBlobGasPerBlob
+	// DefaultMaxBlobGasPerBlock is the Cancun maximum of 6 blobs per block
+	DefaultMaxBlobGasPerBlock = uint64(params.DefaultCancunBlobConfig.Max) * params.BlobTxBlobGasPerBlob
+	// DefaultBlobBaseFeeUpdateFraction is the Cancun blob base fee update fraction
+	DefaultBlobBaseFeeUpdateFraction = params.DefaultCancunBlobConfig.UpdateFraction
+	// DefaultRejectBlobSidecars is false (i.e. the sidecars are discarded)
+	DefaultRejectBlobSidecars = false
+	// DefaultBaseFeeStrategy is the EIP-1559 base fee formula
+	DefaultBaseFeeStrategy = BaseFeeStrategyEIP1559
+	// DefaultGasTarget is 0 (i.e. the block max gas divided by the elasticity multiplier)
+	DefaultGasTarget = uint64(0)
+	// DefaultMaxBaseFeeChange is 0.125 or 12.5%, the EIP-1559 maximum change
+	DefaultMaxBaseFeeChange = math.LegacyNewDecWithPrec(125, 3)
+	// DefaultMaxBaseFee is 0 (i.e. unbounded)
+	DefaultMaxBaseFee = math.LegacyZeroDec()
+	// DefaultBaseFeeUpdateFraction is 0, to be set with the exponential strategy
+	DefaultBaseFeeUpdateFraction = uint64(0)
 
 	ParamsKey = []byte("Params")
 )
 
-// NewParams creates a new Params instance
+// NewParams creates a new Params instance with the default blob gas and base
+// fee strategy parameters
 func NewParams(
 	noBaseFee bool,
 	baseFeeChangeDenom,
@@ -34,26 +56,46 @@ func NewParams(
 	minGasPriceMultiplier math.LegacyDec,
 ) Params {
 	return Params{
-		NoBaseFee:                noBaseFee,
-		BaseFeeChangeDenominator: baseFeeChangeDenom,
-		ElasticityMultiplier:     elasticityMultiplier,
-		BaseFee:                  baseFee,
-		EnableHeight:             enableHeight,
-		MinGasPrice:              minGasPrice,
-		MinGasMultiplier:         minGasPriceMultiplier,
+		NoBaseFee:                 noBaseFee,
+		BaseFeeChangeDenominator:  baseFeeChangeDenom,
+		ElasticityMultiplier:      elasticityMultiplier,
+		BaseFee:                   baseFee,
+		EnableHeight:              enableHeight,
+		MinGasPrice:               minGasPrice,
+		MinGasMultiplier:          minGasPriceMultiplier,
+		MinBlobBaseFee:            DefaultMinBlobBaseFee,
+		TargetBlobGasPerBlock:     DefaultTargetBlobGasPerBlock,
+		MaxBlobGasPerBlock:        DefaultMaxBlobGasPerBlock,
+		BlobBaseFeeUpdateFraction: DefaultBlobBaseFeeUpdateFraction,
+		RejectBlobSidecars:        DefaultRejectBlobSidecars,
+		BaseFeeStrategy:           DefaultBaseFeeStrategy,
+		GasTarget:                 DefaultGasTarget,
+		MaxBaseFeeChange:          DefaultMaxBaseFeeChange,
+		MaxBaseFee:                DefaultMaxBaseFee,
+		BaseFeeUpdateFraction:     DefaultBaseFeeUpdateFraction,
 	}
 }
 
 // DefaultParams returns default evm parameters
 func DefaultParams() Params {
 	return Params{
-		NoBaseFee:                DefaultNoBaseFee,
-		BaseFeeChangeDenominator: params.DefaultBaseFeeChangeDenominator,
-		ElasticityMultiplier:     params.DefaultElasticityMultiplier,
-		BaseFee:                  DefaultBaseFee,
-		EnableHeight:             DefaultEnableHeight,
-		MinGasPrice:              DefaultMinGasPrice,
-		MinGasMultiplier:         DefaultMinGasMultiplier,
+		NoBaseFee:                 DefaultNoBaseFee,
+		BaseFeeChangeDenominator:  params.DefaultBaseFeeChangeDenominator,
+		ElasticityMultiplier:      params.DefaultElasticityMultiplier,
+		BaseFee:                   DefaultBaseFee,
+		EnableHeight:              DefaultEnableHeight,
+		MinGasPrice:               DefaultMinGasPrice,
+		MinGasMultiplier:          DefaultMinGasMultiplier,
+		MinBlobBaseFee:            DefaultMinBlobBaseFee,
+		TargetBlobGasPerBlock:     DefaultTargetBlobGasPerBlock,
+		MaxBlobGasPerBlock:        DefaultMaxBlobGasPerBlock,
+		BlobBaseFeeUpdateFraction: DefaultBlobBaseFeeUpdateFraction,
+		RejectBlobSidecars:        DefaultRejectBlobSidecars,
+		BaseFeeStrategy:           DefaultBaseFeeStrategy,
+		GasTarget:                 DefaultGasTarget,
+		MaxBaseFeeChange:          DefaultMaxBaseFeeChange,
+		MaxBaseFee:                DefaultMaxBaseFee,
+		BaseFeeUpdateFraction:     DefaultBaseFeeUpdateFraction,
 	}
 }
 
@@ -79,7 +121,93 @@ func (p Params) Validate() error {
 		return err
 	}
 
-	return validateMinGasPrice(p.MinGasPrice)
+	if err := validateMinGasPrice(p.MinGasPrice); err != nil {
+		return err
+	}
+
+	if err := p.validateBaseFeeStrategy(); err != nil {
+		return err
+	}
+
+	return p.validateBlobGas()
+}
+
+// validateBaseFeeStrategy performs basic validation on the base fee strategy
+// and its parameters.
+func (p Params) validateBaseFeeStrategy() error {
+	if _, found := BaseFeeStrategy_name[int32(p.BaseFeeStrategy)]; !found {
+		return fmt.Errorf("invalid base fee strategy: %d", p.BaseFeeStrategy)
+	}
+
+	if !p.MaxBaseFeeChange.IsNil() && p.MaxBaseFeeChange.IsNegative() {
+		return fmt.Errorf("max base fee change cannot be negative: %s", p.MaxBaseFeeChange)
+	}
+
+	if !p.MaxBaseFee.IsNil() {
+		if p.MaxBaseFee.IsNegative() {
+			return fmt.Errorf("max base fee cannot be negative: %s", p.MaxBaseFee)
+		}
+		if p.MaxBaseFee.IsPositive() && p.MaxBaseFee.LT(p.MinGasPrice) {
+			return fmt.Errorf("max base fee cannot be lower than the min gas price: %s < %s", p.MaxBaseFee, p.MinGasPrice)
+		}
+	}
+
+	switch p.BaseFeeStrategy {
+	case BaseFeeStrategyClampedEIP1559:
+		if p.MaxBaseFeeChange.IsNil() || !p.MaxBaseFeeChange.IsPositive() {
+			return fmt.Errorf("max base fee change must be positive with the %s strategy", p.BaseFeeStrategy)
+		}
+	case BaseFeeStrategyExponential:
+		if p.BaseFeeUpdateFraction == 0 {
+			return fmt.Errorf("base fee update fraction cannot be 0 with the %s strategy", p.BaseFeeStrategy)
+		}
+		if !p.MinGasPrice.IsPositive() {
+			return fmt.Errorf("min gas price must be positive with the %s strategy", p.BaseFeeStrategy)
+		}
+	}
+
+	return nil
+}
+
+// BlockGasTarget returns the block gas the base fee calculation targets, given
+// the block max gas. A negative max gas means the block gas is unlimited.
+func (p Params) BlockGasTarget(maxGas int64) math.Int {
+	if p.GasTarget > 0 {
+		return math.NewIntFromUint64(p.GasTarget)
+	}
+
+	gasLimit := math.NewIntFromUint64(gomath.MaxUint64)
+	if maxGas > -1 {
+		gasLimit = math.NewInt(maxGas)
+	}
+
+	// CONTRACT: ElasticityMultiplier cannot be 0 as it's checked in the params
+	// validation
+	return gasLimit.Quo(math.NewIntFromUint64(uint64(p.ElasticityMultiplier)))
+}
+
+// validateBlobGas performs basic validation on the EIP-4844 blob gas parameters.
+func (p Params) validateBlobGas() error {
+	if p.MinBlobBaseFee.IsNil() {
+		return fmt.Errorf("min blob base fee cannot be nil")
+	}
+
+	if p.MinBlobBaseFee.IsNegative() {
+		return fmt.Errorf("min blob base fee cannot be negative: %s", p.MinBlobBaseFee)
+	}
+
+	if p.TargetBlobGasPerBlock > p.MaxBlobGasPerBlock {
+		return fmt.Errorf(
+			"target blob gas per block cannot be greater than the max blob gas per block: %d > %d",
+			p.TargetBlobGasPerBlock, p.MaxBlobGasPerBlock,
+		)
+	}
+
+	if p.BlobBaseFeeUpdateFraction == 0 {
+		return fmt.Errorf("blob base fee update fraction cannot be 0")
+	}
+
+	return nil
 }
 
 func (p *Params) IsBaseFeeEnabled(height int64) bool {
@@ -73,6 +73,121 @@ func (suite *ParamsTestSuite) TestParamsValidate() {
 			NewParams(true, 7, 3, math.LegacyNewDec(2000000000), int64(544435345345435345), math.LegacyNewDecWithPrec(20, 4), math.LegacyNewDec(2)),
 			true,
 		},
+		{
+			"invalid: min blob base fee negative",
+			func() Params {
+				p := DefaultParams()
+				p.MinBlobBaseFee = math.LegacyNewDec(-1)
+				return p
+			}(),
+			true,
+		},
+		{
+			"invalid: target blob gas bigger than max",
+			func() Params {
+				p := DefaultParams()
+				p.TargetBlobGasPerBlock = p.MaxBlobGasPerBlock + 1
+				return p
+			}(),
+			true,
+		},
+		{
+			"invalid: blob base fee update fraction is zero",
+			func() Params {
+				p := DefaultParams()
+				p.BlobBaseFeeUpdateFraction = 0
+				return p
+			}(),
+			true,
+		},
+		{
+			"invalid: unknown base fee strategy",
+			func() Params {
+				p := DefaultParams()
+				p.BaseFeeStrategy = BaseFeeStrategy(3)
+				return p
+			}(),
+			true,
+		},
+		{
+			"valid: clamped strategy",
+			func() Params {
+				p := DefaultParams()
+				p.BaseFeeStrategy = BaseFeeStrategyClampedEIP1559
+				p.GasTarget = 10_000_000
+				return p
+			}(),
+			false,
+		},
+		{
+			"invalid: clamped strategy without max base fee change",
+			func() Params {
+				p := DefaultParams()
+				p.BaseFeeStrategy = BaseFeeStrategyClampedEIP1559
+				p.MaxBaseFeeChange = math.LegacyZeroDec()
+				return p
+			}(),
+			true,
+		},
+		{
+			"invalid: max base fee change negative",
+			func() Params {
+				p := DefaultParams()
+				p.MaxBaseFeeChange = math.LegacyNewDec(-1)
+				return p
+			}(),
+			true,
+		},
+		{
+			"valid: exponential strategy",
+			func() Params {
+				p := DefaultParams()
+				p.BaseFeeStrategy = BaseFeeStrategyExponential
+				p.BaseFeeUpdateFraction = 80_000_000
+				p.MinGasPrice = math.LegacyNewDec(1_000_000_000)
+				return p
+			}(),
+			false,
+		},
+		{
+			"invalid: exponential strategy without update fraction",
+			func() Params {
+				p := DefaultParams()
+				p.BaseFeeStrategy = BaseFeeStrategyExponential
+				p.MinGasPrice = math.LegacyNewDec(1_000_000_000)
+				return p
+			}(),
+			true,
+		},
+		{
+			"invalid: exponential strategy without min gas price",
+			func() Params {
+				p := DefaultParams()
+				p.BaseFeeStrategy = BaseFeeStrategyExponential
+				p.BaseFeeUpdateFraction = 80_000_000
+				return p
+			}(),
+			true,
+		},
+		{
+			"invalid: max base fee negative",
+			func() Params {
+				p := DefaultParams()
+				p.MaxBaseFee = math.LegacyNewDec(-1)
+				return p
+			}(),
+			true,
+		},
+		{
+			"invalid: max base fee lower than the min gas price",
+			func() Params {
+				p := DefaultParams()
+				p.MinGasPrice = math.LegacyNewDec(10)
+				p.MaxBaseFee = math.LegacyNewDec(5)
+				return p
+			}(),
+			true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -86,6 +201,16 @@ func (suite *ParamsTestSuite) TestParamsValidate() {
 	}
 }
 
+func (suite *ParamsTestSuite) TestBlockGasTarget() {
+	p := DefaultParams()
+	suite.Require().Equal(math.NewInt(50), p.BlockGasTarget(100))
+	suite.Require().True(p.BlockGasTarget(-1).GT(math.NewIntFromUint64(1 << 62)))
+
+	p.GasTarget = 30
+	suite.Require().Equal(math.NewInt(30), p.BlockGasTarget(100))
+	suite.Require().Equal(math.NewInt(30), p.BlockGasTarget(-1))
+}
+
 func (suite *ParamsTestSuite) TestParamsValidatePriv() {
 	suite.Require().Error(validateMinGasPrice(math.LegacyDec{}))
 	suite.Require().Error(validateMinGasMultiplier(math.LegacyNewDec(-5)))
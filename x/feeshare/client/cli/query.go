@@ -0,0 +1,189 @@
+package cli
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/evm/x/feeshare/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+)
+
+// GetQueryCmd returns the parent command for all feeshare CLI query commands
+func GetQueryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the feeshare module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		GetFeeSharesCmd(),
+		GetFeeShareCmd(),
+		GetDeployerFeeSharesCmd(),
+		GetWithdrawerFeeSharesCmd(),
+		GetParamsCmd(),
+	)
+	return cmd
+}
+
+// GetFeeSharesCmd queries all the registered contracts
+func GetFeeSharesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fee-shares",
+		Short: "Gets the registered contracts",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.FeeShares(cmd.Context(), &types.QueryFeeSharesRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "fee shares")
+	return cmd
+}
+
+// GetFeeShareCmd queries the registration of a contract
+func GetFeeShareCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fee-share CONTRACT_ADDRESS",
+		Short: "Gets the registration of a contract",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.FeeShare(cmd.Context(), &types.QueryFeeShareRequest{ContractAddress: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetDeployerFeeSharesCmd queries the registered contracts of a deployer
+func GetDeployerFeeSharesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deployer-fee-shares DEPLOYER_ADDRESS",
+		Short: "Gets the registered contracts of a deployer",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.DeployerFeeShares(cmd.Context(), &types.QueryDeployerFeeSharesRequest{
+				DeployerAddress: args[0],
+				Pagination:      pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "deployer fee shares")
+	return cmd
+}
+
+// GetWithdrawerFeeSharesCmd queries the registered contracts of a withdrawer
+func GetWithdrawerFeeSharesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "withdrawer-fee-shares WITHDRAWER_ADDRESS",
+		Short: "Gets the registered contracts of a withdrawer",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.WithdrawerFeeShares(cmd.Context(), &types.QueryWithdrawerFeeSharesRequest{
+				WithdrawerAddress: args[0],
+				Pagination:        pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "withdrawer fee shares")
+	return cmd
+}
+
+// GetParamsCmd queries the feeshare module params
+func GetParamsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Short: "Gets feeshare params",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Params(cmd.Context(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
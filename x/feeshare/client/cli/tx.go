@@ -0,0 +1,168 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/spf13/cobra"
+
+	cosmosevmtypes "github.com/cosmos/evm/types"
+	"github.com/cosmos/evm/x/feeshare/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// flagWithdrawer is the flag of the withdrawer address of a registration
+const flagWithdrawer = "withdrawer"
+
+// NewTxCmd returns a root CLI command handler for feeshare transaction commands
+func NewTxCmd() *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "feeshare subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	txCmd.AddCommand(
+		NewRegisterFeeShareCmd(),
+		NewUpdateFeeShareCmd(),
+		NewCancelFeeShareCmd(),
+	)
+	return txCmd
+}
+
+// NewRegisterFeeShareCmd returns a CLI command handler for registering a
+// contract for the fee share
+func NewRegisterFeeShareCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "register CONTRACT_ADDRESS STEP...",
+		Short: "Register a contract deployed by the sender to receive a share of the fees of the transactions calling it",
+		Long: `Register a contract deployed by the sender to receive a share of the fees of the transactions calling it.
+The derivation path from the sender to the contract is given as a list of steps, each one being the nonce of a
+CREATE deployment or the SALT:INIT_CODE_HASH hex values of a CREATE2 deployment.`,
+		Example: fmt.Sprintf(
+			"%s tx %s register 0x... 4 0x...:0x... --%s cosmos1...",
+			"evmd", types.ModuleName, flagWithdrawer,
+		),
+		Args: cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			if err := cosmosevmtypes.ValidateAddress(args[0]); err != nil {
+				return fmt.Errorf("invalid contract address %w", err)
+			}
+
+			path, err := parseDerivationPath(args[1:])
+			if err != nil {
+				return err
+			}
+
+			var withdrawer sdk.AccAddress
+			if withdrawerAddress, _ := cmd.Flags().GetString(flagWithdrawer); withdrawerAddress != "" {
+				if withdrawer, err = sdk.AccAddressFromBech32(withdrawerAddress); err != nil {
+					return err
+				}
+			}
+
+			msg := types.NewMsgRegisterFeeShare(common.HexToAddress(args[0]), cliCtx.GetFromAddress(), withdrawer, path)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(flagWithdrawer, "", "the address receiving the fee share, defaults to the sender")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewUpdateFeeShareCmd returns a CLI command handler for updating the
+// withdrawer of a registered contract
+func NewUpdateFeeShareCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update CONTRACT_ADDRESS WITHDRAWER_ADDRESS",
+		Short: "Update the withdrawer address of a contract registered by the sender",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgUpdateFeeShare{
+				ContractAddress:   args[0],
+				DeployerAddress:   cliCtx.GetFromAddress().String(),
+				WithdrawerAddress: args[1],
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewCancelFeeShareCmd returns a CLI command handler for cancelling the
+// registration of a contract
+func NewCancelFeeShareCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel CONTRACT_ADDRESS",
+		Short: "Cancel the fee share registration of a contract registered by the sender",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgCancelFeeShare{
+				ContractAddress: args[0],
+				DeployerAddress: cliCtx.GetFromAddress().String(),
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// parseDerivationPath parses the steps of a derivation path, given as the
+// nonces of CREATE deployments or the SALT:INIT_CODE_HASH of CREATE2
+// deployments.
+func parseDerivationPath(args []string) ([]types.DerivationStep, error) {
+	path := make([]types.DerivationStep, len(args))
+	for i, arg := range args {
+		if salt, initCodeHash, ok := strings.Cut(arg, ":"); ok {
+			path[i] = types.DerivationStep{Salt: salt, InitCodeHash: initCodeHash}
+			continue
+		}
+
+		nonce, err := strconv.ParseUint(arg, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid derivation step %s: %w", arg, err)
+		}
+		path[i] = types.DerivationStep{Nonce: nonce}
+	}
+	return path, nil
+}
@@ -0,0 +1,29 @@
+package feeshare
+
+import (
+	"fmt"
+
+	"github.com/cosmos/evm/x/feeshare/keeper"
+	"github.com/cosmos/evm/x/feeshare/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// InitGenesis import module genesis
+func InitGenesis(ctx sdk.Context, k keeper.Keeper, data types.GenesisState) {
+	if err := k.SetParams(ctx, data.Params); err != nil {
+		panic(fmt.Errorf("error setting params %s", err))
+	}
+
+	for _, feeShare := range data.FeeShares {
+		k.SetFeeShare(ctx, feeShare)
+	}
+}
+
+// ExportGenesis export module status
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	return &types.GenesisState{
+		Params:    k.GetParams(ctx),
+		FeeShares: k.GetFeeShares(ctx),
+	}
+}
@@ -0,0 +1,78 @@
+package keeper
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/cosmos/evm/x/feeshare/types"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var isTrue = []byte{1}
+
+// GetFeeShares returns all the registered contracts.
+func (k Keeper) GetFeeShares(ctx sdk.Context) []types.FeeShare {
+	feeShares := []types.FeeShare{}
+
+	iterator := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.KeyPrefixFeeShare)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var feeShare types.FeeShare
+		k.cdc.MustUnmarshal(iterator.Value(), &feeShare)
+		feeShares = append(feeShares, feeShare)
+	}
+	return feeShares
+}
+
+// GetFeeShare returns the fee share registration of a contract.
+func (k Keeper) GetFeeShare(ctx sdk.Context, contract common.Address) (types.FeeShare, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixFeeShare)
+	bz := store.Get(contract.Bytes())
+	if bz == nil {
+		return types.FeeShare{}, false
+	}
+
+	var feeShare types.FeeShare
+	k.cdc.MustUnmarshal(bz, &feeShare)
+	return feeShare, true
+}
+
+// IsFeeShareRegistered returns true if the contract is registered.
+func (k Keeper) IsFeeShareRegistered(ctx sdk.Context, contract common.Address) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixFeeShare)
+	return store.Has(contract.Bytes())
+}
+
+// SetFeeShare stores the fee share registration of a contract and indexes it by
+// its deployer and withdrawer.
+func (k Keeper) SetFeeShare(ctx sdk.Context, feeShare types.FeeShare) {
+	contract := feeShare.GetContract()
+	if previous, found := k.GetFeeShare(ctx, contract); found {
+		k.deleteIndexes(ctx, previous)
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixFeeShare)
+	store.Set(contract.Bytes(), k.cdc.MustMarshal(&feeShare))
+
+	kvStore := ctx.KVStore(k.storeKey)
+	kvStore.Set(types.DeployerFeeShareKey(feeShare.GetDeployer(), contract), isTrue)
+	kvStore.Set(types.WithdrawerFeeShareKey(feeShare.GetWithdrawer(), contract), isTrue)
+}
+
+// DeleteFeeShare removes the fee share registration of a contract and its
+// indexes.
+func (k Keeper) DeleteFeeShare(ctx sdk.Context, feeShare types.FeeShare) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixFeeShare)
+	store.Delete(feeShare.GetContract().Bytes())
+	k.deleteIndexes(ctx, feeShare)
+}
+
+func (k Keeper) deleteIndexes(ctx sdk.Context, feeShare types.FeeShare) {
+	kvStore := ctx.KVStore(k.storeKey)
+	kvStore.Delete(types.DeployerFeeShareKey(feeShare.GetDeployer(), feeShare.GetContract()))
+	kvStore.Delete(types.WithdrawerFeeShareKey(feeShare.GetWithdrawer(), feeShare.GetContract()))
+}
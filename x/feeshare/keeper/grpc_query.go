@@ -0,0 +1,133 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/common"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/evm/x/feeshare/types"
+
+	"cosmossdk.io/store/prefix"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// FeeShares returns all the registered contracts
+func (k Keeper) FeeShares(c context.Context, req *types.QueryFeeSharesRequest) (*types.QueryFeeSharesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var feeShares []types.FeeShare
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixFeeShare)
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var feeShare types.FeeShare
+		if err := k.cdc.Unmarshal(value, &feeShare); err != nil {
+			return err
+		}
+		feeShares = append(feeShares, feeShare)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryFeeSharesResponse{
+		FeeShares:  feeShares,
+		Pagination: pageRes,
+	}, nil
+}
+
+// FeeShare returns the registration of a contract
+func (k Keeper) FeeShare(c context.Context, req *types.QueryFeeShareRequest) (*types.QueryFeeShareResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if !common.IsHexAddress(req.ContractAddress) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid contract hex address %s", req.ContractAddress)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	feeShare, found := k.GetFeeShare(ctx, common.HexToAddress(req.ContractAddress))
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "fee share with contract %s", req.ContractAddress)
+	}
+
+	return &types.QueryFeeShareResponse{FeeShare: feeShare}, nil
+}
+
+// DeployerFeeShares returns the registered contracts of a deployer
+func (k Keeper) DeployerFeeShares(c context.Context, req *types.QueryDeployerFeeSharesRequest) (*types.QueryDeployerFeeSharesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	deployer, err := sdk.AccAddressFromBech32(req.DeployerAddress)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid deployer address %s: %s", req.DeployerAddress, err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	contracts, pageRes, err := k.paginateContracts(ctx, types.DeployerFeeSharesPrefix(deployer), req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryDeployerFeeSharesResponse{
+		ContractAddresses: contracts,
+		Pagination:        pageRes,
+	}, nil
+}
+
+// WithdrawerFeeShares returns the registered contracts of a withdrawer
+func (k Keeper) WithdrawerFeeShares(c context.Context, req *types.QueryWithdrawerFeeSharesRequest) (*types.QueryWithdrawerFeeSharesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	withdrawer, err := sdk.AccAddressFromBech32(req.WithdrawerAddress)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid withdrawer address %s: %s", req.WithdrawerAddress, err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	contracts, pageRes, err := k.paginateContracts(ctx, types.WithdrawerFeeSharesPrefix(withdrawer), req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryWithdrawerFeeSharesResponse{
+		ContractAddresses: contracts,
+		Pagination:        pageRes,
+	}, nil
+}
+
+// Params returns the feeshare module params
+func (k Keeper) Params(c context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	params := k.GetParams(ctx)
+	return &types.QueryParamsResponse{Params: params}, nil
+}
+
+// paginateContracts returns the contract addresses of an index prefix.
+func (k Keeper) paginateContracts(ctx sdk.Context, indexPrefix []byte, pagination *query.PageRequest) ([]string, *query.PageResponse, error) {
+	var contracts []string
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), indexPrefix)
+
+	pageRes, err := query.Paginate(store, pagination, func(key, _ []byte) error {
+		contracts = append(contracts, common.BytesToAddress(key).Hex())
+		return nil
+	})
+	if err != nil {
+		return nil, nil, status.Error(codes.Internal, err.Error())
+	}
+	return contracts, pageRes, nil
+}
@@ -0,0 +1,76 @@
+package keeper
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/cosmos/evm/x/feeshare/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ evmtypes.EvmHooks = Hooks{}
+
+// Hooks wrapper struct for the feeshare keeper
+type Hooks struct {
+	k Keeper
+}
+
+// Hooks returns the EVM hooks paying the fee shares of the registered
+// contracts.
+func (k Keeper) Hooks() Hooks {
+	return Hooks{k}
+}
+
+// PostTxProcessing implements EvmHooks.PostTxProcessing. After each successful
+// transaction calling a registered contract, it sends the developer shares of
+// the fees paid for the gas used by the transaction from the fee collector to
+// the withdrawer of the contract. Only the contract called by the transaction
+// receives the fee share, and not the contracts it calls.
+func (h Hooks) PostTxProcessing(ctx sdk.Context, _ common.Address, msg core.Message, receipt *ethtypes.Receipt) error {
+	if msg.To == nil || msg.GasPrice == nil {
+		return nil
+	}
+
+	params := h.k.GetParams(ctx)
+	if !params.EnableFeeShare || params.DeveloperShares.IsNil() || !params.DeveloperShares.IsPositive() {
+		return nil
+	}
+
+	feeShare, found := h.k.GetFeeShare(ctx, *msg.To)
+	if !found {
+		return nil
+	}
+
+	fees := new(big.Int).Mul(new(big.Int).SetUint64(receipt.GasUsed), msg.GasPrice)
+	amount := params.DeveloperShares.MulInt(sdkmath.NewIntFromBigInt(fees)).TruncateInt()
+	if !amount.IsPositive() {
+		return nil
+	}
+
+	// the fees are held by the fee collector in the extended denom of the EVM
+	// coin, with 18 decimals
+	coins := sdk.Coins{sdk.NewCoin(evmtypes.GetEVMCoinExtendedDenom(), amount)}
+	withdrawer := feeShare.GetWithdrawer()
+	if err := h.k.bankKeeper.SendCoinsFromModuleToAccount(ctx, h.k.feeCollectorName, withdrawer, coins); err != nil {
+		return errorsmod.Wrapf(types.ErrFeeSharePayment, "failed to send %s to %s: %s", coins, withdrawer, err)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeDistributeFeeShare,
+			sdk.NewAttribute(types.AttributeKeyContract, feeShare.ContractAddress),
+			sdk.NewAttribute(types.AttributeKeyWithdrawer, feeShare.WithdrawerAddress),
+			sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+		),
+	)
+
+	return nil
+}
@@ -0,0 +1,190 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/cosmos/evm/x/feeshare/types"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+var _ types.MsgServer = &Keeper{}
+
+// RegisterFeeShare implements the gRPC MsgServer interface. It registers a
+// contract to receive a share of the fees of the transactions calling it, once
+// the deployer is verified as the deployer of the contract by deriving the
+// contract address through the CREATE and CREATE2 deployments of the
+// derivation path.
+func (k *Keeper) RegisterFeeShare(goCtx context.Context, msg *types.MsgRegisterFeeShare) (*types.MsgRegisterFeeShareResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	params := k.GetParams(ctx)
+	if !params.EnableFeeShare {
+		return nil, types.ErrFeeShareDisabled
+	}
+
+	contract := common.HexToAddress(msg.ContractAddress)
+	if k.IsFeeShareRegistered(ctx, contract) {
+		return nil, errorsmod.Wrapf(types.ErrFeeShareAlreadyRegistered, "contract %s", contract)
+	}
+
+	deployer, err := sdk.AccAddressFromBech32(msg.DeployerAddress)
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "invalid deployer address %s", msg.DeployerAddress)
+	}
+
+	withdrawer := deployer
+	if msg.WithdrawerAddress != "" {
+		if withdrawer, err = k.validateWithdrawer(msg.WithdrawerAddress); err != nil {
+			return nil, err
+		}
+	}
+
+	// the derivation of each step costs a keccak256 hash
+	ctx.GasMeter().ConsumeGas(
+		params.AddrDerivationCostCreate*uint64(len(msg.DerivationPath)),
+		"fee share contract address derivation",
+	)
+	if derived := types.DeriveContractAddress(common.BytesToAddress(deployer), msg.DerivationPath); derived != contract {
+		return nil, errorsmod.Wrapf(
+			types.ErrFeeShareDeployer,
+			"derived address %s, expected %s", derived, contract,
+		)
+	}
+
+	if !k.evmKeeper.IsContract(ctx, contract) {
+		return nil, errorsmod.Wrapf(types.ErrFeeShareNoContract, "contract %s", contract)
+	}
+
+	feeShare := types.NewFeeShare(contract, deployer, withdrawer)
+	k.SetFeeShare(ctx, feeShare)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRegisterFeeShare,
+			sdk.NewAttribute(types.AttributeKeyContract, feeShare.ContractAddress),
+			sdk.NewAttribute(types.AttributeKeyDeployer, feeShare.DeployerAddress),
+			sdk.NewAttribute(types.AttributeKeyWithdrawer, feeShare.WithdrawerAddress),
+		),
+	)
+
+	return &types.MsgRegisterFeeShareResponse{}, nil
+}
+
+// UpdateFeeShare implements the gRPC MsgServer interface. It updates the
+// withdrawer address of a contract registered by the deployer.
+func (k *Keeper) UpdateFeeShare(goCtx context.Context, msg *types.MsgUpdateFeeShare) (*types.MsgUpdateFeeShareResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !k.GetParams(ctx).EnableFeeShare {
+		return nil, types.ErrFeeShareDisabled
+	}
+
+	feeShare, err := k.getDeployerFeeShare(ctx, msg.ContractAddress, msg.DeployerAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	withdrawer, err := k.validateWithdrawer(msg.WithdrawerAddress)
+	if err != nil {
+		return nil, err
+	}
+	if withdrawer.Equals(feeShare.GetWithdrawer()) {
+		return nil, errorsmod.Wrapf(
+			errortypes.ErrInvalidRequest,
+			"withdrawer %s is already the withdrawer of contract %s", withdrawer, feeShare.ContractAddress,
+		)
+	}
+
+	feeShare.WithdrawerAddress = withdrawer.String()
+	k.SetFeeShare(ctx, feeShare)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUpdateFeeShare,
+			sdk.NewAttribute(types.AttributeKeyContract, feeShare.ContractAddress),
+			sdk.NewAttribute(types.AttributeKeyDeployer, feeShare.DeployerAddress),
+			sdk.NewAttribute(types.AttributeKeyWithdrawer, feeShare.WithdrawerAddress),
+		),
+	)
+
+	return &types.MsgUpdateFeeShareResponse{}, nil
+}
+
+// CancelFeeShare implements the gRPC MsgServer interface. It removes the
+// registration of a contract registered by the deployer. Registrations can be
+// cancelled while the fee share is disabled.
+func (k *Keeper) CancelFeeShare(goCtx context.Context, msg *types.MsgCancelFeeShare) (*types.MsgCancelFeeShareResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	feeShare, err := k.getDeployerFeeShare(ctx, msg.ContractAddress, msg.DeployerAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	k.DeleteFeeShare(ctx, feeShare)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCancelFeeShare,
+			sdk.NewAttribute(types.AttributeKeyContract, feeShare.ContractAddress),
+			sdk.NewAttribute(types.AttributeKeyDeployer, feeShare.DeployerAddress),
+		),
+	)
+
+	return &types.MsgCancelFeeShareResponse{}, nil
+}
+
+// UpdateParams implements the gRPC MsgServer interface. After a successful governance vote
+// it updates the parameters in the keeper only if the requested authority
+// is the Cosmos SDK governance module account
+func (k *Keeper) UpdateParams(goCtx context.Context, req *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if k.authority.String() != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.SetParams(ctx, req.Params); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
+
+// getDeployerFeeShare returns the registration of the contract, if registered
+// by the deployer.
+func (k Keeper) getDeployerFeeShare(ctx sdk.Context, contractAddress, deployerAddress string) (types.FeeShare, error) {
+	feeShare, found := k.GetFeeShare(ctx, common.HexToAddress(contractAddress))
+	if !found {
+		return types.FeeShare{}, errorsmod.Wrapf(types.ErrFeeShareNotFound, "contract %s", contractAddress)
+	}
+	deployer, err := sdk.AccAddressFromBech32(deployerAddress)
+	if err != nil {
+		return types.FeeShare{}, errorsmod.Wrapf(err, "invalid deployer address %s", deployerAddress)
+	}
+	if !deployer.Equals(feeShare.GetDeployer()) {
+		return types.FeeShare{}, errorsmod.Wrapf(
+			errortypes.ErrUnauthorized,
+			"%s is not the deployer of contract %s", deployerAddress, contractAddress,
+		)
+	}
+	return feeShare, nil
+}
+
+// validateWithdrawer checks that the withdrawer can receive the fee shares.
+func (k Keeper) validateWithdrawer(withdrawerAddress string) (sdk.AccAddress, error) {
+	withdrawer, err := sdk.AccAddressFromBech32(withdrawerAddress)
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "invalid withdrawer address %s", withdrawerAddress)
+	}
+	if k.bankKeeper.BlockedAddr(withdrawer) {
+		return nil, errorsmod.Wrapf(errortypes.ErrUnauthorized, "withdrawer %s is not allowed to receive funds", withdrawer)
+	}
+	return withdrawer, nil
+}
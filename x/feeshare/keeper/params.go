@@ -0,0 +1,31 @@
+package keeper
+
+import (
+	"github.com/cosmos/evm/x/feeshare/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetParams returns the total set of feeshare parameters.
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	bz := ctx.KVStore(k.storeKey).Get(types.KeyPrefixParams)
+	if bz == nil {
+		return params
+	}
+	k.cdc.MustUnmarshal(bz, &params)
+	return params
+}
+
+// SetParams sets the feeshare parameters in the store.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) error {
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	bz, err := k.cdc.Marshal(&params)
+	if err != nil {
+		return err
+	}
+	ctx.KVStore(k.storeKey).Set(types.KeyPrefixParams, bz)
+	return nil
+}
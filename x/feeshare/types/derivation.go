@@ -0,0 +1,55 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// Validate checks that the step is a CREATE deployment, or a CREATE2
+// deployment with a 32 bytes salt and init code hash.
+func (ds DerivationStep) Validate() error {
+	if ds.Salt == "" && ds.InitCodeHash == "" {
+		return nil
+	}
+	if ds.Nonce != 0 {
+		return fmt.Errorf("nonce must be zero for a CREATE2 deployment")
+	}
+	if err := validateHash("salt", ds.Salt); err != nil {
+		return err
+	}
+	return validateHash("init code hash", ds.InitCodeHash)
+}
+
+// validateHash checks that the value is a hex encoded 32 bytes hash.
+func validateHash(name, value string) error {
+	bz, err := hexutil.Decode(value)
+	if err != nil {
+		return fmt.Errorf("invalid %s %s: %w", name, value, err)
+	}
+	if len(bz) != common.HashLength {
+		return fmt.Errorf("invalid %s %s: expected %d bytes, got %d", name, value, common.HashLength, len(bz))
+	}
+	return nil
+}
+
+// Derive returns the address of the contract deployed by the step from the
+// given deployer.
+func (ds DerivationStep) Derive(deployer common.Address) common.Address {
+	if ds.Salt == "" {
+		return crypto.CreateAddress(deployer, ds.Nonce)
+	}
+	return crypto.CreateAddress2(deployer, common.HexToHash(ds.Salt), common.HexToHash(ds.InitCodeHash).Bytes())
+}
+
+// DeriveContractAddress returns the address of the contract deployed from the
+// deployer through the CREATE and CREATE2 deployments of the derivation path.
+func DeriveContractAddress(deployer common.Address, path []DerivationStep) common.Address {
+	address := deployer
+	for _, step := range path {
+		address = step.Derive(address)
+	}
+	return address
+}
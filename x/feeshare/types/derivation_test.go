@@ -0,0 +1,53 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	utiltx "github.com/cosmos/evm/testutil/tx"
+	"github.com/cosmos/evm/x/feeshare/types"
+)
+
+func TestDeriveContractAddress(t *testing.T) {
+	deployer := utiltx.GenerateAddress()
+	salt := common.HexToHash("0x01")
+	initCodeHash := crypto.Keccak256Hash([]byte("init code"))
+
+	factory := crypto.CreateAddress(deployer, 3)
+
+	testCases := []struct {
+		name string
+		path []types.DerivationStep
+		exp  common.Address
+	}{
+		{
+			"empty path",
+			nil,
+			deployer,
+		},
+		{
+			"CREATE deployment",
+			[]types.DerivationStep{{Nonce: 3}},
+			factory,
+		},
+		{
+			"CREATE2 deployment from a factory",
+			[]types.DerivationStep{{Nonce: 3}, {Salt: salt.Hex(), InitCodeHash: initCodeHash.Hex()}},
+			crypto.CreateAddress2(factory, salt, initCodeHash.Bytes()),
+		},
+		{
+			"CREATE deployment from a factory",
+			[]types.DerivationStep{{Nonce: 3}, {Nonce: 1}},
+			crypto.CreateAddress(factory, 1),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.exp, types.DeriveContractAddress(deployer, tc.path))
+		})
+	}
+}
@@ -0,0 +1,16 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+// errors
+var (
+	ErrFeeShareDisabled          = errorsmod.Register(ModuleName, 2, "fee share module is disabled")
+	ErrFeeShareAlreadyRegistered = errorsmod.Register(ModuleName, 3, "contract is already registered")
+	ErrFeeShareNotFound          = errorsmod.Register(ModuleName, 4, "fee share not found")
+	ErrFeeShareNoContract        = errorsmod.Register(ModuleName, 5, "no contract deployed at the address")
+	ErrFeeShareDeployer          = errorsmod.Register(ModuleName, 6, "contract not deployed by the deployer")
+	ErrFeeSharePayment           = errorsmod.Register(ModuleName, 7, "fee share payment failed")
+	ErrInvalidDerivationPath     = errorsmod.Register(ModuleName, 8, "invalid derivation path")
+)
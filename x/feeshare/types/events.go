@@ -0,0 +1,14 @@
+package types
+
+// fee share events
+const (
+	EventTypeRegisterFeeShare   = "register_fee_share"
+	EventTypeUpdateFeeShare     = "update_fee_share"
+	EventTypeCancelFeeShare     = "cancel_fee_share"
+	EventTypeDistributeFeeShare = "distribute_fee_share"
+
+	AttributeKeyContract   = "contract"
+	AttributeKeyDeployer   = "deployer"
+	AttributeKeyWithdrawer = "withdrawer"
+	AttributeKeyAmount     = "amount"
+)
@@ -0,0 +1,47 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewFeeShare returns a new fee share registration of a contract.
+func NewFeeShare(contract common.Address, deployer, withdrawer sdk.AccAddress) FeeShare {
+	return FeeShare{
+		ContractAddress:   contract.Hex(),
+		DeployerAddress:   deployer.String(),
+		WithdrawerAddress: withdrawer.String(),
+	}
+}
+
+// GetContract returns the address of the registered contract.
+func (fs FeeShare) GetContract() common.Address {
+	return common.HexToAddress(fs.ContractAddress)
+}
+
+// GetDeployer returns the address of the deployer of the contract.
+func (fs FeeShare) GetDeployer() sdk.AccAddress {
+	return sdk.MustAccAddressFromBech32(fs.DeployerAddress)
+}
+
+// GetWithdrawer returns the address receiving the fee share of the contract.
+func (fs FeeShare) GetWithdrawer() sdk.AccAddress {
+	return sdk.MustAccAddressFromBech32(fs.WithdrawerAddress)
+}
+
+// Validate performs a stateless validation of the fee share registration.
+func (fs FeeShare) Validate() error {
+	if !common.IsHexAddress(fs.ContractAddress) {
+		return fmt.Errorf("invalid contract address %s", fs.ContractAddress)
+	}
+	if _, err := sdk.AccAddressFromBech32(fs.DeployerAddress); err != nil {
+		return fmt.Errorf("invalid deployer address %s: %w", fs.DeployerAddress, err)
+	}
+	if _, err := sdk.AccAddressFromBech32(fs.WithdrawerAddress); err != nil {
+		return fmt.Errorf("invalid withdrawer address %s: %w", fs.WithdrawerAddress, err)
+	}
+	return nil
+}
@@ -0,0 +1,696 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/evm/feeshare/v1/feeshare.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// FeeShare defines the registration of a contract to receive a share of the
+// fees paid by the transactions calling it
+type FeeShare struct {
+	// contract_address is the hex address of the registered contract
+	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// deployer_address is the bech32 address of the account that deployed the
+	// contract, directly or through factory contracts
+	DeployerAddress string `protobuf:"bytes,2,opt,name=deployer_address,json=deployerAddress,proto3" json:"deployer_address,omitempty"`
+	// withdrawer_address is the bech32 address receiving the fee share of the
+	// contract
+	WithdrawerAddress string `protobuf:"bytes,3,opt,name=withdrawer_address,json=withdrawerAddress,proto3" json:"withdrawer_address,omitempty"`
+}
+
+func (m *FeeShare) Reset()         { *m = FeeShare{} }
+func (m *FeeShare) String() string { return proto.CompactTextString(m) }
+func (*FeeShare) ProtoMessage()    {}
+func (*FeeShare) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bb4a10adbdc29439, []int{0}
+}
+func (m *FeeShare) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeShare) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeShare.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeShare) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeShare.Merge(m, src)
+}
+func (m *FeeShare) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeShare) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeShare.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeShare proto.InternalMessageInfo
+
+func (m *FeeShare) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+func (m *FeeShare) GetDeployerAddress() string {
+	if m != nil {
+		return m.DeployerAddress
+	}
+	return ""
+}
+
+func (m *FeeShare) GetWithdrawerAddress() string {
+	if m != nil {
+		return m.WithdrawerAddress
+	}
+	return ""
+}
+
+// DerivationStep defines a contract deployment from the address deployed by the
+// previous step of a derivation path, or from the deployer for the first step
+type DerivationStep struct {
+	// nonce is the nonce of the deploying account for a CREATE deployment
+	Nonce uint64 `protobuf:"varint,1,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	// salt is the hex encoded 32 bytes salt of a CREATE2 deployment. It is empty
+	// for a CREATE deployment.
+	Salt string `protobuf:"bytes,2,opt,name=salt,proto3" json:"salt,omitempty"`
+	// init_code_hash is the hex encoded keccak256 hash of the init code of a
+	// CREATE2 deployment
+	InitCodeHash string `protobuf:"bytes,3,opt,name=init_code_hash,json=initCodeHash,proto3" json:"init_code_hash,omitempty"`
+}
+
+func (m *DerivationStep) Reset()         { *m = DerivationStep{} }
+func (m *DerivationStep) String() string { return proto.CompactTextString(m) }
+func (*DerivationStep) ProtoMessage()    {}
+func (*DerivationStep) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bb4a10adbdc29439, []int{1}
+}
+func (m *DerivationStep) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DerivationStep) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DerivationStep.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DerivationStep) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DerivationStep.Merge(m, src)
+}
+func (m *DerivationStep) XXX_Size() int {
+	return m.Size()
+}
+func (m *DerivationStep) XXX_DiscardUnknown() {
+	xxx_messageInfo_DerivationStep.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DerivationStep proto.InternalMessageInfo
+
+func (m *DerivationStep) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+func (m *DerivationStep) GetSalt() string {
+	if m != nil {
+		return m.Salt
+	}
+	return ""
+}
+
+func (m *DerivationStep) GetInitCodeHash() string {
+	if m != nil {
+		return m.InitCodeHash
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*FeeShare)(nil), "cosmos.evm.feeshare.v1.FeeShare")
+	proto.RegisterType((*DerivationStep)(nil), "cosmos.evm.feeshare.v1.DerivationStep")
+}
+
+func init() {
+	proto.RegisterFile("cosmos/evm/feeshare/v1/feeshare.proto", fileDescriptor_bb4a10adbdc29439)
+}
+
+var fileDescriptor_bb4a10adbdc29439 = []byte{
+	// 273 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x90, 0xcd, 0x4a, 0x03, 0x31,
+	0x10, 0xc7, 0x1b, 0xad, 0xa2, 0x41, 0xaa, 0x06, 0x11, 0x4f, 0x41, 0x8a, 0x8a, 0x1e, 0xdc, 0xa5,
+	0xf8, 0x02, 0x7e, 0x21, 0x9e, 0xdb, 0x9b, 0x97, 0x35, 0x4d, 0x46, 0x13, 0xe8, 0xee, 0x2c, 0x49,
+	0xdc, 0xda, 0xa7, 0xd0, 0xc7, 0xf2, 0xd8, 0xa3, 0x47, 0xd9, 0x7d, 0x11, 0xd9, 0xcf, 0xf6, 0x36,
+	0xf3, 0x9b, 0x1f, 0xfc, 0x87, 0x3f, 0x3d, 0x97, 0xe8, 0x62, 0x74, 0x21, 0x64, 0x71, 0xf8, 0x06,
+	0xe0, 0xb4, 0xb0, 0x10, 0x66, 0xa3, 0x6e, 0x0e, 0x52, 0x8b, 0x1e, 0xd9, 0x71, 0xad, 0x05, 0x90,
+	0xc5, 0x41, 0x77, 0xca, 0x46, 0xc3, 0x2f, 0x42, 0x77, 0x9e, 0x00, 0x26, 0xe5, 0xce, 0xae, 0xe8,
+	0x81, 0xc4, 0xc4, 0x5b, 0x21, 0x7d, 0x24, 0x94, 0xb2, 0xe0, 0xdc, 0x09, 0x39, 0x25, 0x97, 0xbb,
+	0xe3, 0xfd, 0x96, 0xdf, 0xd5, 0xb8, 0x54, 0x15, 0xa4, 0x33, 0x5c, 0x80, 0xed, 0xd4, 0x8d, 0x5a,
+	0x6d, 0x79, 0xab, 0x5e, 0x53, 0x36, 0x37, 0x5e, 0x2b, 0x2b, 0xe6, 0x6b, 0xf2, 0x66, 0x25, 0x1f,
+	0xae, 0x2e, 0x8d, 0x3e, 0x7c, 0xa5, 0x83, 0x47, 0xb0, 0x26, 0x13, 0xde, 0x60, 0x32, 0xf1, 0x90,
+	0xb2, 0x23, 0xba, 0x95, 0x60, 0x22, 0xa1, 0xfa, 0xa5, 0x3f, 0xae, 0x17, 0xc6, 0x68, 0xdf, 0x89,
+	0x99, 0x6f, 0x52, 0xab, 0x99, 0x9d, 0xd1, 0x81, 0x49, 0x8c, 0x8f, 0x24, 0x2a, 0x88, 0xb4, 0x70,
+	0xba, 0x89, 0xd9, 0x2b, 0xe9, 0x03, 0x2a, 0x78, 0x16, 0x4e, 0xdf, 0xdf, 0xfe, 0xe4, 0x9c, 0x2c,
+	0x73, 0x4e, 0xfe, 0x72, 0x4e, 0xbe, 0x0b, 0xde, 0x5b, 0x16, 0xbc, 0xf7, 0x5b, 0xf0, 0xde, 0xcb,
+	0xc5, 0xbb, 0xf1, 0xfa, 0x63, 0x1a, 0x48, 0x8c, 0xc3, 0xb5, 0x5e, 0x3f, 0x57, 0xcd, 0xfa, 0x45,
+	0x0a, 0x6e, 0xba, 0x5d, 0x95, 0x7a, 0xf3, 0x3f, 0x00, 0x08, 0xf8, 0xa2, 0x5b, 0x7d, 0x01, 0x00,
+	0x00,
+}
+
+func (m *FeeShare) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeShare) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeShare) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.WithdrawerAddress) > 0 {
+		i -= len(m.WithdrawerAddress)
+		copy(dAtA[i:], m.WithdrawerAddress)
+		i = encodeVarintFeeshare(dAtA, i, uint64(len(m.WithdrawerAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.DeployerAddress) > 0 {
+		i -= len(m.DeployerAddress)
+		copy(dAtA[i:], m.DeployerAddress)
+		i = encodeVarintFeeshare(dAtA, i, uint64(len(m.DeployerAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintFeeshare(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DerivationStep) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DerivationStep) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DerivationStep) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.InitCodeHash) > 0 {
+		i -= len(m.InitCodeHash)
+		copy(dAtA[i:], m.InitCodeHash)
+		i = encodeVarintFeeshare(dAtA, i, uint64(len(m.InitCodeHash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Salt) > 0 {
+		i -= len(m.Salt)
+		copy(dAtA[i:], m.Salt)
+		i = encodeVarintFeeshare(dAtA, i, uint64(len(m.Salt)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Nonce != 0 {
+		i = encodeVarintFeeshare(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintFeeshare(dAtA []byte, offset int, v uint64) int {
+	offset -= sovFeeshare(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *FeeShare) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovFeeshare(uint64(l))
+	}
+	l = len(m.DeployerAddress)
+	if l > 0 {
+		n += 1 + l + sovFeeshare(uint64(l))
+	}
+	l = len(m.WithdrawerAddress)
+	if l > 0 {
+		n += 1 + l + sovFeeshare(uint64(l))
+	}
+	return n
+}
+
+func (m *DerivationStep) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		n += 1 + sovFeeshare(uint64(m.Nonce))
+	}
+	l = len(m.Salt)
+	if l > 0 {
+		n += 1 + l + sovFeeshare(uint64(l))
+	}
+	l = len(m.InitCodeHash)
+	if l > 0 {
+		n += 1 + l + sovFeeshare(uint64(l))
+	}
+	return n
+}
+
+func sovFeeshare(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozFeeshare(x uint64) (n int) {
+	return sovFeeshare(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *FeeShare) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeeshare
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeShare: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeShare: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeshare
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeeshare
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeeshare
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeployerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeshare
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeeshare
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeeshare
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeployerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeshare
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeeshare
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeeshare
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WithdrawerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeeshare(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeeshare
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DerivationStep) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeeshare
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DerivationStep: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DerivationStep: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeshare
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Salt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeshare
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeeshare
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeeshare
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Salt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitCodeHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeshare
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeeshare
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeeshare
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InitCodeHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeeshare(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeeshare
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipFeeshare(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowFeeshare
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowFeeshare
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowFeeshare
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthFeeshare
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupFeeshare
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthFeeshare
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthFeeshare        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowFeeshare          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupFeeshare = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,42 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+)
+
+// NewGenesisState creates a new genesis state.
+func NewGenesisState(params Params, feeShares []FeeShare) GenesisState {
+	return GenesisState{
+		Params:    params,
+		FeeShares: feeShares,
+	}
+}
+
+// DefaultGenesisState sets the default feeshare genesis state with the default
+// params and no registered contracts.
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		Params:    DefaultParams(),
+		FeeShares: []FeeShare{},
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	seenContracts := make(map[string]bool)
+	for _, feeShare := range gs.FeeShares {
+		if err := feeShare.Validate(); err != nil {
+			return fmt.Errorf("invalid fee share on genesis: %w", err)
+		}
+
+		contract := strings.ToLower(feeShare.ContractAddress)
+		if seenContracts[contract] {
+			return fmt.Errorf("contract duplicated on genesis: %s", feeShare.ContractAddress)
+		}
+		seenContracts[contract] = true
+	}
+
+	return gs.Params.Validate()
+}
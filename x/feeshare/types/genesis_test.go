@@ -0,0 +1,88 @@
+package types_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	utiltx "github.com/cosmos/evm/testutil/tx"
+	"github.com/cosmos/evm/x/feeshare/types"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestGenesisStateValidate(t *testing.T) {
+	contract := utiltx.GenerateAddress()
+	deployer := sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+	withdrawer := sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+	feeShare := types.NewFeeShare(contract, deployer, withdrawer)
+
+	duplicate := feeShare
+	duplicate.ContractAddress = strings.ToLower(duplicate.ContractAddress)
+
+	testCases := []struct {
+		name     string
+		genState *types.GenesisState
+		expPass  bool
+	}{
+		{
+			"default",
+			types.DefaultGenesisState(),
+			true,
+		},
+		{
+			"valid genesis",
+			&types.GenesisState{
+				Params:    types.NewParams(true, math.LegacyNewDecWithPrec(25, 2), 100),
+				FeeShares: []types.FeeShare{feeShare},
+			},
+			true,
+		},
+		{
+			"invalid genesis - duplicated contract",
+			&types.GenesisState{
+				Params:    types.DefaultParams(),
+				FeeShares: []types.FeeShare{feeShare, duplicate},
+			},
+			false,
+		},
+		{
+			"invalid genesis - invalid withdrawer",
+			&types.GenesisState{
+				Params: types.DefaultParams(),
+				FeeShares: []types.FeeShare{
+					{ContractAddress: contract.Hex(), DeployerAddress: deployer.String(), WithdrawerAddress: "invalid"},
+				},
+			},
+			false,
+		},
+		{
+			"invalid genesis - negative developer shares",
+			&types.GenesisState{
+				Params: types.NewParams(true, math.LegacyNewDec(-1), 100),
+			},
+			false,
+		},
+		{
+			"invalid genesis - nil developer shares",
+			&types.GenesisState{
+				Params: types.NewParams(true, math.LegacyDec{}, 100),
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.genState.Validate()
+			if tc.expPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,20 @@
+package types
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// BankKeeper defines the expected interface needed to pay the fee shares.
+type BankKeeper interface {
+	BlockedAddr(addr sdk.AccAddress) bool
+	SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+}
+
+// EVMKeeper defines the expected EVM keeper interface used on feeshare
+type EVMKeeper interface {
+	IsContract(ctx sdk.Context, addr common.Address) bool
+}
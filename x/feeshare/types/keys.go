@@ -0,0 +1,60 @@
+package types
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+// constants
+const (
+	// module name
+	ModuleName = "feeshare"
+
+	// StoreKey to be used when creating the KVStore
+	StoreKey = ModuleName
+
+	// RouterKey to be used for message routing
+	RouterKey = ModuleName
+)
+
+// prefix bytes for the fee share persistent store
+const (
+	prefixFeeShare = iota + 1
+	prefixDeployerFeeShares
+	prefixWithdrawerFeeShares
+	prefixParams
+)
+
+// KVStore key prefixes
+var (
+	KeyPrefixFeeShare            = []byte{prefixFeeShare}
+	KeyPrefixDeployerFeeShares   = []byte{prefixDeployerFeeShares}
+	KeyPrefixWithdrawerFeeShares = []byte{prefixWithdrawerFeeShares}
+	KeyPrefixParams              = []byte{prefixParams}
+)
+
+// DeployerFeeSharesPrefix returns the prefix of the registered contracts of a
+// deployer.
+func DeployerFeeSharesPrefix(deployer sdk.AccAddress) []byte {
+	return append(append([]byte{}, KeyPrefixDeployerFeeShares...), address.MustLengthPrefix(deployer)...)
+}
+
+// WithdrawerFeeSharesPrefix returns the prefix of the registered contracts of
+// a withdrawer.
+func WithdrawerFeeSharesPrefix(withdrawer sdk.AccAddress) []byte {
+	return append(append([]byte{}, KeyPrefixWithdrawerFeeShares...), address.MustLengthPrefix(withdrawer)...)
+}
+
+// DeployerFeeShareKey returns the key indexing a registered contract by its
+// deployer.
+func DeployerFeeShareKey(deployer sdk.AccAddress, contract common.Address) []byte {
+	return append(DeployerFeeSharesPrefix(deployer), contract.Bytes()...)
+}
+
+// WithdrawerFeeShareKey returns the key indexing a registered contract by its
+// withdrawer.
+func WithdrawerFeeShareKey(withdrawer sdk.AccAddress, contract common.Address) []byte {
+	return append(WithdrawerFeeSharesPrefix(withdrawer), contract.Bytes()...)
+}
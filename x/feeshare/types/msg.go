@@ -0,0 +1,111 @@
+package types
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var (
+	_ sdk.Msg              = &MsgRegisterFeeShare{}
+	_ sdk.Msg              = &MsgUpdateFeeShare{}
+	_ sdk.Msg              = &MsgCancelFeeShare{}
+	_ sdk.Msg              = &MsgUpdateParams{}
+	_ sdk.HasValidateBasic = &MsgRegisterFeeShare{}
+	_ sdk.HasValidateBasic = &MsgUpdateFeeShare{}
+	_ sdk.HasValidateBasic = &MsgCancelFeeShare{}
+	_ sdk.HasValidateBasic = &MsgUpdateParams{}
+)
+
+// NewMsgRegisterFeeShare creates a new instance of MsgRegisterFeeShare
+func NewMsgRegisterFeeShare(contract common.Address, deployer, withdrawer sdk.AccAddress, path []DerivationStep) *MsgRegisterFeeShare {
+	withdrawerAddress := ""
+	if len(withdrawer) > 0 {
+		withdrawerAddress = withdrawer.String()
+	}
+	return &MsgRegisterFeeShare{
+		ContractAddress:   contract.Hex(),
+		DeployerAddress:   deployer.String(),
+		WithdrawerAddress: withdrawerAddress,
+		DerivationPath:    path,
+	}
+}
+
+// ValidateBasic runs stateless checks on the message
+func (m MsgRegisterFeeShare) ValidateBasic() error {
+	if err := validateContractAndDeployer(m.ContractAddress, m.DeployerAddress); err != nil {
+		return err
+	}
+	if m.WithdrawerAddress != "" {
+		if _, err := sdk.AccAddressFromBech32(m.WithdrawerAddress); err != nil {
+			return errorsmod.Wrap(err, "invalid withdrawer address")
+		}
+	}
+
+	if len(m.DerivationPath) == 0 {
+		return errorsmod.Wrap(ErrInvalidDerivationPath, "derivation path cannot be empty")
+	}
+	for i, step := range m.DerivationPath {
+		if err := step.Validate(); err != nil {
+			return errorsmod.Wrapf(ErrInvalidDerivationPath, "step %d: %s", i, err)
+		}
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (m MsgRegisterFeeShare) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
+
+// ValidateBasic runs stateless checks on the message
+func (m MsgUpdateFeeShare) ValidateBasic() error {
+	if err := validateContractAndDeployer(m.ContractAddress, m.DeployerAddress); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(m.WithdrawerAddress); err != nil {
+		return errorsmod.Wrap(err, "invalid withdrawer address")
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (m MsgUpdateFeeShare) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
+
+// ValidateBasic runs stateless checks on the message
+func (m MsgCancelFeeShare) ValidateBasic() error {
+	return validateContractAndDeployer(m.ContractAddress, m.DeployerAddress)
+}
+
+// GetSignBytes encodes the message for signing
+func (m MsgCancelFeeShare) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgUpdateParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "Invalid authority address")
+	}
+	return m.Params.Validate()
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgUpdateParams) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
+
+func validateContractAndDeployer(contract, deployer string) error {
+	if !common.IsHexAddress(contract) {
+		return errorsmod.Wrapf(errortypes.ErrInvalidAddress, "invalid contract hex address %s", contract)
+	}
+	if _, err := sdk.AccAddressFromBech32(deployer); err != nil {
+		return errorsmod.Wrap(err, "invalid deployer address")
+	}
+	return nil
+}
@@ -0,0 +1,199 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	utiltx "github.com/cosmos/evm/testutil/tx"
+	"github.com/cosmos/evm/x/feeshare/types"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+type MsgsTestSuite struct {
+	suite.Suite
+}
+
+func TestMsgsTestSuite(t *testing.T) {
+	suite.Run(t, new(MsgsTestSuite))
+}
+
+func (suite *MsgsTestSuite) TestMsgRegisterFeeShareValidateBasic() {
+	contract := utiltx.GenerateAddress()
+	deployer := sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+	withdrawer := sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+	hash := "0x" + "11223344556677889900aabbccddeeff11223344556677889900aabbccddeeff"
+
+	testCases := []struct {
+		msg        string
+		register   *types.MsgRegisterFeeShare
+		expectPass bool
+	}{
+		{
+			"pass - CREATE deployment",
+			types.NewMsgRegisterFeeShare(contract, deployer, withdrawer, []types.DerivationStep{{Nonce: 1}}),
+			true,
+		},
+		{
+			"pass - no withdrawer and CREATE2 deployment",
+			types.NewMsgRegisterFeeShare(contract, deployer, nil, []types.DerivationStep{{Nonce: 0}, {Salt: hash, InitCodeHash: hash}}),
+			true,
+		},
+		{
+			"fail - invalid contract address",
+			&types.MsgRegisterFeeShare{
+				ContractAddress: "0x123",
+				DeployerAddress: deployer.String(),
+				DerivationPath:  []types.DerivationStep{{Nonce: 1}},
+			},
+			false,
+		},
+		{
+			"fail - invalid deployer address",
+			&types.MsgRegisterFeeShare{
+				ContractAddress: contract.Hex(),
+				DeployerAddress: "invalid",
+				DerivationPath:  []types.DerivationStep{{Nonce: 1}},
+			},
+			false,
+		},
+		{
+			"fail - invalid withdrawer address",
+			&types.MsgRegisterFeeShare{
+				ContractAddress:   contract.Hex(),
+				DeployerAddress:   deployer.String(),
+				WithdrawerAddress: "invalid",
+				DerivationPath:    []types.DerivationStep{{Nonce: 1}},
+			},
+			false,
+		},
+		{
+			"fail - empty derivation path",
+			types.NewMsgRegisterFeeShare(contract, deployer, withdrawer, nil),
+			false,
+		},
+		{
+			"fail - CREATE2 deployment with nonce",
+			types.NewMsgRegisterFeeShare(contract, deployer, withdrawer, []types.DerivationStep{{Nonce: 1, Salt: hash, InitCodeHash: hash}}),
+			false,
+		},
+		{
+			"fail - CREATE2 deployment with short salt",
+			types.NewMsgRegisterFeeShare(contract, deployer, withdrawer, []types.DerivationStep{{Salt: "0x1234", InitCodeHash: hash}}),
+			false,
+		},
+		{
+			"fail - CREATE2 deployment without init code hash",
+			types.NewMsgRegisterFeeShare(contract, deployer, withdrawer, []types.DerivationStep{{Salt: hash}}),
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		err := tc.register.ValidateBasic()
+		if tc.expectPass {
+			suite.Require().NoError(err, tc.msg)
+		} else {
+			suite.Require().Error(err, tc.msg)
+		}
+	}
+}
+
+func (suite *MsgsTestSuite) TestMsgUpdateFeeShareValidateBasic() {
+	contract := utiltx.GenerateAddress().Hex()
+	deployer := sdk.AccAddress(utiltx.GenerateAddress().Bytes()).String()
+	withdrawer := sdk.AccAddress(utiltx.GenerateAddress().Bytes()).String()
+
+	testCases := []struct {
+		msg        string
+		update     types.MsgUpdateFeeShare
+		expectPass bool
+	}{
+		{"pass", types.MsgUpdateFeeShare{ContractAddress: contract, DeployerAddress: deployer, WithdrawerAddress: withdrawer}, true},
+		{"fail - invalid contract address", types.MsgUpdateFeeShare{ContractAddress: "invalid", DeployerAddress: deployer, WithdrawerAddress: withdrawer}, false},
+		{"fail - invalid deployer address", types.MsgUpdateFeeShare{ContractAddress: contract, DeployerAddress: "invalid", WithdrawerAddress: withdrawer}, false},
+		{"fail - empty withdrawer address", types.MsgUpdateFeeShare{ContractAddress: contract, DeployerAddress: deployer}, false},
+	}
+
+	for _, tc := range testCases {
+		err := tc.update.ValidateBasic()
+		if tc.expectPass {
+			suite.Require().NoError(err, tc.msg)
+		} else {
+			suite.Require().Error(err, tc.msg)
+		}
+	}
+}
+
+func (suite *MsgsTestSuite) TestMsgCancelFeeShareValidateBasic() {
+	contract := utiltx.GenerateAddress().Hex()
+	deployer := sdk.AccAddress(utiltx.GenerateAddress().Bytes()).String()
+
+	testCases := []struct {
+		msg        string
+		cancel     types.MsgCancelFeeShare
+		expectPass bool
+	}{
+		{"pass", types.MsgCancelFeeShare{ContractAddress: contract, DeployerAddress: deployer}, true},
+		{"fail - invalid contract address", types.MsgCancelFeeShare{ContractAddress: "invalid", DeployerAddress: deployer}, false},
+		{"fail - invalid deployer address", types.MsgCancelFeeShare{ContractAddress: contract, DeployerAddress: "invalid"}, false},
+	}
+
+	for _, tc := range testCases {
+		err := tc.cancel.ValidateBasic()
+		if tc.expectPass {
+			suite.Require().NoError(err, tc.msg)
+		} else {
+			suite.Require().Error(err, tc.msg)
+		}
+	}
+}
+
+func (suite *MsgsTestSuite) TestMsgUpdateParamsValidateBasic() {
+	testCases := []struct {
+		name      string
+		msgUpdate *types.MsgUpdateParams
+		expPass   bool
+	}{
+		{
+			"fail - invalid authority address",
+			&types.MsgUpdateParams{
+				Authority: "invalid",
+				Params:    types.DefaultParams(),
+			},
+			false,
+		},
+		{
+			"fail - developer shares above one",
+			&types.MsgUpdateParams{
+				Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+				Params:    types.NewParams(true, math.LegacyNewDec(2), types.DefaultAddrDerivationCostCreate),
+			},
+			false,
+		},
+		{
+			"pass - valid msg",
+			&types.MsgUpdateParams{
+				Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+				Params:    types.DefaultParams(),
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := tc.msgUpdate.ValidateBasic()
+			if tc.expPass {
+				suite.NoError(err)
+			} else {
+				suite.Error(err)
+			}
+		})
+	}
+}
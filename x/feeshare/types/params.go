@@ -0,0 +1,51 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+)
+
+var (
+	// DefaultEnableFeeShare disables the fee share by default
+	DefaultEnableFeeShare = false
+	// DefaultDeveloperShares sends half of the transaction fees to the contract
+	// developers
+	DefaultDeveloperShares = math.LegacyNewDecWithPrec(50, 2)
+	// DefaultAddrDerivationCostCreate is the gas consumed for each step of a
+	// derivation path, which costs a keccak256 hash
+	DefaultAddrDerivationCostCreate = uint64(50)
+)
+
+// NewParams creates a new Params instance
+func NewParams(
+	enableFeeShare bool,
+	developerShares math.LegacyDec,
+	addrDerivationCostCreate uint64,
+) Params {
+	return Params{
+		EnableFeeShare:           enableFeeShare,
+		DeveloperShares:          developerShares,
+		AddrDerivationCostCreate: addrDerivationCostCreate,
+	}
+}
+
+// DefaultParams returns the default feeshare module params
+func DefaultParams() Params {
+	return Params{
+		EnableFeeShare:           DefaultEnableFeeShare,
+		DeveloperShares:          DefaultDeveloperShares,
+		AddrDerivationCostCreate: DefaultAddrDerivationCostCreate,
+	}
+}
+
+// Validate performs basic validation on the feeshare parameters.
+func (p Params) Validate() error {
+	if p.DeveloperShares.IsNil() {
+		return fmt.Errorf("developer shares cannot be nil")
+	}
+	if p.DeveloperShares.IsNegative() || p.DeveloperShares.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("developer shares must be between 0 and 1, got %s", p.DeveloperShares)
+	}
+	return nil
+}
@@ -0,0 +1,168 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/evm/x/vesting/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkvestingcli "github.com/cosmos/cosmos-sdk/x/auth/vesting/client/cli"
+	sdkvesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+)
+
+// flagDest is the flag of the destination address of a clawback
+const flagDest = "dest"
+
+// NewTxCmd returns a root CLI command handler for vesting transaction commands
+func NewTxCmd() *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "vesting subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	txCmd.AddCommand(
+		NewCreateClawbackVestingAccountCmd(),
+		NewFundVestingAccountCmd(),
+		NewClawbackCmd(),
+	)
+	return txCmd
+}
+
+// NewCreateClawbackVestingAccountCmd returns a CLI command handler for
+// converting the sender account into a clawback vesting account
+func NewCreateClawbackVestingAccountCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-clawback-vesting-account FUNDER_ADDRESS",
+		Short: "Convert the sender account into a clawback vesting account funded by the funder",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			funder, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCreateClawbackVestingAccount(funder, cliCtx.GetFromAddress())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewFundVestingAccountCmd returns a CLI command handler for funding a
+// clawback vesting account
+func NewFundVestingAccountCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fund-vesting-account VESTING_ADDRESS PERIODS_JSON_FILE",
+		Short: "Fund a clawback vesting account with the vesting schedule of the periods file",
+		Long: `Fund a clawback vesting account with the vesting schedule of the periods file.
+The file uses the format of the create-periodic-vesting-account command of the Cosmos SDK vesting module:
+
+{"start_time": 1625204910, "periods": [{"coins": "10test", "length_seconds": 2592000}]}`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			vestingAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			contents, err := os.ReadFile(args[1])
+			if err != nil {
+				return err
+			}
+
+			var vestingData sdkvestingcli.VestingData
+			if err := json.Unmarshal(contents, &vestingData); err != nil {
+				return err
+			}
+
+			periods := make(sdkvesting.Periods, 0, len(vestingData.Periods))
+			for i, p := range vestingData.Periods {
+				amount, err := sdk.ParseCoinsNormalized(p.Coins)
+				if err != nil {
+					return fmt.Errorf("invalid coins of period %d: %w", i, err)
+				}
+				periods = append(periods, sdkvesting.Period{Length: p.Length, Amount: amount})
+			}
+
+			msg := &types.MsgFundVestingAccount{
+				FunderAddress:  cliCtx.GetFromAddress().String(),
+				VestingAddress: vestingAddr.String(),
+				StartTime:      vestingData.StartTime,
+				VestingPeriods: periods,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewClawbackCmd returns a CLI command handler for clawing back the unvested
+// coins of a clawback vesting account funded by the sender
+func NewClawbackCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "clawback ACCOUNT_ADDRESS",
+		Short: "Claw back the unvested coins of a clawback vesting account funded by the sender",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			account, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			var dest sdk.AccAddress
+			if destAddress, _ := cmd.Flags().GetString(flagDest); destAddress != "" {
+				if dest, err = sdk.AccAddressFromBech32(destAddress); err != nil {
+					return err
+				}
+			}
+
+			msg := types.NewMsgClawback(cliCtx.GetFromAddress(), account, dest)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(flagDest, "", "the address receiving the clawed back coins, defaults to the sender")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
@@ -0,0 +1,40 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/evm/x/vesting/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	vestingexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// Balances returns the locked, unvested and vested coins of a vesting account
+func (k Keeper) Balances(c context.Context, req *types.QueryBalancesRequest) (*types.QueryBalancesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address %s", req.Address)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	acc, ok := k.accountKeeper.GetAccount(ctx, addr).(vestingexported.VestingAccount)
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "%s: %s", types.ErrNotVestingAccount, req.Address)
+	}
+
+	blockTime := ctx.BlockTime()
+	return &types.QueryBalancesResponse{
+		Locked:   acc.LockedCoins(blockTime),
+		Unvested: acc.GetVestingCoins(blockTime),
+		Vested:   acc.GetVestedCoins(blockTime),
+	}, nil
+}
@@ -0,0 +1,42 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/evm/x/vesting/types"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/log"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Keeper of this module manages the clawback vesting accounts. The accounts
+// are stored by the auth module, so the keeper does not have a store.
+type Keeper struct {
+	accountKeeper types.AccountKeeper
+	bankKeeper    types.BankKeeper
+}
+
+// NewKeeper creates new instances of the vesting Keeper
+func NewKeeper(ak types.AccountKeeper, bk types.BankKeeper) Keeper {
+	return Keeper{
+		accountKeeper: ak,
+		bankKeeper:    bk,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// GetClawbackVestingAccount returns the clawback vesting account of the given
+// address, or an error if the account is not a clawback vesting account.
+func (k Keeper) GetClawbackVestingAccount(ctx sdk.Context, addr sdk.AccAddress) (*types.ClawbackVestingAccount, error) {
+	acc, ok := k.accountKeeper.GetAccount(ctx, addr).(*types.ClawbackVestingAccount)
+	if !ok {
+		return nil, errorsmod.Wrapf(types.ErrNotClawbackVestingAccount, "account %s", addr)
+	}
+	return acc, nil
+}
@@ -0,0 +1,173 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/cosmos/evm/x/vesting/types"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	sdkvesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+)
+
+var _ types.MsgServer = Keeper{}
+
+// CreateClawbackVestingAccount implements the gRPC MsgServer interface. It
+// converts the base account of the signer into a clawback vesting account,
+// that only the funder can fund and claw back.
+func (k Keeper) CreateClawbackVestingAccount(goCtx context.Context, msg *types.MsgCreateClawbackVestingAccount) (*types.MsgCreateClawbackVestingAccountResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	funder, err := sdk.AccAddressFromBech32(msg.FunderAddress)
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "invalid funder address %s", msg.FunderAddress)
+	}
+	vestingAddr, err := sdk.AccAddressFromBech32(msg.VestingAddress)
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "invalid vesting address %s", msg.VestingAddress)
+	}
+
+	if k.bankKeeper.BlockedAddr(vestingAddr) {
+		return nil, errorsmod.Wrapf(errortypes.ErrUnauthorized, "%s is not allowed to receive funds", msg.VestingAddress)
+	}
+
+	acc := k.accountKeeper.GetAccount(ctx, vestingAddr)
+	if acc == nil {
+		return nil, errorsmod.Wrapf(errortypes.ErrUnknownAddress, "account %s does not exist", msg.VestingAddress)
+	}
+	// only plain accounts can be converted, which excludes the module and the
+	// vesting accounts
+	baseAcc, ok := acc.(*authtypes.BaseAccount)
+	if !ok {
+		return nil, errorsmod.Wrapf(types.ErrNotEligibleAccount, "account %s has type %T", msg.VestingAddress, acc)
+	}
+
+	vestingAcc := types.NewClawbackVestingAccount(baseAcc, funder, ctx.BlockTime().Unix())
+	k.accountKeeper.SetAccount(ctx, vestingAcc)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCreateClawbackVestingAccount,
+			sdk.NewAttribute(types.AttributeKeyFunder, msg.FunderAddress),
+			sdk.NewAttribute(types.AttributeKeyAccount, msg.VestingAddress),
+		),
+	)
+
+	return &types.MsgCreateClawbackVestingAccountResponse{}, nil
+}
+
+// FundVestingAccount implements the gRPC MsgServer interface. It sends the
+// coins of the vesting periods from the funder to the clawback vesting account
+// and adds the periods to its vesting schedule.
+func (k Keeper) FundVestingAccount(goCtx context.Context, msg *types.MsgFundVestingAccount) (*types.MsgFundVestingAccountResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	funder, err := sdk.AccAddressFromBech32(msg.FunderAddress)
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "invalid funder address %s", msg.FunderAddress)
+	}
+	vestingAddr, err := sdk.AccAddressFromBech32(msg.VestingAddress)
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "invalid vesting address %s", msg.VestingAddress)
+	}
+	if err := types.ValidatePeriods(msg.VestingPeriods); err != nil {
+		return nil, err
+	}
+
+	vestingAcc, err := k.GetClawbackVestingAccount(ctx, vestingAddr)
+	if err != nil {
+		return nil, err
+	}
+	if vestingAcc.FunderAddress != msg.FunderAddress {
+		return nil, errorsmod.Wrapf(types.ErrNotFunder, "%s, expected %s", msg.FunderAddress, vestingAcc.FunderAddress)
+	}
+
+	periods := sdkvesting.Periods(msg.VestingPeriods)
+	amount := periods.TotalAmount()
+	if err := k.bankKeeper.IsSendEnabledCoins(ctx, amount...); err != nil {
+		return nil, err
+	}
+
+	vestingAcc.AddVestingSchedule(msg.StartTime, periods)
+	k.accountKeeper.SetAccount(ctx, vestingAcc)
+
+	if err := k.bankKeeper.SendCoins(ctx, funder, vestingAddr, amount); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeFundVestingAccount,
+			sdk.NewAttribute(types.AttributeKeyFunder, msg.FunderAddress),
+			sdk.NewAttribute(types.AttributeKeyAccount, msg.VestingAddress),
+			sdk.NewAttribute(types.AttributeKeyStartTime, strconv.FormatInt(msg.StartTime, 10)),
+			sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+		),
+	)
+
+	return &types.MsgFundVestingAccountResponse{}, nil
+}
+
+// Clawback implements the gRPC MsgServer interface. It removes the coins that
+// did not vest yet from the schedule of the clawback vesting account and sends
+// them to the destination. The coins of the account that are delegated cannot
+// be clawed back.
+func (k Keeper) Clawback(goCtx context.Context, msg *types.MsgClawback) (*types.MsgClawbackResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	vestingAddr, err := sdk.AccAddressFromBech32(msg.AccountAddress)
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "invalid account address %s", msg.AccountAddress)
+	}
+
+	destAddress := msg.FunderAddress
+	if msg.DestAddress != "" {
+		destAddress = msg.DestAddress
+	}
+	dest, err := sdk.AccAddressFromBech32(destAddress)
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "invalid destination address %s", destAddress)
+	}
+	if k.bankKeeper.BlockedAddr(dest) {
+		return nil, errorsmod.Wrapf(errortypes.ErrUnauthorized, "%s is not allowed to receive funds", destAddress)
+	}
+
+	vestingAcc, err := k.GetClawbackVestingAccount(ctx, vestingAddr)
+	if err != nil {
+		return nil, err
+	}
+	if vestingAcc.FunderAddress != msg.FunderAddress {
+		return nil, errorsmod.Wrapf(types.ErrNotFunder, "%s, expected %s", msg.FunderAddress, vestingAcc.FunderAddress)
+	}
+
+	// the delegated unvested coins are not part of the balance
+	unvested := vestingAcc.GetVestingCoins(ctx.BlockTime())
+	clawback := unvested.Min(k.bankKeeper.GetAllBalances(ctx, vestingAddr))
+	if clawback.IsZero() {
+		return &types.MsgClawbackResponse{Coins: sdk.NewCoins()}, nil
+	}
+
+	// the account is updated first so that the clawed back coins are no
+	// longer locked
+	k.accountKeeper.SetAccount(ctx, vestingAcc.ComputeClawback(clawback, ctx.BlockTime()))
+
+	if err := k.bankKeeper.SendCoins(ctx, vestingAddr, dest, clawback); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeClawback,
+			sdk.NewAttribute(types.AttributeKeyFunder, msg.FunderAddress),
+			sdk.NewAttribute(types.AttributeKeyAccount, msg.AccountAddress),
+			sdk.NewAttribute(types.AttributeKeyDestination, destAddress),
+			sdk.NewAttribute(types.AttributeKeyAmount, clawback.String()),
+		),
+	)
+
+	return &types.MsgClawbackResponse{Coins: clawback}, nil
+}
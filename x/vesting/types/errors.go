@@ -0,0 +1,14 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+// errors
+var (
+	ErrNotEligibleAccount        = errorsmod.Register(ModuleName, 2, "account cannot be converted to a clawback vesting account")
+	ErrNotClawbackVestingAccount = errorsmod.Register(ModuleName, 3, "account is not a clawback vesting account")
+	ErrNotFunder                 = errorsmod.Register(ModuleName, 4, "address is not the funder of the vesting account")
+	ErrInvalidVestingPeriods     = errorsmod.Register(ModuleName, 5, "invalid vesting periods")
+	ErrNotVestingAccount         = errorsmod.Register(ModuleName, 6, "account is not a vesting account")
+)
@@ -0,0 +1,11 @@
+package types
+
+// constants
+const (
+	// module name. It differs from the Cosmos SDK x/auth/vesting module name,
+	// which is registered alongside this module.
+	ModuleName = "evmvesting"
+
+	// RouterKey to be used for message routing
+	RouterKey = ModuleName
+)
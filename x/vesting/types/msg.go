@@ -0,0 +1,124 @@
+package types
+
+import (
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkvesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+)
+
+var (
+	_ sdk.Msg              = &MsgCreateClawbackVestingAccount{}
+	_ sdk.Msg              = &MsgFundVestingAccount{}
+	_ sdk.Msg              = &MsgClawback{}
+	_ sdk.HasValidateBasic = &MsgCreateClawbackVestingAccount{}
+	_ sdk.HasValidateBasic = &MsgFundVestingAccount{}
+	_ sdk.HasValidateBasic = &MsgClawback{}
+)
+
+// NewMsgCreateClawbackVestingAccount creates a new instance of MsgCreateClawbackVestingAccount
+func NewMsgCreateClawbackVestingAccount(funder, vesting sdk.AccAddress) *MsgCreateClawbackVestingAccount {
+	return &MsgCreateClawbackVestingAccount{
+		FunderAddress:  funder.String(),
+		VestingAddress: vesting.String(),
+	}
+}
+
+// ValidateBasic runs stateless checks on the message
+func (m MsgCreateClawbackVestingAccount) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.FunderAddress); err != nil {
+		return errorsmod.Wrap(err, "invalid funder address")
+	}
+	if _, err := sdk.AccAddressFromBech32(m.VestingAddress); err != nil {
+		return errorsmod.Wrap(err, "invalid vesting address")
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (m MsgCreateClawbackVestingAccount) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
+
+// NewMsgFundVestingAccount creates a new instance of MsgFundVestingAccount
+func NewMsgFundVestingAccount(funder, vesting sdk.AccAddress, startTime time.Time, periods sdkvesting.Periods) *MsgFundVestingAccount {
+	return &MsgFundVestingAccount{
+		FunderAddress:  funder.String(),
+		VestingAddress: vesting.String(),
+		StartTime:      startTime.Unix(),
+		VestingPeriods: periods,
+	}
+}
+
+// ValidateBasic runs stateless checks on the message
+func (m MsgFundVestingAccount) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.FunderAddress); err != nil {
+		return errorsmod.Wrap(err, "invalid funder address")
+	}
+	if _, err := sdk.AccAddressFromBech32(m.VestingAddress); err != nil {
+		return errorsmod.Wrap(err, "invalid vesting address")
+	}
+	if m.StartTime < 0 {
+		return errorsmod.Wrapf(ErrInvalidVestingPeriods, "negative start time %d", m.StartTime)
+	}
+	return ValidatePeriods(m.VestingPeriods)
+}
+
+// GetSignBytes encodes the message for signing
+func (m MsgFundVestingAccount) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
+
+// NewMsgClawback creates a new instance of MsgClawback. The funder receives
+// the clawed back coins if the destination is empty.
+func NewMsgClawback(funder, account, dest sdk.AccAddress) *MsgClawback {
+	destAddress := ""
+	if len(dest) > 0 {
+		destAddress = dest.String()
+	}
+	return &MsgClawback{
+		FunderAddress:  funder.String(),
+		AccountAddress: account.String(),
+		DestAddress:    destAddress,
+	}
+}
+
+// ValidateBasic runs stateless checks on the message
+func (m MsgClawback) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.FunderAddress); err != nil {
+		return errorsmod.Wrap(err, "invalid funder address")
+	}
+	if _, err := sdk.AccAddressFromBech32(m.AccountAddress); err != nil {
+		return errorsmod.Wrap(err, "invalid account address")
+	}
+	if m.DestAddress != "" {
+		if _, err := sdk.AccAddressFromBech32(m.DestAddress); err != nil {
+			return errorsmod.Wrap(err, "invalid destination address")
+		}
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (m MsgClawback) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
+
+// ValidatePeriods checks that the vesting periods are not empty, and that each
+// of them has a positive length and vests a valid positive amount.
+func ValidatePeriods(periods []sdkvesting.Period) error {
+	if len(periods) == 0 {
+		return errorsmod.Wrap(ErrInvalidVestingPeriods, "vesting periods cannot be empty")
+	}
+	for i, p := range periods {
+		if p.Length <= 0 {
+			return errorsmod.Wrapf(ErrInvalidVestingPeriods, "period %d has a non positive length %d", i, p.Length)
+		}
+		if !p.Amount.IsValid() || !p.Amount.IsAllPositive() {
+			return errorsmod.Wrapf(ErrInvalidVestingPeriods, "period %d has invalid coins %s", i, p.Amount)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,124 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	utiltx "github.com/cosmos/evm/testutil/tx"
+	"github.com/cosmos/evm/x/vesting/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkvesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+)
+
+type MsgsTestSuite struct {
+	suite.Suite
+}
+
+func TestMsgsTestSuite(t *testing.T) {
+	suite.Run(t, new(MsgsTestSuite))
+}
+
+func (suite *MsgsTestSuite) TestMsgCreateClawbackVestingAccountValidateBasic() {
+	funder := sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+	vesting := sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+
+	testCases := []struct {
+		msg        string
+		create     *types.MsgCreateClawbackVestingAccount
+		expectPass bool
+	}{
+		{"pass", types.NewMsgCreateClawbackVestingAccount(funder, vesting), true},
+		{"fail - invalid funder address", &types.MsgCreateClawbackVestingAccount{FunderAddress: "invalid", VestingAddress: vesting.String()}, false},
+		{"fail - invalid vesting address", &types.MsgCreateClawbackVestingAccount{FunderAddress: funder.String(), VestingAddress: "invalid"}, false},
+	}
+
+	for i, tc := range testCases {
+		err := tc.create.ValidateBasic()
+		if tc.expectPass {
+			suite.Require().NoError(err, "valid test %d failed: %s", i, tc.msg)
+		} else {
+			suite.Require().Error(err, "invalid test %d passed: %s", i, tc.msg)
+		}
+	}
+}
+
+func (suite *MsgsTestSuite) TestMsgFundVestingAccountValidateBasic() {
+	funder := sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+	vesting := sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+	start := time.Unix(100, 0)
+
+	testCases := []struct {
+		msg        string
+		fund       *types.MsgFundVestingAccount
+		expectPass bool
+	}{
+		{
+			"pass",
+			types.NewMsgFundVestingAccount(funder, vesting, start, sdkvesting.Periods{{Length: 10, Amount: coins(1)}}),
+			true,
+		},
+		{
+			"fail - invalid vesting address",
+			&types.MsgFundVestingAccount{FunderAddress: funder.String(), VestingAddress: "invalid", VestingPeriods: sdkvesting.Periods{{Length: 10, Amount: coins(1)}}},
+			false,
+		},
+		{
+			"fail - negative start time",
+			types.NewMsgFundVestingAccount(funder, vesting, time.Unix(-1, 0), sdkvesting.Periods{{Length: 10, Amount: coins(1)}}),
+			false,
+		},
+		{
+			"fail - no periods",
+			types.NewMsgFundVestingAccount(funder, vesting, start, nil),
+			false,
+		},
+		{
+			"fail - zero length period",
+			types.NewMsgFundVestingAccount(funder, vesting, start, sdkvesting.Periods{{Length: 0, Amount: coins(1)}}),
+			false,
+		},
+		{
+			"fail - empty period",
+			types.NewMsgFundVestingAccount(funder, vesting, start, sdkvesting.Periods{{Length: 10}}),
+			false,
+		},
+	}
+
+	for i, tc := range testCases {
+		err := tc.fund.ValidateBasic()
+		if tc.expectPass {
+			suite.Require().NoError(err, "valid test %d failed: %s", i, tc.msg)
+		} else {
+			suite.Require().Error(err, "invalid test %d passed: %s", i, tc.msg)
+		}
+	}
+}
+
+func (suite *MsgsTestSuite) TestMsgClawbackValidateBasic() {
+	funder := sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+	account := sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+	dest := sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+
+	testCases := []struct {
+		msg        string
+		clawback   *types.MsgClawback
+		expectPass bool
+	}{
+		{"pass", types.NewMsgClawback(funder, account, dest), true},
+		{"pass - no destination", types.NewMsgClawback(funder, account, nil), true},
+		{"fail - invalid account address", &types.MsgClawback{FunderAddress: funder.String(), AccountAddress: "invalid"}, false},
+		{"fail - invalid destination address", &types.MsgClawback{FunderAddress: funder.String(), AccountAddress: account.String(), DestAddress: "invalid"}, false},
+	}
+
+	for i, tc := range testCases {
+		err := tc.clawback.ValidateBasic()
+		if tc.expectPass {
+			suite.Require().NoError(err, "valid test %d failed: %s", i, tc.msg)
+		} else {
+			suite.Require().Error(err, "invalid test %d passed: %s", i, tc.msg)
+		}
+	}
+}
@@ -0,0 +1,145 @@
+package types
+
+import (
+	"errors"
+	"sort"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
+	sdkvesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+)
+
+var (
+	_ sdk.AccountI                   = (*ClawbackVestingAccount)(nil)
+	_ authtypes.GenesisAccount       = (*ClawbackVestingAccount)(nil)
+	_ vestingexported.VestingAccount = (*ClawbackVestingAccount)(nil)
+)
+
+// NewClawbackVestingAccount returns a clawback vesting account for the given
+// base account. The account does not hold any vesting coins until it is funded.
+func NewClawbackVestingAccount(baseAcc *authtypes.BaseAccount, funder sdk.AccAddress, startTime int64) *ClawbackVestingAccount {
+	baseVestingAcc := &sdkvesting.BaseVestingAccount{
+		BaseAccount:     baseAcc,
+		OriginalVesting: sdk.NewCoins(),
+		EndTime:         startTime,
+	}
+
+	return &ClawbackVestingAccount{
+		PeriodicVestingAccount: sdkvesting.NewPeriodicVestingAccountRaw(baseVestingAcc, startTime, nil),
+		FunderAddress:          funder.String(),
+	}
+}
+
+// Validate checks for errors on the account fields. An account that was not
+// funded yet has no vesting periods.
+func (va ClawbackVestingAccount) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(va.FunderAddress); err != nil {
+		return errors.New("invalid funder address")
+	}
+	if len(va.VestingPeriods) > 0 {
+		return va.PeriodicVestingAccount.Validate()
+	}
+	if va.EndTime != va.StartTime {
+		return errors.New("vesting end time does not match length of all vesting periods")
+	}
+	if !va.OriginalVesting.IsZero() {
+		return errors.New("original vesting coins must be empty without vesting periods")
+	}
+	return va.BaseAccount.Validate()
+}
+
+// AddVestingSchedule adds the vesting periods starting at the given unix time
+// to the schedule of the account. The coins vesting at the same time in both
+// schedules are merged into a single period.
+func (va *ClawbackVestingAccount) AddVestingSchedule(startTime int64, periods sdkvesting.Periods) {
+	if len(va.VestingPeriods) == 0 {
+		va.StartTime = startTime
+		va.VestingPeriods = periods
+	} else {
+		va.StartTime, va.VestingPeriods = mergePeriods(va.StartTime, va.VestingPeriods, startTime, periods)
+	}
+
+	va.OriginalVesting = va.OriginalVesting.Add(periods.TotalAmount()...)
+	va.EndTime = va.StartTime + sdkvesting.Periods(va.VestingPeriods).TotalLength()
+}
+
+// ComputeClawback removes the given coins from the vesting schedule, starting
+// from the last period, and returns the updated account. The amount must not
+// exceed the coins that did not vest yet at the given time. The delegated
+// vesting coins are capped to the coins still vesting after the clawback.
+func (va ClawbackVestingAccount) ComputeClawback(amount sdk.Coins, blockTime time.Time) *ClawbackVestingAccount {
+	remaining := amount
+	periods := make(sdkvesting.Periods, len(va.VestingPeriods))
+	copy(periods, va.VestingPeriods)
+
+	for i := len(periods) - 1; i >= 0 && !remaining.IsZero(); i-- {
+		taken := periods[i].Amount.Min(remaining)
+		periods[i].Amount = periods[i].Amount.Sub(taken...)
+		remaining = remaining.Sub(taken...)
+	}
+
+	// drop the emptied periods, adding their length to the next one so that
+	// the remaining periods keep vesting at the same time
+	var (
+		kept   sdkvesting.Periods
+		length int64
+	)
+	for _, p := range periods {
+		length += p.Length
+		if p.Amount.IsZero() {
+			continue
+		}
+		kept = append(kept, sdkvesting.Period{Length: length, Amount: p.Amount})
+		length = 0
+	}
+
+	baseVestingAcc := &sdkvesting.BaseVestingAccount{
+		BaseAccount:     va.BaseAccount,
+		OriginalVesting: va.OriginalVesting.Sub(amount...),
+		EndTime:         va.StartTime + kept.TotalLength(),
+	}
+
+	// the delegated coins that are no longer vesting become free
+	vesting := va.GetVestingCoins(blockTime).Sub(amount...)
+	delegatedVesting := va.DelegatedVesting.Min(vesting)
+	baseVestingAcc.DelegatedVesting = delegatedVesting
+	baseVestingAcc.DelegatedFree = va.DelegatedFree.Add(va.DelegatedVesting.Sub(delegatedVesting...)...)
+
+	return &ClawbackVestingAccount{
+		PeriodicVestingAccount: sdkvesting.NewPeriodicVestingAccountRaw(baseVestingAcc, va.StartTime, kept),
+		FunderAddress:          va.FunderAddress,
+	}
+}
+
+// mergePeriods merges two vesting schedules into a single one starting at the
+// earliest start time. The coins vesting at the same time are merged into a
+// single period.
+func mergePeriods(startA int64, periodsA []sdkvesting.Period, startB int64, periodsB []sdkvesting.Period) (int64, []sdkvesting.Period) {
+	start := min(startA, startB)
+
+	vestingAt := make(map[int64]sdk.Coins)
+	addEvents := func(t int64, periods []sdkvesting.Period) {
+		for _, p := range periods {
+			t += p.Length
+			vestingAt[t] = vestingAt[t].Add(p.Amount...)
+		}
+	}
+	addEvents(startA, periodsA)
+	addEvents(startB, periodsB)
+
+	times := make([]int64, 0, len(vestingAt))
+	for t := range vestingAt {
+		times = append(times, t)
+	}
+	sort.Slice(times, func(i, j int) bool { return times[i] < times[j] })
+
+	merged := make([]sdkvesting.Period, 0, len(times))
+	prev := start
+	for _, t := range times {
+		merged = append(merged, sdkvesting.Period{Length: t - prev, Amount: vestingAt[t]})
+		prev = t
+	}
+	return start, merged
+}
@@ -0,0 +1,156 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	utiltx "github.com/cosmos/evm/testutil/tx"
+	"github.com/cosmos/evm/x/vesting/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	sdkvesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+)
+
+const denom = "atest"
+
+func coins(amount int64) sdk.Coins {
+	return sdk.NewCoins(sdk.NewInt64Coin(denom, amount))
+}
+
+func newClawbackVestingAccount(start int64, periods sdkvesting.Periods) *types.ClawbackVestingAccount {
+	addr := sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+	funder := sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+	va := types.NewClawbackVestingAccount(authtypes.NewBaseAccountWithAddress(addr), funder, start)
+	if len(periods) > 0 {
+		va.AddVestingSchedule(start, periods)
+	}
+	return va
+}
+
+func TestAddVestingSchedule(t *testing.T) {
+	testCases := []struct {
+		name        string
+		start       int64
+		periods     sdkvesting.Periods
+		addStart    int64
+		addPeriods  sdkvesting.Periods
+		expStart    int64
+		expPeriods  sdkvesting.Periods
+		expOriginal sdk.Coins
+	}{
+		{
+			"first schedule",
+			0, nil,
+			100, sdkvesting.Periods{{Length: 10, Amount: coins(1)}, {Length: 10, Amount: coins(2)}},
+			100, sdkvesting.Periods{{Length: 10, Amount: coins(1)}, {Length: 10, Amount: coins(2)}},
+			coins(3),
+		},
+		{
+			"later schedule merged with the vesting times of the first one",
+			100, sdkvesting.Periods{{Length: 10, Amount: coins(1)}, {Length: 10, Amount: coins(2)}},
+			105, sdkvesting.Periods{{Length: 5, Amount: coins(4)}, {Length: 20, Amount: coins(8)}},
+			100, sdkvesting.Periods{{Length: 10, Amount: coins(5)}, {Length: 10, Amount: coins(2)}, {Length: 10, Amount: coins(8)}},
+			coins(15),
+		},
+		{
+			"earlier schedule moves the start time",
+			100, sdkvesting.Periods{{Length: 10, Amount: coins(1)}},
+			50, sdkvesting.Periods{{Length: 20, Amount: coins(2)}},
+			50, sdkvesting.Periods{{Length: 20, Amount: coins(2)}, {Length: 40, Amount: coins(1)}},
+			coins(3),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			va := newClawbackVestingAccount(tc.start, tc.periods)
+			va.AddVestingSchedule(tc.addStart, tc.addPeriods)
+
+			require.Equal(t, tc.expStart, va.StartTime)
+			require.Equal(t, []sdkvesting.Period(tc.expPeriods), va.VestingPeriods)
+			require.Equal(t, tc.expOriginal.String(), va.OriginalVesting.String())
+			require.Equal(t, tc.expStart+tc.expPeriods.TotalLength(), va.EndTime)
+			require.NoError(t, va.Validate())
+		})
+	}
+}
+
+func TestComputeClawback(t *testing.T) {
+	periods := sdkvesting.Periods{
+		{Length: 10, Amount: coins(1)},
+		{Length: 10, Amount: coins(2)},
+		{Length: 10, Amount: coins(4)},
+	}
+
+	testCases := []struct {
+		name                string
+		blockTime           int64
+		delegatedVesting    sdk.Coins
+		amount              sdk.Coins
+		expPeriods          sdkvesting.Periods
+		expDelegatedVesting sdk.Coins
+		expDelegatedFree    sdk.Coins
+	}{
+		{
+			"all the unvested coins",
+			115, nil, coins(6),
+			sdkvesting.Periods{{Length: 10, Amount: coins(1)}},
+			sdk.Coins{}, sdk.Coins{},
+		},
+		{
+			"part of a period",
+			115, nil, coins(5),
+			sdkvesting.Periods{{Length: 10, Amount: coins(1)}, {Length: 10, Amount: coins(1)}},
+			sdk.Coins{}, sdk.Coins{},
+		},
+		{
+			"delegated coins kept vesting",
+			115, coins(3), coins(3),
+			sdkvesting.Periods{{Length: 10, Amount: coins(1)}, {Length: 10, Amount: coins(2)}, {Length: 10, Amount: coins(1)}},
+			coins(3), sdk.Coins{},
+		},
+		{
+			"delegated coins freed",
+			125, coins(4), coins(1),
+			sdkvesting.Periods{{Length: 10, Amount: coins(1)}, {Length: 10, Amount: coins(2)}, {Length: 10, Amount: coins(3)}},
+			coins(3), coins(1),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			va := newClawbackVestingAccount(100, periods)
+			va.DelegatedVesting = tc.delegatedVesting
+
+			updated := va.ComputeClawback(tc.amount, time.Unix(tc.blockTime, 0))
+
+			require.Equal(t, []sdkvesting.Period(tc.expPeriods), updated.VestingPeriods)
+			require.Equal(t, tc.expPeriods.TotalAmount().String(), updated.OriginalVesting.String())
+			require.Equal(t, int64(100)+tc.expPeriods.TotalLength(), updated.EndTime)
+			require.Equal(t, tc.expDelegatedVesting.String(), updated.DelegatedVesting.String())
+			require.Equal(t, tc.expDelegatedFree.String(), updated.DelegatedFree.String())
+			require.Equal(t, va.FunderAddress, updated.FunderAddress)
+			require.NoError(t, updated.Validate())
+
+			// the original account is not modified
+			require.Equal(t, []sdkvesting.Period(periods), va.VestingPeriods)
+		})
+	}
+}
+
+func TestClawbackVestingAccountValidate(t *testing.T) {
+	va := newClawbackVestingAccount(100, nil)
+	require.NoError(t, va.Validate())
+
+	va.FunderAddress = ""
+	require.Error(t, va.Validate())
+
+	va = newClawbackVestingAccount(100, sdkvesting.Periods{{Length: 10, Amount: coins(1)}})
+	require.NoError(t, va.Validate())
+
+	va.EndTime = 100
+	require.Error(t, va.Validate())
+}
@@ -35,6 +35,7 @@ func GetQueryCmd() *cobra.Command {
 		GetAccountCmd(),
 		GetParamsCmd(),
 		GetConfigCmd(),
+		GetBurntFeesCmd(),
 		HexToBech32Cmd(),
 		Bech32ToHexCmd(),
 		GetBankBalanceCmd(),
@@ -213,6 +214,34 @@ func GetConfigCmd() *cobra.Command {
 	return cmd
 }
 
+// GetBurntFeesCmd queries the total amount of burnt EVM transaction fees
+func GetBurntFeesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "burnt-fees",
+		Short: "Get the burnt fees",
+		Long:  "Get the total amount of EVM transaction fees burnt by the chain, in the 18 decimals representation of the evm denom.",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.BurntFees(cmd.Context(), &types.QueryBurntFeesRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 // GetConfigCmd queries the evm configuration
 func HexToBech32Cmd() *cobra.Command {
 	cmd := &cobra.Command{
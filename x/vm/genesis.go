@@ -61,6 +61,10 @@ func InitGenesis(
 		panic(fmt.Errorf("error adding preinstalls: %s", err))
 	}
 
+	if !data.BurntFees.IsNil() {
+		k.SetBurntFees(ctx, data.BurntFees)
+	}
+
 	return []abci.ValidatorUpdate{}
 }
 
@@ -81,7 +85,8 @@ func ExportGenesis(ctx sdk.Context, k *keeper.Keeper) *types.GenesisState {
 	})
 
 	return &types.GenesisState{
-		Accounts: ethGenAccounts,
-		Params:   k.GetParams(ctx),
+		Accounts:  ethGenAccounts,
+		Params:    k.GetParams(ctx),
+		BurntFees: k.GetBurntFees(ctx),
 	}
 }
@@ -10,7 +10,8 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-// BeginBlock emits a base fee event which will be adjusted to the evm decimals
+// BeginBlock emits a base fee event which will be adjusted to the evm decimals,
+// along with the blob base fee of the block
 func (k *Keeper) BeginBlock(ctx sdk.Context) error {
 	logger := ctx.Logger().With("begin_block", "evm")
 
@@ -28,6 +29,7 @@ func (k *Keeper) BeginBlock(ctx sdk.Context) error {
 			sdk.NewEvent(
 				evmtypes.EventTypeFeeMarket,
 				sdk.NewAttribute(evmtypes.AttributeKeyBaseFee, res.BaseFee.String()),
+				sdk.NewAttribute(evmtypes.AttributeKeyBlobBaseFee, k.GetBlobBaseFee(ctx).String()),
 			),
 		})
 	}
@@ -32,3 +32,23 @@ func GetProposerAddress(ctx sdk.Context, proposerAddress sdk.ConsAddress) sdk.Co
 	}
 	return proposerAddress
 }
+
+// GetProposerOperatorAccount returns the account of the operator of the block
+// proposer's validator.
+func (k Keeper) GetProposerOperatorAccount(ctx sdk.Context) (sdk.AccAddress, error) {
+	validator, err := k.stakingKeeper.GetValidatorByConsAddr(ctx, GetProposerAddress(ctx, nil))
+	if err != nil {
+		return nil, errorsmod.Wrapf(
+			stakingtypes.ErrNoValidatorFound,
+			"failed to retrieve validator from block proposer address %s. Error: %s",
+			sdk.ConsAddress(ctx.BlockHeader().ProposerAddress).String(),
+			err.Error(),
+		)
+	}
+
+	operator, err := k.stakingKeeper.ValidatorAddressCodec().StringToBytes(validator.GetOperator())
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "invalid operator address %s", validator.GetOperator())
+	}
+	return operator, nil
+}
@@ -0,0 +1,128 @@
+package keeper
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/core"
+
+	"github.com/cosmos/evm/x/vm/types"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// DistributeFees splits the fees paid for the gas used by an EVM transaction,
+// held by the fee collector after the gas refund, according to the fee
+// distribution params.
+//
+// When the base fee is burnt, the base fee portion of the fees is burnt, minus
+// the base fee share sent to the base fee recipient, and the priority tip is
+// sent to the operator of the block proposer. The tip is left to the fee
+// collector when the proposer is unknown. Otherwise, all the fees are left to
+// the fee collector and distributed to the validators.
+//
+// The hook fees are the fees already paid out of the fee collector by the post
+// processing hooks, e.g. the fee shares of the contract developers. They are
+// taken proportionally from the base fee and the priority tip, so that only
+// the fees left to the fee collector are burnt and distributed.
+//
+// NOTE: the base fee share is burnt if the base fee recipient is blocked from
+// receiving funds, e.g. if it became a module account after the params update.
+func (k *Keeper) DistributeFees(ctx sdk.Context, msg core.Message, gasUsed uint64, baseFee *big.Int, hookFees sdkmath.Int, feeDistribution types.FeeDistribution) error {
+	if !feeDistribution.BurnBaseFee || gasUsed == 0 || baseFee == nil || baseFee.Sign() <= 0 {
+		return nil
+	}
+
+	// the effective gas price can only be lower than the base fee when it is
+	// not enforced, e.g. on simulations
+	burnablePrice := baseFee
+	if msg.GasPrice.Cmp(baseFee) < 0 {
+		burnablePrice = msg.GasPrice
+	}
+	gas := new(big.Int).SetUint64(gasUsed)
+	baseFeeAmount := sdkmath.NewIntFromBigInt(new(big.Int).Mul(gas, burnablePrice))
+	tip := sdkmath.NewIntFromBigInt(new(big.Int).Mul(gas, new(big.Int).Sub(msg.GasPrice, burnablePrice)))
+
+	if fees := baseFeeAmount.Add(tip); !hookFees.IsNil() && hookFees.IsPositive() && fees.IsPositive() {
+		remaining := sdkmath.MaxInt(fees.Sub(hookFees), sdkmath.ZeroInt())
+		baseFeeAmount = baseFeeAmount.Mul(remaining).Quo(fees)
+		tip = remaining.Sub(baseFeeAmount)
+	}
+
+	share := feeDistribution.GetBaseFeeShare().MulInt(baseFeeAmount).TruncateInt()
+	denom := types.GetEVMCoinDenom()
+
+	if share.IsPositive() {
+		recipient, err := sdk.AccAddressFromBech32(feeDistribution.BaseFeeRecipient)
+		if err != nil {
+			return errorsmod.Wrapf(err, "invalid base fee recipient %s", feeDistribution.BaseFeeRecipient)
+		}
+		if k.bankWrapper.BlockedAddr(recipient) {
+			k.Logger(ctx).Error("base fee share burnt, the base fee recipient is blocked", "recipient", recipient.String(), "share", share.String())
+			share = sdkmath.ZeroInt()
+		} else if err := k.bankWrapper.SendCoinsFromModuleToAccount(ctx, authtypes.FeeCollectorName, recipient, sdk.Coins{sdk.NewCoin(denom, share)}); err != nil {
+			return errorsmod.Wrapf(err, "failed to send the base fee share %s to %s", share, recipient)
+		}
+	}
+	burnt := baseFeeAmount.Sub(share)
+
+	if burnt.IsPositive() {
+		feeCollector := k.accountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+		if err := k.bankWrapper.BurnAmountFromAccount(ctx, feeCollector, burnt.BigInt()); err != nil {
+			return errorsmod.Wrapf(err, "failed to burn the base fee %s", burnt)
+		}
+		k.SetBurntFees(ctx, k.GetBurntFees(ctx).Add(burnt))
+	}
+
+	var proposer sdk.AccAddress
+	if tip.IsPositive() {
+		operator, err := k.GetProposerOperatorAccount(ctx)
+		if err != nil {
+			k.Logger(ctx).Debug("priority tip left to the fee collector", "error", err)
+		} else {
+			proposer = operator
+			if err := k.bankWrapper.SendCoinsFromModuleToAccount(ctx, authtypes.FeeCollectorName, proposer, sdk.Coins{sdk.NewCoin(denom, tip)}); err != nil {
+				return errorsmod.Wrapf(err, "failed to send the priority tip %s to %s", tip, proposer)
+			}
+		}
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeFeeBurn,
+			sdk.NewAttribute(types.AttributeKeyBurntFee, burnt.String()),
+			sdk.NewAttribute(types.AttributeKeyBaseFeeShare, share.String()),
+			sdk.NewAttribute(types.AttributeKeyPriorityTip, tip.String()),
+			sdk.NewAttribute(types.AttributeKeyProposer, proposer.String()),
+		),
+	)
+
+	return nil
+}
+
+// GetBurntFees returns the total amount of EVM transaction fees burnt by the
+// chain, in the 18 decimals representation of the EVM denom.
+func (k Keeper) GetBurntFees(ctx sdk.Context) sdkmath.Int {
+	bz := ctx.KVStore(k.storeKey).Get(types.KeyPrefixBurntFees)
+	if len(bz) == 0 {
+		return sdkmath.ZeroInt()
+	}
+
+	var burnt sdkmath.Int
+	if err := burnt.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+	return burnt
+}
+
+// SetBurntFees sets the total amount of EVM transaction fees burnt by the chain.
+func (k Keeper) SetBurntFees(ctx sdk.Context, burnt sdkmath.Int) {
+	bz, err := burnt.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	ctx.KVStore(k.storeKey).Set(types.KeyPrefixBurntFees, bz)
+}
@@ -5,12 +5,14 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"math"
 	"math/big"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/tracing"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/ethereum/go-ethereum/eth/tracers"
@@ -22,6 +24,7 @@ import (
 	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
 
 	cosmosevmtypes "github.com/cosmos/evm/types"
+	"github.com/cosmos/evm/utils"
 	evmante "github.com/cosmos/evm/x/vm/ante"
 	"github.com/cosmos/evm/x/vm/statedb"
 	"github.com/cosmos/evm/x/vm/types"
@@ -29,6 +32,7 @@ import (
 	sdkmath "cosmossdk.io/math"
 	storetypes "cosmossdk.io/store/types"
 
+	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -36,6 +40,9 @@ var _ types.QueryServer = Keeper{}
 
 const (
 	defaultTraceTimeout = 5 * time.Second
+	// callTracerName is the name of the go-ethereum call tracer, the only one
+	// supporting the tracing of precompile calls.
+	callTracerName = "callTracer"
 	// maxTracePredecessors is the maximum amount of transaction predecessors to be included in a trace Tx request.
 	// This limit is chosen as a sensible default to prevent unbounded predecessor iteration.
 	maxTracePredecessors = 10_000
@@ -237,6 +244,13 @@ func (k Keeper) EthCall(c context.Context, req *types.EthCallRequest) (*types.Ms
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
+	if len(req.Overrides) > 0 {
+		ctx, err = k.applyStateOverrides(ctx, req.Overrides)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
 	cfg, err := k.EVMConfig(ctx, GetProposerAddress(ctx, req.ProposerAddress))
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
@@ -289,6 +303,13 @@ func (k Keeper) EstimateGasInternal(c context.Context, req *types.EthCallRequest
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
+	if len(req.Overrides) > 0 {
+		ctx, err = k.applyStateOverrides(ctx, req.Overrides)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
 	// Binary search the gas requirement, as it may be higher than the amount used
 	var (
 		lo     = ethparams.TxGas - 1
@@ -430,6 +451,209 @@ func (k Keeper) EstimateGasInternal(c context.Context, req *types.EthCallRequest
 	return &types.EstimateGasResponse{Gas: hi}, nil
 }
 
+// SimulateV1 implements the eth_simulateV1 rpc api. It executes the calls of
+// each of the requested blocks sequentially on top of the current state, so
+// that every call sees the state changes of the previous ones.
+func (k Keeper) SimulateV1(c context.Context, req *types.SimulateV1Request) (*types.SimulateV1Response, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var opts types.SimOpts
+	if err := json.Unmarshal(req.Opts, &opts); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := opts.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	cfg, err := k.EVMConfig(ctx, GetProposerAddress(ctx, req.ProposerAddress))
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	// the simulated blocks are built on top of the requested one, and their
+	// state changes are discarded with the cache context
+	ctx, _ = ctx.CacheContext()
+	ctx = k.SetConsensusParamsInCtx(ctx)
+	parent := &types.SimBlockResult{
+		Number:    hexutil.Uint64(ctx.BlockHeight()), //nolint:gosec // G115 // block height is positive
+		Hash:      common.BytesToHash(ctx.HeaderHash()),
+		Timestamp: hexutil.Uint64(ctx.BlockTime().Unix()), //nolint:gosec // G115 // block time is positive
+	}
+
+	// the gas cap is a budget shared by all the calls of the request, so that
+	// the simulation can't execute more gas than a single capped call
+	gasBudget := req.GasCap
+	if gasBudget == 0 {
+		gasBudget = math.MaxUint64
+	}
+
+	results := make([]*types.SimBlockResult, 0, len(opts.BlockStateCalls))
+	for i, block := range opts.BlockStateCalls {
+		result, err := k.simulateBlock(ctx, *cfg, block, parent, &gasBudget)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "block %d: %s", i, err.Error())
+		}
+		results = append(results, result)
+		parent = result
+	}
+
+	bz, err := json.Marshal(results)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.SimulateV1Response{Data: bz}, nil
+}
+
+// simulateBlock executes the calls of a simulated block on top of its parent,
+// committing their state changes to the given context. The gas used by the
+// calls is deducted from the given gas budget.
+func (k *Keeper) simulateBlock(
+	ctx sdk.Context,
+	cfg statedb.EVMConfig,
+	block types.SimBlock,
+	parent *types.SimBlockResult,
+	gasBudget *uint64,
+) (*types.SimBlockResult, error) {
+	// the simulated block follows its parent unless overridden
+	height, err := cosmosevmtypes.SafeInt64(uint64(parent.Number) + 1)
+	if err != nil {
+		return nil, err
+	}
+	timestamp, err := cosmosevmtypes.SafeInt64(uint64(parent.Timestamp) + types.SimulateTimestampIncrement)
+	if err != nil {
+		return nil, err
+	}
+	ctx = ctx.
+		WithBlockHeight(height).
+		WithBlockTime(time.Unix(timestamp, 0).UTC()).
+		WithBlockGasMeter(storetypes.NewGasMeter(cosmosevmtypes.BlockGasLimit(ctx))).
+		WithHeaderHash(nil)
+	ctx = applyBlockOverrides(ctx, &cfg, block.BlockOverrides)
+	if ctx.BlockHeight() <= int64(parent.Number) { //nolint:gosec // G115 // parent number is a valid height
+		return nil, fmt.Errorf("block number %d must be higher than %d", ctx.BlockHeight(), parent.Number)
+	}
+	if ctx.BlockTime().Unix() <= int64(parent.Timestamp) { //nolint:gosec // G115 // parent timestamp is a valid time
+		return nil, fmt.Errorf("block timestamp %d must be higher than %d", ctx.BlockTime().Unix(), parent.Timestamp)
+	}
+
+	result := &types.SimBlockResult{
+		Number:       hexutil.Uint64(ctx.BlockHeight()), //nolint:gosec // G115 // block height is positive
+		ParentHash:   parent.Hash,
+		Timestamp:    hexutil.Uint64(ctx.BlockTime().Unix()), //nolint:gosec // G115 // block time is positive
+		GasLimit:     hexutil.Uint64(cosmosevmtypes.BlockGasLimit(ctx)),
+		Miner:        cfg.CoinBase,
+		Transactions: []common.Hash{},
+		Calls:        []types.SimCallResult{},
+	}
+	if overrides := block.BlockOverrides; overrides != nil && overrides.GasLimit != nil {
+		result.GasLimit = *overrides.GasLimit
+	}
+	if cfg.BaseFee != nil {
+		result.BaseFeePerGas = (*hexutil.Big)(cfg.BaseFee)
+	}
+
+	if block.StateOverrides != nil {
+		if err := k.overrideState(ctx, *block.StateOverrides); err != nil {
+			return nil, err
+		}
+	}
+
+	var (
+		gasUsed uint64
+		logs    []*ethtypes.Log
+	)
+	for i, args := range block.Calls {
+		from := args.GetFrom()
+		if args.Nonce == nil {
+			nonce := k.GetNonce(ctx, from)
+			args.Nonce = (*hexutil.Uint64)(&nonce)
+		}
+		if *gasBudget == 0 {
+			return nil, fmt.Errorf("call %d: gas budget exhausted", i)
+		}
+		remaining := uint64(result.GasLimit) - gasUsed
+		if args.Gas == nil {
+			gas := min(remaining, *gasBudget)
+			args.Gas = (*hexutil.Uint64)(&gas)
+		}
+		if uint64(*args.Gas) > remaining {
+			return nil, fmt.Errorf("call %d: block gas limit reached: %d > %d", i, *args.Gas, remaining)
+		}
+
+		// the explicit gas limit of the call is capped at the remaining budget
+		msg, err := args.ToMessage(*gasBudget, cfg.BaseFee, false, false)
+		if err != nil {
+			return nil, fmt.Errorf("call %d: %w", i, err)
+		}
+
+		txHash := args.ToTransaction().AsTransaction().Hash()
+		txConfig := statedb.NewTxConfig(common.Hash{}, txHash, uint(i), uint(len(logs))) //nolint:gosec // G115 // index won't exceed uint
+		rsp, err := k.ApplyMessageWithConfig(buildTraceCtx(ctx, msg.GasLimit), msg, nil, true, &cfg, txConfig, false)
+		if err != nil {
+			return nil, fmt.Errorf("call %d: %w", i, err)
+		}
+		if msg.To != nil {
+			// the sender nonce is incremented by the ante handler on actual
+			// transactions, contract creations already take care of it
+			acct := k.GetAccountOrEmpty(ctx, from)
+			acct.Nonce = msg.Nonce + 1
+			if err := k.SetAccount(ctx, from, acct); err != nil {
+				return nil, fmt.Errorf("call %d: %w", i, err)
+			}
+		}
+
+		callLogs := types.LogsToEthereum(rsp.Logs)
+		call := types.SimCallResult{
+			ReturnValue: rsp.Ret,
+			Logs:        callLogs,
+			GasUsed:     hexutil.Uint64(rsp.GasUsed),
+			Status:      hexutil.Uint64(ethtypes.ReceiptStatusSuccessful),
+		}
+		if rsp.Failed() {
+			call.Status = hexutil.Uint64(ethtypes.ReceiptStatusFailed)
+			if rsp.VmError == vm.ErrExecutionReverted.Error() {
+				call.Error = &types.SimCallError{
+					Code:    types.SimulateErrCodeReverted,
+					Message: types.NewExecErrorWithReason(rsp.Ret).Error(),
+					Data:    hexutil.Encode(rsp.Ret),
+				}
+			} else {
+				call.Error = &types.SimCallError{
+					Code:    types.SimulateErrCodeVMError,
+					Message: rsp.VmError,
+				}
+			}
+		}
+
+		gasUsed += rsp.GasUsed
+		*gasBudget -= min(rsp.GasUsed, *gasBudget)
+		logs = append(logs, callLogs...)
+		result.Transactions = append(result.Transactions, txHash)
+		result.Calls = append(result.Calls, call)
+	}
+	result.GasUsed = hexutil.Uint64(gasUsed)
+
+	header := &ethtypes.Header{
+		ParentHash: result.ParentHash,
+		Coinbase:   result.Miner,
+		Number:     new(big.Int).SetUint64(uint64(result.Number)),
+		GasLimit:   uint64(result.GasLimit),
+		GasUsed:    gasUsed,
+		Time:       uint64(result.Timestamp),
+		BaseFee:    cfg.BaseFee,
+	}
+	result.Hash = header.Hash()
+	for _, log := range logs {
+		log.BlockHash = result.Hash
+		log.BlockNumber = uint64(result.Number)
+	}
+	return result, nil
+}
+
 // TraceTx configures a new tracer according to the provided configuration, and
 // executes the given message in the provided environment. The return value will
 // be tracer-dependent.
@@ -534,6 +758,84 @@ func (k Keeper) TraceTx(c context.Context, req *types.QueryTraceTxRequest) (*typ
 	}, nil
 }
 
+// TraceCall configures a new tracer according to the provided configuration, and
+// executes the given call on top of the state of the requested block, with the
+// optional state and block overrides applied. The return value will be
+// tracer-dependent.
+func (k Keeper) TraceCall(c context.Context, req *types.QueryTraceCallRequest) (*types.QueryTraceCallResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.TraceConfig != nil && req.TraceConfig.Limit < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "output limit cannot be negative, got %d", req.TraceConfig.Limit)
+	}
+
+	var args types.TransactionArgs
+	if err := json.Unmarshal(req.Args, &args); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	if req.BlockNumber > 0 {
+		ctx = ctx.WithBlockHeight(req.BlockNumber)
+		ctx = ctx.WithBlockTime(req.BlockTime)
+		ctx = ctx.WithHeaderHash(common.Hex2Bytes(req.BlockHash))
+	}
+	if req.BlockMaxGas != 0 {
+		ctx = ctx.WithConsensusParams(tmproto.ConsensusParams{
+			Block: &tmproto.BlockParams{MaxGas: req.BlockMaxGas},
+		})
+	}
+
+	cfg, err := k.EVMConfig(ctx, GetProposerAddress(ctx, req.ProposerAddress))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to load evm config: %s", err.Error())
+	}
+
+	if len(req.StateOverrides) > 0 {
+		ctx, err = k.applyStateOverrides(ctx, req.StateOverrides)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+	if len(req.BlockOverrides) > 0 {
+		var overrides types.BlockOverrides
+		if err := json.Unmarshal(req.BlockOverrides, &overrides); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		if err := overrides.Validate(); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		ctx = applyBlockOverrides(ctx, cfg, &overrides)
+	}
+
+	// ApplyMessageWithConfig expect correct nonce set in msg
+	nonce := k.GetNonce(ctx, args.GetFrom())
+	args.Nonce = (*hexutil.Uint64)(&nonce)
+
+	msg, err := args.ToMessage(req.GasCap, cfg.BaseFee, false, false)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	txConfig := statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash()))
+	result, _, err := k.traceMsg(ctx, cfg, txConfig, msg, req.TraceConfig, false)
+	if err != nil {
+		// error will be returned with detail status from traceMsg
+		return nil, err
+	}
+
+	resultData, err := json.Marshal(result)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryTraceCallResponse{
+		Data: resultData,
+	}, nil
+}
+
 // TraceBlock configures a new tracer according to the provided configuration, and
 // executes the given message in the provided environment for all the transactions in the queried block.
 // The return value will be tracer dependent.
@@ -614,6 +916,23 @@ func (k *Keeper) traceTx(
 	tx *ethtypes.Transaction,
 	traceConfig *types.TraceConfig,
 	commitMessage bool,
+) (*any, uint, error) {
+	msg, err := core.TransactionToMessage(tx, signer, cfg.BaseFee)
+	if err != nil {
+		return nil, 0, status.Error(codes.Internal, err.Error())
+	}
+
+	return k.traceMsg(ctx, cfg, txConfig, *msg, traceConfig, commitMessage)
+}
+
+// traceMsg do trace on one message, it returns a tuple: (traceResult, nextLogIndex, error).
+func (k *Keeper) traceMsg(
+	ctx sdk.Context,
+	cfg *statedb.EVMConfig,
+	txConfig statedb.TxConfig,
+	msg core.Message,
+	traceConfig *types.TraceConfig,
+	commitMessage bool,
 ) (*any, uint, error) {
 	// Assemble the structured logger or the JavaScript tracer
 	var (
@@ -623,10 +942,6 @@ func (k *Keeper) traceTx(
 		err              error
 		timeout          = defaultTraceTimeout
 	)
-	msg, err := core.TransactionToMessage(tx, signer, cfg.BaseFee)
-	if err != nil {
-		return nil, 0, status.Error(codes.Internal, err.Error())
-	}
 
 	if traceConfig == nil {
 		traceConfig = &types.TraceConfig{}
@@ -664,12 +979,27 @@ func (k *Keeper) traceTx(
 	}
 
 	if traceConfig.Tracer != "" {
-		if tracer, err = tracers.DefaultDirectory.New(traceConfig.Tracer, tCtx, jsonTracerConfig,
+		if tracer, err = k.tracers.New(traceConfig.Tracer, tCtx, jsonTracerConfig,
 			types.GetEthChainConfig()); err != nil {
 			return nil, 0, status.Error(codes.Internal, err.Error())
 		}
 	}
 
+	// Record the Cosmos SDK execution of the precompile calls on the call frames
+	var precompileTracer *types.PrecompileTracer
+	if traceConfig.TracePrecompiles {
+		if traceConfig.Tracer != callTracerName {
+			return nil, 0, status.Errorf(codes.InvalidArgument, "precompile calls can only be traced with the %s", callTracerName)
+		}
+		cdc, ok := k.cdc.(codec.JSONCodec)
+		if !ok {
+			return nil, 0, status.Error(codes.Internal, "codec cannot encode the precompile messages to JSON")
+		}
+		precompileTracer = types.NewPrecompileTracer(cdc)
+		tracer.Hooks = precompileTracer.WrapHooks(tracer.Hooks)
+		ctx = types.ContextWithPrecompileTracer(ctx, precompileTracer)
+	}
+
 	// Define a meaningful timeout of a single transaction trace
 	if traceConfig.Timeout != "" {
 		if timeout, err = time.ParseDuration(traceConfig.Timeout); err != nil {
@@ -690,7 +1020,7 @@ func (k *Keeper) traceTx(
 
 	// Build EVM execution context
 	ctx = buildTraceCtx(ctx, msg.GasLimit)
-	res, err := k.ApplyMessageWithConfig(ctx, *msg, tracer.Hooks, commitMessage, cfg, txConfig, false)
+	res, err := k.ApplyMessageWithConfig(ctx, msg, tracer.Hooks, commitMessage, cfg, txConfig, false)
 	if err != nil {
 		return nil, 0, status.Error(codes.Internal, err.Error())
 	}
@@ -700,6 +1030,11 @@ func (k *Keeper) traceTx(
 	if err != nil {
 		return nil, 0, status.Error(codes.Internal, err.Error())
 	}
+	if precompileTracer != nil {
+		if result, err = precompileTracer.AnnotateCallFrames(result.(json.RawMessage)); err != nil {
+			return nil, 0, status.Error(codes.Internal, err.Error())
+		}
+	}
 
 	return &result, txConfig.LogIndex + uint(len(res.Logs)), nil
 }
@@ -726,6 +1061,12 @@ func (k Keeper) GlobalMinGasPrice(c context.Context, _ *types.QueryGlobalMinGasP
 	return &types.QueryGlobalMinGasPriceResponse{MinGasPrice: minGasPrice}, nil
 }
 
+// BurntFees implements the Query/BurntFees gRPC method
+func (k Keeper) BurntFees(c context.Context, _ *types.QueryBurntFeesRequest) (*types.QueryBurntFeesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryBurntFeesResponse{BurntFees: k.GetBurntFees(ctx)}, nil
+}
+
 // Config implements the Query/Config gRPC method
 func (k Keeper) Config(_ context.Context, _ *types.QueryConfigRequest) (*types.QueryConfigResponse, error) {
 	config := types.GetChainConfig()
@@ -742,3 +1083,78 @@ func buildTraceCtx(ctx sdk.Context, gasLimit uint64) sdk.Context {
 	return evmante.BuildEvmExecutionCtx(ctx).
 		WithGasMeter(cosmosevmtypes.NewInfiniteGasMeterWithLimit(gasLimit))
 }
+
+// applyStateOverrides decodes the given JSON encoded state overrides and applies
+// them through a throwaway StateDB on top of a cache context, which is returned
+// to execute the message call against the overridden state.
+func (k *Keeper) applyStateOverrides(ctx sdk.Context, bz []byte) (sdk.Context, error) {
+	var overrides types.StateOverride
+	if err := json.Unmarshal(bz, &overrides); err != nil {
+		return ctx, err
+	}
+	if err := overrides.Validate(); err != nil {
+		return ctx, err
+	}
+
+	ctx, _ = ctx.CacheContext()
+	if err := k.overrideState(ctx, overrides); err != nil {
+		return ctx, err
+	}
+	return ctx, nil
+}
+
+// overrideState writes the given state overrides to the provided context
+// through a throwaway StateDB.
+func (k *Keeper) overrideState(ctx sdk.Context, overrides types.StateOverride) error {
+	stateDB := statedb.New(ctx, k, statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash())))
+	for addr, account := range overrides {
+		if account.Nonce != nil {
+			stateDB.SetNonce(addr, uint64(*account.Nonce), tracing.NonceChangeUnspecified)
+		}
+		if account.Code != nil {
+			stateDB.SetCode(addr, *account.Code)
+		}
+		if account.Balance != nil && *account.Balance != nil {
+			balance, err := utils.Uint256FromBigInt((*account.Balance).ToInt())
+			if err != nil {
+				return fmt.Errorf("invalid balance override for account %s: %w", addr.Hex(), err)
+			}
+			stateDB.SetBalance(addr, balance)
+		}
+		if account.State != nil {
+			stateDB.SetStorage(addr, *account.State)
+		}
+		if account.StateDiff != nil {
+			for key, value := range *account.StateDiff {
+				stateDB.SetState(addr, key, value)
+			}
+		}
+	}
+
+	return stateDB.Commit()
+}
+
+// applyBlockOverrides returns the context and updates the EVM configuration of
+// the block with the given header fields overridden. The overrides must have
+// been validated with BlockOverrides.Validate.
+func applyBlockOverrides(ctx sdk.Context, cfg *statedb.EVMConfig, overrides *types.BlockOverrides) sdk.Context {
+	if overrides == nil {
+		return ctx
+	}
+	if overrides.Number != nil {
+		ctx = ctx.WithBlockHeight(overrides.Number.ToInt().Int64())
+	}
+	if overrides.Time != nil {
+		ctx = ctx.WithBlockTime(time.Unix(int64(*overrides.Time), 0).UTC()) //nolint:gosec // G115 // validated to fit int64
+	}
+	if overrides.GasLimit != nil {
+		ctx = ctx.WithBlockGasMeter(storetypes.NewGasMeter(uint64(*overrides.GasLimit)))
+	}
+	if overrides.FeeRecipient != nil {
+		cfg.CoinBase = *overrides.FeeRecipient
+	}
+	if overrides.BaseFeePerGas != nil {
+		cfg.BaseFee = overrides.BaseFeePerGas.ToInt()
+	}
+	return ctx
+}
@@ -67,6 +67,8 @@ type Keeper struct {
 
 	// Tracer used to collect execution traces from the EVM transaction execution
 	tracer string
+	// tracers holds the custom native tracers registered by the application
+	tracers *types.TracerRegistry
 
 	hooks types.EvmHooks
 	// EVM Hooks for tx post-processing
@@ -197,6 +199,25 @@ func (k *Keeper) SetHooks(eh types.EvmHooks) *Keeper {
 	return k
 }
 
+// CleanHooks resets the hooks for the EVM module
+// NOTE: Should only be used for testing purposes
+func (k *Keeper) CleanHooks() *Keeper {
+	k.hooks = nil
+	return k
+}
+
+// WithTracers sets the custom native tracers available to the debug_trace*
+// endpoints.
+// Called only once during initialization, panics if called more than once.
+func (k *Keeper) WithTracers(registry *types.TracerRegistry) *Keeper {
+	if k.tracers != nil {
+		panic("cannot set evm tracers twice")
+	}
+
+	k.tracers = registry
+	return k
+}
+
 // PostTxProcessing delegates the call to the hooks.
 // If no hook has been registered, this function returns with a `nil` error
 func (k *Keeper) PostTxProcessing(
@@ -342,6 +363,16 @@ func (k Keeper) GetBaseFee(ctx sdk.Context) *big.Int {
 	return baseFee
 }
 
+// GetBlobBaseFee returns the EIP-4844 blob base fee of the current block from
+// the fee market module, or zero before the Cancun hardfork.
+func (k Keeper) GetBlobBaseFee(ctx sdk.Context) *big.Int {
+	ethCfg := types.GetEthChainConfig()
+	if !ethCfg.IsCancun(big.NewInt(ctx.BlockHeight()), uint64(ctx.BlockTime().Unix())) { //#nosec G115 -- int overflow is not a concern here
+		return big.NewInt(0)
+	}
+	return k.feeMarketWrapper.GetBlobBaseFee(ctx)
+}
+
 // GetMinGasMultiplier returns the MinGasMultiplier param from the fee market module
 func (k Keeper) GetMinGasMultiplier(ctx sdk.Context) math.LegacyDec {
 	return k.feeMarketWrapper.GetParams(ctx).MinGasMultiplier
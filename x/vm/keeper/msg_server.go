@@ -18,6 +18,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
@@ -138,6 +139,18 @@ func (k *Keeper) UpdateParams(goCtx context.Context, req *types.MsgUpdateParams)
 		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority, expected %s, got %s", k.authority.String(), req.Authority)
 	}
 
+	// the base fee share can't be sent to a module account or any other
+	// address blocked from receiving funds
+	if recipient := req.Params.FeeDistribution.BaseFeeRecipient; recipient != "" {
+		recipientAddr, err := sdk.AccAddressFromBech32(recipient)
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "invalid base fee recipient %s", recipient)
+		}
+		if k.bankWrapper.BlockedAddr(recipientAddr) {
+			return nil, errorsmod.Wrapf(errortypes.ErrUnauthorized, "base fee recipient %s is not allowed to receive funds", recipient)
+		}
+	}
+
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	if err := k.SetParams(ctx, req.Params); err != nil {
 		return nil, err
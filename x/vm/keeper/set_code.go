@@ -0,0 +1,78 @@
+package keeper
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/tracing"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
+
+	"github.com/cosmos/evm/x/vm/statedb"
+)
+
+// applyAuthorizations applies the EIP-7702 code delegations of the message
+// to the state, skipping the invalid ones as they don't invalidate the
+// transaction.
+// NOTE: This code is inspired from the go-ethereum state transition. For the
+// canonical code refer to: https://github.com/ethereum/go-ethereum/blob/master/core/state_transition.go
+func applyAuthorizations(stateDB *statedb.StateDB, chainID *big.Int, authList []ethtypes.SetCodeAuthorization) {
+	for i := range authList {
+		_ = applyAuthorization(stateDB, chainID, &authList[i])
+	}
+}
+
+// applyAuthorization installs the delegation designator of the authorization
+// in the code of its authority, and bumps the authority nonce.
+func applyAuthorization(stateDB *statedb.StateDB, chainID *big.Int, auth *ethtypes.SetCodeAuthorization) error {
+	authority, err := validateAuthorization(stateDB, chainID, auth)
+	if err != nil {
+		return err
+	}
+
+	// the intrinsic gas charges the authorization as a new account, the
+	// difference is refunded when the authority already exists
+	if stateDB.Exist(authority) {
+		stateDB.AddRefund(params.CallNewAccountGas - params.TxAuthTupleGas)
+	}
+
+	stateDB.SetNonce(authority, auth.Nonce+1, tracing.NonceChangeAuthorization)
+	if auth.Address == (common.Address{}) {
+		// a delegation to the zero address clears the delegation
+		stateDB.SetCode(authority, nil)
+		return nil
+	}
+	stateDB.SetCode(authority, ethtypes.AddressToDelegation(auth.Address))
+	return nil
+}
+
+// validateAuthorization recovers the authority of the authorization and
+// checks that it can be delegated: it's an EOA, possibly already delegated,
+// and its nonce matches the authorization one.
+func validateAuthorization(stateDB *statedb.StateDB, chainID *big.Int, auth *ethtypes.SetCodeAuthorization) (common.Address, error) {
+	if !auth.ChainID.IsZero() && auth.ChainID.CmpBig(chainID) != 0 {
+		return common.Address{}, core.ErrAuthorizationWrongChainID
+	}
+	// limit nonce to 2^64-1 per EIP-2681
+	if auth.Nonce+1 < auth.Nonce {
+		return common.Address{}, core.ErrAuthorizationNonceOverflow
+	}
+	authority, err := auth.Authority()
+	if err != nil {
+		return common.Address{}, fmt.Errorf("%w: %v", core.ErrAuthorizationInvalidSignature, err)
+	}
+
+	// the authority is added to the access list even if the authorization is
+	// invalid
+	stateDB.AddAddressToAccessList(authority)
+	code := stateDB.GetCode(authority)
+	if _, ok := ethtypes.ParseDelegation(code); len(code) != 0 && !ok {
+		return authority, core.ErrAuthorizationDestinationHasCode
+	}
+	if nonce := stateDB.GetNonce(authority); nonce != auth.Nonce {
+		return authority, core.ErrAuthorizationNonceMismatch
+	}
+	return authority, nil
+}
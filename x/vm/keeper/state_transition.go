@@ -23,6 +23,7 @@ import (
 	storetypes "cosmossdk.io/store/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	consensustypes "github.com/cosmos/cosmos-sdk/x/consensus/types"
 )
 
@@ -42,6 +43,11 @@ func (k *Keeper) NewEVM(
 	stateDB vm.StateDB,
 ) *vm.EVM {
 	ctx = k.SetConsensusParamsInCtx(ctx)
+	// the BLOBBASEFEE opcode requires a blob base fee
+	blobBaseFee := cfg.BlobBaseFee
+	if blobBaseFee == nil {
+		blobBaseFee = big.NewInt(0)
+	}
 	blockCtx := vm.BlockContext{
 		CanTransfer: core.CanTransfer,
 		Transfer:    core.Transfer,
@@ -52,6 +58,7 @@ func (k *Keeper) NewEVM(
 		Time:        uint64(ctx.BlockHeader().Time.Unix()), //#nosec G115 -- int overflow is not a concern here
 		Difficulty:  big.NewInt(0),                         // unused. Only required in PoW context
 		BaseFee:     cfg.BaseFee,
+		BlobBaseFee: blobBaseFee,
 		Random:      &common.MaxHash, // need to be different than nil to signal it is after the merge and pick up the right opcodes
 	}
 
@@ -204,6 +211,10 @@ func (k *Keeper) ApplyTransaction(ctx sdk.Context, tx *ethtypes.Transaction) (*t
 	ethLogs := types.LogsToEthereum(res.Logs)
 	_, bloomReceipt := k.initializeBloomFromLogs(ctx, ethLogs)
 
+	// fees paid out of the fee collector by the post processing hooks, which
+	// are not burnt nor distributed again
+	hookFees := math.ZeroInt()
+
 	if !res.Failed() {
 		var contractAddr common.Address
 		if msg.To == nil {
@@ -231,6 +242,13 @@ func (k *Keeper) ApplyTransaction(ctx sdk.Context, tx *ethtypes.Transaction) (*t
 
 		eventsLen := len(tmpCtx.EventManager().Events())
 
+		var feeCollector sdk.AccAddress
+		feesBefore := math.ZeroInt()
+		if cfg.Params.FeeDistribution.BurnBaseFee {
+			feeCollector = k.accountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+			feesBefore = k.bankWrapper.GetBalance(tmpCtx, feeCollector, types.GetEVMCoinDenom()).Amount
+		}
+
 		// Note: PostTxProcessing hooks currently do not charge for gas
 		// and function similar to EndBlockers in abci, but for EVM transactions
 		if err = k.PostTxProcessing(tmpCtx, signerAddr, *msg, receipt); err != nil {
@@ -245,6 +263,13 @@ func (k *Keeper) ApplyTransaction(ctx sdk.Context, tx *ethtypes.Transaction) (*t
 		} else if commitFn != nil {
 			commitFn()
 
+			if feeCollector != nil {
+				feesAfter := k.bankWrapper.GetBalance(ctx, feeCollector, types.GetEVMCoinDenom()).Amount
+				if feesAfter.LT(feesBefore) {
+					hookFees = feesBefore.Sub(feesAfter)
+				}
+			}
+
 			// Since the post-processing can alter the log, we need to update the result
 			res.Logs = types.NewLogsFromEth(receipt.Logs)
 			events := tmpCtx.EventManager().Events()
@@ -267,6 +292,11 @@ func (k *Keeper) ApplyTransaction(ctx sdk.Context, tx *ethtypes.Transaction) (*t
 		return nil, errorsmod.Wrapf(err, "failed to refund gas leftover gas to sender %s", msg.From)
 	}
 
+	// split the fees of the gas used between the burn, the base fee recipient and the block proposer
+	if err = k.DistributeFees(ctx, *msg, res.GasUsed, cfg.BaseFee, hookFees, cfg.Params.FeeDistribution); err != nil {
+		return nil, errorsmod.Wrap(err, "failed to distribute the transaction fees")
+	}
+
 	if len(ethLogs) > 0 {
 		// Update transient block bloom filter
 		k.SetBlockBloomTransient(ctx, bloom)
@@ -348,11 +378,13 @@ func (k *Keeper) ApplyMessageWithConfig(ctx sdk.Context, msg core.Message, trace
 	// Allow the tracer captures the tx level events, mainly the gas consumption.
 	vmCfg := evm.Config
 	if vmCfg.Tracer != nil {
-		vmCfg.Tracer.OnTxStart(
-			evm.GetVMContext(),
-			ethtypes.NewTx(&ethtypes.LegacyTx{To: msg.To, Data: msg.Data, Value: msg.Value, Gas: msg.GasLimit}),
-			msg.From,
-		)
+		if vmCfg.Tracer.OnTxStart != nil {
+			vmCfg.Tracer.OnTxStart(
+				evm.GetVMContext(),
+				ethtypes.NewTx(&ethtypes.LegacyTx{To: msg.To, Data: msg.Data, Value: msg.Value, Gas: msg.GasLimit}),
+				msg.From,
+			)
+		}
 		defer func() {
 			if vmCfg.Tracer.OnTxEnd != nil {
 				vmCfg.Tracer.OnTxEnd(&ethtypes.Receipt{GasUsed: msg.GasLimit - leftoverGas}, vmErr)
@@ -379,6 +411,20 @@ func (k *Keeper) ApplyMessageWithConfig(ctx sdk.Context, msg core.Message, trace
 	}
 	leftoverGas -= intrinsicGas
 
+	// EIP-7702 authorizations are checked here too, as eth_call doesn't go
+	// through the transaction validation
+	if msg.SetCodeAuthorizations != nil {
+		if !ethCfg.IsPrague(evm.Context.BlockNumber, evm.Context.Time) {
+			return nil, errorsmod.Wrap(types.ErrInvalidSetCodeTx, "set code transactions are not enabled")
+		}
+		if contractCreation {
+			return nil, errorsmod.Wrapf(core.ErrSetCodeTxCreate, "sender %s", msg.From)
+		}
+		if len(msg.SetCodeAuthorizations) == 0 {
+			return nil, errorsmod.Wrapf(core.ErrEmptyAuthList, "sender %s", msg.From)
+		}
+	}
+
 	// access list preparation is moved from ante handler to here, because it's needed when `ApplyMessage` is called
 	// under contexts where ante handlers are not run, for example `eth_call` and `eth_estimateGas`.
 	rules := ethCfg.Rules(big.NewInt(ctx.BlockHeight()), true, uint64(ctx.BlockTime().Unix())) //#nosec G115 -- int overflow is not a concern here
@@ -397,6 +443,14 @@ func (k *Keeper) ApplyMessageWithConfig(ctx sdk.Context, msg core.Message, trace
 		ret, _, leftoverGas, vmErr = evm.Create(sender.Address(), msg.Data, leftoverGas, convertedValue)
 		stateDB.SetNonce(sender.Address(), msg.Nonce+1, tracing.NonceChangeContractCreator)
 	} else {
+		if msg.SetCodeAuthorizations != nil {
+			applyAuthorizations(stateDB, ethCfg.ChainID, msg.SetCodeAuthorizations)
+		}
+		// warm the delegation target of the recipient, once the delegations
+		// of the transaction are applied
+		if addr, ok := ethtypes.ParseDelegation(stateDB.GetCode(*msg.To)); ok {
+			stateDB.AddAddressToAccessList(addr)
+		}
 		ret, leftoverGas, vmErr = evm.Call(sender.Address(), *msg.To, msg.Data, leftoverGas, convertedValue)
 	}
 
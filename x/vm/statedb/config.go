@@ -43,5 +43,6 @@ type EVMConfig struct {
 	Params                  types.Params
 	CoinBase                common.Address
 	BaseFee                 *big.Int
+	BlobBaseFee             *big.Int
 	EnablePreimageRecording bool
 }
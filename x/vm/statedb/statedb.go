@@ -477,6 +477,38 @@ func (s *StateDB) SetState(addr common.Address, key, value common.Hash) common.H
 	return common.Hash{}
 }
 
+// SetBalance sets the balance of account.
+// It is used to apply the balance overrides of message calls.
+func (s *StateDB) SetBalance(addr common.Address, amount *uint256.Int) {
+	if stateObject := s.getOrNewStateObject(addr); stateObject != nil {
+		stateObject.SetBalance(amount)
+	}
+}
+
+// SetStorage replaces the entire storage of account with the given one, the
+// slots that are not part of it are cleared.
+// It is used to apply the state overrides of message calls.
+func (s *StateDB) SetStorage(addr common.Address, storage map[common.Hash]common.Hash) {
+	stateObject := s.getOrNewStateObject(addr)
+	if stateObject == nil {
+		return
+	}
+	// collect the keys first, as SetState caches the committed values while
+	// iterating the store
+	var keys []common.Hash
+	s.keeper.ForEachStorage(s.ctx, addr, func(key, _ common.Hash) bool {
+		keys = append(keys, key)
+		return true
+	})
+	keys = append(keys, stateObject.dirtyStorage.SortedKeys()...)
+	for _, key := range keys {
+		stateObject.SetState(key, common.Hash{})
+	}
+	for key, value := range storage {
+		stateObject.SetState(key, value)
+	}
+}
+
 // SelfDestruct marks the given account as self-destructed.
 // This clears the account balance.
 //
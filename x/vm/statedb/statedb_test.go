@@ -690,6 +690,32 @@ func (suite *StateDBTestSuite) TestIterateStorage() {
 	suite.Require().Equal(1, len(storage))
 }
 
+func (suite *StateDBTestSuite) TestSetStorage() {
+	ctx := sdk.Context{}
+
+	key1 := common.BigToHash(big.NewInt(1))
+	value1 := common.BigToHash(big.NewInt(2))
+	key2 := common.BigToHash(big.NewInt(3))
+	value2 := common.BigToHash(big.NewInt(4))
+
+	keeper := mocks.NewEVMKeeper()
+	db := statedb.New(sdk.Context{}, keeper, emptyTxConfig)
+	db.SetState(address, key1, value1)
+	suite.Require().NoError(db.Commit())
+
+	// the storage is replaced, clearing the slots that are not overridden
+	db = statedb.New(sdk.Context{}, keeper, emptyTxConfig)
+	db.SetStorage(address, map[common.Hash]common.Hash{key2: value2})
+	db.SetBalance(address, uint256.NewInt(100))
+	suite.Require().Equal(common.Hash{}, db.GetState(address, key1))
+	suite.Require().Equal(value2, db.GetState(address, key2))
+	suite.Require().NoError(db.Commit())
+
+	suite.Require().Equal(common.Hash{}, keeper.GetState(ctx, address, key1))
+	suite.Require().Equal(value2, keeper.GetState(ctx, address, key2))
+	suite.Require().Equal(uint256.NewInt(100), keeper.GetAccount(ctx, address).Balance)
+}
+
 func CollectContractStorage(db vm.StateDB) statedb.Storage {
 	storage := make(statedb.Storage)
 	stDB, ok := db.(*statedb.StateDB)
@@ -0,0 +1,23 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// accountSequenceKey is the context key of the sender sequence an Ethereum
+// transaction nonce was validated against.
+type accountSequenceKey struct{}
+
+// ContextWithAccountSequence sets on the context the sequence of the sender
+// before the ante handler processed the Ethereum transaction, so that the
+// mempool can validate the transaction nonce against it.
+func ContextWithAccountSequence(ctx sdk.Context, sequence uint64) sdk.Context {
+	return ctx.WithValue(accountSequenceKey{}, sequence)
+}
+
+// AccountSequenceFromContext returns the sequence of the sender before the
+// ante handler processed the Ethereum transaction, and false if it wasn't set.
+func AccountSequenceFromContext(ctx sdk.Context) (uint64, bool) {
+	sequence, ok := ctx.Value(accountSequenceKey{}).(uint64)
+	return sequence, ok
+}
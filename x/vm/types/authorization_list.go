@@ -0,0 +1,82 @@
+package types
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/holiman/uint256"
+
+	"github.com/cosmos/evm/types"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+)
+
+// AuthorizationList is an EIP-7702 authorization list that represents the
+// slice of the protobuf SetCodeAuthorizations.
+type AuthorizationList []SetCodeAuthorization
+
+// NewAuthorizationList creates a new protobuf-compatible AuthorizationList
+// from an ethereum core authorization list
+func NewAuthorizationList(ethAuthList []ethtypes.SetCodeAuthorization) AuthorizationList {
+	if ethAuthList == nil {
+		return nil
+	}
+
+	authList := make(AuthorizationList, 0, len(ethAuthList))
+	for _, auth := range ethAuthList {
+		chainID := sdkmath.NewIntFromBigInt(auth.ChainID.ToBig())
+		authList = append(authList, SetCodeAuthorization{
+			ChainID: &chainID,
+			Address: auth.Address.Hex(),
+			Nonce:   auth.Nonce,
+			V:       uint32(auth.V),
+			R:       auth.R.Bytes(),
+			S:       auth.S.Bytes(),
+		})
+	}
+
+	return authList
+}
+
+// ToEthAuthorizationList is an utility function to convert the protobuf
+// compatible AuthorizationList to the eth core authorization list from
+// go-ethereum
+func (al AuthorizationList) ToEthAuthorizationList() []ethtypes.SetCodeAuthorization {
+	ethAuthList := make([]ethtypes.SetCodeAuthorization, 0, len(al))
+
+	for _, auth := range al {
+		var chainID uint256.Int
+		if auth.ChainID != nil {
+			chainID.SetFromBig(auth.ChainID.BigInt())
+		}
+		ethAuthList = append(ethAuthList, ethtypes.SetCodeAuthorization{
+			ChainID: chainID,
+			Address: common.HexToAddress(auth.Address),
+			Nonce:   auth.Nonce,
+			V:       uint8(auth.V), //#nosec G115 -- the signature values are validated to fit their types
+			R:       *new(uint256.Int).SetBytes(auth.R),
+			S:       *new(uint256.Int).SetBytes(auth.S),
+		})
+	}
+
+	return ethAuthList
+}
+
+// Validate performs a stateless validation of the authorization fields. The
+// signature itself is only verified when the authorization is applied, as an
+// invalid authorization doesn't invalidate the transaction.
+func (auth SetCodeAuthorization) Validate() error {
+	if auth.ChainID == nil {
+		return errorsmod.Wrap(ErrInvalidSetCodeTx, "authorization chain ID cannot be nil")
+	}
+	if auth.ChainID.IsNegative() || !types.IsValidInt256(auth.ChainID.BigInt()) {
+		return errorsmod.Wrapf(ErrInvalidSetCodeTx, "invalid authorization chain ID %s", auth.ChainID)
+	}
+	if err := types.ValidateAddress(auth.Address); err != nil {
+		return errorsmod.Wrap(err, "invalid authorization address")
+	}
+	if auth.V > 255 || len(auth.R) > 32 || len(auth.S) > 32 {
+		return errorsmod.Wrap(ErrInvalidSetCodeTx, "authorization signature values out of bound")
+	}
+	return nil
+}
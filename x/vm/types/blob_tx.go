@@ -0,0 +1,375 @@
+package types
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto/kzg4844"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/holiman/uint256"
+
+	"github.com/cosmos/evm/types"
+	ethutils "github.com/cosmos/evm/utils/eth"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// NewBlobTx returns the BlobTx data of the given blob transaction. The blob
+// sidecar of the transaction, if any, is not part of the returned data.
+func NewBlobTx(tx *ethtypes.Transaction) (*BlobTx, error) {
+	txData := &BlobTx{
+		Nonce:    tx.Nonce(),
+		Data:     tx.Data(),
+		GasLimit: tx.Gas(),
+	}
+
+	v, r, s := tx.RawSignatureValues()
+	if to := tx.To(); to != nil {
+		txData.To = to.Hex()
+	}
+
+	if tx.Value() != nil {
+		amountInt, err := types.SafeNewIntFromBigInt(tx.Value())
+		if err != nil {
+			return nil, err
+		}
+		txData.Amount = &amountInt
+	}
+
+	if tx.GasFeeCap() != nil {
+		gasFeeCapInt, err := types.SafeNewIntFromBigInt(tx.GasFeeCap())
+		if err != nil {
+			return nil, err
+		}
+		txData.GasFeeCap = &gasFeeCapInt
+	}
+
+	if tx.GasTipCap() != nil {
+		gasTipCapInt, err := types.SafeNewIntFromBigInt(tx.GasTipCap())
+		if err != nil {
+			return nil, err
+		}
+		txData.GasTipCap = &gasTipCapInt
+	}
+
+	if tx.BlobGasFeeCap() != nil {
+		blobFeeCapInt, err := types.SafeNewIntFromBigInt(tx.BlobGasFeeCap())
+		if err != nil {
+			return nil, err
+		}
+		txData.BlobFeeCap = &blobFeeCapInt
+	}
+
+	for _, hash := range tx.BlobHashes() {
+		txData.BlobHashes = append(txData.BlobHashes, hash.Hex())
+	}
+
+	if tx.AccessList() != nil {
+		al := tx.AccessList()
+		txData.Accesses = NewAccessList(&al)
+	}
+
+	txData.SetSignatureValues(tx.ChainId(), v, r, s)
+	return txData, nil
+}
+
+// TxType returns the tx type
+func (tx *BlobTx) TxType() uint8 {
+	return ethtypes.BlobTxType
+}
+
+// Copy returns an instance with the same field values
+func (tx *BlobTx) Copy() TxData {
+	return &BlobTx{
+		ChainID:    tx.ChainID,
+		Nonce:      tx.Nonce,
+		GasTipCap:  tx.GasTipCap,
+		GasFeeCap:  tx.GasFeeCap,
+		GasLimit:   tx.GasLimit,
+		To:         tx.To,
+		Amount:     tx.Amount,
+		Data:       common.CopyBytes(tx.Data),
+		Accesses:   tx.Accesses,
+		BlobFeeCap: tx.BlobFeeCap,
+		BlobHashes: append([]string(nil), tx.BlobHashes...),
+		V:          common.CopyBytes(tx.V),
+		R:          common.CopyBytes(tx.R),
+		S:          common.CopyBytes(tx.S),
+	}
+}
+
+// GetChainID returns the chain id field from the BlobTx
+func (tx *BlobTx) GetChainID() *big.Int {
+	if tx.ChainID == nil {
+		return nil
+	}
+
+	return tx.ChainID.BigInt()
+}
+
+// GetAccessList returns the AccessList field.
+func (tx *BlobTx) GetAccessList() ethtypes.AccessList {
+	if tx.Accesses == nil {
+		return nil
+	}
+	return *tx.Accesses.ToEthAccessList()
+}
+
+// GetData returns the a copy of the input data bytes.
+func (tx *BlobTx) GetData() []byte {
+	return common.CopyBytes(tx.Data)
+}
+
+// GetGas returns the gas limit.
+func (tx *BlobTx) GetGas() uint64 {
+	return tx.GasLimit
+}
+
+// GetGasPrice returns the gas fee cap field.
+func (tx *BlobTx) GetGasPrice() *big.Int {
+	return tx.GetGasFeeCap()
+}
+
+// GetGasTipCap returns the gas tip cap field.
+func (tx *BlobTx) GetGasTipCap() *big.Int {
+	if tx.GasTipCap == nil {
+		return nil
+	}
+	return tx.GasTipCap.BigInt()
+}
+
+// GetGasFeeCap returns the gas fee cap field.
+func (tx *BlobTx) GetGasFeeCap() *big.Int {
+	if tx.GasFeeCap == nil {
+		return nil
+	}
+	return tx.GasFeeCap.BigInt()
+}
+
+// GetBlobFeeCap returns the blob gas fee cap field.
+func (tx *BlobTx) GetBlobFeeCap() *big.Int {
+	if tx.BlobFeeCap == nil {
+		return nil
+	}
+	return tx.BlobFeeCap.BigInt()
+}
+
+// GetBlobHashes returns the versioned hashes of the blobs.
+func (tx *BlobTx) GetBlobHashes() []common.Hash {
+	hashes := make([]common.Hash, len(tx.BlobHashes))
+	for i, hash := range tx.BlobHashes {
+		hashes[i] = common.HexToHash(hash)
+	}
+	return hashes
+}
+
+// GetBlobGas returns the blob gas used by the transaction.
+func (tx *BlobTx) GetBlobGas() uint64 {
+	return params.BlobTxBlobGasPerBlob * uint64(len(tx.BlobHashes))
+}
+
+// GetValue returns the tx amount.
+func (tx *BlobTx) GetValue() *big.Int {
+	if tx.Amount == nil {
+		return nil
+	}
+
+	return tx.Amount.BigInt()
+}
+
+// GetNonce returns the account sequence for the transaction.
+func (tx *BlobTx) GetNonce() uint64 { return tx.Nonce }
+
+// GetTo returns the pointer to the recipient address.
+func (tx *BlobTx) GetTo() *common.Address {
+	if tx.To == "" {
+		return nil
+	}
+	to := common.HexToAddress(tx.To)
+	return &to
+}
+
+// AsEthereumData returns an BlobTx transaction tx from the proto-formatted
+// TxData defined on the Cosmos EVM.
+func (tx *BlobTx) AsEthereumData() ethtypes.TxData {
+	v, r, s := tx.GetRawSignatureValues()
+	var to common.Address
+	if addr := tx.GetTo(); addr != nil {
+		to = *addr
+	}
+	return &ethtypes.BlobTx{
+		ChainID:    uint256FromBig(tx.GetChainID()),
+		Nonce:      tx.GetNonce(),
+		GasTipCap:  uint256FromBig(tx.GetGasTipCap()),
+		GasFeeCap:  uint256FromBig(tx.GetGasFeeCap()),
+		Gas:        tx.GetGas(),
+		To:         to,
+		Value:      uint256FromBig(tx.GetValue()),
+		Data:       tx.GetData(),
+		AccessList: tx.GetAccessList(),
+		BlobFeeCap: uint256FromBig(tx.GetBlobFeeCap()),
+		BlobHashes: tx.GetBlobHashes(),
+		V:          uint256FromBig(v),
+		R:          uint256FromBig(r),
+		S:          uint256FromBig(s),
+	}
+}
+
+// GetRawSignatureValues returns the V, R, S signature values of the transaction.
+// The return values should not be modified by the caller.
+func (tx *BlobTx) GetRawSignatureValues() (v, r, s *big.Int) {
+	return ethutils.RawSignatureValues(tx.V, tx.R, tx.S)
+}
+
+// SetSignatureValues sets the signature values to the transaction.
+func (tx *BlobTx) SetSignatureValues(chainID, v, r, s *big.Int) {
+	if v != nil {
+		tx.V = v.Bytes()
+	}
+	if r != nil {
+		tx.R = r.Bytes()
+	}
+	if s != nil {
+		tx.S = s.Bytes()
+	}
+	if chainID != nil {
+		chainIDInt := sdkmath.NewIntFromBigInt(chainID)
+		tx.ChainID = &chainIDInt
+	}
+}
+
+// Validate performs a stateless validation of the tx fields.
+func (tx BlobTx) Validate() error {
+	if tx.GasTipCap == nil {
+		return errorsmod.Wrap(ErrInvalidGasCap, "gas tip cap cannot nil")
+	}
+
+	if tx.GasFeeCap == nil {
+		return errorsmod.Wrap(ErrInvalidGasCap, "gas fee cap cannot nil")
+	}
+
+	if tx.BlobFeeCap == nil {
+		return errorsmod.Wrap(ErrInvalidGasCap, "blob fee cap cannot nil")
+	}
+
+	if tx.GasTipCap.IsNegative() {
+		return errorsmod.Wrapf(ErrInvalidGasCap, "gas tip cap cannot be negative %s", tx.GasTipCap)
+	}
+
+	if tx.GasFeeCap.IsNegative() {
+		return errorsmod.Wrapf(ErrInvalidGasCap, "gas fee cap cannot be negative %s", tx.GasFeeCap)
+	}
+
+	if tx.BlobFeeCap.IsNegative() {
+		return errorsmod.Wrapf(ErrInvalidGasCap, "blob fee cap cannot be negative %s", tx.BlobFeeCap)
+	}
+
+	if !types.IsValidInt256(tx.GetGasTipCap()) {
+		return errorsmod.Wrap(ErrInvalidGasCap, "out of bound")
+	}
+
+	if !types.IsValidInt256(tx.GetGasFeeCap()) {
+		return errorsmod.Wrap(ErrInvalidGasCap, "out of bound")
+	}
+
+	if !types.IsValidInt256(tx.GetBlobFeeCap()) {
+		return errorsmod.Wrap(ErrInvalidGasCap, "out of bound")
+	}
+
+	if tx.GasFeeCap.LT(*tx.GasTipCap) {
+		return errorsmod.Wrapf(
+			ErrInvalidGasCap, "max priority fee per gas higher than max fee per gas (%s > %s)",
+			tx.GasTipCap, tx.GasFeeCap,
+		)
+	}
+
+	if !types.IsValidInt256(tx.Fee()) {
+		return errorsmod.Wrap(ErrInvalidGasFee, "out of bound")
+	}
+
+	if !types.IsValidInt256(tx.BlobFee(tx.GetBlobFeeCap())) {
+		return errorsmod.Wrap(ErrInvalidGasFee, "out of bound")
+	}
+
+	amount := tx.GetValue()
+	// Amount can be 0
+	if amount != nil && amount.Sign() == -1 {
+		return errorsmod.Wrapf(ErrInvalidAmount, "amount cannot be negative %s", amount)
+	}
+	if !types.IsValidInt256(amount) {
+		return errorsmod.Wrap(ErrInvalidAmount, "out of bound")
+	}
+
+	// blob transactions cannot create contracts
+	if tx.To == "" {
+		return errorsmod.Wrap(ErrInvalidBlobTx, "blob transaction cannot be a contract creation")
+	}
+	if err := types.ValidateAddress(tx.To); err != nil {
+		return errorsmod.Wrap(err, "invalid to address")
+	}
+
+	if len(tx.BlobHashes) == 0 {
+		return errorsmod.Wrap(ErrInvalidBlobTx, "blob transaction must have at least one blob hash")
+	}
+	for i, hash := range tx.BlobHashes {
+		bz, err := hexutil.Decode(hash)
+		if err != nil || !kzg4844.IsValidVersionedHash(bz) {
+			return errorsmod.Wrapf(ErrInvalidBlobTx, "blob %d has an invalid versioned hash %s", i, hash)
+		}
+	}
+
+	if tx.GetChainID() == nil {
+		return errorsmod.Wrap(
+			errortypes.ErrInvalidChainID,
+			"chain ID must be present on Blob txs",
+		)
+	}
+
+	return nil
+}
+
+// Fee returns gasprice * gaslimit. The blob fee is not included, as it
+// depends on the blob base fee of the block.
+func (tx BlobTx) Fee() *big.Int {
+	return fee(tx.GetGasFeeCap(), tx.GetGas())
+}
+
+// BlobFee returns blob gas price * blob gas.
+func (tx BlobTx) BlobFee(blobGasPrice *big.Int) *big.Int {
+	return fee(blobGasPrice, tx.GetBlobGas())
+}
+
+// Cost returns amount + gasprice * gaslimit + blob fee cap * blob gas.
+func (tx BlobTx) Cost() *big.Int {
+	return cost(new(big.Int).Add(tx.Fee(), tx.BlobFee(tx.GetBlobFeeCap())), tx.GetValue())
+}
+
+// EffectiveGasPrice returns the effective gas price
+func (tx *BlobTx) EffectiveGasPrice(baseFee *big.Int) *big.Int {
+	return EffectiveGasPrice(baseFee, tx.GasFeeCap.BigInt(), tx.GasTipCap.BigInt())
+}
+
+// EffectiveFee returns effective_gasprice * gaslimit.
+func (tx BlobTx) EffectiveFee(baseFee *big.Int) *big.Int {
+	return fee(tx.EffectiveGasPrice(baseFee), tx.GetGas())
+}
+
+// EffectiveCost returns amount + effective_gasprice * gaslimit.
+func (tx BlobTx) EffectiveCost(baseFee *big.Int) *big.Int {
+	return cost(tx.EffectiveFee(baseFee), tx.GetValue())
+}
+
+// uint256FromBig converts a validated big integer to its uint256
+// representation, nil values are kept nil.
+func uint256FromBig(x *big.Int) *uint256.Int {
+	if x == nil {
+		return nil
+	}
+	v, _ := uint256.FromBig(x)
+	return v
+}
@@ -0,0 +1,209 @@
+package types_test
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/holiman/uint256"
+
+	"github.com/cosmos/evm/x/vm/types"
+)
+
+// validBlobHash is a versioned hash of a KZG commitment
+var validBlobHash = common.HexToHash("0x0100000000000000000000000000000000000000000000000000000000000001")
+
+func (suite *TxDataTestSuite) TestNewBlobTx() {
+	testCases := []struct {
+		name     string
+		expError bool
+		tx       *ethtypes.Transaction
+	}{
+		{
+			"non-empty tx",
+			false,
+			ethtypes.NewTx(&ethtypes.BlobTx{
+				Nonce:      1,
+				Data:       []byte("data"),
+				Gas:        100,
+				Value:      uint256.NewInt(1),
+				AccessList: ethtypes.AccessList{},
+				To:         suite.addr,
+				BlobFeeCap: uint256.NewInt(1),
+				BlobHashes: []common.Hash{validBlobHash},
+				V:          uint256.NewInt(1),
+				R:          uint256.NewInt(1),
+				S:          uint256.NewInt(1),
+			}),
+		},
+	}
+
+	for _, tc := range testCases {
+		tx, err := types.NewBlobTx(tc.tx)
+
+		if tc.expError {
+			suite.Require().Error(err)
+		} else {
+			suite.Require().NoError(err)
+			suite.Require().NotEmpty(tx)
+			suite.Require().Equal(uint8(ethtypes.BlobTxType), tx.TxType())
+			suite.Require().Equal([]common.Hash{validBlobHash}, tx.GetBlobHashes())
+		}
+	}
+}
+
+func (suite *TxDataTestSuite) TestBlobTxAsEthereumData() {
+	tx := &types.BlobTx{
+		ChainID:    &suite.sdkInt,
+		Nonce:      suite.uint64,
+		GasTipCap:  &suite.sdkInt,
+		GasFeeCap:  &suite.sdkInt,
+		GasLimit:   suite.uint64,
+		To:         suite.hexAddr,
+		Amount:     &suite.sdkInt,
+		Data:       []byte("data"),
+		BlobFeeCap: &suite.sdkInt,
+		BlobHashes: []string{validBlobHash.Hex()},
+	}
+
+	ethTx := ethtypes.NewTx(tx.AsEthereumData())
+	suite.Require().Equal(uint8(ethtypes.BlobTxType), ethTx.Type())
+	suite.Require().Equal(suite.addr, *ethTx.To())
+	suite.Require().Equal(suite.sdkInt.BigInt(), ethTx.BlobGasFeeCap())
+	suite.Require().Equal([]common.Hash{validBlobHash}, ethTx.BlobHashes())
+	suite.Require().Equal(tx.GetBlobGas(), ethTx.BlobGas())
+
+	res, err := types.NewBlobTx(ethTx)
+	suite.Require().NoError(err)
+	suite.Require().Equal(tx.BlobHashes, res.BlobHashes)
+	suite.Require().Equal(tx.BlobFeeCap, res.BlobFeeCap)
+}
+
+func (suite *TxDataTestSuite) TestBlobTxGetBlobGas() {
+	tx := types.BlobTx{
+		BlobHashes: []string{validBlobHash.Hex(), validBlobHash.Hex()},
+	}
+	suite.Require().Equal(2*params.BlobTxBlobGasPerBlob, int(tx.GetBlobGas()))
+}
+
+func (suite *TxDataTestSuite) TestBlobTxValidate() {
+	testCases := []struct {
+		name     string
+		tx       types.BlobTx
+		expError bool
+	}{
+		{
+			"empty",
+			types.BlobTx{},
+			true,
+		},
+		{
+			"blob fee cap is nil",
+			types.BlobTx{
+				GasTipCap: &suite.sdkInt,
+				GasFeeCap: &suite.sdkInt,
+			},
+			true,
+		},
+		{
+			"blob fee cap is negative",
+			types.BlobTx{
+				GasTipCap:  &suite.sdkInt,
+				GasFeeCap:  &suite.sdkInt,
+				BlobFeeCap: &suite.sdkMinusOneInt,
+			},
+			true,
+		},
+		{
+			"contract creation",
+			types.BlobTx{
+				GasTipCap:  &suite.sdkInt,
+				GasFeeCap:  &suite.sdkInt,
+				BlobFeeCap: &suite.sdkInt,
+				Amount:     &suite.sdkInt,
+				BlobHashes: []string{validBlobHash.Hex()},
+				ChainID:    &suite.sdkInt,
+			},
+			true,
+		},
+		{
+			"no blob hashes",
+			types.BlobTx{
+				GasTipCap:  &suite.sdkInt,
+				GasFeeCap:  &suite.sdkInt,
+				BlobFeeCap: &suite.sdkInt,
+				Amount:     &suite.sdkInt,
+				To:         suite.hexAddr,
+				ChainID:    &suite.sdkInt,
+			},
+			true,
+		},
+		{
+			"invalid blob hash version",
+			types.BlobTx{
+				GasTipCap:  &suite.sdkInt,
+				GasFeeCap:  &suite.sdkInt,
+				BlobFeeCap: &suite.sdkInt,
+				Amount:     &suite.sdkInt,
+				To:         suite.hexAddr,
+				BlobHashes: []string{common.HexToHash("0x02").Hex()},
+				ChainID:    &suite.sdkInt,
+			},
+			true,
+		},
+		{
+			"chain ID not present on Blob txs",
+			types.BlobTx{
+				GasTipCap:  &suite.sdkInt,
+				GasFeeCap:  &suite.sdkInt,
+				BlobFeeCap: &suite.sdkInt,
+				Amount:     &suite.sdkInt,
+				To:         suite.hexAddr,
+				BlobHashes: []string{validBlobHash.Hex()},
+			},
+			true,
+		},
+		{
+			"no errors",
+			types.BlobTx{
+				GasTipCap:  &suite.sdkInt,
+				GasFeeCap:  &suite.sdkInt,
+				BlobFeeCap: &suite.sdkInt,
+				Amount:     &suite.sdkInt,
+				To:         suite.hexAddr,
+				BlobHashes: []string{validBlobHash.Hex()},
+				ChainID:    &suite.sdkInt,
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		err := tc.tx.Validate()
+
+		if tc.expError {
+			suite.Require().Error(err, tc.name)
+			continue
+		}
+
+		suite.Require().NoError(err, tc.name)
+	}
+}
+
+func (suite *TxDataTestSuite) TestBlobTxCost() {
+	tx := types.BlobTx{
+		GasTipCap:  &suite.sdkInt,
+		GasFeeCap:  &suite.sdkInt,
+		GasLimit:   uint64(1),
+		Amount:     &suite.sdkZeroInt,
+		BlobFeeCap: &suite.sdkInt,
+		BlobHashes: []string{validBlobHash.Hex()},
+	}
+
+	blobFee := new(big.Int).Mul(suite.sdkInt.BigInt(), big.NewInt(params.BlobTxBlobGasPerBlob))
+	suite.Require().Equal(suite.sdkInt.BigInt(), tx.Fee())
+	suite.Require().Equal(blobFee, tx.BlobFee(suite.sdkInt.BigInt()))
+	suite.Require().Equal(new(big.Int).Add(suite.sdkInt.BigInt(), blobFee), tx.Cost())
+	suite.Require().Equal(suite.sdkInt.BigInt(), tx.EffectiveCost(suite.sdkInt.BigInt()))
+}
@@ -49,6 +49,8 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		"os.vm.v1.TxData",
 		(*TxData)(nil),
 		&DynamicFeeTx{},
+		&BlobTx{},
+		&SetCodeTx{},
 		&AccessListTx{},
 		&LegacyTx{},
 	)
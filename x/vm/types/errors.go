@@ -32,6 +32,8 @@ const (
 	codeErrABIPack
 	codeErrABIUnpack
 	codeErrInvalidPreinstall
+	codeErrInvalidBlobTx
+	codeErrInvalidSetCodeTx
 )
 
 var (
@@ -92,6 +94,12 @@ var (
 	// ErrInvalidPreinstall returns an error if a preinstall is invalid
 	ErrInvalidPreinstall = errorsmod.Register(ModuleName, codeErrInvalidPreinstall, "invalid preinstall")
 
+	// ErrInvalidBlobTx returns an error if an EIP-4844 blob transaction is invalid
+	ErrInvalidBlobTx = errorsmod.Register(ModuleName, codeErrInvalidBlobTx, "invalid blob transaction")
+
+	// ErrInvalidSetCodeTx returns an error if an EIP-7702 set code transaction is invalid
+	ErrInvalidSetCodeTx = errorsmod.Register(ModuleName, codeErrInvalidSetCodeTx, "invalid set code transaction")
+
 	// RevertSelector is selector of ErrExecutionReverted
 	RevertSelector = crypto.Keccak256([]byte("Error(string)"))[:4]
 )
@@ -6,8 +6,10 @@ const (
 	EventTypeBlockBloom = "block_bloom"
 	EventTypeTxLog      = "tx_log"
 	EventTypeFeeMarket  = "evm_fee_market"
+	EventTypeFeeBurn    = "evm_fee_burn"
 
 	AttributeKeyBaseFee         = "base_fee"
+	AttributeKeyBlobBaseFee     = "blob_base_fee"
 	AttributeKeyContractAddress = "contract"
 	AttributeKeyRecipient       = "recipient"
 	AttributeKeyTxHash          = "txHash"
@@ -16,6 +18,10 @@ const (
 	AttributeKeyTxGasUsed       = "txGasUsed"
 	AttributeKeyTxType          = "txType"
 	AttributeKeyTxLog           = "txLog"
+	AttributeKeyBurntFee        = "burnt_fee"
+	AttributeKeyBaseFeeShare    = "base_fee_share"
+	AttributeKeyPriorityTip     = "priority_tip"
+	AttributeKeyProposer        = "proposer"
 
 	// tx failed in eth vm execution
 	AttributeKeyEthereumTxFailed = "ethereumTxFailed"
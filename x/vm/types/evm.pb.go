@@ -74,6 +74,9 @@ type Params struct {
 	// active_static_precompiles defines the slice of hex addresses of the
 	// precompiled contracts that are active
 	ActiveStaticPrecompiles []string `protobuf:"bytes,9,rep,name=active_static_precompiles,json=activeStaticPrecompiles,proto3" json:"active_static_precompiles,omitempty"`
+	// fee_distribution defines how the fees of the EVM transactions are split
+	// between the block proposer, the community and the burn
+	FeeDistribution FeeDistribution `protobuf:"bytes,10,opt,name=fee_distribution,json=feeDistribution,proto3" json:"fee_distribution"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -151,6 +154,77 @@ func (m *Params) GetActiveStaticPrecompiles() []string {
 	return nil
 }
 
+func (m *Params) GetFeeDistribution() FeeDistribution {
+	if m != nil {
+		return m.FeeDistribution
+	}
+	return FeeDistribution{}
+}
+
+// FeeDistribution defines the distribution of the fees paid by the EVM
+// transactions. By default, all the fees are sent to the fee collector and
+// distributed to the validators.
+type FeeDistribution struct {
+	// burn_base_fee defines if the base fee portion of the fees is burnt, as on
+	// Ethereum after the London hardfork. When enabled, the priority tip is sent
+	// to the operator of the block proposer instead of the fee collector.
+	BurnBaseFee bool `protobuf:"varint,1,opt,name=burn_base_fee,json=burnBaseFee,proto3" json:"burn_base_fee,omitempty"`
+	// base_fee_share defines the share of the base fee portion sent to the base
+	// fee recipient instead of being burnt
+	BaseFeeShare cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=base_fee_share,json=baseFeeShare,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"base_fee_share"`
+	// base_fee_recipient is the bech32 address receiving the base fee share,
+	// e.g. a developer fund. It cannot be a module account blocked from
+	// receiving funds.
+	BaseFeeRecipient string `protobuf:"bytes,3,opt,name=base_fee_recipient,json=baseFeeRecipient,proto3" json:"base_fee_recipient,omitempty"`
+}
+
+func (m *FeeDistribution) Reset()         { *m = FeeDistribution{} }
+func (m *FeeDistribution) String() string { return proto.CompactTextString(m) }
+func (*FeeDistribution) ProtoMessage()    {}
+func (*FeeDistribution) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d1129b8db63d55c7, []int{1}
+}
+func (m *FeeDistribution) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeDistribution) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeDistribution.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeDistribution) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeDistribution.Merge(m, src)
+}
+func (m *FeeDistribution) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeDistribution) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeDistribution.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeDistribution proto.InternalMessageInfo
+
+func (m *FeeDistribution) GetBurnBaseFee() bool {
+	if m != nil {
+		return m.BurnBaseFee
+	}
+	return false
+}
+
+func (m *FeeDistribution) GetBaseFeeRecipient() string {
+	if m != nil {
+		return m.BaseFeeRecipient
+	}
+	return ""
+}
+
 // AccessControl defines the permission policy of the EVM
 // for creating and calling contracts
 type AccessControl struct {
@@ -164,7 +238,7 @@ func (m *AccessControl) Reset()         { *m = AccessControl{} }
 func (m *AccessControl) String() string { return proto.CompactTextString(m) }
 func (*AccessControl) ProtoMessage()    {}
 func (*AccessControl) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d1129b8db63d55c7, []int{1}
+	return fileDescriptor_d1129b8db63d55c7, []int{2}
 }
 func (m *AccessControl) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -225,7 +299,7 @@ func (m *AccessControlType) Reset()         { *m = AccessControlType{} }
 func (m *AccessControlType) String() string { return proto.CompactTextString(m) }
 func (*AccessControlType) ProtoMessage()    {}
 func (*AccessControlType) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d1129b8db63d55c7, []int{2}
+	return fileDescriptor_d1129b8db63d55c7, []int{3}
 }
 func (m *AccessControlType) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -335,7 +409,7 @@ func (m *ChainConfig) Reset()         { *m = ChainConfig{} }
 func (m *ChainConfig) String() string { return proto.CompactTextString(m) }
 func (*ChainConfig) ProtoMessage()    {}
 func (*ChainConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d1129b8db63d55c7, []int{3}
+	return fileDescriptor_d1129b8db63d55c7, []int{4}
 }
 func (m *ChainConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -404,7 +478,7 @@ func (m *State) Reset()         { *m = State{} }
 func (m *State) String() string { return proto.CompactTextString(m) }
 func (*State) ProtoMessage()    {}
 func (*State) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d1129b8db63d55c7, []int{4}
+	return fileDescriptor_d1129b8db63d55c7, []int{5}
 }
 func (m *State) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -461,7 +535,7 @@ func (m *TransactionLogs) Reset()         { *m = TransactionLogs{} }
 func (m *TransactionLogs) String() string { return proto.CompactTextString(m) }
 func (*TransactionLogs) ProtoMessage()    {}
 func (*TransactionLogs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d1129b8db63d55c7, []int{5}
+	return fileDescriptor_d1129b8db63d55c7, []int{6}
 }
 func (m *TransactionLogs) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -537,7 +611,7 @@ func (m *Log) Reset()         { *m = Log{} }
 func (m *Log) String() string { return proto.CompactTextString(m) }
 func (*Log) ProtoMessage()    {}
 func (*Log) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d1129b8db63d55c7, []int{6}
+	return fileDescriptor_d1129b8db63d55c7, []int{7}
 }
 func (m *Log) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -652,7 +726,7 @@ func (m *TxResult) Reset()         { *m = TxResult{} }
 func (m *TxResult) String() string { return proto.CompactTextString(m) }
 func (*TxResult) ProtoMessage()    {}
 func (*TxResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d1129b8db63d55c7, []int{7}
+	return fileDescriptor_d1129b8db63d55c7, []int{8}
 }
 func (m *TxResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -693,7 +767,7 @@ func (m *AccessTuple) Reset()         { *m = AccessTuple{} }
 func (m *AccessTuple) String() string { return proto.CompactTextString(m) }
 func (*AccessTuple) ProtoMessage()    {}
 func (*AccessTuple) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d1129b8db63d55c7, []int{8}
+	return fileDescriptor_d1129b8db63d55c7, []int{9}
 }
 func (m *AccessTuple) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -747,13 +821,17 @@ type TraceConfig struct {
 	EnableReturnData bool `protobuf:"varint,12,opt,name=enable_return_data,json=enableReturnData,proto3" json:"enableReturnData"`
 	// tracer_json_config configures the tracer using a JSON string
 	TracerJsonConfig string `protobuf:"bytes,13,opt,name=tracer_json_config,json=tracerJsonConfig,proto3" json:"tracerConfig"`
+	// trace_precompiles records, for each precompile call frame of the
+	// callTracer, the decoded method and arguments, and the Cosmos SDK messages
+	// executed and events emitted by the precompile
+	TracePrecompiles bool `protobuf:"varint,14,opt,name=trace_precompiles,json=tracePrecompiles,proto3" json:"tracePrecompiles"`
 }
 
 func (m *TraceConfig) Reset()         { *m = TraceConfig{} }
 func (m *TraceConfig) String() string { return proto.CompactTextString(m) }
 func (*TraceConfig) ProtoMessage()    {}
 func (*TraceConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d1129b8db63d55c7, []int{9}
+	return fileDescriptor_d1129b8db63d55c7, []int{10}
 }
 func (m *TraceConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -859,6 +937,13 @@ func (m *TraceConfig) GetTracerJsonConfig() string {
 	return ""
 }
 
+func (m *TraceConfig) GetTracePrecompiles() bool {
+	if m != nil {
+		return m.TracePrecompiles
+	}
+	return false
+}
+
 // Preinstall defines a contract that is preinstalled on-chain with a specific
 // contract address and bytecode
 type Preinstall struct {
@@ -874,7 +959,7 @@ func (m *Preinstall) Reset()         { *m = Preinstall{} }
 func (m *Preinstall) String() string { return proto.CompactTextString(m) }
 func (*Preinstall) ProtoMessage()    {}
 func (*Preinstall) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d1129b8db63d55c7, []int{10}
+	return fileDescriptor_d1129b8db63d55c7, []int{11}
 }
 func (m *Preinstall) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -927,6 +1012,7 @@ func (m *Preinstall) GetCode() string {
 func init() {
 	proto.RegisterEnum("cosmos.evm.vm.v1.AccessType", AccessType_name, AccessType_value)
 	proto.RegisterType((*Params)(nil), "cosmos.evm.vm.v1.Params")
+	proto.RegisterType((*FeeDistribution)(nil), "cosmos.evm.vm.v1.FeeDistribution")
 	proto.RegisterType((*AccessControl)(nil), "cosmos.evm.vm.v1.AccessControl")
 	proto.RegisterType((*AccessControlType)(nil), "cosmos.evm.vm.v1.AccessControlType")
 	proto.RegisterType((*ChainConfig)(nil), "cosmos.evm.vm.v1.ChainConfig")
@@ -942,132 +1028,140 @@ func init() {
 func init() { proto.RegisterFile("cosmos/evm/vm/v1/evm.proto", fileDescriptor_d1129b8db63d55c7) }
 
 var fileDescriptor_d1129b8db63d55c7 = []byte{
-	// 1988 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x58, 0x5d, 0x6f, 0x1b, 0xc7,
-	0xd5, 0x16, 0xc5, 0x95, 0xb4, 0x1c, 0x52, 0xd4, 0x7a, 0x44, 0xc9, 0x34, 0xed, 0x68, 0xf9, 0xee,
-	0xdb, 0x0b, 0xd5, 0x48, 0x25, 0x4b, 0x8e, 0x5a, 0xc3, 0xe9, 0x07, 0x44, 0x99, 0x69, 0xa5, 0xda,
-	0x0e, 0x31, 0x54, 0x12, 0xa4, 0x68, 0xb1, 0x18, 0xee, 0x8e, 0x97, 0x1b, 0xee, 0xee, 0x10, 0x3b,
-	0x4b, 0x86, 0xea, 0x2f, 0x08, 0x7c, 0x95, 0xfe, 0x00, 0x03, 0x01, 0x7a, 0x93, 0x4b, 0xff, 0x84,
-	0x5e, 0x06, 0xbd, 0xca, 0x65, 0x51, 0xa0, 0x8b, 0x82, 0xbe, 0x08, 0xa0, 0x4b, 0xfd, 0x82, 0x62,
-	0x3e, 0xf8, 0x29, 0x85, 0x55, 0x01, 0xc1, 0x9e, 0xe7, 0xcc, 0x39, 0xcf, 0x73, 0x66, 0xe6, 0xec,
-	0xec, 0x59, 0x82, 0x8a, 0x43, 0x59, 0x48, 0xd9, 0x3e, 0xe9, 0x87, 0xfb, 0xfc, 0xef, 0x80, 0x8f,
-	0xf6, 0xba, 0x31, 0x4d, 0x28, 0x34, 0xe4, 0xdc, 0x1e, 0xb7, 0xf0, 0xbf, 0x83, 0xca, 0x1d, 0x1c,
-	0xfa, 0x11, 0xdd, 0x17, 0xff, 0x4a, 0xa7, 0x4a, 0xc9, 0xa3, 0x1e, 0x15, 0xc3, 0x7d, 0x3e, 0x92,
-	0x56, 0xeb, 0x6d, 0x16, 0xac, 0x36, 0x70, 0x8c, 0x43, 0x06, 0x0f, 0x40, 0x8e, 0xf4, 0x43, 0xdb,
-	0x25, 0x11, 0x0d, 0xcb, 0x99, 0x6a, 0x66, 0x37, 0x57, 0x2b, 0x5d, 0xa5, 0xa6, 0x71, 0x81, 0xc3,
-	0xe0, 0xa9, 0x35, 0x9e, 0xb2, 0x90, 0x4e, 0xfa, 0xe1, 0x33, 0x3e, 0x84, 0xc7, 0x00, 0x90, 0x41,
-	0x12, 0x63, 0x9b, 0xf8, 0x5d, 0x56, 0xd6, 0xaa, 0xd9, 0xdd, 0x6c, 0xcd, 0x1a, 0xa6, 0x66, 0xae,
-	0xce, 0xad, 0xf5, 0xd3, 0x06, 0xbb, 0x4a, 0xcd, 0x3b, 0x8a, 0x60, 0xec, 0x68, 0xa1, 0x9c, 0x00,
-	0x75, 0xbf, 0xcb, 0xe0, 0x21, 0xd8, 0xc2, 0x41, 0x40, 0xbf, 0xb4, 0x7b, 0x11, 0xcf, 0x88, 0x38,
-	0x09, 0x71, 0xed, 0x64, 0xc0, 0xca, 0x2b, 0xd5, 0xcc, 0xae, 0x8e, 0x36, 0xc5, 0xe4, 0x27, 0x93,
-	0xb9, 0xf3, 0x01, 0x8f, 0x29, 0xf0, 0x74, 0x9c, 0x36, 0x8e, 0x22, 0x12, 0xb0, 0xf2, 0x5a, 0x35,
-	0xbb, 0x9b, 0xab, 0x6d, 0x0c, 0x53, 0x33, 0x5f, 0xff, 0xf4, 0xc5, 0x89, 0x32, 0xa3, 0x3c, 0xe9,
-	0x87, 0x23, 0x00, 0xff, 0x04, 0x8a, 0xd8, 0x71, 0x08, 0x63, 0xb6, 0x43, 0xa3, 0x24, 0xa6, 0x41,
-	0x59, 0xaf, 0x66, 0x76, 0xf3, 0x87, 0xe6, 0xde, 0xfc, 0xe6, 0xed, 0x1d, 0x0b, 0xbf, 0x13, 0xe9,
-	0x56, 0xdb, 0xfa, 0x2e, 0x35, 0x97, 0x86, 0xa9, 0xb9, 0x3e, 0x63, 0x46, 0xeb, 0x78, 0x1a, 0xc2,
-	0xa7, 0xe0, 0x1e, 0x76, 0x12, 0xbf, 0x4f, 0x6c, 0x96, 0xe0, 0xc4, 0x77, 0xec, 0x6e, 0x4c, 0x1c,
-	0x1a, 0x76, 0xfd, 0x80, 0xb0, 0x72, 0x8e, 0xe7, 0x87, 0xee, 0x4a, 0x87, 0xa6, 0x98, 0x6f, 0x4c,
-	0xa6, 0x9f, 0xde, 0x7f, 0xfd, 0xc3, 0xdb, 0x87, 0xdb, 0x53, 0xe7, 0x3b, 0xe0, 0x27, 0x2c, 0x4f,
-	0xe5, 0x4c, 0xd3, 0x97, 0x8d, 0xec, 0x99, 0xa6, 0x67, 0x0d, 0xed, 0x4c, 0xd3, 0x57, 0x8d, 0x35,
-	0xeb, 0x2f, 0x19, 0x30, 0x9b, 0x0b, 0x3c, 0x06, 0xab, 0x4e, 0x4c, 0x70, 0x42, 0xc4, 0xb1, 0xe5,
-	0x0f, 0xff, 0xff, 0xbf, 0xac, 0xe9, 0xfc, 0xa2, 0x4b, 0x6a, 0x1a, 0x5f, 0x17, 0x52, 0x81, 0xf0,
-	0x57, 0x40, 0x73, 0x70, 0x10, 0x94, 0x97, 0xff, 0x57, 0x02, 0x11, 0x66, 0xfd, 0x2b, 0x03, 0xee,
-	0x5c, 0xf3, 0x80, 0x0e, 0xc8, 0xab, 0x3d, 0x4f, 0x2e, 0xba, 0x32, 0xb9, 0xe2, 0xe1, 0x83, 0x1f,
-	0xe3, 0x16, 0xa4, 0x3f, 0x19, 0xa6, 0x26, 0x98, 0xe0, 0xab, 0xd4, 0x84, 0xb2, 0x7c, 0xa6, 0x88,
-	0x2c, 0x04, 0xf0, 0xd8, 0x03, 0x3a, 0x60, 0x73, 0xf6, 0x60, 0xed, 0xc0, 0x67, 0x49, 0x79, 0x59,
-	0xd4, 0xc4, 0xe3, 0x61, 0x6a, 0xce, 0x26, 0xf6, 0xdc, 0x67, 0xc9, 0x55, 0x6a, 0x56, 0x66, 0x58,
-	0xa7, 0x23, 0x2d, 0x74, 0x07, 0xcf, 0x07, 0x58, 0xdf, 0x1a, 0x20, 0x7f, 0xd2, 0xc6, 0x7e, 0x74,
-	0x42, 0xa3, 0x57, 0xbe, 0x07, 0xff, 0x08, 0x36, 0xda, 0x34, 0x24, 0x2c, 0x21, 0xd8, 0xb5, 0x5b,
-	0x01, 0x75, 0x3a, 0xea, 0x89, 0x79, 0xfc, 0xcf, 0xd4, 0xdc, 0x92, 0x0b, 0x64, 0x6e, 0x67, 0xcf,
-	0xa7, 0xfb, 0x21, 0x4e, 0xda, 0x7b, 0xa7, 0x11, 0x17, 0xdd, 0x96, 0xa2, 0x73, 0x91, 0x16, 0x2a,
-	0x8e, 0x2d, 0x35, 0x6e, 0x80, 0x6d, 0x50, 0x74, 0x31, 0xb5, 0x5f, 0xd1, 0xb8, 0xa3, 0xc8, 0x97,
-	0x05, 0x79, 0xed, 0x47, 0xc9, 0x87, 0xa9, 0x59, 0x78, 0x76, 0xfc, 0xf1, 0x47, 0x34, 0xee, 0x08,
-	0x8a, 0xab, 0xd4, 0xdc, 0x92, 0x62, 0xb3, 0x44, 0x16, 0x2a, 0xb8, 0x98, 0x8e, 0xdd, 0xe0, 0x67,
-	0xc0, 0x18, 0x3b, 0xb0, 0x5e, 0xb7, 0x4b, 0xe3, 0xa4, 0x9c, 0xe5, 0x0f, 0x5e, 0xed, 0x67, 0xc3,
-	0xd4, 0x2c, 0x2a, 0xca, 0xa6, 0x9c, 0xb9, 0x4a, 0xcd, 0xbb, 0x73, 0xa4, 0x2a, 0xc6, 0x42, 0x45,
-	0x45, 0xab, 0x5c, 0x61, 0x0b, 0x14, 0x88, 0xdf, 0x3d, 0x38, 0x7a, 0xa4, 0x16, 0xa0, 0x89, 0x05,
-	0xfc, 0x66, 0xd1, 0x02, 0xf2, 0xf5, 0xd3, 0xc6, 0xc1, 0xd1, 0xa3, 0x51, 0xfe, 0x9b, 0xea, 0xda,
-	0x98, 0x62, 0xb1, 0x50, 0x5e, 0x42, 0x99, 0xfc, 0x48, 0xe3, 0x48, 0x69, 0xac, 0xde, 0x56, 0xe3,
-	0xe8, 0x26, 0x8d, 0xa3, 0x59, 0x8d, 0xa3, 0x59, 0x8d, 0x27, 0x4a, 0x63, 0xed, 0xb6, 0x1a, 0x4f,
-	0x6e, 0xd2, 0x78, 0x32, 0xab, 0x21, 0x7d, 0x78, 0x31, 0xb5, 0x2e, 0xfe, 0x8c, 0xa3, 0xc4, 0xef,
-	0x85, 0x4a, 0x46, 0xbf, 0x75, 0x31, 0xcd, 0x45, 0x5a, 0xa8, 0x38, 0xb6, 0x48, 0xf6, 0x0e, 0x28,
-	0x39, 0x34, 0x62, 0x09, 0xb7, 0x45, 0xb4, 0x1b, 0x10, 0x25, 0x91, 0x13, 0x12, 0x4f, 0x16, 0x49,
-	0xdc, 0x97, 0x12, 0x37, 0x85, 0x5b, 0x68, 0x73, 0xd6, 0x2c, 0xc5, 0x6c, 0x60, 0x74, 0x49, 0x42,
-	0x62, 0xd6, 0xea, 0xc5, 0x9e, 0x12, 0x02, 0x42, 0xe8, 0x83, 0x45, 0x42, 0xaa, 0xac, 0xe6, 0x43,
-	0x2d, 0xb4, 0x31, 0x31, 0x49, 0x81, 0xcf, 0x41, 0xd1, 0xe7, 0xaa, 0xad, 0x5e, 0xa0, 0xe8, 0xf3,
-	0x82, 0xfe, 0x70, 0x11, 0xbd, 0x7a, 0x14, 0x66, 0x03, 0x2d, 0xb4, 0x3e, 0x32, 0x48, 0x6a, 0x17,
-	0xc0, 0xb0, 0xe7, 0xc7, 0xb6, 0x17, 0x60, 0xc7, 0x27, 0xb1, 0xa2, 0x2f, 0x08, 0xfa, 0x9f, 0x2f,
-	0xa2, 0xbf, 0x27, 0xe9, 0xaf, 0x07, 0x5b, 0xc8, 0xe0, 0xc6, 0xdf, 0x4a, 0x9b, 0x54, 0x69, 0x82,
-	0x42, 0x8b, 0xc4, 0x81, 0x1f, 0x29, 0xfe, 0x75, 0xc1, 0xff, 0x68, 0x11, 0xbf, 0xaa, 0xa0, 0xe9,
-	0x30, 0x0b, 0xe5, 0x25, 0x1c, 0x93, 0x06, 0x34, 0x72, 0xe9, 0x88, 0xf4, 0xce, 0xad, 0x49, 0xa7,
-	0xc3, 0x2c, 0x94, 0x97, 0x50, 0x92, 0x7a, 0x60, 0x13, 0xc7, 0x31, 0xfd, 0x72, 0x6e, 0x43, 0xa0,
-	0xe0, 0xfe, 0xc5, 0x22, 0xee, 0xd1, 0xe5, 0x7a, 0x3d, 0x9a, 0x5f, 0xae, 0xdc, 0x3a, 0xb3, 0x25,
-	0x2e, 0x80, 0x5e, 0x8c, 0x2f, 0xe6, 0x74, 0x4a, 0xb7, 0xde, 0xf8, 0xeb, 0xc1, 0x16, 0x32, 0xb8,
-	0x71, 0x46, 0xe5, 0x0b, 0x50, 0x0a, 0x49, 0xec, 0x11, 0x3b, 0x22, 0x09, 0xeb, 0x06, 0x7e, 0xa2,
-	0x74, 0xb6, 0x6e, 0xfd, 0x1c, 0xdc, 0x14, 0x6e, 0x21, 0x28, 0xcc, 0x2f, 0x95, 0x55, 0x6a, 0xdd,
-	0x03, 0xba, 0xc3, 0xdf, 0x16, 0xb6, 0xef, 0x96, 0xcb, 0xd5, 0xcc, 0xae, 0x86, 0xd6, 0x04, 0x3e,
-	0x75, 0x61, 0x09, 0xac, 0xc8, 0x0e, 0xeb, 0x1e, 0xd7, 0x45, 0x12, 0xc0, 0x0a, 0xd0, 0x5d, 0xe2,
-	0xf8, 0x21, 0x0e, 0x58, 0xb9, 0x22, 0x02, 0xc6, 0x18, 0x7e, 0x0a, 0xd6, 0x59, 0x1b, 0x47, 0x5e,
-	0x1b, 0xfb, 0x76, 0xe2, 0x87, 0xa4, 0x7c, 0x5f, 0x64, 0x7c, 0xb0, 0x28, 0xe3, 0x92, 0xcc, 0x78,
-	0x26, 0xce, 0x42, 0x85, 0x11, 0x3e, 0xf7, 0x43, 0x02, 0x1b, 0x20, 0xef, 0xe0, 0xc8, 0xe9, 0x45,
-	0x92, 0xf5, 0x81, 0x60, 0xdd, 0x5f, 0xc4, 0xaa, 0x5e, 0xc5, 0x53, 0x51, 0x16, 0x02, 0x12, 0x8d,
-	0x18, 0xbb, 0x31, 0xf6, 0x7a, 0x44, 0x32, 0xbe, 0x77, 0x6b, 0xc6, 0xa9, 0x28, 0x0b, 0x01, 0x89,
-	0x46, 0x8c, 0x7d, 0x12, 0x77, 0x02, 0xc5, 0xb8, 0x73, 0x6b, 0xc6, 0xa9, 0x28, 0x0b, 0x01, 0x89,
-	0x04, 0xe3, 0x0b, 0x00, 0x28, 0xc3, 0x1d, 0x2c, 0x09, 0x4d, 0x41, 0xb8, 0xb7, 0x88, 0x50, 0xb5,
-	0xaf, 0x93, 0x20, 0x0b, 0xe5, 0x04, 0xe0, 0x74, 0x67, 0x9a, 0xbe, 0x62, 0xac, 0x9e, 0x69, 0xfa,
-	0xb6, 0x71, 0xf7, 0x4c, 0xd3, 0xef, 0x1a, 0x65, 0x6b, 0x1f, 0xac, 0xf0, 0x16, 0x8f, 0x40, 0x03,
-	0x64, 0x3b, 0xe4, 0x42, 0xf6, 0x05, 0x88, 0x0f, 0xf9, 0xd9, 0xf7, 0x71, 0xd0, 0x23, 0xf2, 0x75,
-	0x8e, 0x24, 0xb0, 0x1a, 0x60, 0xe3, 0x3c, 0xc6, 0x11, 0xe3, 0xed, 0x21, 0x8d, 0x9e, 0x53, 0x8f,
-	0x41, 0x08, 0xb4, 0x36, 0x66, 0x6d, 0x15, 0x2b, 0xc6, 0xf0, 0xa7, 0x40, 0x0b, 0xa8, 0xc7, 0x44,
-	0x63, 0x93, 0x3f, 0xdc, 0xba, 0xde, 0x45, 0x3d, 0xa7, 0x1e, 0x12, 0x2e, 0xd6, 0xdf, 0x97, 0x41,
-	0xf6, 0x39, 0xf5, 0x60, 0x19, 0xac, 0x61, 0xd7, 0x8d, 0x09, 0x63, 0x8a, 0x69, 0x04, 0xe1, 0x36,
-	0x58, 0x4d, 0x68, 0xd7, 0x77, 0x24, 0x5d, 0x0e, 0x29, 0xc4, 0x85, 0x5d, 0x9c, 0x60, 0xd1, 0x03,
-	0x14, 0x90, 0x18, 0xf3, 0x6e, 0x5b, 0x94, 0xba, 0x1d, 0xf5, 0xc2, 0x16, 0x89, 0xc5, 0xab, 0x5c,
-	0xab, 0x6d, 0x5c, 0xa6, 0x66, 0x5e, 0xd8, 0x5f, 0x0a, 0x33, 0x9a, 0x06, 0xf0, 0x7d, 0xb0, 0x96,
-	0x0c, 0x6c, 0xb1, 0x86, 0x15, 0xb1, 0xc5, 0x9b, 0x97, 0xa9, 0xb9, 0x91, 0x4c, 0x96, 0xf9, 0x3b,
-	0xcc, 0xda, 0x68, 0x35, 0x19, 0xf0, 0xff, 0xe1, 0x3e, 0xd0, 0x93, 0x81, 0xed, 0x47, 0x2e, 0x19,
-	0x88, 0x97, 0xb8, 0x56, 0x2b, 0x5d, 0xa6, 0xa6, 0x31, 0xe5, 0x7e, 0xca, 0xe7, 0xd0, 0x5a, 0x32,
-	0x10, 0x03, 0xf8, 0x3e, 0x00, 0x32, 0x25, 0xa1, 0x20, 0xdf, 0xc9, 0xeb, 0x97, 0xa9, 0x99, 0x13,
-	0x56, 0xc1, 0x3d, 0x19, 0x42, 0x0b, 0xac, 0x48, 0x6e, 0x5d, 0x70, 0x17, 0x2e, 0x53, 0x53, 0x0f,
-	0xa8, 0x27, 0x39, 0xe5, 0x14, 0xdf, 0xaa, 0x98, 0x84, 0xb4, 0x4f, 0x5c, 0xf1, 0x62, 0xd4, 0xd1,
-	0x08, 0x5a, 0x5f, 0x2f, 0x03, 0xfd, 0x7c, 0x80, 0x08, 0xeb, 0x05, 0x09, 0xfc, 0x08, 0x18, 0xa2,
-	0x57, 0xc4, 0x4e, 0x62, 0xcf, 0x6c, 0x6d, 0xed, 0xfe, 0xe4, 0x35, 0x36, 0xef, 0x61, 0xa1, 0x8d,
-	0x91, 0xe9, 0x58, 0xed, 0x7f, 0x09, 0xac, 0xb4, 0x02, 0x4a, 0x43, 0x51, 0x09, 0x05, 0x24, 0x01,
-	0xfc, 0x4c, 0xec, 0x9a, 0x38, 0xe5, 0xac, 0xe8, 0xc3, 0xff, 0xef, 0xfa, 0x29, 0xcf, 0x95, 0x4a,
-	0xed, 0x3e, 0xef, 0xc2, 0xaf, 0x52, 0xb3, 0x28, 0xb5, 0x55, 0xbc, 0xf5, 0xed, 0x0f, 0x6f, 0x1f,
-	0x66, 0xf8, 0x06, 0x8b, 0x7a, 0x32, 0x40, 0x36, 0x26, 0x89, 0x38, 0xb9, 0x02, 0xe2, 0x43, 0x7e,
-	0xe1, 0xc4, 0xa4, 0x4f, 0xe2, 0x84, 0xb8, 0xea, 0x4b, 0x6b, 0x8c, 0xf9, 0xed, 0xe5, 0x61, 0x66,
-	0xf7, 0x18, 0x71, 0xe5, 0x71, 0xa0, 0x35, 0x0f, 0xb3, 0x4f, 0x18, 0x71, 0x9f, 0x6a, 0x5f, 0x7d,
-	0x63, 0x2e, 0x59, 0x18, 0xe4, 0x55, 0x8b, 0xde, 0xeb, 0x06, 0x64, 0x41, 0x99, 0x1d, 0x82, 0x02,
-	0x4b, 0x68, 0x8c, 0x3d, 0x62, 0x77, 0xc8, 0x85, 0x2a, 0x36, 0x59, 0x3a, 0xca, 0xfe, 0x7b, 0x72,
-	0xc1, 0xd0, 0x34, 0x50, 0x12, 0xdf, 0x68, 0x20, 0x7f, 0x1e, 0x63, 0x87, 0xa8, 0x86, 0x9b, 0x17,
-	0x2c, 0x87, 0xb1, 0x92, 0x50, 0x88, 0x6b, 0xf3, 0x67, 0x92, 0xf6, 0x12, 0xf5, 0x50, 0x8d, 0x20,
-	0x8f, 0x88, 0x09, 0x19, 0x10, 0x47, 0xec, 0xa5, 0x86, 0x14, 0x82, 0x47, 0x60, 0xdd, 0xf5, 0x19,
-	0x6e, 0x05, 0xe2, 0x53, 0xcd, 0xe9, 0xc8, 0xe5, 0xd7, 0x8c, 0xcb, 0xd4, 0x2c, 0xa8, 0x89, 0x26,
-	0xb7, 0xa3, 0x19, 0x04, 0x3f, 0x04, 0x1b, 0x93, 0x30, 0x91, 0xad, 0xd8, 0x1b, 0xbd, 0x06, 0x2f,
-	0x53, 0xb3, 0x38, 0x76, 0x15, 0x33, 0x68, 0x0e, 0xcb, 0x4b, 0xbf, 0xd5, 0xf3, 0x44, 0x05, 0xea,
-	0x48, 0x02, 0x6e, 0x0d, 0xfc, 0xd0, 0x4f, 0x44, 0xc5, 0xad, 0x20, 0x09, 0xe0, 0x87, 0x20, 0x47,
-	0xfb, 0x24, 0x8e, 0x7d, 0x97, 0x30, 0xd1, 0x3b, 0xe5, 0x0f, 0xdf, 0xbb, 0x5e, 0x06, 0x53, 0x1f,
-	0x23, 0x68, 0xe2, 0xcf, 0x17, 0x47, 0x22, 0x91, 0x64, 0x48, 0x42, 0x1a, 0x5f, 0x88, 0xee, 0x48,
-	0x2d, 0x4e, 0x4e, 0xbc, 0x10, 0x76, 0x34, 0x83, 0x60, 0x0d, 0x40, 0x15, 0x16, 0x93, 0xa4, 0x17,
-	0x47, 0xb6, 0xb8, 0x04, 0x0a, 0x22, 0x56, 0x3c, 0x8a, 0x72, 0x16, 0x89, 0xc9, 0x67, 0x38, 0xc1,
-	0xe8, 0x9a, 0x05, 0xfe, 0x1a, 0x40, 0x79, 0x26, 0xf6, 0x17, 0x8c, 0x46, 0xfc, 0x93, 0xea, 0x95,
-	0xef, 0xa9, 0xf6, 0x46, 0xe8, 0xcb, 0x59, 0x95, 0xb3, 0x21, 0xd1, 0x19, 0xa3, 0x6a, 0x15, 0x67,
-	0x9a, 0xae, 0x19, 0x2b, 0x67, 0x9a, 0xbe, 0x66, 0xe8, 0xe3, 0xfd, 0x53, 0xab, 0x40, 0x9b, 0x23,
-	0x3c, 0x95, 0x9e, 0xf5, 0x12, 0x80, 0x46, 0x4c, 0x7c, 0xde, 0x84, 0x06, 0x01, 0xbf, 0xb9, 0x22,
-	0x1c, 0x92, 0xd1, 0x95, 0xc9, 0xc7, 0xd3, 0x85, 0xb9, 0x3c, 0x5b, 0x98, 0x10, 0x68, 0x0e, 0x75,
-	0x89, 0x28, 0x8d, 0x1c, 0x12, 0xe3, 0x87, 0x7f, 0xcb, 0x80, 0xa9, 0x2f, 0x4f, 0xf8, 0x4b, 0x50,
-	0x39, 0x3e, 0x39, 0xa9, 0x37, 0x9b, 0xf6, 0xf9, 0xe7, 0x8d, 0xba, 0xdd, 0xa8, 0xa3, 0x17, 0xa7,
-	0xcd, 0xe6, 0xe9, 0xc7, 0x2f, 0x9f, 0xd7, 0x9b, 0x4d, 0x63, 0xa9, 0xf2, 0xe0, 0xf5, 0x9b, 0x6a,
-	0x79, 0xe2, 0xdf, 0x20, 0x71, 0xe8, 0x33, 0xe6, 0xd3, 0x28, 0xe0, 0x02, 0x1f, 0x80, 0xed, 0xe9,
-	0x68, 0x54, 0x6f, 0x9e, 0xa3, 0xd3, 0x93, 0xf3, 0xfa, 0x33, 0x23, 0x53, 0x29, 0xbf, 0x7e, 0x53,
-	0x2d, 0x4d, 0x22, 0x11, 0x61, 0x49, 0xec, 0x3b, 0xfc, 0xc9, 0x7b, 0x02, 0xca, 0x37, 0x6b, 0xd6,
-	0x9f, 0x19, 0xcb, 0x95, 0xca, 0xeb, 0x37, 0xd5, 0xed, 0x9b, 0x14, 0x89, 0x5b, 0xd1, 0xbe, 0xfa,
-	0xeb, 0xce, 0x52, 0xed, 0xe9, 0x77, 0xc3, 0x9d, 0xcc, 0xf7, 0xc3, 0x9d, 0xcc, 0xbf, 0x87, 0x3b,
-	0x99, 0xaf, 0xdf, 0xed, 0x2c, 0x7d, 0xff, 0x6e, 0x67, 0xe9, 0x1f, 0xef, 0x76, 0x96, 0xfe, 0x50,
-	0xf5, 0xfc, 0xa4, 0xdd, 0x6b, 0xed, 0x39, 0x34, 0xdc, 0x9f, 0xff, 0xa5, 0x81, 0x7f, 0x53, 0xb3,
-	0xd6, 0xaa, 0xf8, 0x41, 0xe8, 0xf1, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0x92, 0xb6, 0x78, 0x1a,
-	0x69, 0x12, 0x00, 0x00,
+	// 2116 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x58, 0x4d, 0x4f, 0xe4, 0xc8,
+	0x19, 0xa6, 0xc1, 0x80, 0xbb, 0xba, 0x69, 0x4c, 0xf1, 0x31, 0x9e, 0x66, 0x16, 0x13, 0x6f, 0x0e,
+	0x64, 0x34, 0x81, 0x81, 0x59, 0x92, 0x11, 0x9b, 0x0f, 0xd1, 0xd0, 0x93, 0x40, 0x98, 0x59, 0x54,
+	0xcd, 0xee, 0x6a, 0xa3, 0x44, 0x56, 0xb5, 0x5d, 0xb8, 0xbd, 0xd8, 0xae, 0x96, 0xcb, 0xcd, 0x36,
+	0xf9, 0x05, 0x9b, 0x39, 0x6d, 0x7e, 0xc0, 0x48, 0x91, 0x72, 0xd9, 0x53, 0xb4, 0x3f, 0x21, 0xc7,
+	0x55, 0x4e, 0x7b, 0x8c, 0x56, 0x8a, 0x15, 0x31, 0x87, 0x95, 0x38, 0xf2, 0x0b, 0xa2, 0xfa, 0xe8,
+	0x6f, 0xa6, 0x43, 0xa4, 0x16, 0xd4, 0xf3, 0x7e, 0x3c, 0xcf, 0xeb, 0xaa, 0xd7, 0x76, 0x95, 0x41,
+	0xd9, 0xa5, 0x2c, 0xa2, 0x6c, 0x8b, 0x5c, 0x46, 0x5b, 0xfc, 0xb7, 0xcd, 0x47, 0x9b, 0xcd, 0x84,
+	0xa6, 0x14, 0x1a, 0xd2, 0xb7, 0xc9, 0x2d, 0xfc, 0xb7, 0x5d, 0x5e, 0xc0, 0x51, 0x10, 0xd3, 0x2d,
+	0xf1, 0x57, 0x06, 0x95, 0x97, 0x7c, 0xea, 0x53, 0x31, 0xdc, 0xe2, 0x23, 0x69, 0xb5, 0xff, 0xac,
+	0x81, 0x99, 0x53, 0x9c, 0xe0, 0x88, 0xc1, 0x6d, 0x90, 0x27, 0x97, 0x91, 0xe3, 0x91, 0x98, 0x46,
+	0x66, 0x6e, 0x3d, 0xb7, 0x91, 0xaf, 0x2c, 0xdd, 0x66, 0x96, 0x71, 0x85, 0xa3, 0x70, 0xcf, 0xee,
+	0xba, 0x6c, 0xa4, 0x93, 0xcb, 0xe8, 0x90, 0x0f, 0xe1, 0x3e, 0x00, 0xa4, 0x9d, 0x26, 0xd8, 0x21,
+	0x41, 0x93, 0x99, 0xda, 0xfa, 0xd4, 0xc6, 0x54, 0xc5, 0xbe, 0xce, 0xac, 0x7c, 0x95, 0x5b, 0xab,
+	0x47, 0xa7, 0xec, 0x36, 0xb3, 0x16, 0x14, 0x41, 0x37, 0xd0, 0x46, 0x79, 0x01, 0xaa, 0x41, 0x93,
+	0xc1, 0x1d, 0xb0, 0x8c, 0xc3, 0x90, 0x7e, 0xe1, 0xb4, 0x62, 0x5e, 0x11, 0x71, 0x53, 0xe2, 0x39,
+	0x69, 0x9b, 0x99, 0xd3, 0xeb, 0xb9, 0x0d, 0x1d, 0x2d, 0x0a, 0xe7, 0xc7, 0x3d, 0xdf, 0x59, 0x9b,
+	0xe7, 0x14, 0x79, 0x39, 0x6e, 0x03, 0xc7, 0x31, 0x09, 0x99, 0x39, 0xbb, 0x3e, 0xb5, 0x91, 0xaf,
+	0xcc, 0x5f, 0x67, 0x56, 0xa1, 0xfa, 0xc9, 0xcb, 0x03, 0x65, 0x46, 0x05, 0x72, 0x19, 0x75, 0x00,
+	0xfc, 0x23, 0x28, 0x61, 0xd7, 0x25, 0x8c, 0x39, 0x2e, 0x8d, 0xd3, 0x84, 0x86, 0xa6, 0xbe, 0x9e,
+	0xdb, 0x28, 0xec, 0x58, 0x9b, 0xc3, 0x93, 0xb7, 0xb9, 0x2f, 0xe2, 0x0e, 0x64, 0x58, 0x65, 0xf9,
+	0xdb, 0xcc, 0x9a, 0xb8, 0xce, 0xac, 0xb9, 0x01, 0x33, 0x9a, 0xc3, 0xfd, 0x10, 0xee, 0x81, 0x87,
+	0xd8, 0x4d, 0x83, 0x4b, 0xe2, 0xb0, 0x14, 0xa7, 0x81, 0xeb, 0x34, 0x13, 0xe2, 0xd2, 0xa8, 0x19,
+	0x84, 0x84, 0x99, 0x79, 0x5e, 0x1f, 0x7a, 0x20, 0x03, 0x6a, 0xc2, 0x7f, 0xda, 0x73, 0xc3, 0x4f,
+	0x81, 0x71, 0x4e, 0x88, 0xe3, 0x05, 0x2c, 0x4d, 0x82, 0x7a, 0x2b, 0x0d, 0x68, 0x6c, 0x02, 0x51,
+	0xdc, 0x8f, 0x46, 0x8b, 0x7b, 0x41, 0xc8, 0x61, 0x5f, 0x60, 0x25, 0xcf, 0xcb, 0xfb, 0xfa, 0x87,
+	0x6f, 0x1e, 0xe7, 0xd0, 0xfc, 0xf9, 0xa0, 0x6f, 0x6f, 0xf5, 0xf5, 0x0f, 0xdf, 0x3c, 0x5e, 0xe9,
+	0x6b, 0x9c, 0x36, 0x6f, 0x1d, 0xb9, 0xdc, 0xc7, 0x9a, 0x3e, 0x69, 0x4c, 0x1d, 0x6b, 0xfa, 0x94,
+	0xa1, 0x1d, 0x6b, 0xfa, 0x8c, 0x31, 0x6b, 0xff, 0x3d, 0x07, 0xe6, 0x87, 0xe8, 0xa1, 0x0d, 0xe6,
+	0xea, 0xad, 0x24, 0x76, 0xea, 0x98, 0x11, 0xe7, 0x9c, 0x10, 0xd1, 0x18, 0x3a, 0x2a, 0x70, 0x63,
+	0x05, 0x33, 0xf2, 0x82, 0x10, 0x78, 0x04, 0x4a, 0x1d, 0xb7, 0xc3, 0x1a, 0x38, 0x21, 0xe6, 0xa4,
+	0xe8, 0x9e, 0xf7, 0x79, 0x69, 0xdf, 0x67, 0xd6, 0xaa, 0xac, 0x80, 0x79, 0x17, 0x9b, 0x01, 0xdd,
+	0x8a, 0x70, 0xda, 0xd8, 0x3c, 0x21, 0x3e, 0x76, 0xaf, 0x0e, 0x89, 0x8b, 0x8a, 0x75, 0xc9, 0x52,
+	0xe3, 0x89, 0xf0, 0x09, 0x80, 0x5d, 0xaa, 0x84, 0xb8, 0x41, 0x33, 0x20, 0x71, 0x6a, 0x4e, 0x71,
+	0x3a, 0x64, 0xa8, 0x48, 0xd4, 0xb1, 0xdb, 0x7f, 0xc9, 0x81, 0xc1, 0x55, 0x81, 0xfb, 0x60, 0xc6,
+	0x4d, 0x08, 0x4e, 0x65, 0x9d, 0x85, 0x9d, 0xf7, 0xff, 0xc7, 0xea, 0x9e, 0x5d, 0x35, 0x49, 0x45,
+	0xe3, 0x75, 0x22, 0x95, 0x08, 0x7f, 0x09, 0x34, 0x17, 0x87, 0xa1, 0x39, 0xf9, 0xff, 0x12, 0x88,
+	0x34, 0xfb, 0xdf, 0x39, 0xb0, 0x30, 0x12, 0x01, 0x5d, 0x50, 0x50, 0xdd, 0x97, 0x5e, 0x35, 0x65,
+	0x71, 0xa5, 0x9d, 0x47, 0xef, 0xe2, 0x16, 0xa4, 0x3f, 0xbe, 0xce, 0x2c, 0xd0, 0xc3, 0xb7, 0x99,
+	0x05, 0xe5, 0x8d, 0xd4, 0x47, 0x64, 0x23, 0x80, 0xbb, 0x11, 0xd0, 0x05, 0x8b, 0x83, 0x2d, 0xee,
+	0x84, 0x01, 0x4b, 0xcd, 0x49, 0x71, 0x77, 0x3c, 0xbb, 0xce, 0xac, 0xc1, 0xc2, 0x4e, 0x02, 0x96,
+	0xde, 0x66, 0x56, 0x79, 0x80, 0xb5, 0x3f, 0xd3, 0x46, 0x0b, 0x78, 0x38, 0xc1, 0xfe, 0xda, 0x00,
+	0x85, 0x83, 0x06, 0x0e, 0xe2, 0x03, 0x1a, 0x9f, 0x07, 0x3e, 0xfc, 0x03, 0x98, 0x6f, 0xd0, 0x88,
+	0xb0, 0x94, 0x60, 0xcf, 0xa9, 0x87, 0xd4, 0xbd, 0x50, 0xcf, 0x8e, 0x67, 0xdf, 0x67, 0xd6, 0xf2,
+	0xe8, 0xca, 0x1f, 0xc5, 0x5c, 0x74, 0x45, 0x8a, 0x0e, 0x65, 0xda, 0xa8, 0xd4, 0xb5, 0x54, 0xb8,
+	0x01, 0x36, 0x40, 0xc9, 0xc3, 0xd4, 0x39, 0xa7, 0xc9, 0x85, 0x22, 0x97, 0xad, 0x55, 0x79, 0x27,
+	0xf9, 0x75, 0x66, 0x15, 0x0f, 0xf7, 0x3f, 0x7a, 0x41, 0x93, 0x0b, 0x41, 0x71, 0x9b, 0x59, 0xcb,
+	0x52, 0x6c, 0x90, 0xc8, 0x46, 0x45, 0x0f, 0xd3, 0x6e, 0x18, 0xbf, 0x09, 0xbb, 0x01, 0xac, 0xd5,
+	0x6c, 0xd2, 0x44, 0xf6, 0x9d, 0x5e, 0xf9, 0xe9, 0x75, 0x66, 0x95, 0x14, 0x65, 0x4d, 0x7a, 0x6e,
+	0x33, 0xeb, 0xc1, 0x10, 0xa9, 0xca, 0xb1, 0x51, 0x49, 0xd1, 0xaa, 0x50, 0x58, 0x07, 0x45, 0x12,
+	0x34, 0xb7, 0x77, 0x9f, 0xaa, 0x0b, 0xd0, 0xc4, 0x05, 0xfc, 0x7a, 0xdc, 0x05, 0x14, 0xaa, 0x47,
+	0xa7, 0xdb, 0xbb, 0x4f, 0x3b, 0xf5, 0x2f, 0x4a, 0xa9, 0x7e, 0x16, 0x1b, 0x15, 0x24, 0x94, 0xc5,
+	0x77, 0x34, 0x76, 0x95, 0xc6, 0xcc, 0x7d, 0x35, 0x76, 0xef, 0xd2, 0xd8, 0x1d, 0xd4, 0xd8, 0x1d,
+	0xd4, 0x78, 0xae, 0x34, 0x66, 0xef, 0xab, 0xf1, 0xfc, 0x2e, 0x8d, 0xe7, 0x83, 0x1a, 0x32, 0x86,
+	0x37, 0x53, 0xfd, 0xea, 0x4f, 0x38, 0x4e, 0x83, 0x56, 0xa4, 0x64, 0xf4, 0x7b, 0x37, 0xd3, 0x50,
+	0xa6, 0x8d, 0x4a, 0x5d, 0x8b, 0x64, 0xbf, 0x00, 0x4b, 0x2e, 0x8d, 0x59, 0xca, 0x6d, 0x31, 0x6d,
+	0x86, 0x44, 0x49, 0xe4, 0x85, 0xc4, 0xf3, 0x71, 0x12, 0xab, 0x52, 0xe2, 0xae, 0x74, 0x1b, 0x2d,
+	0x0e, 0x9a, 0xa5, 0x98, 0x03, 0x8c, 0x26, 0x49, 0x49, 0xc2, 0xea, 0xad, 0xc4, 0x57, 0x42, 0x40,
+	0x08, 0x7d, 0x30, 0x4e, 0x48, 0xb5, 0xd5, 0x70, 0xaa, 0x8d, 0xe6, 0x7b, 0x26, 0x29, 0xf0, 0x19,
+	0x28, 0x05, 0x5c, 0xb5, 0xde, 0x0a, 0x15, 0x7d, 0x41, 0xd0, 0xef, 0x8c, 0xa3, 0x57, 0xb7, 0xc2,
+	0x60, 0xa2, 0x8d, 0xe6, 0x3a, 0x06, 0x49, 0xed, 0x01, 0x18, 0xb5, 0x82, 0xc4, 0xf1, 0x43, 0xec,
+	0x06, 0x24, 0x51, 0xf4, 0x45, 0x41, 0xff, 0xb3, 0x71, 0xf4, 0x0f, 0x25, 0xfd, 0x68, 0xb2, 0x8d,
+	0x0c, 0x6e, 0xfc, 0x8d, 0xb4, 0x49, 0x95, 0x1a, 0x28, 0xd6, 0x49, 0x12, 0x06, 0xb1, 0xe2, 0x9f,
+	0x13, 0xfc, 0x4f, 0xc7, 0xf1, 0xab, 0x0e, 0xea, 0x4f, 0xb3, 0x51, 0x41, 0xc2, 0x2e, 0x69, 0x48,
+	0x63, 0x8f, 0x76, 0x48, 0x17, 0xee, 0x4d, 0xda, 0x9f, 0x66, 0xa3, 0x82, 0x84, 0x92, 0xd4, 0x07,
+	0x8b, 0x38, 0x49, 0xe8, 0x17, 0x43, 0x13, 0x02, 0x05, 0xf7, 0xcf, 0xc7, 0x71, 0x77, 0x1e, 0xae,
+	0xa3, 0xd9, 0xfc, 0xe1, 0xca, 0xad, 0x03, 0x53, 0xe2, 0x01, 0xe8, 0x27, 0xf8, 0x6a, 0x48, 0x67,
+	0xe9, 0xde, 0x13, 0x3f, 0x9a, 0x6c, 0x23, 0x83, 0x1b, 0x07, 0x54, 0x3e, 0x07, 0x4b, 0x11, 0x49,
+	0x7c, 0xe2, 0xc4, 0x24, 0x65, 0xcd, 0x30, 0x48, 0x95, 0xce, 0xf2, 0xbd, 0xef, 0x83, 0xbb, 0xd2,
+	0x6d, 0x04, 0x85, 0xf9, 0x95, 0xb2, 0x4a, 0xad, 0x87, 0x40, 0x77, 0xf9, 0xdb, 0xc2, 0x09, 0x3c,
+	0xd3, 0x5c, 0xcf, 0x6d, 0x68, 0x68, 0x56, 0xe0, 0x23, 0x0f, 0x2e, 0x81, 0x69, 0xb9, 0xd7, 0x7c,
+	0x28, 0x5e, 0xef, 0x12, 0xc0, 0x32, 0xd0, 0x3d, 0xe2, 0x06, 0x11, 0x0e, 0x99, 0x59, 0x16, 0x09,
+	0x5d, 0x0c, 0x3f, 0x01, 0x73, 0xac, 0x81, 0x63, 0xbf, 0x81, 0x03, 0x27, 0x0d, 0x22, 0x62, 0xae,
+	0x8a, 0x8a, 0xb7, 0xc7, 0x55, 0xbc, 0x24, 0x2b, 0x1e, 0xc8, 0xb3, 0x51, 0xb1, 0x83, 0xcf, 0x82,
+	0x88, 0xc0, 0x53, 0x50, 0x70, 0x71, 0xec, 0xb6, 0x62, 0xc9, 0xfa, 0x48, 0xb0, 0x6e, 0x8d, 0x63,
+	0x55, 0xaf, 0xe2, 0xbe, 0x2c, 0x1b, 0x01, 0x89, 0x3a, 0x8c, 0xcd, 0x04, 0xfb, 0x2d, 0x22, 0x19,
+	0xdf, 0xbb, 0x37, 0x63, 0x5f, 0x96, 0x8d, 0x80, 0x44, 0x1d, 0xc6, 0x4b, 0x92, 0x5c, 0x84, 0x8a,
+	0x71, 0xed, 0xde, 0x8c, 0x7d, 0x59, 0x36, 0x02, 0x12, 0x09, 0xc6, 0x97, 0x00, 0x50, 0x86, 0x2f,
+	0xb0, 0x24, 0xb4, 0x04, 0xe1, 0xe6, 0x38, 0x42, 0xb5, 0x91, 0xef, 0x25, 0xd9, 0x28, 0x2f, 0x00,
+	0xa7, 0x3b, 0xd6, 0xf4, 0x69, 0x63, 0xe6, 0x58, 0xd3, 0x57, 0x8c, 0x07, 0xc7, 0x9a, 0xfe, 0xc0,
+	0x30, 0xed, 0x2d, 0x30, 0xcd, 0x37, 0xbb, 0x04, 0x1a, 0x60, 0xea, 0x82, 0x5c, 0xc9, 0x7d, 0x01,
+	0xe2, 0x43, 0xbe, 0xf6, 0x97, 0x38, 0x6c, 0xa9, 0x9d, 0x22, 0x92, 0xc0, 0x3e, 0x05, 0xf3, 0x67,
+	0x09, 0x8e, 0x19, 0xdf, 0x28, 0xd3, 0xf8, 0x84, 0xfa, 0x0c, 0x42, 0xa0, 0x35, 0x30, 0x6b, 0xa8,
+	0x5c, 0x31, 0x86, 0x3f, 0x01, 0x5a, 0x48, 0x7d, 0x26, 0x36, 0x36, 0x85, 0x9d, 0xe5, 0xd1, 0x5d,
+	0xd4, 0x09, 0xf5, 0x91, 0x08, 0xb1, 0xff, 0x39, 0x09, 0xa6, 0x4e, 0xa8, 0x0f, 0x4d, 0x30, 0x8b,
+	0x3d, 0x2f, 0x21, 0x8c, 0x29, 0xa6, 0x0e, 0x84, 0x2b, 0x60, 0x26, 0xa5, 0xcd, 0xc0, 0x95, 0x74,
+	0x79, 0xa4, 0x10, 0x17, 0xf6, 0x70, 0x8a, 0xc5, 0x1e, 0xa0, 0x88, 0xc4, 0x98, 0x9f, 0x3b, 0x44,
+	0xab, 0x3b, 0x71, 0x2b, 0xaa, 0x93, 0x44, 0xbc, 0xca, 0xb5, 0xca, 0xfc, 0x4d, 0x66, 0x15, 0x84,
+	0xfd, 0x95, 0x30, 0xa3, 0x7e, 0x00, 0x9f, 0x80, 0xd9, 0xb4, 0xed, 0x88, 0x6b, 0x98, 0x16, 0x53,
+	0xbc, 0x78, 0x93, 0x59, 0xf3, 0x69, 0xef, 0x32, 0x7f, 0x8b, 0x59, 0x03, 0xcd, 0xa4, 0x6d, 0xfe,
+	0x1f, 0x6e, 0x01, 0x3d, 0x6d, 0x3b, 0x41, 0xec, 0x91, 0xb6, 0x78, 0x89, 0x6b, 0x95, 0xa5, 0x9b,
+	0xcc, 0x32, 0xfa, 0xc2, 0x8f, 0xb8, 0x0f, 0xcd, 0xa6, 0x6d, 0x31, 0x80, 0x4f, 0x00, 0x90, 0x25,
+	0x09, 0x05, 0xf9, 0x4e, 0x9e, 0xbb, 0xc9, 0xac, 0xbc, 0xb0, 0x0a, 0xee, 0xde, 0x10, 0xda, 0x60,
+	0x5a, 0x72, 0xeb, 0x82, 0xbb, 0x78, 0x93, 0x59, 0x7a, 0x48, 0x7d, 0xc9, 0x29, 0x5d, 0x7c, 0xaa,
+	0x12, 0x12, 0xd1, 0x4b, 0xe2, 0x89, 0x17, 0xa3, 0x8e, 0x3a, 0xd0, 0xfe, 0x6a, 0x12, 0xe8, 0x67,
+	0x6d, 0x44, 0x58, 0x2b, 0x4c, 0xe1, 0x0b, 0x60, 0x88, 0xbd, 0x22, 0x76, 0x53, 0x67, 0x60, 0x6a,
+	0x2b, 0xab, 0xbd, 0xd7, 0xd8, 0x70, 0x84, 0x8d, 0xe6, 0x3b, 0xa6, 0x7d, 0x35, 0xff, 0x4b, 0x60,
+	0xba, 0x1e, 0x52, 0x1a, 0x89, 0x4e, 0x28, 0x22, 0x09, 0xe0, 0xa7, 0x62, 0xd6, 0xc4, 0x2a, 0x4f,
+	0xbd, 0xeb, 0x24, 0x34, 0xd4, 0x2a, 0x95, 0x55, 0xbe, 0x0b, 0xbf, 0xcd, 0xac, 0x92, 0xd4, 0x56,
+	0xf9, 0xb6, 0x3c, 0x1b, 0xcd, 0xa4, 0x6d, 0xd1, 0x4f, 0x06, 0x98, 0x4a, 0x48, 0x2a, 0x56, 0xae,
+	0x88, 0xf8, 0x90, 0x3f, 0x70, 0x12, 0x72, 0x49, 0x92, 0x94, 0x78, 0xea, 0xcc, 0xd9, 0xc5, 0xfc,
+	0xe9, 0xe5, 0x63, 0xe6, 0xb4, 0x18, 0xf1, 0xe4, 0x72, 0xa0, 0x59, 0x1f, 0xb3, 0x8f, 0x19, 0xf1,
+	0xf6, 0xb4, 0x2f, 0xff, 0x6a, 0x4d, 0xd8, 0x18, 0x14, 0xd4, 0x16, 0xbd, 0xd5, 0x0c, 0xc9, 0x98,
+	0x36, 0xdb, 0x01, 0x45, 0x96, 0xd2, 0x04, 0xfb, 0xc4, 0xb9, 0x20, 0x57, 0xaa, 0xd9, 0x64, 0xeb,
+	0x28, 0xfb, 0xef, 0xc8, 0x15, 0x43, 0xfd, 0x40, 0x49, 0xbc, 0xd5, 0x40, 0xe1, 0x2c, 0xc1, 0x2e,
+	0x51, 0x1b, 0x6e, 0xde, 0xb0, 0x1c, 0x26, 0x4a, 0x42, 0x21, 0xae, 0xcd, 0xef, 0x49, 0xda, 0x4a,
+	0xd5, 0x4d, 0xd5, 0x81, 0x3c, 0x23, 0x21, 0xa4, 0x4d, 0x5c, 0x31, 0x97, 0x1a, 0x52, 0x08, 0xee,
+	0x82, 0x39, 0x2f, 0x60, 0xb8, 0x1e, 0x8a, 0x43, 0xab, 0x7b, 0x21, 0x2f, 0xbf, 0x62, 0xdc, 0x64,
+	0x56, 0x51, 0x39, 0x6a, 0xdc, 0x8e, 0x06, 0x10, 0xfc, 0x10, 0xcc, 0xf7, 0xd2, 0x44, 0xb5, 0x62,
+	0x6e, 0xf4, 0x0a, 0xbc, 0xc9, 0xac, 0x52, 0x37, 0x54, 0x78, 0xd0, 0x10, 0x96, 0x0f, 0xfd, 0x7a,
+	0xcb, 0x17, 0x1d, 0xa8, 0x23, 0x09, 0xb8, 0x35, 0x0c, 0xa2, 0x20, 0x15, 0x1d, 0x37, 0x8d, 0x24,
+	0x80, 0x1f, 0x82, 0x3c, 0xbd, 0x24, 0x49, 0x12, 0x78, 0x84, 0xa9, 0x03, 0xf1, 0x7b, 0xa3, 0x6d,
+	0xd0, 0x77, 0x18, 0x41, 0xbd, 0x78, 0x7e, 0x71, 0x24, 0x16, 0x45, 0x46, 0x24, 0xa2, 0xc9, 0x95,
+	0x59, 0xe8, 0x5d, 0x9c, 0x74, 0xbc, 0x14, 0x76, 0x34, 0x80, 0x60, 0x05, 0x40, 0x95, 0x96, 0x90,
+	0x94, 0x1f, 0x7c, 0xc5, 0x43, 0xa0, 0x28, 0x72, 0xc5, 0xad, 0x28, 0xbd, 0x48, 0x38, 0x0f, 0x71,
+	0x8a, 0xd1, 0x88, 0x05, 0xfe, 0x0a, 0x40, 0xb9, 0x26, 0xce, 0xe7, 0x8c, 0xc6, 0xfc, 0x48, 0x75,
+	0x1e, 0xf8, 0x6a, 0x7b, 0x23, 0xf4, 0xa5, 0x57, 0xd5, 0x6c, 0x48, 0x74, 0xcc, 0x68, 0xe7, 0x48,
+	0xb5, 0x0f, 0x16, 0x84, 0x6d, 0xe0, 0x1b, 0x42, 0xa9, 0x57, 0x82, 0x70, 0xf6, 0x7d, 0x40, 0x40,
+	0x23, 0x96, 0x63, 0x4d, 0xd7, 0x8c, 0xe9, 0x63, 0x4d, 0x9f, 0x35, 0xf4, 0xee, 0x12, 0xa8, 0x89,
+	0x40, 0x8b, 0x1d, 0xdc, 0x77, 0x85, 0xf6, 0x2b, 0x00, 0x4e, 0x13, 0x12, 0xf0, 0x7d, 0x6c, 0x18,
+	0xf2, 0x87, 0x5f, 0x8c, 0x23, 0xd2, 0x79, 0xea, 0xf2, 0x71, 0x7f, 0x6f, 0x4f, 0x0e, 0xf6, 0x36,
+	0x04, 0x9a, 0x4b, 0x3d, 0xa2, 0x8e, 0xe9, 0x62, 0xfc, 0xf8, 0x1f, 0x39, 0xd0, 0x77, 0x78, 0x85,
+	0xbf, 0x00, 0xe5, 0xfd, 0x83, 0x83, 0x6a, 0xad, 0xe6, 0x9c, 0x7d, 0x76, 0x5a, 0x75, 0x4e, 0xab,
+	0xe8, 0xe5, 0x51, 0xad, 0x76, 0xf4, 0xd1, 0xab, 0x93, 0x6a, 0xad, 0x66, 0x4c, 0x94, 0x1f, 0xbd,
+	0x7e, 0xb3, 0x6e, 0xf6, 0xe2, 0x4f, 0x49, 0x12, 0x05, 0x8c, 0x05, 0x34, 0x0e, 0xb9, 0xc0, 0x07,
+	0x60, 0xa5, 0x3f, 0x1b, 0x55, 0x6b, 0x67, 0xe8, 0xe8, 0xe0, 0xac, 0x7a, 0x68, 0xe4, 0xca, 0xe6,
+	0xeb, 0x37, 0xeb, 0x4b, 0xbd, 0x4c, 0x44, 0xf8, 0xc7, 0x0b, 0xfe, 0xa1, 0x08, 0x3e, 0x07, 0xe6,
+	0xdd, 0x9a, 0xd5, 0x43, 0x63, 0xb2, 0x5c, 0x7e, 0xfd, 0x66, 0x7d, 0xe5, 0x2e, 0x45, 0xe2, 0x95,
+	0xb5, 0x2f, 0xff, 0xb6, 0x36, 0x51, 0xd9, 0xfb, 0xf6, 0x7a, 0x2d, 0xf7, 0xdd, 0xf5, 0x5a, 0xee,
+	0x3f, 0xd7, 0x6b, 0xb9, 0xaf, 0xde, 0xae, 0x4d, 0x7c, 0xf7, 0x76, 0x6d, 0xe2, 0x5f, 0x6f, 0xd7,
+	0x26, 0x7e, 0xbf, 0xee, 0x07, 0x69, 0xa3, 0x55, 0xdf, 0x74, 0x69, 0xb4, 0x35, 0xfc, 0x75, 0x85,
+	0x1f, 0xcb, 0x59, 0x7d, 0x46, 0x7c, 0x5d, 0x7b, 0xf6, 0xdf, 0x01, 0x00, 0x2d, 0x4c, 0x3b, 0xaa,
+	0xb6, 0x13, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -1090,6 +1184,16 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.FeeDistribution.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvm(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
 	if len(m.ActiveStaticPrecompiles) > 0 {
 		for iNdEx := len(m.ActiveStaticPrecompiles) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.ActiveStaticPrecompiles[iNdEx])
@@ -1129,21 +1233,21 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x28
 	}
 	if len(m.ExtraEIPs) > 0 {
-		dAtA3 := make([]byte, len(m.ExtraEIPs)*10)
-		var j2 int
+		dAtA4 := make([]byte, len(m.ExtraEIPs)*10)
+		var j3 int
 		for _, num1 := range m.ExtraEIPs {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA3[j2] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j2++
+				j3++
 			}
-			dAtA3[j2] = uint8(num)
-			j2++
+			dAtA4[j3] = uint8(num)
+			j3++
 		}
-		i -= j2
-		copy(dAtA[i:], dAtA3[:j2])
-		i = encodeVarintEvm(dAtA, i, uint64(j2))
+		i -= j3
+		copy(dAtA[i:], dAtA4[:j3])
+		i = encodeVarintEvm(dAtA, i, uint64(j3))
 		i--
 		dAtA[i] = 0x22
 	}
@@ -1157,6 +1261,56 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *FeeDistribution) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeDistribution) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeDistribution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BaseFeeRecipient) > 0 {
+		i -= len(m.BaseFeeRecipient)
+		copy(dAtA[i:], m.BaseFeeRecipient)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.BaseFeeRecipient)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size := m.BaseFeeShare.Size()
+		i -= size
+		if _, err := m.BaseFeeShare.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvm(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.BurnBaseFee {
+		i--
+		if m.BurnBaseFee {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *AccessControl) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1845,6 +1999,16 @@ func (m *TraceConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.TracePrecompiles {
+		i--
+		if m.TracePrecompiles {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x70
+	}
 	if len(m.TracerJsonConfig) > 0 {
 		i -= len(m.TracerJsonConfig)
 		copy(dAtA[i:], m.TracerJsonConfig)
@@ -2030,6 +2194,26 @@ func (m *Params) Size() (n int) {
 			n += 1 + l + sovEvm(uint64(l))
 		}
 	}
+	l = m.FeeDistribution.Size()
+	n += 1 + l + sovEvm(uint64(l))
+	return n
+}
+
+func (m *FeeDistribution) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BurnBaseFee {
+		n += 2
+	}
+	l = m.BaseFeeShare.Size()
+	n += 1 + l + sovEvm(uint64(l))
+	l = len(m.BaseFeeRecipient)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
 	return n
 }
 
@@ -2336,6 +2520,9 @@ func (m *TraceConfig) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovEvm(uint64(l))
 	}
+	if m.TracePrecompiles {
+		n += 2
+	}
 	return n
 }
 
@@ -2620,6 +2807,175 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.ActiveStaticPrecompiles = append(m.ActiveStaticPrecompiles, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeDistribution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeDistribution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeDistribution) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeDistribution: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeDistribution: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnBaseFee", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BurnBaseFee = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFeeShare", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseFeeShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFeeRecipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseFeeRecipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvm(dAtA[iNdEx:])
@@ -4872,6 +5228,26 @@ func (m *TraceConfig) Unmarshal(dAtA []byte) error {
 			}
 			m.TracerJsonConfig = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TracePrecompiles", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.TracePrecompiles = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvm(dAtA[iNdEx:])
@@ -0,0 +1,23 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// feePayerKey is the context key of the account paying the fees of an
+// Ethereum transaction on behalf of its sender.
+type feePayerKey struct{}
+
+// ContextWithFeePayer sets on the context the account that paid the fees of
+// the Ethereum transaction instead of its sender, e.g. its fee granter, so
+// that the leftover gas is refunded to it.
+func ContextWithFeePayer(ctx sdk.Context, feePayer sdk.AccAddress) sdk.Context {
+	return ctx.WithValue(feePayerKey{}, feePayer)
+}
+
+// FeePayerFromContext returns the account that paid the fees of the Ethereum
+// transaction, nil if they were paid by its sender.
+func FeePayerFromContext(ctx sdk.Context) sdk.AccAddress {
+	feePayer, _ := ctx.Value(feePayerKey{}).(sdk.AccAddress)
+	return feePayer
+}
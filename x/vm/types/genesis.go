@@ -4,6 +4,8 @@ import (
 	"fmt"
 
 	"github.com/cosmos/evm/types"
+
+	"cosmossdk.io/math"
 )
 
 // Validate performs a basic validation of a GenesisAccount fields.
@@ -21,6 +23,7 @@ func DefaultGenesisState() *GenesisState {
 		Accounts:    []GenesisAccount{},
 		Params:      DefaultParams(),
 		Preinstalls: []Preinstall{},
+		BurntFees:   math.ZeroInt(),
 	}
 }
 
@@ -30,6 +33,7 @@ func NewGenesisState(params Params, accounts []GenesisAccount, preinstalls []Pre
 		Accounts:    accounts,
 		Params:      params,
 		Preinstalls: preinstalls,
+		BurntFees:   math.ZeroInt(),
 	}
 }
 
@@ -66,5 +70,9 @@ func (gs GenesisState) Validate() error {
 		seenPreinstalls[preinstall.Address] = true
 	}
 
+	if !gs.BurntFees.IsNil() && gs.BurntFees.IsNegative() {
+		return fmt.Errorf("burnt fees cannot be negative: %s", gs.BurntFees)
+	}
+
 	return gs.Params.Validate()
 }
@@ -4,6 +4,7 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -32,6 +33,9 @@ type GenesisState struct {
 	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
 	// preinstalls defines a set of predefined contracts
 	Preinstalls []Preinstall `protobuf:"bytes,3,rep,name=preinstalls,proto3" json:"preinstalls"`
+	// burnt_fees is the total amount of EVM transaction fees burnt by the chain,
+	// in the 18 decimals representation of the EVM denom
+	BurntFees cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=burnt_fees,json=burntFees,proto3,customtype=cosmossdk.io/math.Int" json:"burnt_fees"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -162,29 +166,32 @@ func init() {
 func init() { proto.RegisterFile("cosmos/evm/vm/v1/genesis.proto", fileDescriptor_e6b6f3a3ceb84d18) }
 
 var fileDescriptor_e6b6f3a3ceb84d18 = []byte{
-	// 340 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4b, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x4f, 0x2d, 0xcb, 0xd5, 0x07, 0x21, 0x43, 0xfd, 0xf4, 0xd4, 0xbc, 0xd4, 0xe2,
-	0xcc, 0x62, 0xbd, 0x82, 0xa2, 0xfc, 0x92, 0x7c, 0x21, 0x01, 0x88, 0xbc, 0x5e, 0x6a, 0x59, 0xae,
-	0x1e, 0x08, 0x19, 0x4a, 0x09, 0x26, 0xe6, 0x66, 0xe6, 0xe5, 0xeb, 0x83, 0x49, 0x88, 0x22, 0x29,
-	0x29, 0x0c, 0x43, 0x40, 0xca, 0x21, 0x72, 0x22, 0xe9, 0xf9, 0xe9, 0xf9, 0x60, 0xa6, 0x3e, 0x88,
-	0x05, 0x11, 0x55, 0xba, 0xcf, 0xc8, 0xc5, 0xe3, 0x0e, 0xb1, 0x28, 0xb8, 0x24, 0xb1, 0x24, 0x55,
-	0xc8, 0x9d, 0x8b, 0x23, 0x31, 0x39, 0x39, 0xbf, 0x34, 0xaf, 0xa4, 0x58, 0x82, 0x51, 0x81, 0x59,
-	0x83, 0xdb, 0x48, 0x41, 0x0f, 0xdd, 0x6a, 0x3d, 0xa8, 0x0e, 0x47, 0x88, 0x42, 0x27, 0xce, 0x13,
-	0xf7, 0xe4, 0x19, 0x56, 0x3c, 0xdf, 0xa0, 0xc5, 0x18, 0x04, 0xd7, 0x2c, 0x64, 0xcd, 0xc5, 0x56,
-	0x90, 0x58, 0x94, 0x98, 0x5b, 0x2c, 0xc1, 0xa4, 0xc0, 0xa8, 0xc1, 0x6d, 0x24, 0x81, 0x69, 0x4c,
-	0x00, 0x58, 0x1e, 0x59, 0x3b, 0x54, 0x8b, 0x90, 0x27, 0x17, 0x77, 0x41, 0x51, 0x6a, 0x66, 0x5e,
-	0x71, 0x49, 0x62, 0x4e, 0x4e, 0xb1, 0x04, 0x33, 0xd8, 0x21, 0x32, 0x58, 0x4c, 0x80, 0x2b, 0x42,
-	0x36, 0x05, 0x59, 0xaf, 0x52, 0x3b, 0x23, 0x17, 0x1f, 0xaa, 0x7b, 0x85, 0x24, 0xb8, 0xd8, 0x13,
-	0x53, 0x52, 0x8a, 0x52, 0x8b, 0x41, 0x5e, 0x64, 0xd4, 0xe0, 0x0c, 0x82, 0x71, 0x85, 0x84, 0xb8,
-	0x58, 0x92, 0xf3, 0x53, 0x52, 0xc1, 0x4e, 0xe6, 0x0c, 0x02, 0xb3, 0x85, 0xdc, 0xb9, 0xd8, 0x8b,
-	0x4b, 0xf2, 0x8b, 0x12, 0xd3, 0x53, 0xa1, 0xee, 0x10, 0xc7, 0x74, 0x07, 0x38, 0xec, 0x9c, 0x44,
-	0x40, 0x4e, 0x58, 0x75, 0x5f, 0x9e, 0x3d, 0x18, 0xa2, 0x1e, 0xe2, 0x1a, 0x98, 0x6e, 0x27, 0xab,
-	0x13, 0x8f, 0xe4, 0x18, 0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39,
-	0x86, 0x0b, 0x8f, 0xe5, 0x18, 0x6e, 0x3c, 0x96, 0x63, 0x88, 0x52, 0x48, 0xcf, 0x2c, 0xc9, 0x28,
-	0x4d, 0xd2, 0x4b, 0xce, 0xcf, 0xd5, 0x47, 0x8a, 0xc2, 0x0a, 0x50, 0x24, 0x96, 0x54, 0x16, 0xa4,
-	0x16, 0x27, 0xb1, 0x81, 0xa3, 0xcb, 0x18, 0x10, 0x00, 0x00, 0xff, 0xff, 0x3a, 0x23, 0x28, 0x5f,
-	0x27, 0x02, 0x00, 0x00,
+	// 388 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x52, 0x31, 0x6f, 0xda, 0x40,
+	0x14, 0xf6, 0x01, 0x82, 0xfa, 0xa8, 0xaa, 0xf6, 0x44, 0x55, 0x0b, 0xb5, 0xc6, 0x62, 0xb2, 0x3a,
+	0x9c, 0x05, 0xdd, 0xda, 0x2e, 0xf5, 0x50, 0xc4, 0x16, 0x99, 0x2d, 0x4b, 0x74, 0xd8, 0x17, 0x63,
+	0x05, 0xfb, 0x2c, 0xdf, 0x81, 0x92, 0x5f, 0x90, 0x35, 0x7f, 0x20, 0x7b, 0x94, 0x29, 0x3f, 0x83,
+	0x91, 0x31, 0xca, 0x40, 0x22, 0x18, 0xf2, 0x37, 0xa2, 0xbb, 0x03, 0xe4, 0x84, 0x48, 0x4f, 0xd6,
+	0xb3, 0xdf, 0xf7, 0x7d, 0xef, 0x7d, 0xcf, 0x0f, 0xda, 0x21, 0xe3, 0x29, 0xe3, 0x1e, 0x9d, 0xa7,
+	0x9e, 0x8c, 0x9e, 0x17, 0xd3, 0x8c, 0xf2, 0x84, 0xe3, 0xbc, 0x60, 0x82, 0xa1, 0xcf, 0xba, 0x8e,
+	0xe9, 0x3c, 0xc5, 0x32, 0x7a, 0xed, 0x2f, 0x24, 0x4d, 0x32, 0xe6, 0xa9, 0xa7, 0x06, 0xb5, 0xdb,
+	0x07, 0x22, 0x12, 0xae, 0x6b, 0xad, 0x98, 0xc5, 0x4c, 0xa5, 0x9e, 0xcc, 0xf4, 0xd7, 0xee, 0x75,
+	0x05, 0x7e, 0x1c, 0xe8, 0x46, 0x23, 0x41, 0x04, 0x45, 0x03, 0xf8, 0x81, 0x84, 0x21, 0x9b, 0x65,
+	0x82, 0x5b, 0xc0, 0xa9, 0xba, 0xcd, 0xbe, 0x83, 0xdf, 0xb6, 0xc6, 0x5b, 0xc6, 0x3f, 0x0d, 0xf4,
+	0xcd, 0xc5, 0xaa, 0x63, 0xdc, 0x3c, 0xdf, 0xfd, 0x04, 0xc1, 0x9e, 0x8c, 0xfe, 0xc0, 0x7a, 0x4e,
+	0x0a, 0x92, 0x72, 0xab, 0xe2, 0x00, 0xb7, 0xd9, 0xb7, 0x0e, 0x65, 0x8e, 0x54, 0xbd, 0x4c, 0xdf,
+	0x52, 0xd0, 0x10, 0x36, 0xf3, 0x82, 0x26, 0x19, 0x17, 0x64, 0x3a, 0xe5, 0x56, 0x55, 0x0d, 0xf2,
+	0xfd, 0x1d, 0x85, 0x3d, 0xa8, 0xac, 0x52, 0xe6, 0xa2, 0xbf, 0x10, 0x8e, 0x67, 0x45, 0x26, 0x4e,
+	0x4e, 0x29, 0xe5, 0x56, 0xcd, 0x01, 0xae, 0xe9, 0xff, 0x90, 0xd8, 0x87, 0x55, 0xe7, 0xab, 0x16,
+	0xe4, 0xd1, 0x19, 0x4e, 0x98, 0x97, 0x12, 0x31, 0xc1, 0xc3, 0x4c, 0x04, 0xa6, 0x22, 0xfc, 0xa7,
+	0x94, 0x77, 0x2f, 0x01, 0xfc, 0xf4, 0xda, 0x2d, 0xb2, 0x60, 0x83, 0x44, 0x51, 0x41, 0xb9, 0x5c,
+	0x10, 0x70, 0xcd, 0x60, 0xf7, 0x8a, 0x10, 0xac, 0x85, 0x2c, 0xa2, 0xca, 0xb0, 0x19, 0xa8, 0x1c,
+	0x0d, 0x60, 0x83, 0x0b, 0x56, 0x90, 0x98, 0x6e, 0x5d, 0x7c, 0x3b, 0x74, 0xa1, 0x36, 0xef, 0xb7,
+	0xe4, 0x50, 0xb7, 0x8f, 0x9d, 0xc6, 0x48, 0xe3, 0xb5, 0x97, 0x1d, 0xdb, 0xff, 0xbd, 0x58, 0xdb,
+	0x60, 0xb9, 0xb6, 0xc1, 0xd3, 0xda, 0x06, 0x57, 0x1b, 0xdb, 0x58, 0x6e, 0x6c, 0xe3, 0x7e, 0x63,
+	0x1b, 0xc7, 0x4e, 0x9c, 0x88, 0xc9, 0x6c, 0x8c, 0x43, 0x96, 0x7a, 0xa5, 0x03, 0x38, 0x97, 0x27,
+	0x20, 0x2e, 0x72, 0xca, 0xc7, 0x75, 0xf5, 0xb3, 0x7f, 0xbd, 0x0c, 0x00, 0xf8, 0x01, 0x99, 0x68,
+	0x65, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -207,6 +214,16 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.BurntFees.Size()
+		i -= size
+		if _, err := m.BurntFees.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
 	if len(m.Preinstalls) > 0 {
 		for iNdEx := len(m.Preinstalls) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -330,6 +347,8 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	l = m.BurntFees.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -492,6 +511,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurntFees", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BurntFees.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -52,6 +52,24 @@ func (_m *FeeMarketKeeper) GetBaseFee(ctx types.Context) math.LegacyDec {
 	return r0
 }
 
+// GetBlobBaseFee provides a mock function with given fields: ctx
+func (_m *FeeMarketKeeper) GetBlobBaseFee(ctx types.Context) math.LegacyDec {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBlobBaseFee")
+	}
+
+	var r0 math.LegacyDec
+	if rf, ok := ret.Get(0).(func(types.Context) math.LegacyDec); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(math.LegacyDec)
+	}
+
+	return r0
+}
+
 // GetParams provides a mock function with given fields: ctx
 func (_m *FeeMarketKeeper) GetParams(ctx types.Context) feemarkettypes.Params {
 	ret := _m.Called(ctx)
@@ -84,6 +84,44 @@ func newMsgEthereumTx(
 	}
 
 	switch {
+	case tx.AuthorizationList != nil:
+		gtc := sdkmath.NewIntFromBigInt(tx.GasTipCap)
+		gfc := sdkmath.NewIntFromBigInt(tx.GasFeeCap)
+
+		txData = &SetCodeTx{
+			ChainID:        cid,
+			Amount:         amt,
+			To:             toAddr,
+			GasTipCap:      &gtc,
+			GasFeeCap:      &gfc,
+			Nonce:          tx.Nonce,
+			GasLimit:       tx.GasLimit,
+			Data:           tx.Input,
+			Accesses:       NewAccessList(tx.Accesses),
+			Authorizations: NewAuthorizationList(tx.AuthorizationList),
+		}
+	case tx.BlobHashes != nil:
+		gtc := sdkmath.NewIntFromBigInt(tx.GasTipCap)
+		gfc := sdkmath.NewIntFromBigInt(tx.GasFeeCap)
+		bfc := sdkmath.NewIntFromBigInt(tx.BlobFeeCap)
+		hashes := make([]string, len(tx.BlobHashes))
+		for i, hash := range tx.BlobHashes {
+			hashes[i] = hash.Hex()
+		}
+
+		txData = &BlobTx{
+			ChainID:    cid,
+			Amount:     amt,
+			To:         toAddr,
+			GasTipCap:  &gtc,
+			GasFeeCap:  &gfc,
+			Nonce:      tx.Nonce,
+			GasLimit:   tx.GasLimit,
+			Data:       tx.Input,
+			Accesses:   NewAccessList(tx.Accesses),
+			BlobFeeCap: &bfc,
+			BlobHashes: hashes,
+		}
 	case tx.GasFeeCap != nil:
 		gtc := sdkmath.NewIntFromBigInt(tx.GasTipCap)
 		gfc := sdkmath.NewIntFromBigInt(tx.GasFeeCap)
@@ -368,6 +406,13 @@ func (msg MsgEthereumTx) AsMessage(baseFee *big.Int) (*core.Message, error) {
 		Data:       txData.GetData(),
 		AccessList: txData.GetAccessList(),
 	}
+	if blobTx, ok := txData.(*BlobTx); ok {
+		ethMsg.BlobGasFeeCap = blobTx.GetBlobFeeCap()
+		ethMsg.BlobHashes = blobTx.GetBlobHashes()
+	}
+	if setCodeTx, ok := txData.(*SetCodeTx); ok {
+		ethMsg.SetCodeAuthorizations = setCodeTx.GetAuthorizationList()
+	}
 	return &ethMsg, nil
 }
 
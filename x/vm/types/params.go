@@ -14,6 +14,9 @@ import (
 	host "github.com/cosmos/ibc-go/v10/modules/core/24-host"
 
 	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 var (
@@ -43,6 +46,11 @@ var (
 			AccessControlList: DefaultCallAllowlistAddresses,
 		},
 	}
+	// DefaultFeeDistribution sends all the EVM transaction fees to the fee collector
+	DefaultFeeDistribution = FeeDistribution{
+		BurnBaseFee:  false,
+		BaseFeeShare: math.LegacyZeroDec(),
+	}
 )
 
 // NewParams creates a new Params instance
@@ -59,6 +67,7 @@ func NewParams(
 		ActiveStaticPrecompiles: activeStaticPrecompiles,
 		EVMChannels:             evmChannels,
 		AccessControl:           accessControl,
+		FeeDistribution:         DefaultFeeDistribution,
 	}
 }
 
@@ -71,6 +80,7 @@ func DefaultParams() Params {
 		ActiveStaticPrecompiles: DefaultStaticPrecompiles,
 		EVMChannels:             DefaultEVMChannels,
 		AccessControl:           DefaultAccessControl,
+		FeeDistribution:         DefaultFeeDistribution,
 	}
 }
 
@@ -110,6 +120,10 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := p.FeeDistribution.Validate(); err != nil {
+		return err
+	}
+
 	return validateChannels(p.EVMChannels)
 }
 
@@ -152,6 +166,34 @@ func (act AccessControlType) Validate() error {
 	return validateAllowlistAddresses(act.AccessControlList)
 }
 
+// Validate checks that the base fee share is a valid fraction, sent to a valid
+// recipient. A nil share is handled as zero.
+func (fd FeeDistribution) Validate() error {
+	if fd.BaseFeeRecipient != "" {
+		if _, err := sdk.AccAddressFromBech32(fd.BaseFeeRecipient); err != nil {
+			return fmt.Errorf("invalid base fee recipient %s: %w", fd.BaseFeeRecipient, err)
+		}
+	}
+
+	share := fd.GetBaseFeeShare()
+	if share.IsNegative() || share.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("base fee share must be between 0 and 1, got %s", share)
+	}
+	if share.IsPositive() && fd.BaseFeeRecipient == "" {
+		return fmt.Errorf("base fee recipient cannot be empty with a base fee share of %s", share)
+	}
+	return nil
+}
+
+// GetBaseFeeShare returns the share of the base fee sent to the base fee
+// recipient, or zero if unset.
+func (fd FeeDistribution) GetBaseFeeShare() math.LegacyDec {
+	if fd.BaseFeeShare.IsNil() {
+		return math.LegacyZeroDec()
+	}
+	return fd.BaseFeeShare
+}
+
 func validateAccessType(i interface{}) error {
 	accessType, ok := i.(AccessType)
 	if !ok {
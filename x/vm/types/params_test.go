@@ -5,6 +5,8 @@ import (
 
 	ethparams "github.com/ethereum/go-ethereum/params"
 	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
 )
 
 func TestParamsValidate(t *testing.T) {
@@ -49,6 +51,47 @@ func TestParamsValidate(t *testing.T) {
 			},
 			errContains: "precompiles need to be sorted",
 		},
+		{
+			name: "valid fee distribution",
+			params: Params{
+				FeeDistribution: FeeDistribution{
+					BurnBaseFee:      true,
+					BaseFeeShare:     math.LegacyNewDecWithPrec(1, 1),
+					BaseFeeRecipient: "cosmos1qql8ag4cluz6r4dz28p3w00dnc9w8ueulg2gmc",
+				},
+			},
+			expPass: true,
+		},
+		{
+			name: "invalid base fee share",
+			params: Params{
+				FeeDistribution: FeeDistribution{
+					BurnBaseFee:      true,
+					BaseFeeShare:     math.LegacyNewDecWithPrec(11, 1),
+					BaseFeeRecipient: "cosmos1qql8ag4cluz6r4dz28p3w00dnc9w8ueulg2gmc",
+				},
+			},
+			errContains: "base fee share must be between 0 and 1",
+		},
+		{
+			name: "base fee share without recipient",
+			params: Params{
+				FeeDistribution: FeeDistribution{
+					BurnBaseFee:  true,
+					BaseFeeShare: math.LegacyNewDecWithPrec(1, 1),
+				},
+			},
+			errContains: "base fee recipient cannot be empty",
+		},
+		{
+			name: "invalid base fee recipient",
+			params: Params{
+				FeeDistribution: FeeDistribution{
+					BaseFeeRecipient: "invalid",
+				},
+			},
+			errContains: "invalid base fee recipient",
+		},
 	}
 
 	for _, tc := range testCases {
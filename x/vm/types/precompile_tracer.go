@@ -0,0 +1,179 @@
+package types
+
+import (
+	"encoding/json"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/tracing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// precompileTracerKey is the context key of the precompile tracer of a trace.
+type precompileTracerKey struct{}
+
+// PrecompileCall is the Cosmos SDK execution of a precompile call frame.
+type PrecompileCall struct {
+	// Method is the name of the precompile ABI method called
+	Method string `json:"method"`
+	// Args are the decoded arguments of the call, by name
+	Args map[string]interface{} `json:"args,omitempty"`
+	// Messages are the Cosmos SDK messages executed by the precompile
+	Messages []json.RawMessage `json:"messages,omitempty"`
+	// Events are the Cosmos SDK events emitted by the precompile
+	Events sdk.StringEvents `json:"events,omitempty"`
+}
+
+// precompileFrame is a precompile call being traced.
+type precompileFrame struct {
+	call         PrecompileCall
+	eventManager sdk.EventManagerI
+	eventsStart  int
+}
+
+// PrecompileTracer records the Cosmos SDK execution of the precompile calls of
+// a transaction, and annotates the matching frames of the callTracer result.
+// Frames are identified by their index in the depth-first order of the calls,
+// which is the order of the callTracer frames.
+type PrecompileTracer struct {
+	cdc    codec.JSONCodec
+	frame  int
+	stack  []int
+	frames map[int]*precompileFrame
+}
+
+// NewPrecompileTracer returns a precompile tracer encoding the executed
+// messages with the given codec.
+func NewPrecompileTracer(cdc codec.JSONCodec) *PrecompileTracer {
+	return &PrecompileTracer{
+		cdc:    cdc,
+		frame:  -1,
+		frames: make(map[int]*precompileFrame),
+	}
+}
+
+// ContextWithPrecompileTracer sets the precompile tracer on the context, for
+// the precompiles to record their execution.
+func ContextWithPrecompileTracer(ctx sdk.Context, tracer *PrecompileTracer) sdk.Context {
+	return ctx.WithValue(precompileTracerKey{}, tracer)
+}
+
+// PrecompileTracerFromContext returns the precompile tracer of the context,
+// nil if precompile calls are not traced.
+func PrecompileTracerFromContext(ctx sdk.Context) *PrecompileTracer {
+	tracer, _ := ctx.Value(precompileTracerKey{}).(*PrecompileTracer)
+	return tracer
+}
+
+// WrapHooks returns a copy of the hooks that also keeps track of the current
+// call frame.
+func (t *PrecompileTracer) WrapHooks(hooks *tracing.Hooks) *tracing.Hooks {
+	wrapped := *hooks
+	wrapped.OnEnter = func(depth int, typ byte, from, to common.Address, input []byte, gas uint64, value *big.Int) {
+		t.frame++
+		t.stack = append(t.stack, t.frame)
+		if hooks.OnEnter != nil {
+			hooks.OnEnter(depth, typ, from, to, input, gas, value)
+		}
+	}
+	wrapped.OnExit = func(depth int, output []byte, gasUsed uint64, err error, reverted bool) {
+		if len(t.stack) > 0 {
+			t.onFrameExit(t.stack[len(t.stack)-1])
+			t.stack = t.stack[:len(t.stack)-1]
+		}
+		if hooks.OnExit != nil {
+			hooks.OnExit(depth, output, gasUsed, err, reverted)
+		}
+	}
+	return &wrapped
+}
+
+// OnPrecompileCall records the method and arguments of the precompile call of
+// the current frame. The events emitted on the context from now on are
+// recorded until the frame exits.
+func (t *PrecompileTracer) OnPrecompileCall(ctx sdk.Context, method *abi.Method, args []interface{}) {
+	if len(t.stack) == 0 {
+		return
+	}
+
+	call := PrecompileCall{Method: method.Name}
+	if len(args) > 0 {
+		call.Args = make(map[string]interface{}, len(args))
+		for i, arg := range args {
+			name := method.Inputs[i].Name
+			if name == "" {
+				name = method.Inputs[i].Type.String()
+			}
+			call.Args[name] = arg
+		}
+	}
+
+	eventManager := ctx.EventManager()
+	t.frames[t.stack[len(t.stack)-1]] = &precompileFrame{
+		call:         call,
+		eventManager: eventManager,
+		eventsStart:  len(eventManager.Events()),
+	}
+}
+
+// OnMsg records a Cosmos SDK message executed by the precompile call of the
+// current frame.
+func (t *PrecompileTracer) OnMsg(msg sdk.Msg) {
+	if len(t.stack) == 0 {
+		return
+	}
+	frame, found := t.frames[t.stack[len(t.stack)-1]]
+	if !found {
+		return
+	}
+
+	bz, err := t.cdc.MarshalInterfaceJSON(msg)
+	if err != nil {
+		// fallback to the message type only
+		bz, _ = json.Marshal(map[string]string{"@type": sdk.MsgTypeURL(msg)})
+	}
+	frame.call.Messages = append(frame.call.Messages, bz)
+}
+
+// onFrameExit records the events emitted by the precompile call of the frame.
+func (t *PrecompileTracer) onFrameExit(index int) {
+	frame, found := t.frames[index]
+	if !found {
+		return
+	}
+	// the events of a reverted call are kept, as its frame holds the error
+	if events := frame.eventManager.Events(); len(events) > frame.eventsStart {
+		frame.call.Events = sdk.StringifyEvents(events[frame.eventsStart:].ToABCIEvents())
+	}
+}
+
+// AnnotateCallFrames adds the recorded precompile calls to the matching
+// frames of the callTracer result, under the "precompile" field.
+func (t *PrecompileTracer) AnnotateCallFrames(result json.RawMessage) (json.RawMessage, error) {
+	var root map[string]interface{}
+	if err := json.Unmarshal(result, &root); err != nil {
+		return nil, err
+	}
+
+	index := 0
+	var annotate func(frame map[string]interface{})
+	annotate = func(frame map[string]interface{}) {
+		if precompileFrame, found := t.frames[index]; found {
+			frame["precompile"] = precompileFrame.call
+		}
+		index++
+
+		calls, _ := frame["calls"].([]interface{})
+		for _, call := range calls {
+			if callFrame, ok := call.(map[string]interface{}); ok {
+				annotate(callFrame)
+			}
+		}
+	}
+	annotate(root)
+
+	return json.Marshal(root)
+}
@@ -0,0 +1,73 @@
+package types_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/tracing"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/evm/x/vm/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestPrecompileTracer(t *testing.T) {
+	registry := codectypes.NewInterfaceRegistry()
+	banktypes.RegisterInterfaces(registry)
+	tracer := types.NewPrecompileTracer(codec.NewProtoCodec(registry))
+	hooks := tracer.WrapHooks(&tracing.Hooks{})
+
+	uint256Type, err := abi.NewType("uint256", "", nil)
+	require.NoError(t, err)
+	method := abi.NewMethod("send", "send", abi.Function, "", false, false,
+		abi.Arguments{{Name: "amount", Type: uint256Type}}, nil)
+
+	em := sdk.NewEventManager()
+	em.EmitEvent(sdk.NewEvent("before"))
+	baseCtx := sdk.Context{}.WithContext(context.Background())
+	ctx := types.ContextWithPrecompileTracer(baseCtx.WithEventManager(em), tracer)
+	require.Equal(t, tracer, types.PrecompileTracerFromContext(ctx))
+	require.Nil(t, types.PrecompileTracerFromContext(baseCtx))
+
+	// contract call (frame 0) calling another contract (frame 1) and then a
+	// precompile (frame 2)
+	hooks.OnEnter(0, 0, common.Address{}, common.Address{}, nil, 0, nil)
+	hooks.OnEnter(1, 0, common.Address{}, common.Address{}, nil, 0, nil)
+	hooks.OnExit(1, nil, 0, nil, false)
+	hooks.OnEnter(1, 0, common.Address{}, common.Address{}, nil, 0, nil)
+	tracer.OnPrecompileCall(ctx, &method, []interface{}{"1"})
+	tracer.OnMsg(&banktypes.MsgSend{FromAddress: "from", ToAddress: "to"})
+	em.EmitEvent(sdk.NewEvent("transfer", sdk.NewAttribute("amount", "1")))
+	hooks.OnExit(1, nil, 0, nil, false)
+	em.EmitEvent(sdk.NewEvent("after"))
+	hooks.OnExit(0, nil, 0, nil, false)
+
+	result, err := tracer.AnnotateCallFrames(json.RawMessage(`{"type":"CALL","calls":[{"type":"CALL"},{"type":"CALL"}]}`))
+	require.NoError(t, err)
+
+	var root struct {
+		Precompile *types.PrecompileCall `json:"precompile"`
+		Calls      []struct {
+			Precompile *types.PrecompileCall `json:"precompile"`
+		} `json:"calls"`
+	}
+	require.NoError(t, json.Unmarshal(result, &root))
+	require.Nil(t, root.Precompile)
+	require.Len(t, root.Calls, 2)
+	require.Nil(t, root.Calls[0].Precompile)
+
+	call := root.Calls[1].Precompile
+	require.NotNil(t, call)
+	require.Equal(t, "send", call.Method)
+	require.Equal(t, map[string]interface{}{"amount": "1"}, call.Args)
+	require.Len(t, call.Messages, 1)
+	require.JSONEq(t, `{"@type":"/cosmos.bank.v1beta1.MsgSend","from_address":"from","to_address":"to","amount":[]}`, string(call.Messages[0]))
+	require.Equal(t, sdk.StringEvents{{Type: "transfer", Attributes: []sdk.Attribute{{Key: "amount", Value: "1"}}}}, call.Events)
+}
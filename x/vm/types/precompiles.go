@@ -13,6 +13,8 @@ const (
 	BankPrecompileAddress         = "0x0000000000000000000000000000000000000804"
 	GovPrecompileAddress          = "0x0000000000000000000000000000000000000805"
 	SlashingPrecompileAddress     = "0x0000000000000000000000000000000000000806"
+	AuthzPrecompileAddress        = "0x0000000000000000000000000000000000000807"
+	FeegrantPrecompileAddress     = "0x0000000000000000000000000000000000000808"
 )
 
 // AvailableStaticPrecompiles defines the full list of all available EVM extension addresses.
@@ -29,4 +31,6 @@ var AvailableStaticPrecompiles = []string{
 	BankPrecompileAddress,
 	GovPrecompileAddress,
 	SlashingPrecompileAddress,
+	AuthzPrecompileAddress,
+	FeegrantPrecompileAddress,
 }
@@ -881,6 +881,9 @@ type EthCallRequest struct {
 	ProposerAddress github_com_cosmos_cosmos_sdk_types.ConsAddress `protobuf:"bytes,3,opt,name=proposer_address,json=proposerAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.ConsAddress" json:"proposer_address,omitempty"`
 	// chain_id is the eip155 chain id parsed from the requested block header
 	ChainId int64 `protobuf:"varint,4,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// overrides uses the same json format as the json rpc api state overrides,
+	// they are applied to the state before the call is executed.
+	Overrides []byte `protobuf:"bytes,5,opt,name=overrides,proto3" json:"overrides,omitempty"`
 }
 
 func (m *EthCallRequest) Reset()         { *m = EthCallRequest{} }
@@ -944,6 +947,13 @@ func (m *EthCallRequest) GetChainId() int64 {
 	return 0
 }
 
+func (m *EthCallRequest) GetOverrides() []byte {
+	if m != nil {
+		return m.Overrides
+	}
+	return nil
+}
+
 // EstimateGasResponse defines EstimateGas response
 type EstimateGasResponse struct {
 	// gas returns the estimated gas
@@ -1009,6 +1019,125 @@ func (m *EstimateGasResponse) GetVmError() string {
 	return ""
 }
 
+// SimulateV1Request defines SimulateV1 request
+type SimulateV1Request struct {
+	// opts uses the same json format as the json rpc api simulation options.
+	Opts []byte `protobuf:"bytes,1,opt,name=opts,proto3" json:"opts,omitempty"`
+	// gas_cap defines the default gas cap to be used
+	GasCap uint64 `protobuf:"varint,2,opt,name=gas_cap,json=gasCap,proto3" json:"gas_cap,omitempty"`
+	// proposer_address of the requested block in hex format
+	ProposerAddress github_com_cosmos_cosmos_sdk_types.ConsAddress `protobuf:"bytes,3,opt,name=proposer_address,json=proposerAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.ConsAddress" json:"proposer_address,omitempty"`
+	// chain_id is the eip155 chain id parsed from the requested block header
+	ChainId int64 `protobuf:"varint,4,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+}
+
+func (m *SimulateV1Request) Reset()         { *m = SimulateV1Request{} }
+func (m *SimulateV1Request) String() string { return proto.CompactTextString(m) }
+func (*SimulateV1Request) ProtoMessage()    {}
+func (*SimulateV1Request) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e8f08e175b3ef0c, []int{20}
+}
+func (m *SimulateV1Request) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SimulateV1Request) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SimulateV1Request.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SimulateV1Request) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SimulateV1Request.Merge(m, src)
+}
+func (m *SimulateV1Request) XXX_Size() int {
+	return m.Size()
+}
+func (m *SimulateV1Request) XXX_DiscardUnknown() {
+	xxx_messageInfo_SimulateV1Request.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SimulateV1Request proto.InternalMessageInfo
+
+func (m *SimulateV1Request) GetOpts() []byte {
+	if m != nil {
+		return m.Opts
+	}
+	return nil
+}
+
+func (m *SimulateV1Request) GetGasCap() uint64 {
+	if m != nil {
+		return m.GasCap
+	}
+	return 0
+}
+
+func (m *SimulateV1Request) GetProposerAddress() github_com_cosmos_cosmos_sdk_types.ConsAddress {
+	if m != nil {
+		return m.ProposerAddress
+	}
+	return nil
+}
+
+func (m *SimulateV1Request) GetChainId() int64 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
+}
+
+// SimulateV1Response defines SimulateV1 response
+type SimulateV1Response struct {
+	// data is the json encoded list of simulated blocks
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (m *SimulateV1Response) Reset()         { *m = SimulateV1Response{} }
+func (m *SimulateV1Response) String() string { return proto.CompactTextString(m) }
+func (*SimulateV1Response) ProtoMessage()    {}
+func (*SimulateV1Response) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e8f08e175b3ef0c, []int{21}
+}
+func (m *SimulateV1Response) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SimulateV1Response) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SimulateV1Response.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SimulateV1Response) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SimulateV1Response.Merge(m, src)
+}
+func (m *SimulateV1Response) XXX_Size() int {
+	return m.Size()
+}
+func (m *SimulateV1Response) XXX_DiscardUnknown() {
+	xxx_messageInfo_SimulateV1Response.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SimulateV1Response proto.InternalMessageInfo
+
+func (m *SimulateV1Response) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
 // QueryTraceTxRequest defines TraceTx request
 type QueryTraceTxRequest struct {
 	// msg is the MsgEthereumTx for the requested transaction
@@ -1036,7 +1165,7 @@ func (m *QueryTraceTxRequest) Reset()         { *m = QueryTraceTxRequest{} }
 func (m *QueryTraceTxRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryTraceTxRequest) ProtoMessage()    {}
 func (*QueryTraceTxRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e8f08e175b3ef0c, []int{20}
+	return fileDescriptor_0e8f08e175b3ef0c, []int{22}
 }
 func (m *QueryTraceTxRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1138,7 +1267,7 @@ func (m *QueryTraceTxResponse) Reset()         { *m = QueryTraceTxResponse{} }
 func (m *QueryTraceTxResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryTraceTxResponse) ProtoMessage()    {}
 func (*QueryTraceTxResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e8f08e175b3ef0c, []int{21}
+	return fileDescriptor_0e8f08e175b3ef0c, []int{23}
 }
 func (m *QueryTraceTxResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1198,7 +1327,7 @@ func (m *QueryTraceBlockRequest) Reset()         { *m = QueryTraceBlockRequest{}
 func (m *QueryTraceBlockRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryTraceBlockRequest) ProtoMessage()    {}
 func (*QueryTraceBlockRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e8f08e175b3ef0c, []int{22}
+	return fileDescriptor_0e8f08e175b3ef0c, []int{24}
 }
 func (m *QueryTraceBlockRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1293,7 +1422,7 @@ func (m *QueryTraceBlockResponse) Reset()         { *m = QueryTraceBlockResponse
 func (m *QueryTraceBlockResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryTraceBlockResponse) ProtoMessage()    {}
 func (*QueryTraceBlockResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e8f08e175b3ef0c, []int{23}
+	return fileDescriptor_0e8f08e175b3ef0c, []int{25}
 }
 func (m *QueryTraceBlockResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1329,6 +1458,190 @@ func (m *QueryTraceBlockResponse) GetData() []byte {
 	return nil
 }
 
+// QueryTraceCallRequest defines TraceCall request
+type QueryTraceCallRequest struct {
+	// args uses the same json format as the json rpc api.
+	Args []byte `protobuf:"bytes,1,opt,name=args,proto3" json:"args,omitempty"`
+	// gas_cap defines the default gas cap to be used
+	GasCap uint64 `protobuf:"varint,2,opt,name=gas_cap,json=gasCap,proto3" json:"gas_cap,omitempty"`
+	// trace_config holds extra parameters to trace functions.
+	TraceConfig *TraceConfig `protobuf:"bytes,3,opt,name=trace_config,json=traceConfig,proto3" json:"trace_config,omitempty"`
+	// block_number of the block the call is executed on top of
+	BlockNumber int64 `protobuf:"varint,4,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	// block_hash (hex) of the block the call is executed on top of
+	BlockHash string `protobuf:"bytes,5,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	// block_time of the block the call is executed on top of
+	BlockTime time.Time `protobuf:"bytes,6,opt,name=block_time,json=blockTime,proto3,stdtime" json:"block_time"`
+	// proposer_address is the proposer of the requested block
+	ProposerAddress github_com_cosmos_cosmos_sdk_types.ConsAddress `protobuf:"bytes,7,opt,name=proposer_address,json=proposerAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.ConsAddress" json:"proposer_address,omitempty"`
+	// chain_id is the eip155 chain id parsed from the requested block header
+	ChainId int64 `protobuf:"varint,8,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// block_max_gas of the requested block
+	BlockMaxGas int64 `protobuf:"varint,9,opt,name=block_max_gas,json=blockMaxGas,proto3" json:"block_max_gas,omitempty"`
+	// state_overrides uses the same json format as the json rpc api state
+	// overrides, they are applied to the state before the call is traced.
+	StateOverrides []byte `protobuf:"bytes,10,opt,name=state_overrides,json=stateOverrides,proto3" json:"state_overrides,omitempty"`
+	// block_overrides uses the same json format as the json rpc api block
+	// overrides, they are applied to the block context of the traced call.
+	BlockOverrides []byte `protobuf:"bytes,11,opt,name=block_overrides,json=blockOverrides,proto3" json:"block_overrides,omitempty"`
+}
+
+func (m *QueryTraceCallRequest) Reset()         { *m = QueryTraceCallRequest{} }
+func (m *QueryTraceCallRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTraceCallRequest) ProtoMessage()    {}
+func (*QueryTraceCallRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e8f08e175b3ef0c, []int{26}
+}
+func (m *QueryTraceCallRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTraceCallRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTraceCallRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTraceCallRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTraceCallRequest.Merge(m, src)
+}
+func (m *QueryTraceCallRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTraceCallRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTraceCallRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTraceCallRequest proto.InternalMessageInfo
+
+func (m *QueryTraceCallRequest) GetArgs() []byte {
+	if m != nil {
+		return m.Args
+	}
+	return nil
+}
+
+func (m *QueryTraceCallRequest) GetGasCap() uint64 {
+	if m != nil {
+		return m.GasCap
+	}
+	return 0
+}
+
+func (m *QueryTraceCallRequest) GetTraceConfig() *TraceConfig {
+	if m != nil {
+		return m.TraceConfig
+	}
+	return nil
+}
+
+func (m *QueryTraceCallRequest) GetBlockNumber() int64 {
+	if m != nil {
+		return m.BlockNumber
+	}
+	return 0
+}
+
+func (m *QueryTraceCallRequest) GetBlockHash() string {
+	if m != nil {
+		return m.BlockHash
+	}
+	return ""
+}
+
+func (m *QueryTraceCallRequest) GetBlockTime() time.Time {
+	if m != nil {
+		return m.BlockTime
+	}
+	return time.Time{}
+}
+
+func (m *QueryTraceCallRequest) GetProposerAddress() github_com_cosmos_cosmos_sdk_types.ConsAddress {
+	if m != nil {
+		return m.ProposerAddress
+	}
+	return nil
+}
+
+func (m *QueryTraceCallRequest) GetChainId() int64 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
+}
+
+func (m *QueryTraceCallRequest) GetBlockMaxGas() int64 {
+	if m != nil {
+		return m.BlockMaxGas
+	}
+	return 0
+}
+
+func (m *QueryTraceCallRequest) GetStateOverrides() []byte {
+	if m != nil {
+		return m.StateOverrides
+	}
+	return nil
+}
+
+func (m *QueryTraceCallRequest) GetBlockOverrides() []byte {
+	if m != nil {
+		return m.BlockOverrides
+	}
+	return nil
+}
+
+// QueryTraceCallResponse defines TraceCall response
+type QueryTraceCallResponse struct {
+	// data is the response serialized in bytes
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (m *QueryTraceCallResponse) Reset()         { *m = QueryTraceCallResponse{} }
+func (m *QueryTraceCallResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTraceCallResponse) ProtoMessage()    {}
+func (*QueryTraceCallResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e8f08e175b3ef0c, []int{27}
+}
+func (m *QueryTraceCallResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTraceCallResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTraceCallResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTraceCallResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTraceCallResponse.Merge(m, src)
+}
+func (m *QueryTraceCallResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTraceCallResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTraceCallResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTraceCallResponse proto.InternalMessageInfo
+
+func (m *QueryTraceCallResponse) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
 // QueryBaseFeeRequest defines the request type for querying the EIP1559 base
 // fee.
 type QueryBaseFeeRequest struct {
@@ -1338,7 +1651,7 @@ func (m *QueryBaseFeeRequest) Reset()         { *m = QueryBaseFeeRequest{} }
 func (m *QueryBaseFeeRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryBaseFeeRequest) ProtoMessage()    {}
 func (*QueryBaseFeeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e8f08e175b3ef0c, []int{24}
+	return fileDescriptor_0e8f08e175b3ef0c, []int{28}
 }
 func (m *QueryBaseFeeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1377,7 +1690,7 @@ func (m *QueryBaseFeeResponse) Reset()         { *m = QueryBaseFeeResponse{} }
 func (m *QueryBaseFeeResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryBaseFeeResponse) ProtoMessage()    {}
 func (*QueryBaseFeeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e8f08e175b3ef0c, []int{25}
+	return fileDescriptor_0e8f08e175b3ef0c, []int{29}
 }
 func (m *QueryBaseFeeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1415,7 +1728,7 @@ func (m *QueryGlobalMinGasPriceRequest) Reset()         { *m = QueryGlobalMinGas
 func (m *QueryGlobalMinGasPriceRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGlobalMinGasPriceRequest) ProtoMessage()    {}
 func (*QueryGlobalMinGasPriceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e8f08e175b3ef0c, []int{26}
+	return fileDescriptor_0e8f08e175b3ef0c, []int{30}
 }
 func (m *QueryGlobalMinGasPriceRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1454,7 +1767,7 @@ func (m *QueryGlobalMinGasPriceResponse) Reset()         { *m = QueryGlobalMinGa
 func (m *QueryGlobalMinGasPriceResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGlobalMinGasPriceResponse) ProtoMessage()    {}
 func (*QueryGlobalMinGasPriceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e8f08e175b3ef0c, []int{27}
+	return fileDescriptor_0e8f08e175b3ef0c, []int{31}
 }
 func (m *QueryGlobalMinGasPriceResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1483,149 +1796,246 @@ func (m *QueryGlobalMinGasPriceResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryGlobalMinGasPriceResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*QueryConfigRequest)(nil), "cosmos.evm.vm.v1.QueryConfigRequest")
-	proto.RegisterType((*QueryConfigResponse)(nil), "cosmos.evm.vm.v1.QueryConfigResponse")
-	proto.RegisterType((*QueryAccountRequest)(nil), "cosmos.evm.vm.v1.QueryAccountRequest")
-	proto.RegisterType((*QueryAccountResponse)(nil), "cosmos.evm.vm.v1.QueryAccountResponse")
-	proto.RegisterType((*QueryCosmosAccountRequest)(nil), "cosmos.evm.vm.v1.QueryCosmosAccountRequest")
-	proto.RegisterType((*QueryCosmosAccountResponse)(nil), "cosmos.evm.vm.v1.QueryCosmosAccountResponse")
-	proto.RegisterType((*QueryValidatorAccountRequest)(nil), "cosmos.evm.vm.v1.QueryValidatorAccountRequest")
-	proto.RegisterType((*QueryValidatorAccountResponse)(nil), "cosmos.evm.vm.v1.QueryValidatorAccountResponse")
-	proto.RegisterType((*QueryBalanceRequest)(nil), "cosmos.evm.vm.v1.QueryBalanceRequest")
-	proto.RegisterType((*QueryBalanceResponse)(nil), "cosmos.evm.vm.v1.QueryBalanceResponse")
-	proto.RegisterType((*QueryStorageRequest)(nil), "cosmos.evm.vm.v1.QueryStorageRequest")
-	proto.RegisterType((*QueryStorageResponse)(nil), "cosmos.evm.vm.v1.QueryStorageResponse")
-	proto.RegisterType((*QueryCodeRequest)(nil), "cosmos.evm.vm.v1.QueryCodeRequest")
-	proto.RegisterType((*QueryCodeResponse)(nil), "cosmos.evm.vm.v1.QueryCodeResponse")
-	proto.RegisterType((*QueryTxLogsRequest)(nil), "cosmos.evm.vm.v1.QueryTxLogsRequest")
-	proto.RegisterType((*QueryTxLogsResponse)(nil), "cosmos.evm.vm.v1.QueryTxLogsResponse")
-	proto.RegisterType((*QueryParamsRequest)(nil), "cosmos.evm.vm.v1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "cosmos.evm.vm.v1.QueryParamsResponse")
-	proto.RegisterType((*EthCallRequest)(nil), "cosmos.evm.vm.v1.EthCallRequest")
-	proto.RegisterType((*EstimateGasResponse)(nil), "cosmos.evm.vm.v1.EstimateGasResponse")
-	proto.RegisterType((*QueryTraceTxRequest)(nil), "cosmos.evm.vm.v1.QueryTraceTxRequest")
-	proto.RegisterType((*QueryTraceTxResponse)(nil), "cosmos.evm.vm.v1.QueryTraceTxResponse")
-	proto.RegisterType((*QueryTraceBlockRequest)(nil), "cosmos.evm.vm.v1.QueryTraceBlockRequest")
-	proto.RegisterType((*QueryTraceBlockResponse)(nil), "cosmos.evm.vm.v1.QueryTraceBlockResponse")
-	proto.RegisterType((*QueryBaseFeeRequest)(nil), "cosmos.evm.vm.v1.QueryBaseFeeRequest")
-	proto.RegisterType((*QueryBaseFeeResponse)(nil), "cosmos.evm.vm.v1.QueryBaseFeeResponse")
-	proto.RegisterType((*QueryGlobalMinGasPriceRequest)(nil), "cosmos.evm.vm.v1.QueryGlobalMinGasPriceRequest")
-	proto.RegisterType((*QueryGlobalMinGasPriceResponse)(nil), "cosmos.evm.vm.v1.QueryGlobalMinGasPriceResponse")
+// QueryBurntFeesRequest defines the request type for querying the burnt fees
+type QueryBurntFeesRequest struct {
 }
 
-func init() { proto.RegisterFile("cosmos/evm/vm/v1/query.proto", fileDescriptor_0e8f08e175b3ef0c) }
-
-var fileDescriptor_0e8f08e175b3ef0c = []byte{
-	// 1624 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x58, 0xcf, 0x6f, 0x13, 0xc7,
-	0x17, 0xcf, 0xc6, 0x4e, 0xec, 0x8c, 0x13, 0x08, 0x43, 0xf8, 0xe2, 0xec, 0x37, 0xb1, 0xc3, 0x42,
-	0x7e, 0x12, 0x76, 0x49, 0x4a, 0x2b, 0x95, 0x1e, 0xda, 0x24, 0x0a, 0x81, 0x02, 0x15, 0xdd, 0x46,
-	0x3d, 0x54, 0xaa, 0xac, 0xf1, 0x7a, 0x58, 0xaf, 0xe2, 0xdd, 0x31, 0x3b, 0xeb, 0xd4, 0x81, 0xd2,
-	0x43, 0xa5, 0x22, 0x28, 0x17, 0xa4, 0xde, 0x5b, 0x8e, 0xbd, 0xb5, 0x37, 0xfe, 0x05, 0x8e, 0x48,
-	0xbd, 0x54, 0x3d, 0xd0, 0x0a, 0x2a, 0xb5, 0x7f, 0x43, 0x4f, 0xd5, 0xfc, 0x58, 0xdb, 0xeb, 0xf5,
-	0xda, 0xa1, 0xa2, 0xb7, 0x4a, 0x56, 0x32, 0x3f, 0xde, 0xbc, 0xf7, 0x79, 0x6f, 0xde, 0xbc, 0xf7,
-	0xb1, 0xc1, 0x8c, 0x45, 0xa8, 0x4b, 0xa8, 0x81, 0xf7, 0x5d, 0x83, 0x7d, 0xd6, 0x8c, 0x5b, 0x0d,
-	0xec, 0x1f, 0xe8, 0x75, 0x9f, 0x04, 0x04, 0x4e, 0x8a, 0x5d, 0x1d, 0xef, 0xbb, 0x3a, 0xfb, 0xac,
-	0xa9, 0xc7, 0x90, 0xeb, 0x78, 0xc4, 0xe0, 0x7f, 0x85, 0x90, 0xba, 0x22, 0x55, 0x94, 0x11, 0xc5,
-	0xe2, 0xb4, 0xb1, 0xbf, 0x56, 0xc6, 0x01, 0x5a, 0x33, 0xea, 0xc8, 0x76, 0x3c, 0x14, 0x38, 0xc4,
-	0x93, 0xb2, 0x6a, 0xcc, 0x1c, 0x53, 0x2d, 0xf6, 0xa6, 0x63, 0x7b, 0x41, 0x53, 0x6e, 0x4d, 0xd9,
-	0xc4, 0x26, 0x7c, 0x68, 0xb0, 0x91, 0x5c, 0x9d, 0xb1, 0x09, 0xb1, 0x6b, 0xd8, 0x40, 0x75, 0xc7,
-	0x40, 0x9e, 0x47, 0x02, 0x6e, 0x89, 0xca, 0xdd, 0xa2, 0xdc, 0xe5, 0xb3, 0x72, 0xe3, 0xa6, 0x11,
-	0x38, 0x2e, 0xa6, 0x01, 0x72, 0xeb, 0x42, 0x40, 0x9b, 0x02, 0xf0, 0x43, 0x86, 0x76, 0x8b, 0x78,
-	0x37, 0x1d, 0xdb, 0xc4, 0xb7, 0x1a, 0x98, 0x06, 0xda, 0x35, 0x70, 0x3c, 0xb2, 0x4a, 0xeb, 0xc4,
-	0xa3, 0x18, 0xbe, 0x09, 0x46, 0x2d, 0xbe, 0x92, 0x57, 0xe6, 0x94, 0xa5, 0xdc, 0xfa, 0xac, 0xde,
-	0x1d, 0x1a, 0x7d, 0xab, 0x8a, 0x1c, 0x4f, 0x1e, 0x93, 0xc2, 0xda, 0xdb, 0x52, 0xdb, 0x86, 0x65,
-	0x91, 0x86, 0x17, 0x48, 0x23, 0x30, 0x0f, 0x32, 0xa8, 0x52, 0xf1, 0x31, 0xa5, 0x5c, 0xdd, 0x98,
-	0x19, 0x4e, 0x2f, 0x66, 0xef, 0x3f, 0x2e, 0x0e, 0xfd, 0xf9, 0xb8, 0x38, 0xa4, 0x59, 0x60, 0x2a,
-	0x7a, 0x54, 0x22, 0xc9, 0x83, 0x4c, 0x19, 0xd5, 0x90, 0x67, 0xe1, 0xf0, 0xac, 0x9c, 0xc2, 0xff,
-	0x83, 0x31, 0x8b, 0x54, 0x70, 0xa9, 0x8a, 0x68, 0x35, 0x3f, 0xcc, 0xf7, 0xb2, 0x6c, 0xe1, 0x32,
-	0xa2, 0x55, 0x38, 0x05, 0x46, 0x3c, 0xc2, 0x0e, 0xa5, 0xe6, 0x94, 0xa5, 0xb4, 0x29, 0x26, 0xda,
-	0xbb, 0x60, 0x5a, 0x7a, 0xcb, 0x9c, 0xf9, 0x07, 0x28, 0xef, 0x29, 0x40, 0xed, 0xa5, 0x41, 0x82,
-	0x9d, 0x07, 0x47, 0x44, 0x9c, 0x4a, 0x51, 0x4d, 0x13, 0x62, 0x75, 0x43, 0x2c, 0x42, 0x15, 0x64,
-	0x29, 0x33, 0xca, 0xf0, 0x0d, 0x73, 0x7c, 0xad, 0x39, 0x53, 0x81, 0x84, 0xd6, 0x92, 0xd7, 0x70,
-	0xcb, 0xd8, 0x97, 0x1e, 0x4c, 0xc8, 0xd5, 0x0f, 0xf8, 0xa2, 0x76, 0x15, 0xcc, 0x70, 0x1c, 0x1f,
-	0xa3, 0x9a, 0x53, 0x41, 0x01, 0xf1, 0xbb, 0x9c, 0x39, 0x05, 0xc6, 0x2d, 0xe2, 0x75, 0xe3, 0xc8,
-	0xb1, 0xb5, 0x8d, 0x98, 0x57, 0x0f, 0x15, 0x30, 0x9b, 0xa0, 0x4d, 0x3a, 0xb6, 0x08, 0x8e, 0x86,
-	0xa8, 0xa2, 0x1a, 0x43, 0xb0, 0xaf, 0xd1, 0xb5, 0x30, 0x89, 0x36, 0xc5, 0x3d, 0xbf, 0xca, 0xf5,
-	0x9c, 0x97, 0x49, 0xd4, 0x3a, 0x3a, 0x28, 0x89, 0xb4, 0xab, 0xd2, 0xd8, 0x47, 0x01, 0xf1, 0x91,
-	0x3d, 0xd8, 0x18, 0x9c, 0x04, 0xa9, 0x3d, 0x7c, 0x20, 0xf3, 0x8d, 0x0d, 0x3b, 0xcc, 0xaf, 0x4a,
-	0xf3, 0x2d, 0x65, 0xd2, 0xfc, 0x14, 0x18, 0xd9, 0x47, 0xb5, 0x46, 0x68, 0x5c, 0x4c, 0xb4, 0xb7,
-	0xc0, 0xa4, 0x4c, 0xa5, 0xca, 0x2b, 0x39, 0xb9, 0x08, 0x8e, 0x75, 0x9c, 0x93, 0x26, 0x20, 0x48,
-	0xb3, 0xdc, 0xe7, 0xa7, 0xc6, 0x4d, 0x3e, 0xd6, 0x6e, 0xcb, 0x17, 0xbf, 0xdb, 0xbc, 0x46, 0x6c,
-	0x1a, 0x9a, 0x80, 0x20, 0xcd, 0x5f, 0x8c, 0xd0, 0xcf, 0xc7, 0xf0, 0x12, 0x00, 0xed, 0xda, 0xc5,
-	0x7d, 0xcb, 0xad, 0x2f, 0x84, 0x4f, 0x9e, 0x15, 0x3a, 0x5d, 0x94, 0x49, 0x59, 0xe8, 0xf4, 0x1b,
-	0xed, 0x50, 0x99, 0x1d, 0x27, 0x3b, 0x40, 0x3e, 0x50, 0x64, 0x60, 0x43, 0xe3, 0x12, 0xe7, 0x32,
-	0x48, 0xd7, 0x88, 0xcd, 0xbc, 0x4b, 0x2d, 0xe5, 0xd6, 0x4f, 0xc4, 0xcb, 0xca, 0x35, 0x62, 0x9b,
-	0x5c, 0x04, 0xee, 0xf4, 0x00, 0xb5, 0x38, 0x10, 0x94, 0xb0, 0xd3, 0x89, 0xaa, 0x55, 0xf9, 0x6e,
-	0x20, 0x1f, 0xb9, 0x61, 0x1c, 0x34, 0x53, 0x02, 0x0c, 0x57, 0x25, 0xc0, 0x77, 0xc0, 0x68, 0x9d,
-	0xaf, 0xc8, 0xca, 0x97, 0x8f, 0x43, 0x14, 0x27, 0x36, 0xc7, 0x9e, 0x3e, 0x2f, 0x0e, 0x7d, 0xff,
-	0xc7, 0x8f, 0x2b, 0x8a, 0x29, 0x8f, 0x68, 0x4f, 0x14, 0x70, 0x64, 0x3b, 0xa8, 0x6e, 0xa1, 0x5a,
-	0xad, 0x23, 0xdc, 0xc8, 0xb7, 0x69, 0x78, 0x31, 0x6c, 0x0c, 0x4f, 0x82, 0x8c, 0x8d, 0x68, 0xc9,
-	0x42, 0x75, 0xf9, 0x46, 0x46, 0x6d, 0x44, 0xb7, 0x50, 0x1d, 0x7e, 0x0a, 0x26, 0xeb, 0x3e, 0xa9,
-	0x13, 0x8a, 0xfd, 0xd6, 0x3b, 0x63, 0x6f, 0x64, 0x7c, 0x73, 0xfd, 0xaf, 0xe7, 0x45, 0xdd, 0x76,
-	0x82, 0x6a, 0xa3, 0xac, 0x5b, 0xc4, 0x35, 0x64, 0xf3, 0x10, 0xff, 0xce, 0xd1, 0xca, 0x9e, 0x11,
-	0x1c, 0xd4, 0x31, 0xd5, 0xb7, 0xda, 0x0f, 0xdc, 0x3c, 0x1a, 0xea, 0x0a, 0x1f, 0xe7, 0x34, 0xc8,
-	0x5a, 0xac, 0x6a, 0x97, 0x9c, 0x4a, 0x3e, 0x3d, 0xa7, 0x2c, 0xa5, 0xcc, 0x0c, 0x9f, 0x5f, 0xa9,
-	0x68, 0xbb, 0xe0, 0xf8, 0x36, 0x0d, 0x1c, 0x17, 0x05, 0x78, 0x07, 0xb5, 0xa3, 0x31, 0x09, 0x52,
-	0x36, 0x12, 0xe0, 0xd3, 0x26, 0x1b, 0xb2, 0x15, 0x1f, 0x07, 0x1c, 0xf7, 0xb8, 0xc9, 0x86, 0x4c,
-	0xeb, 0xbe, 0x5b, 0xc2, 0xbe, 0x4f, 0xc4, 0x83, 0x1e, 0x33, 0x33, 0xfb, 0xee, 0x36, 0x9b, 0x6a,
-	0x0f, 0xd2, 0x61, 0x16, 0xf8, 0xc8, 0xc2, 0xbb, 0xcd, 0x30, 0x28, 0x6b, 0x20, 0xe5, 0xd2, 0xb0,
-	0xb7, 0x14, 0xe3, 0x11, 0xbe, 0x4e, 0xed, 0xed, 0xa0, 0x8a, 0x7d, 0xdc, 0x70, 0x77, 0x9b, 0x26,
-	0x93, 0x85, 0xef, 0x81, 0xf1, 0x80, 0x29, 0x29, 0xc9, 0xbe, 0x94, 0x4a, 0xea, 0x4b, 0xdc, 0x94,
-	0xec, 0x4b, 0xb9, 0xa0, 0x3d, 0x81, 0x5b, 0x60, 0xbc, 0xee, 0xe3, 0x0a, 0xb6, 0x30, 0xa5, 0xc4,
-	0xa7, 0xf9, 0x34, 0x4f, 0xc1, 0x81, 0xd6, 0x23, 0x87, 0x58, 0x5d, 0x2d, 0xd7, 0x88, 0xb5, 0x17,
-	0x56, 0xb0, 0x11, 0x1e, 0xc6, 0x1c, 0x5f, 0x13, 0xf5, 0x0b, 0xce, 0x02, 0x20, 0x44, 0xf8, 0x33,
-	0x1b, 0xe5, 0x11, 0x19, 0xe3, 0x2b, 0xbc, 0x33, 0x5d, 0x0e, 0xb7, 0x59, 0x83, 0xce, 0x67, 0xb8,
-	0x1b, 0xaa, 0x2e, 0xba, 0xb7, 0x1e, 0x76, 0x6f, 0x7d, 0x37, 0xec, 0xde, 0x9b, 0x13, 0x2c, 0xcd,
-	0x1e, 0xfd, 0x5a, 0x54, 0x44, 0xaa, 0x09, 0x4d, 0x6c, 0xbb, 0x67, 0xb6, 0x64, 0xff, 0x9d, 0x6c,
-	0x19, 0x8b, 0x64, 0x0b, 0xd4, 0xc0, 0x84, 0xf0, 0xc1, 0x45, 0xcd, 0x12, 0x4b, 0x10, 0xd0, 0x11,
-	0x86, 0xeb, 0xa8, 0xb9, 0x83, 0xe8, 0xfb, 0xe9, 0xec, 0xf0, 0x64, 0xca, 0xcc, 0x06, 0xcd, 0x92,
-	0xe3, 0x55, 0x70, 0x53, 0x5b, 0x91, 0xc5, 0xb1, 0x95, 0x0a, 0xed, 0xca, 0x55, 0x41, 0x01, 0x0a,
-	0x1f, 0x08, 0x1b, 0x6b, 0x4f, 0x52, 0xe0, 0x7f, 0x6d, 0xe1, 0x4d, 0xa6, 0xb5, 0x23, 0x75, 0x82,
-	0x66, 0x58, 0x3f, 0x06, 0xa7, 0x4e, 0xd0, 0xa4, 0xaf, 0x21, 0x75, 0xfe, 0xbb, 0xf5, 0x43, 0xde,
-	0xba, 0x76, 0x0e, 0x9c, 0x8c, 0x5d, 0x5c, 0x9f, 0x8b, 0x3e, 0xd1, 0xea, 0xf5, 0x14, 0x5f, 0xc2,
-	0xb8, 0xcd, 0x4a, 0xa7, 0xa2, 0xcb, 0x52, 0xc5, 0x05, 0x90, 0x65, 0x85, 0xbf, 0x74, 0x13, 0xcb,
-	0x5e, 0xba, 0x39, 0xfd, 0xcb, 0xf3, 0xe2, 0x09, 0xe1, 0x21, 0xad, 0xec, 0xe9, 0x0e, 0x31, 0x5c,
-	0x14, 0x54, 0xf5, 0x2b, 0x5e, 0xc0, 0x7a, 0x3c, 0x3f, 0xad, 0x15, 0x25, 0xbb, 0xd9, 0xa9, 0x91,
-	0x32, 0xaa, 0x5d, 0x77, 0xbc, 0x1d, 0x44, 0x6f, 0xf8, 0x4e, 0x8b, 0x5a, 0x68, 0x16, 0x28, 0x24,
-	0x09, 0x48, 0xc3, 0x1b, 0x60, 0xc2, 0x75, 0x3c, 0xe6, 0x74, 0xa9, 0xce, 0x36, 0xa4, 0xf5, 0x59,
-	0x76, 0x4b, 0xc9, 0x08, 0x72, 0x6e, 0x5b, 0xd5, 0xfa, 0xd7, 0x47, 0xc1, 0x08, 0xb7, 0x02, 0xbf,
-	0x52, 0x40, 0x46, 0x12, 0x2c, 0x38, 0x1f, 0xcf, 0xc2, 0x1e, 0x0c, 0x5a, 0x5d, 0x18, 0x24, 0x26,
-	0x70, 0x6a, 0x67, 0xbf, 0xfc, 0xe9, 0xf7, 0x6f, 0x86, 0xe7, 0xe1, 0x69, 0x23, 0xf6, 0xed, 0x42,
-	0x92, 0x2c, 0xe3, 0x8e, 0x4c, 0x9a, 0xbb, 0xf0, 0x5b, 0x05, 0x4c, 0x44, 0x78, 0x2c, 0x3c, 0x9b,
-	0x60, 0xa6, 0x17, 0x5f, 0x56, 0x57, 0x0f, 0x27, 0x2c, 0x91, 0xad, 0x73, 0x64, 0xab, 0x70, 0x25,
-	0x8e, 0x2c, 0xa4, 0xcc, 0x31, 0x80, 0x3f, 0x28, 0x60, 0xb2, 0x9b, 0x92, 0x42, 0x3d, 0xc1, 0x6c,
-	0x02, 0x13, 0x56, 0x8d, 0x43, 0xcb, 0x4b, 0xa4, 0x17, 0x39, 0xd2, 0x0b, 0x70, 0x3d, 0x8e, 0x74,
-	0x3f, 0x3c, 0xd3, 0x06, 0xdb, 0xc9, 0xb2, 0xef, 0xc2, 0x7b, 0x0a, 0xc8, 0x48, 0xf2, 0x99, 0x78,
-	0xb5, 0x51, 0x5e, 0x9b, 0x78, 0xb5, 0x5d, 0x1c, 0x56, 0x5b, 0xe5, 0xb0, 0x16, 0xe0, 0x99, 0x38,
-	0x2c, 0x49, 0x66, 0x69, 0x47, 0xe8, 0x1e, 0x2a, 0x20, 0x23, 0x69, 0x68, 0x22, 0x90, 0x28, 0xe7,
-	0x4d, 0x04, 0xd2, 0xc5, 0x66, 0xb5, 0x35, 0x0e, 0xe4, 0x2c, 0x5c, 0x8e, 0x03, 0xa1, 0x42, 0xb4,
-	0x8d, 0xc3, 0xb8, 0xb3, 0x87, 0x0f, 0xee, 0xc2, 0xdb, 0x20, 0xcd, 0xd8, 0x2a, 0xd4, 0x12, 0x53,
-	0xa6, 0x45, 0x81, 0xd5, 0xd3, 0x7d, 0x65, 0x24, 0x86, 0x65, 0x8e, 0xe1, 0x34, 0x3c, 0xd5, 0x2b,
-	0x9b, 0x2a, 0x91, 0x48, 0x7c, 0x06, 0x46, 0x05, 0x61, 0x83, 0x67, 0x12, 0x34, 0x47, 0x78, 0xa1,
-	0x3a, 0x3f, 0x40, 0x4a, 0x22, 0x98, 0xe3, 0x08, 0x54, 0x98, 0x8f, 0x23, 0x10, 0x64, 0x10, 0x36,
-	0x41, 0x46, 0x72, 0x41, 0x38, 0x17, 0xd7, 0x19, 0xa5, 0x89, 0xea, 0xe2, 0xa0, 0x4e, 0x16, 0xda,
-	0xd5, 0xb8, 0xdd, 0x19, 0xa8, 0xc6, 0xed, 0xe2, 0xa0, 0x5a, 0xb2, 0x98, 0xb9, 0x2f, 0x40, 0xae,
-	0x83, 0xcc, 0x1d, 0xc2, 0x7a, 0x0f, 0x9f, 0x7b, 0xb0, 0x41, 0x6d, 0x81, 0xdb, 0x9e, 0x83, 0x85,
-	0x1e, 0xb6, 0xa5, 0x38, 0x2b, 0x91, 0xf0, 0x73, 0x90, 0x91, 0x5d, 0x3e, 0x31, 0xf7, 0xa2, 0x84,
-	0x30, 0x31, 0xf7, 0xba, 0xc8, 0x42, 0x3f, 0xef, 0x45, 0x8b, 0x0f, 0x9a, 0xf0, 0xbe, 0x02, 0x40,
-	0xbb, 0xfd, 0xc0, 0xa5, 0x7e, 0xaa, 0x3b, 0xa9, 0x85, 0xba, 0x7c, 0x08, 0x49, 0x89, 0x63, 0x9e,
-	0xe3, 0x28, 0xc2, 0xd9, 0x24, 0x1c, 0xbc, 0x27, 0xb2, 0x40, 0xc8, 0x16, 0xd6, 0xa7, 0x1a, 0x74,
-	0x76, 0xbe, 0x3e, 0xd5, 0x20, 0xd2, 0x09, 0xfb, 0x05, 0x22, 0xec, 0x90, 0x2c, 0xf3, 0x25, 0x7f,
-	0x39, 0x93, 0xf8, 0xa6, 0x3a, 0x7e, 0x0b, 0x4a, 0xcc, 0xfc, 0xe8, 0x6f, 0x43, 0xfd, 0x32, 0x5f,
-	0x10, 0x2c, 0xf8, 0x9d, 0x02, 0x8e, 0xc5, 0x7a, 0x29, 0x4c, 0x2a, 0xc4, 0x49, 0x6d, 0x59, 0x3d,
-	0x7f, 0xf8, 0x03, 0x12, 0xda, 0x22, 0x87, 0x76, 0x0a, 0x16, 0xe3, 0xd0, 0x22, 0xed, 0x7b, 0xf3,
-	0xe2, 0xd3, 0x17, 0x05, 0xe5, 0xd9, 0x8b, 0x82, 0xf2, 0xdb, 0x8b, 0x82, 0xf2, 0xe8, 0x65, 0x61,
-	0xe8, 0xd9, 0xcb, 0xc2, 0xd0, 0xcf, 0x2f, 0x0b, 0x43, 0x9f, 0xcc, 0xc5, 0x09, 0x14, 0x53, 0xd2,
-	0x64, 0x6a, 0x38, 0x7d, 0x2a, 0x8f, 0x72, 0xba, 0xf6, 0xc6, 0xdf, 0x01, 0x00, 0x00, 0xff, 0xff,
-	0xd0, 0x32, 0x62, 0xca, 0x4c, 0x14, 0x00, 0x00,
+func (m *QueryBurntFeesRequest) Reset()         { *m = QueryBurntFeesRequest{} }
+func (m *QueryBurntFeesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBurntFeesRequest) ProtoMessage()    {}
+func (*QueryBurntFeesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e8f08e175b3ef0c, []int{32}
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
+func (m *QueryBurntFeesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBurntFeesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBurntFeesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBurntFeesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBurntFeesRequest.Merge(m, src)
+}
+func (m *QueryBurntFeesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBurntFeesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBurntFeesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBurntFeesRequest proto.InternalMessageInfo
+
+// QueryBurntFeesResponse returns the total amount of burnt fees
+type QueryBurntFeesResponse struct {
+	// burnt_fees is the total amount of EVM transaction fees burnt by the chain,
+	// in the 18 decimals representation of the EVM denom
+	BurntFees cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=burnt_fees,json=burntFees,proto3,customtype=cosmossdk.io/math.Int" json:"burnt_fees"`
+}
+
+func (m *QueryBurntFeesResponse) Reset()         { *m = QueryBurntFeesResponse{} }
+func (m *QueryBurntFeesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBurntFeesResponse) ProtoMessage()    {}
+func (*QueryBurntFeesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e8f08e175b3ef0c, []int{33}
+}
+func (m *QueryBurntFeesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBurntFeesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBurntFeesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBurntFeesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBurntFeesResponse.Merge(m, src)
+}
+func (m *QueryBurntFeesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBurntFeesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBurntFeesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBurntFeesResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*QueryConfigRequest)(nil), "cosmos.evm.vm.v1.QueryConfigRequest")
+	proto.RegisterType((*QueryConfigResponse)(nil), "cosmos.evm.vm.v1.QueryConfigResponse")
+	proto.RegisterType((*QueryAccountRequest)(nil), "cosmos.evm.vm.v1.QueryAccountRequest")
+	proto.RegisterType((*QueryAccountResponse)(nil), "cosmos.evm.vm.v1.QueryAccountResponse")
+	proto.RegisterType((*QueryCosmosAccountRequest)(nil), "cosmos.evm.vm.v1.QueryCosmosAccountRequest")
+	proto.RegisterType((*QueryCosmosAccountResponse)(nil), "cosmos.evm.vm.v1.QueryCosmosAccountResponse")
+	proto.RegisterType((*QueryValidatorAccountRequest)(nil), "cosmos.evm.vm.v1.QueryValidatorAccountRequest")
+	proto.RegisterType((*QueryValidatorAccountResponse)(nil), "cosmos.evm.vm.v1.QueryValidatorAccountResponse")
+	proto.RegisterType((*QueryBalanceRequest)(nil), "cosmos.evm.vm.v1.QueryBalanceRequest")
+	proto.RegisterType((*QueryBalanceResponse)(nil), "cosmos.evm.vm.v1.QueryBalanceResponse")
+	proto.RegisterType((*QueryStorageRequest)(nil), "cosmos.evm.vm.v1.QueryStorageRequest")
+	proto.RegisterType((*QueryStorageResponse)(nil), "cosmos.evm.vm.v1.QueryStorageResponse")
+	proto.RegisterType((*QueryCodeRequest)(nil), "cosmos.evm.vm.v1.QueryCodeRequest")
+	proto.RegisterType((*QueryCodeResponse)(nil), "cosmos.evm.vm.v1.QueryCodeResponse")
+	proto.RegisterType((*QueryTxLogsRequest)(nil), "cosmos.evm.vm.v1.QueryTxLogsRequest")
+	proto.RegisterType((*QueryTxLogsResponse)(nil), "cosmos.evm.vm.v1.QueryTxLogsResponse")
+	proto.RegisterType((*QueryParamsRequest)(nil), "cosmos.evm.vm.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "cosmos.evm.vm.v1.QueryParamsResponse")
+	proto.RegisterType((*EthCallRequest)(nil), "cosmos.evm.vm.v1.EthCallRequest")
+	proto.RegisterType((*EstimateGasResponse)(nil), "cosmos.evm.vm.v1.EstimateGasResponse")
+	proto.RegisterType((*SimulateV1Request)(nil), "cosmos.evm.vm.v1.SimulateV1Request")
+	proto.RegisterType((*SimulateV1Response)(nil), "cosmos.evm.vm.v1.SimulateV1Response")
+	proto.RegisterType((*QueryTraceTxRequest)(nil), "cosmos.evm.vm.v1.QueryTraceTxRequest")
+	proto.RegisterType((*QueryTraceTxResponse)(nil), "cosmos.evm.vm.v1.QueryTraceTxResponse")
+	proto.RegisterType((*QueryTraceBlockRequest)(nil), "cosmos.evm.vm.v1.QueryTraceBlockRequest")
+	proto.RegisterType((*QueryTraceBlockResponse)(nil), "cosmos.evm.vm.v1.QueryTraceBlockResponse")
+	proto.RegisterType((*QueryTraceCallRequest)(nil), "cosmos.evm.vm.v1.QueryTraceCallRequest")
+	proto.RegisterType((*QueryTraceCallResponse)(nil), "cosmos.evm.vm.v1.QueryTraceCallResponse")
+	proto.RegisterType((*QueryBaseFeeRequest)(nil), "cosmos.evm.vm.v1.QueryBaseFeeRequest")
+	proto.RegisterType((*QueryBaseFeeResponse)(nil), "cosmos.evm.vm.v1.QueryBaseFeeResponse")
+	proto.RegisterType((*QueryGlobalMinGasPriceRequest)(nil), "cosmos.evm.vm.v1.QueryGlobalMinGasPriceRequest")
+	proto.RegisterType((*QueryGlobalMinGasPriceResponse)(nil), "cosmos.evm.vm.v1.QueryGlobalMinGasPriceResponse")
+	proto.RegisterType((*QueryBurntFeesRequest)(nil), "cosmos.evm.vm.v1.QueryBurntFeesRequest")
+	proto.RegisterType((*QueryBurntFeesResponse)(nil), "cosmos.evm.vm.v1.QueryBurntFeesResponse")
+}
+
+func init() { proto.RegisterFile("cosmos/evm/vm/v1/query.proto", fileDescriptor_0e8f08e175b3ef0c) }
+
+var fileDescriptor_0e8f08e175b3ef0c = []byte{
+	// 1850 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x58, 0xcd, 0x6f, 0x1b, 0xc7,
+	0x15, 0xd7, 0x9a, 0x94, 0x48, 0x3e, 0x4a, 0x8e, 0x3c, 0x96, 0x6d, 0x7a, 0x2b, 0x91, 0xf2, 0x5a,
+	0x5f, 0xb6, 0x15, 0x6e, 0xa4, 0xa6, 0x05, 0xea, 0x16, 0x68, 0x2d, 0xc1, 0x56, 0xd2, 0xd8, 0xad,
+	0xcb, 0x08, 0x3e, 0x14, 0x28, 0x88, 0x21, 0x39, 0x5e, 0x2e, 0xc4, 0xdd, 0x65, 0x76, 0x86, 0x2c,
+	0x9d, 0xd4, 0x39, 0x04, 0x6d, 0x90, 0x20, 0x97, 0x00, 0xbd, 0xf4, 0xd4, 0xe6, 0xd8, 0x5b, 0x7b,
+	0x2b, 0xd0, 0xbf, 0x20, 0xc7, 0x00, 0x45, 0x81, 0xa2, 0x07, 0xb7, 0xb0, 0x0b, 0xb4, 0x7f, 0x43,
+	0x4f, 0xc5, 0x7c, 0x2c, 0x77, 0x57, 0xcb, 0xe5, 0x32, 0x81, 0x0c, 0xf4, 0x10, 0x40, 0xb0, 0x67,
+	0xdf, 0xbc, 0x79, 0xef, 0xf7, 0xe6, 0x7d, 0xcc, 0x7b, 0x84, 0xd5, 0xb6, 0x47, 0x1d, 0x8f, 0x9a,
+	0x64, 0xe8, 0x98, 0xfc, 0x6f, 0xcf, 0x7c, 0x67, 0x40, 0xfc, 0x27, 0xf5, 0xbe, 0xef, 0x31, 0x0f,
+	0x2d, 0xcb, 0xdd, 0x3a, 0x19, 0x3a, 0x75, 0xfe, 0xb7, 0xa7, 0x5f, 0xc0, 0x8e, 0xed, 0x7a, 0xa6,
+	0xf8, 0x57, 0x32, 0xe9, 0x37, 0x95, 0x88, 0x16, 0xa6, 0x44, 0x9e, 0x36, 0x87, 0x7b, 0x2d, 0xc2,
+	0xf0, 0x9e, 0xd9, 0xc7, 0x96, 0xed, 0x62, 0x66, 0x7b, 0xae, 0xe2, 0xd5, 0x13, 0xea, 0xb8, 0x68,
+	0xb9, 0x77, 0x35, 0xb1, 0xc7, 0x46, 0x6a, 0x6b, 0xc5, 0xf2, 0x2c, 0x4f, 0x2c, 0x4d, 0xbe, 0x52,
+	0xd4, 0x55, 0xcb, 0xf3, 0xac, 0x1e, 0x31, 0x71, 0xdf, 0x36, 0xb1, 0xeb, 0x7a, 0x4c, 0x68, 0xa2,
+	0x6a, 0xb7, 0xa6, 0x76, 0xc5, 0x57, 0x6b, 0xf0, 0xd8, 0x64, 0xb6, 0x43, 0x28, 0xc3, 0x4e, 0x5f,
+	0x32, 0x18, 0x2b, 0x80, 0x7e, 0xc2, 0xd1, 0x1e, 0x7a, 0xee, 0x63, 0xdb, 0x6a, 0x90, 0x77, 0x06,
+	0x84, 0x32, 0xe3, 0x3e, 0x5c, 0x8c, 0x51, 0x69, 0xdf, 0x73, 0x29, 0x41, 0xdf, 0x82, 0x85, 0xb6,
+	0xa0, 0x54, 0xb4, 0x75, 0x6d, 0xa7, 0xbc, 0xbf, 0x56, 0x3f, 0x7d, 0x35, 0xf5, 0xc3, 0x2e, 0xb6,
+	0x5d, 0x75, 0x4c, 0x31, 0x1b, 0xdf, 0x51, 0xd2, 0xee, 0xb4, 0xdb, 0xde, 0xc0, 0x65, 0x4a, 0x09,
+	0xaa, 0x40, 0x01, 0x77, 0x3a, 0x3e, 0xa1, 0x54, 0x88, 0x2b, 0x35, 0x82, 0xcf, 0xdb, 0xc5, 0x8f,
+	0x3e, 0xab, 0xcd, 0xfd, 0xe7, 0xb3, 0xda, 0x9c, 0xd1, 0x86, 0x95, 0xf8, 0x51, 0x85, 0xa4, 0x02,
+	0x85, 0x16, 0xee, 0x61, 0xb7, 0x4d, 0x82, 0xb3, 0xea, 0x13, 0x7d, 0x03, 0x4a, 0x6d, 0xaf, 0x43,
+	0x9a, 0x5d, 0x4c, 0xbb, 0x95, 0x73, 0x62, 0xaf, 0xc8, 0x09, 0x6f, 0x60, 0xda, 0x45, 0x2b, 0x30,
+	0xef, 0x7a, 0xfc, 0x50, 0x6e, 0x5d, 0xdb, 0xc9, 0x37, 0xe4, 0x87, 0xf1, 0x7d, 0xb8, 0xaa, 0xac,
+	0xe5, 0xc6, 0x7c, 0x05, 0x94, 0x1f, 0x6a, 0xa0, 0x4f, 0x92, 0xa0, 0xc0, 0x6e, 0xc2, 0x79, 0x79,
+	0x4f, 0xcd, 0xb8, 0xa4, 0x25, 0x49, 0xbd, 0x23, 0x89, 0x48, 0x87, 0x22, 0xe5, 0x4a, 0x39, 0xbe,
+	0x73, 0x02, 0xdf, 0xf8, 0x9b, 0x8b, 0xc0, 0x52, 0x6a, 0xd3, 0x1d, 0x38, 0x2d, 0xe2, 0x2b, 0x0b,
+	0x96, 0x14, 0xf5, 0x47, 0x82, 0x68, 0xbc, 0x05, 0xab, 0x02, 0xc7, 0x23, 0xdc, 0xb3, 0x3b, 0x98,
+	0x79, 0xfe, 0x29, 0x63, 0xae, 0xc1, 0x62, 0xdb, 0x73, 0x4f, 0xe3, 0x28, 0x73, 0xda, 0x9d, 0x84,
+	0x55, 0x9f, 0x68, 0xb0, 0x96, 0x22, 0x4d, 0x19, 0xb6, 0x0d, 0xaf, 0x04, 0xa8, 0xe2, 0x12, 0x03,
+	0xb0, 0x67, 0x68, 0x5a, 0x10, 0x44, 0x07, 0xd2, 0xcf, 0x5f, 0xc6, 0x3d, 0xaf, 0xc1, 0x4a, 0xfc,
+	0x68, 0x56, 0x10, 0x19, 0x6f, 0x29, 0x65, 0x6f, 0x33, 0xcf, 0xc7, 0x56, 0xb6, 0x32, 0xb4, 0x0c,
+	0xb9, 0x13, 0xf2, 0x44, 0xc5, 0x1b, 0x5f, 0x46, 0xd4, 0xef, 0xc2, 0x4a, 0x5c, 0x98, 0x52, 0xbf,
+	0x02, 0xf3, 0x43, 0xdc, 0x1b, 0x04, 0xca, 0xe5, 0x87, 0xf1, 0x6d, 0x58, 0x56, 0xa1, 0xd4, 0xf9,
+	0x52, 0x46, 0x6e, 0xc3, 0x85, 0xc8, 0x39, 0xa5, 0x02, 0x41, 0x9e, 0xc7, 0xbe, 0x38, 0xb5, 0xd8,
+	0x10, 0x6b, 0xe3, 0x5d, 0x95, 0xf1, 0xc7, 0xa3, 0xfb, 0x9e, 0x45, 0x03, 0x15, 0x08, 0xf2, 0x22,
+	0x63, 0xa4, 0x7c, 0xb1, 0x46, 0xf7, 0x00, 0xc2, 0xda, 0x25, 0x6c, 0x2b, 0xef, 0x6f, 0x05, 0x29,
+	0xcf, 0x0b, 0x5d, 0x5d, 0x96, 0x49, 0x55, 0xe8, 0xea, 0x0f, 0xc3, 0xab, 0x6a, 0x44, 0x4e, 0x46,
+	0x40, 0x7e, 0xac, 0xc1, 0xc5, 0x98, 0x72, 0x85, 0xf3, 0x06, 0xe4, 0x7b, 0x9e, 0xc5, 0xad, 0xcb,
+	0xed, 0x94, 0xf7, 0x2f, 0x25, 0xcb, 0xca, 0x7d, 0xcf, 0x6a, 0x08, 0x16, 0x74, 0x34, 0x01, 0xd4,
+	0x76, 0x26, 0x28, 0xa9, 0x27, 0x8a, 0x6a, 0x5c, 0xf9, 0x1e, 0x62, 0x1f, 0x3b, 0xc1, 0x3d, 0x18,
+	0x0d, 0xb8, 0x18, 0xa3, 0x2a, 0x80, 0xdf, 0x85, 0x85, 0xbe, 0xa0, 0xa8, 0xca, 0x57, 0x49, 0x42,
+	0x94, 0x27, 0x0e, 0x4a, 0x9f, 0x3f, 0xab, 0xcd, 0xfd, 0xfe, 0xdf, 0x7f, 0xbc, 0xa9, 0x35, 0xd4,
+	0x11, 0xe3, 0xaf, 0x1a, 0x9c, 0xbf, 0xcb, 0xba, 0x87, 0xb8, 0xd7, 0x8b, 0x5c, 0x37, 0xf6, 0x2d,
+	0x1a, 0x38, 0x86, 0xaf, 0xd1, 0x15, 0x28, 0x58, 0x98, 0x36, 0xdb, 0xb8, 0xaf, 0x72, 0x64, 0xc1,
+	0xc2, 0xf4, 0x10, 0xf7, 0xd1, 0xcf, 0x60, 0xb9, 0xef, 0x7b, 0x7d, 0x8f, 0x12, 0x7f, 0x9c, 0x67,
+	0x3c, 0x47, 0x16, 0x0f, 0xf6, 0xff, 0xfb, 0xac, 0x56, 0xb7, 0x6c, 0xd6, 0x1d, 0xb4, 0xea, 0x6d,
+	0xcf, 0x31, 0xd5, 0xe3, 0x21, 0xff, 0x7b, 0x95, 0x76, 0x4e, 0x4c, 0xf6, 0xa4, 0x4f, 0x68, 0xfd,
+	0x30, 0x4c, 0xf0, 0xc6, 0x2b, 0x81, 0xac, 0x20, 0x39, 0xaf, 0x42, 0xb1, 0xcd, 0xab, 0x76, 0xd3,
+	0xee, 0x54, 0xf2, 0xeb, 0xda, 0x4e, 0xae, 0x51, 0x10, 0xdf, 0x6f, 0x76, 0xd0, 0x2a, 0x94, 0xbc,
+	0x21, 0xf1, 0x7d, 0xbb, 0x43, 0x68, 0x65, 0x5e, 0x60, 0x0d, 0x09, 0xc6, 0x31, 0x5c, 0xbc, 0x4b,
+	0x99, 0xed, 0x60, 0x46, 0x8e, 0x70, 0x78, 0x57, 0xcb, 0x90, 0xb3, 0xb0, 0x34, 0x2d, 0xdf, 0xe0,
+	0x4b, 0x4e, 0xf1, 0x09, 0x13, 0x56, 0x2d, 0x36, 0xf8, 0x92, 0xeb, 0x1c, 0x3a, 0x4d, 0xe2, 0xfb,
+	0x9e, 0x4c, 0xf7, 0x52, 0xa3, 0x30, 0x74, 0xee, 0xf2, 0x4f, 0xe3, 0xcf, 0x1a, 0x5c, 0x78, 0xdb,
+	0x76, 0x06, 0x3d, 0xcc, 0xc8, 0xa3, 0xbd, 0xc8, 0x85, 0x79, 0x7d, 0x36, 0xbe, 0x30, 0xbe, 0xfe,
+	0x3f, 0xbc, 0x30, 0x63, 0x07, 0x50, 0x14, 0x7b, 0x98, 0x86, 0x1d, 0xcc, 0x70, 0x00, 0x9e, 0xaf,
+	0x8d, 0x8f, 0xf3, 0x41, 0x2a, 0xf8, 0xb8, 0x4d, 0x8e, 0x47, 0x81, 0xa1, 0x7b, 0x90, 0x73, 0x68,
+	0xf0, 0xc0, 0xd6, 0x92, 0x61, 0xf6, 0x80, 0x5a, 0x77, 0x59, 0x97, 0xf8, 0x64, 0xe0, 0x1c, 0x8f,
+	0x1a, 0x9c, 0x17, 0xfd, 0x00, 0x16, 0x19, 0x17, 0xd2, 0x54, 0x8f, 0x73, 0x2e, 0xed, 0x71, 0x16,
+	0xaa, 0xd4, 0xe3, 0x5c, 0x66, 0xe1, 0x07, 0x3a, 0x84, 0xc5, 0xbe, 0x4f, 0x3a, 0xa4, 0x4d, 0x28,
+	0xf5, 0x7c, 0x5a, 0xc9, 0xaf, 0xe7, 0x66, 0xd1, 0x1e, 0x3b, 0xc4, 0x1f, 0x97, 0x56, 0xcf, 0x6b,
+	0x9f, 0x04, 0x65, 0x7c, 0x5e, 0x5c, 0x4d, 0x59, 0xd0, 0x64, 0x11, 0x47, 0x6b, 0x00, 0x92, 0x45,
+	0xd4, 0x9a, 0x05, 0xe1, 0xf8, 0x92, 0xa0, 0x88, 0xe7, 0xf9, 0x8d, 0x60, 0x9b, 0x77, 0x29, 0x95,
+	0x82, 0x30, 0x43, 0xaf, 0xcb, 0x16, 0xa6, 0x1e, 0xb4, 0x30, 0xf5, 0xe3, 0xa0, 0x85, 0x39, 0x58,
+	0xe2, 0xb9, 0xf6, 0xe9, 0x3f, 0x6a, 0x9a, 0xcc, 0x37, 0x29, 0x89, 0x6f, 0x4f, 0x8c, 0x80, 0xe2,
+	0xcb, 0x89, 0x80, 0x52, 0x3c, 0x65, 0x0c, 0x58, 0x92, 0x36, 0x38, 0x78, 0xd4, 0xe4, 0x79, 0x00,
+	0x91, 0x6b, 0x78, 0x80, 0x47, 0x47, 0x98, 0xfe, 0x30, 0x5f, 0x3c, 0xb7, 0x9c, 0x6b, 0x14, 0xd9,
+	0xa8, 0x69, 0xbb, 0x1d, 0x32, 0x32, 0x6e, 0xaa, 0x17, 0x62, 0x1c, 0x0a, 0x53, 0xe2, 0xe6, 0x4f,
+	0x39, 0xb8, 0x1c, 0x32, 0x1f, 0x70, 0xa9, 0x91, 0xd0, 0x61, 0xa3, 0xa0, 0x88, 0x66, 0x87, 0x0e,
+	0x1b, 0xd1, 0x33, 0x08, 0x9d, 0xaf, 0xbd, 0x3e, 0xa3, 0xd7, 0x8d, 0x57, 0xe1, 0x4a, 0xc2, 0x71,
+	0x53, 0x1c, 0xfd, 0x41, 0x1e, 0x2e, 0x85, 0xfc, 0x5f, 0xf9, 0xf1, 0x38, 0x7b, 0x0f, 0xe7, 0xb3,
+	0x3c, 0x3c, 0x3f, 0xdd, 0xc3, 0x0b, 0x67, 0xec, 0xe1, 0xc2, 0xcb, 0xf1, 0x70, 0x31, 0xc3, 0xc3,
+	0xa5, 0x84, 0x87, 0x79, 0x3f, 0x4c, 0x19, 0x66, 0xa4, 0x19, 0x3e, 0x9a, 0x20, 0x7c, 0x74, 0x5e,
+	0x90, 0x7f, 0x1c, 0x50, 0x39, 0xa3, 0x14, 0x16, 0x32, 0x96, 0x25, 0xa3, 0x20, 0x8f, 0x19, 0x8d,
+	0x5d, 0xb8, 0x7c, 0x3a, 0x06, 0xa6, 0x84, 0xcc, 0xa5, 0x71, 0x8f, 0x4c, 0xc9, 0x3d, 0x42, 0xc2,
+	0x69, 0x6e, 0x25, 0x4e, 0x56, 0x22, 0x5e, 0x87, 0x22, 0x6f, 0x98, 0x9a, 0x8f, 0x89, 0xea, 0x41,
+	0x0f, 0xae, 0xfe, 0xfd, 0x59, 0xed, 0x92, 0xbc, 0x32, 0xda, 0x39, 0xa9, 0xdb, 0x9e, 0xe9, 0x60,
+	0xd6, 0xad, 0xbf, 0xe9, 0x32, 0xde, 0x1b, 0x8b, 0xd3, 0x46, 0x4d, 0x4d, 0x05, 0x47, 0x3d, 0xaf,
+	0x85, 0x7b, 0x0f, 0x6c, 0xf7, 0x08, 0xd3, 0x87, 0xbe, 0x3d, 0x6e, 0xc9, 0x8d, 0x36, 0x54, 0xd3,
+	0x18, 0x94, 0xe2, 0x3b, 0xb0, 0xe4, 0xd8, 0x2e, 0xbf, 0xc5, 0x66, 0x9f, 0x6f, 0x28, 0xed, 0x6b,
+	0xdc, 0xed, 0xe9, 0x08, 0xca, 0x4e, 0x28, 0xca, 0xb8, 0xa2, 0x92, 0xe3, 0x60, 0xe0, 0xbb, 0xec,
+	0x1e, 0x21, 0xe3, 0x06, 0xee, 0x11, 0x5c, 0x3e, 0xbd, 0xa1, 0xb4, 0x7e, 0x0f, 0xa0, 0xc5, 0x89,
+	0xdc, 0x5e, 0x3a, 0x9b, 0xca, 0x52, 0x2b, 0x90, 0xb2, 0xff, 0x1b, 0x04, 0xf3, 0x42, 0x30, 0xfa,
+	0x95, 0x06, 0x05, 0x35, 0x09, 0xa1, 0xcd, 0x64, 0x1e, 0x4d, 0x18, 0x75, 0xf5, 0xad, 0x2c, 0x36,
+	0x09, 0xd1, 0xb8, 0xf5, 0xc1, 0x5f, 0xfe, 0xf5, 0xeb, 0x73, 0x9b, 0xe8, 0xba, 0x99, 0xf8, 0x19,
+	0x40, 0x4d, 0x43, 0xe6, 0x7b, 0x2a, 0xec, 0x9f, 0xa2, 0xdf, 0x6a, 0xb0, 0x14, 0x1b, 0x38, 0xd1,
+	0xad, 0x14, 0x35, 0x93, 0x06, 0x5b, 0x7d, 0x77, 0x36, 0x66, 0x85, 0x6c, 0x5f, 0x20, 0xdb, 0x45,
+	0x37, 0x93, 0xc8, 0x82, 0xd9, 0x36, 0x01, 0xf0, 0x0f, 0x1a, 0x2c, 0x9f, 0x9e, 0x1d, 0x51, 0x3d,
+	0x45, 0x6d, 0xca, 0xc8, 0xaa, 0x9b, 0x33, 0xf3, 0x2b, 0xa4, 0xb7, 0x05, 0xd2, 0xd7, 0xd1, 0x7e,
+	0x12, 0xe9, 0x30, 0x38, 0x13, 0x82, 0x8d, 0x8e, 0xc3, 0x4f, 0xd1, 0x87, 0x1a, 0x14, 0xd4, 0x94,
+	0x98, 0xea, 0xda, 0xf8, 0x00, 0xaa, 0x6f, 0x65, 0xb1, 0x29, 0x58, 0xbb, 0x02, 0xd6, 0x16, 0xda,
+	0x48, 0xc2, 0x52, 0x53, 0x27, 0x8d, 0x5c, 0xdd, 0x27, 0x1a, 0x14, 0xd4, 0xbc, 0x98, 0x0a, 0x24,
+	0x3e, 0x9c, 0xea, 0x5b, 0x59, 0x6c, 0x0a, 0xc8, 0x9e, 0x00, 0x72, 0x0b, 0xdd, 0x48, 0x02, 0xa1,
+	0x92, 0x35, 0xc4, 0x61, 0xbe, 0x77, 0x42, 0x9e, 0x3c, 0x45, 0xef, 0x42, 0x9e, 0x8f, 0x95, 0xc8,
+	0x48, 0x0d, 0x99, 0xf1, 0xac, 0xaa, 0x5f, 0x9f, 0xca, 0xa3, 0x30, 0xdc, 0x10, 0x18, 0xae, 0xa3,
+	0x6b, 0x93, 0xa2, 0xa9, 0x13, 0xbb, 0x89, 0x9f, 0xc3, 0x82, 0x9c, 0xac, 0xd0, 0x46, 0x8a, 0xe4,
+	0xd8, 0x00, 0xa7, 0x6f, 0x66, 0x70, 0x29, 0x04, 0xeb, 0x02, 0x81, 0x8e, 0x2a, 0x49, 0x04, 0x72,
+	0x6a, 0x43, 0x23, 0x28, 0xa8, 0xa1, 0x0d, 0xad, 0x27, 0x65, 0xc6, 0xe7, 0x39, 0x7d, 0x3b, 0xab,
+	0xdb, 0x0a, 0xf4, 0x1a, 0x42, 0xef, 0x2a, 0xd2, 0x93, 0x7a, 0x09, 0xeb, 0x36, 0xdb, 0x5c, 0xdd,
+	0xfb, 0x50, 0x8e, 0xcc, 0x55, 0x33, 0x68, 0x9f, 0x60, 0xf3, 0x84, 0xc1, 0xcc, 0xd8, 0x12, 0xba,
+	0xd7, 0x51, 0x75, 0x82, 0x6e, 0xc5, 0xce, 0x6b, 0x32, 0x7a, 0x1f, 0x20, 0x1c, 0x62, 0xd0, 0x04,
+	0x87, 0x26, 0xc6, 0x33, 0x7d, 0x63, 0x3a, 0x93, 0x02, 0xb0, 0x29, 0x00, 0xd4, 0xd0, 0xda, 0x84,
+	0xd0, 0x53, 0xdc, 0xcd, 0xe1, 0x1e, 0xfa, 0x05, 0x14, 0x54, 0x27, 0x9c, 0x1a, 0xfb, 0xf1, 0xa1,
+	0x49, 0xdf, 0xca, 0x62, 0xcb, 0xbe, 0x7d, 0xd9, 0x24, 0xb1, 0x11, 0xfa, 0xa5, 0x06, 0xa5, 0xf1,
+	0x73, 0x8b, 0xb6, 0xa7, 0x49, 0x8e, 0xfa, 0x60, 0x27, 0x9b, 0x51, 0x81, 0xd8, 0x10, 0x20, 0xaa,
+	0x68, 0x35, 0x0d, 0x84, 0x08, 0x82, 0x8f, 0x34, 0x80, 0xb0, 0x53, 0x44, 0x53, 0xc5, 0x47, 0xa7,
+	0x00, 0xfd, 0xc6, 0x0c, 0x9c, 0xd9, 0xfe, 0x90, 0x48, 0x44, 0x37, 0xc2, 0xfd, 0xa1, 0x5a, 0x87,
+	0x29, 0x45, 0x31, 0xda, 0x71, 0xe8, 0x5b, 0x59, 0x6c, 0xd9, 0xfe, 0x08, 0x3a, 0x13, 0x5e, 0x00,
+	0x54, 0x23, 0xba, 0x91, 0x5a, 0x5a, 0x22, 0xbf, 0x5d, 0xeb, 0x9b, 0x19, 0x5c, 0xd9, 0x05, 0x40,
+	0x76, 0xca, 0xe8, 0x77, 0x1a, 0x5c, 0x48, 0xf4, 0x30, 0x28, 0xed, 0x3d, 0x4a, 0x6b, 0x87, 0xf4,
+	0xd7, 0x66, 0x3f, 0xa0, 0xa0, 0x6d, 0x0b, 0x68, 0xd7, 0x50, 0x2d, 0x09, 0x2d, 0xd6, 0x36, 0x89,
+	0x50, 0x1d, 0xf7, 0x39, 0xa9, 0xa1, 0x7a, 0xba, 0x45, 0xd2, 0x77, 0xb2, 0x19, 0xb3, 0x43, 0x35,
+	0x6c, 0xa5, 0x0e, 0x6e, 0x7f, 0xfe, 0xbc, 0xaa, 0x7d, 0xf1, 0xbc, 0xaa, 0xfd, 0xf3, 0x79, 0x55,
+	0xfb, 0xf4, 0x45, 0x75, 0xee, 0x8b, 0x17, 0xd5, 0xb9, 0xbf, 0xbd, 0xa8, 0xce, 0xfd, 0x74, 0x3d,
+	0xd9, 0x90, 0x73, 0x09, 0x23, 0x2e, 0x43, 0xb4, 0xe3, 0xad, 0x05, 0xd1, 0xfe, 0x7f, 0xf3, 0x7f,
+	0x03, 0x00, 0x99, 0x44, 0x20, 0x9f, 0x83, 0x19, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the grpc package it is being compiled against.
 const _ = grpc.SupportPackageIsVersion4
@@ -1654,8 +2064,12 @@ type QueryClient interface {
 	EthCall(ctx context.Context, in *EthCallRequest, opts ...grpc.CallOption) (*MsgEthereumTxResponse, error)
 	// EstimateGas implements the `eth_estimateGas` rpc api
 	EstimateGas(ctx context.Context, in *EthCallRequest, opts ...grpc.CallOption) (*EstimateGasResponse, error)
+	// SimulateV1 implements the `eth_simulateV1` rpc api
+	SimulateV1(ctx context.Context, in *SimulateV1Request, opts ...grpc.CallOption) (*SimulateV1Response, error)
 	// TraceTx implements the `debug_traceTransaction` rpc api
 	TraceTx(ctx context.Context, in *QueryTraceTxRequest, opts ...grpc.CallOption) (*QueryTraceTxResponse, error)
+	// TraceCall implements the `debug_traceCall` rpc api
+	TraceCall(ctx context.Context, in *QueryTraceCallRequest, opts ...grpc.CallOption) (*QueryTraceCallResponse, error)
 	// TraceBlock implements the `debug_traceBlockByNumber` and
 	// `debug_traceBlockByHash` rpc api
 	TraceBlock(ctx context.Context, in *QueryTraceBlockRequest, opts ...grpc.CallOption) (*QueryTraceBlockResponse, error)
@@ -1670,6 +2084,9 @@ type QueryClient interface {
 	// but makes the conversion to 18 decimals
 	// when the evm denom is represented with a different precision.
 	GlobalMinGasPrice(ctx context.Context, in *QueryGlobalMinGasPriceRequest, opts ...grpc.CallOption) (*QueryGlobalMinGasPriceResponse, error)
+	// BurntFees queries the total amount of EVM transaction fees burnt by the
+	// chain
+	BurntFees(ctx context.Context, in *QueryBurntFeesRequest, opts ...grpc.CallOption) (*QueryBurntFeesResponse, error)
 }
 
 type queryClient struct {
@@ -1761,6 +2178,15 @@ func (c *queryClient) EstimateGas(ctx context.Context, in *EthCallRequest, opts
 	return out, nil
 }
 
+func (c *queryClient) SimulateV1(ctx context.Context, in *SimulateV1Request, opts ...grpc.CallOption) (*SimulateV1Response, error) {
+	out := new(SimulateV1Response)
+	err := c.cc.Invoke(ctx, "/cosmos.evm.vm.v1.Query/SimulateV1", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) TraceTx(ctx context.Context, in *QueryTraceTxRequest, opts ...grpc.CallOption) (*QueryTraceTxResponse, error) {
 	out := new(QueryTraceTxResponse)
 	err := c.cc.Invoke(ctx, "/cosmos.evm.vm.v1.Query/TraceTx", in, out, opts...)
@@ -1770,6 +2196,15 @@ func (c *queryClient) TraceTx(ctx context.Context, in *QueryTraceTxRequest, opts
 	return out, nil
 }
 
+func (c *queryClient) TraceCall(ctx context.Context, in *QueryTraceCallRequest, opts ...grpc.CallOption) (*QueryTraceCallResponse, error) {
+	out := new(QueryTraceCallResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.evm.vm.v1.Query/TraceCall", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) TraceBlock(ctx context.Context, in *QueryTraceBlockRequest, opts ...grpc.CallOption) (*QueryTraceBlockResponse, error) {
 	out := new(QueryTraceBlockResponse)
 	err := c.cc.Invoke(ctx, "/cosmos.evm.vm.v1.Query/TraceBlock", in, out, opts...)
@@ -1806,6 +2241,15 @@ func (c *queryClient) GlobalMinGasPrice(ctx context.Context, in *QueryGlobalMinG
 	return out, nil
 }
 
+func (c *queryClient) BurntFees(ctx context.Context, in *QueryBurntFeesRequest, opts ...grpc.CallOption) (*QueryBurntFeesResponse, error) {
+	out := new(QueryBurntFeesResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.evm.vm.v1.Query/BurntFees", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Account queries an Ethereum account.
@@ -1828,8 +2272,12 @@ type QueryServer interface {
 	EthCall(context.Context, *EthCallRequest) (*MsgEthereumTxResponse, error)
 	// EstimateGas implements the `eth_estimateGas` rpc api
 	EstimateGas(context.Context, *EthCallRequest) (*EstimateGasResponse, error)
+	// SimulateV1 implements the `eth_simulateV1` rpc api
+	SimulateV1(context.Context, *SimulateV1Request) (*SimulateV1Response, error)
 	// TraceTx implements the `debug_traceTransaction` rpc api
 	TraceTx(context.Context, *QueryTraceTxRequest) (*QueryTraceTxResponse, error)
+	// TraceCall implements the `debug_traceCall` rpc api
+	TraceCall(context.Context, *QueryTraceCallRequest) (*QueryTraceCallResponse, error)
 	// TraceBlock implements the `debug_traceBlockByNumber` and
 	// `debug_traceBlockByHash` rpc api
 	TraceBlock(context.Context, *QueryTraceBlockRequest) (*QueryTraceBlockResponse, error)
@@ -1844,6 +2292,9 @@ type QueryServer interface {
 	// but makes the conversion to 18 decimals
 	// when the evm denom is represented with a different precision.
 	GlobalMinGasPrice(context.Context, *QueryGlobalMinGasPriceRequest) (*QueryGlobalMinGasPriceResponse, error)
+	// BurntFees queries the total amount of EVM transaction fees burnt by the
+	// chain
+	BurntFees(context.Context, *QueryBurntFeesRequest) (*QueryBurntFeesResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1877,9 +2328,15 @@ func (*UnimplementedQueryServer) EthCall(ctx context.Context, req *EthCallReques
 func (*UnimplementedQueryServer) EstimateGas(ctx context.Context, req *EthCallRequest) (*EstimateGasResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EstimateGas not implemented")
 }
+func (*UnimplementedQueryServer) SimulateV1(ctx context.Context, req *SimulateV1Request) (*SimulateV1Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateV1 not implemented")
+}
 func (*UnimplementedQueryServer) TraceTx(ctx context.Context, req *QueryTraceTxRequest) (*QueryTraceTxResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TraceTx not implemented")
 }
+func (*UnimplementedQueryServer) TraceCall(ctx context.Context, req *QueryTraceCallRequest) (*QueryTraceCallResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TraceCall not implemented")
+}
 func (*UnimplementedQueryServer) TraceBlock(ctx context.Context, req *QueryTraceBlockRequest) (*QueryTraceBlockResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TraceBlock not implemented")
 }
@@ -1892,6 +2349,9 @@ func (*UnimplementedQueryServer) Config(ctx context.Context, req *QueryConfigReq
 func (*UnimplementedQueryServer) GlobalMinGasPrice(ctx context.Context, req *QueryGlobalMinGasPriceRequest) (*QueryGlobalMinGasPriceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GlobalMinGasPrice not implemented")
 }
+func (*UnimplementedQueryServer) BurntFees(ctx context.Context, req *QueryBurntFeesRequest) (*QueryBurntFeesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BurntFees not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -2059,6 +2519,24 @@ func _Query_EstimateGas_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_SimulateV1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SimulateV1Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SimulateV1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evm.vm.v1.Query/SimulateV1",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SimulateV1(ctx, req.(*SimulateV1Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_TraceTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryTraceTxRequest)
 	if err := dec(in); err != nil {
@@ -2077,6 +2555,24 @@ func _Query_TraceTx_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_TraceCall_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTraceCallRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TraceCall(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evm.vm.v1.Query/TraceCall",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TraceCall(ctx, req.(*QueryTraceCallRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_TraceBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryTraceBlockRequest)
 	if err := dec(in); err != nil {
@@ -2149,6 +2645,24 @@ func _Query_GlobalMinGasPrice_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_BurntFees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBurntFeesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BurntFees(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evm.vm.v1.Query/BurntFees",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BurntFees(ctx, req.(*QueryBurntFeesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.evm.vm.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -2189,10 +2703,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "EstimateGas",
 			Handler:    _Query_EstimateGas_Handler,
 		},
+		{
+			MethodName: "SimulateV1",
+			Handler:    _Query_SimulateV1_Handler,
+		},
 		{
 			MethodName: "TraceTx",
 			Handler:    _Query_TraceTx_Handler,
 		},
+		{
+			MethodName: "TraceCall",
+			Handler:    _Query_TraceCall_Handler,
+		},
 		{
 			MethodName: "TraceBlock",
 			Handler:    _Query_TraceBlock_Handler,
@@ -2209,6 +2731,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GlobalMinGasPrice",
 			Handler:    _Query_GlobalMinGasPrice_Handler,
 		},
+		{
+			MethodName: "BurntFees",
+			Handler:    _Query_BurntFees_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/evm/vm/v1/query.proto",
@@ -2838,6 +3364,13 @@ func (m *EthCallRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Overrides) > 0 {
+		i -= len(m.Overrides)
+		copy(dAtA[i:], m.Overrides)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Overrides)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if m.ChainId != 0 {
 		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
 		i--
@@ -2907,6 +3440,83 @@ func (m *EstimateGasResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SimulateV1Request) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SimulateV1Request) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SimulateV1Request) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ProposerAddress) > 0 {
+		i -= len(m.ProposerAddress)
+		copy(dAtA[i:], m.ProposerAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProposerAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.GasCap != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GasCap))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Opts) > 0 {
+		i -= len(m.Opts)
+		copy(dAtA[i:], m.Opts)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Opts)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SimulateV1Response) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SimulateV1Response) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SimulateV1Response) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryTraceTxRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3151,7 +3761,7 @@ func (m *QueryTraceBlockResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryBaseFeeRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryTraceCallRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3161,57 +3771,185 @@ func (m *QueryBaseFeeRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryBaseFeeRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryTraceCallRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryBaseFeeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryTraceCallRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	return len(dAtA) - i, nil
-}
-
-func (m *QueryBaseFeeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if len(m.BlockOverrides) > 0 {
+		i -= len(m.BlockOverrides)
+		copy(dAtA[i:], m.BlockOverrides)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BlockOverrides)))
+		i--
+		dAtA[i] = 0x5a
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryBaseFeeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryBaseFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.BaseFee != nil {
-		{
-			size := m.BaseFee.Size()
-			i -= size
-			if _, err := m.BaseFee.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
+	if len(m.StateOverrides) > 0 {
+		i -= len(m.StateOverrides)
+		copy(dAtA[i:], m.StateOverrides)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StateOverrides)))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x52
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *QueryGlobalMinGasPriceRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
+	if m.BlockMaxGas != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlockMaxGas))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.ChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.ProposerAddress) > 0 {
+		i -= len(m.ProposerAddress)
+		copy(dAtA[i:], m.ProposerAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProposerAddress)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	n10, err10 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.BlockTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.BlockTime):])
+	if err10 != nil {
+		return 0, err10
+	}
+	i -= n10
+	i = encodeVarintQuery(dAtA, i, uint64(n10))
+	i--
+	dAtA[i] = 0x32
+	if len(m.BlockHash) > 0 {
+		i -= len(m.BlockHash)
+		copy(dAtA[i:], m.BlockHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BlockHash)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.BlockNumber != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlockNumber))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.TraceConfig != nil {
+		{
+			size, err := m.TraceConfig.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.GasCap != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GasCap))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Args) > 0 {
+		i -= len(m.Args)
+		copy(dAtA[i:], m.Args)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Args)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTraceCallResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTraceCallResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTraceCallResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBaseFeeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBaseFeeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBaseFeeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBaseFeeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBaseFeeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBaseFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BaseFee != nil {
+		{
+			size := m.BaseFee.Size()
+			i -= size
+			if _, err := m.BaseFee.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGlobalMinGasPriceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
@@ -3265,6 +4003,62 @@ func (m *QueryGlobalMinGasPriceResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryBurntFeesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBurntFeesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBurntFeesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBurntFeesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBurntFeesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBurntFeesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.BurntFees.Size()
+		i -= size
+		if _, err := m.BurntFees.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -3553,6 +4347,10 @@ func (m *EthCallRequest) Size() (n int) {
 	if m.ChainId != 0 {
 		n += 1 + sovQuery(uint64(m.ChainId))
 	}
+	l = len(m.Overrides)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -3576,6 +4374,42 @@ func (m *EstimateGasResponse) Size() (n int) {
 	return n
 }
 
+func (m *SimulateV1Request) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Opts)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.GasCap != 0 {
+		n += 1 + sovQuery(uint64(m.GasCap))
+	}
+	l = len(m.ProposerAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ChainId != 0 {
+		n += 1 + sovQuery(uint64(m.ChainId))
+	}
+	return n
+}
+
+func (m *SimulateV1Response) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func (m *QueryTraceTxRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3682,34 +4516,94 @@ func (m *QueryTraceBlockResponse) Size() (n int) {
 	return n
 }
 
-func (m *QueryBaseFeeRequest) Size() (n int) {
+func (m *QueryTraceCallRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	return n
-}
-
-func (m *QueryBaseFeeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	l = len(m.Args)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
 	}
-	var l int
-	_ = l
-	if m.BaseFee != nil {
-		l = m.BaseFee.Size()
+	if m.GasCap != 0 {
+		n += 1 + sovQuery(uint64(m.GasCap))
+	}
+	if m.TraceConfig != nil {
+		l = m.TraceConfig.Size()
 		n += 1 + l + sovQuery(uint64(l))
 	}
-	return n
-}
-
-func (m *QueryGlobalMinGasPriceRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.BlockNumber != 0 {
+		n += 1 + sovQuery(uint64(m.BlockNumber))
 	}
-	var l int
-	_ = l
+	l = len(m.BlockHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.BlockTime)
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.ProposerAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ChainId != 0 {
+		n += 1 + sovQuery(uint64(m.ChainId))
+	}
+	if m.BlockMaxGas != 0 {
+		n += 1 + sovQuery(uint64(m.BlockMaxGas))
+	}
+	l = len(m.StateOverrides)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.BlockOverrides)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTraceCallResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBaseFeeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryBaseFeeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BaseFee != nil {
+		l = m.BaseFee.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGlobalMinGasPriceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
 	return n
 }
 
@@ -3724,6 +4618,26 @@ func (m *QueryGlobalMinGasPriceResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryBurntFeesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryBurntFeesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.BurntFees.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -5517,6 +6431,40 @@ func (m *EthCallRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Overrides", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Overrides = append(m.Overrides[:0], dAtA[iNdEx:postIndex]...)
+			if m.Overrides == nil {
+				m.Overrides = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5673,7 +6621,7 @@ func (m *EstimateGasResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTraceTxRequest) Unmarshal(dAtA []byte) error {
+func (m *SimulateV1Request) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5696,17 +6644,17 @@ func (m *QueryTraceTxRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTraceTxRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SimulateV1Request: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTraceTxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SimulateV1Request: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Opts", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5716,33 +6664,50 @@ func (m *QueryTraceTxRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Msg == nil {
-				m.Msg = &MsgEthereumTx{}
-			}
-			if err := m.Msg.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Opts = append(m.Opts[:0], dAtA[iNdEx:postIndex]...)
+			if m.Opts == nil {
+				m.Opts = []byte{}
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasCap", wireType)
+			}
+			m.GasCap = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasCap |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TraceConfig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposerAddress", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5752,31 +6717,612 @@ func (m *QueryTraceTxRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TraceConfig == nil {
-				m.TraceConfig = &TraceConfig{}
+			m.ProposerAddress = append(m.ProposerAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProposerAddress == nil {
+				m.ProposerAddress = []byte{}
 			}
-			if err := m.TraceConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SimulateV1Response) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SimulateV1Response: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SimulateV1Response: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTraceTxRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTraceTxRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTraceTxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Msg == nil {
+				m.Msg = &MsgEthereumTx{}
+			}
+			if err := m.Msg.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TraceConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TraceConfig == nil {
+				m.TraceConfig = &TraceConfig{}
+			}
+			if err := m.TraceConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Predecessors", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Predecessors = append(m.Predecessors, &MsgEthereumTx{})
+			if err := m.Predecessors[len(m.Predecessors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockNumber", wireType)
+			}
+			m.BlockNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockNumber |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.BlockTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposerAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProposerAddress = append(m.ProposerAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProposerAddress == nil {
+				m.ProposerAddress = []byte{}
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockMaxGas", wireType)
+			}
+			m.BlockMaxGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockMaxGas |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTraceTxResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTraceTxResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTraceTxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTraceBlockRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTraceBlockRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTraceBlockRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Txs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Txs = append(m.Txs, &MsgEthereumTx{})
+			if err := m.Txs[len(m.Txs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Predecessors", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TraceConfig", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5803,8 +7349,10 @@ func (m *QueryTraceTxRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Predecessors = append(m.Predecessors, &MsgEthereumTx{})
-			if err := m.Predecessors[len(m.Predecessors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.TraceConfig == nil {
+				m.TraceConfig = &TraceConfig{}
+			}
+			if err := m.TraceConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5985,7 +7533,7 @@ func (m *QueryTraceTxRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTraceTxResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTraceBlockResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6008,10 +7556,10 @@ func (m *QueryTraceTxResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTraceTxResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTraceBlockResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTraceTxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTraceBlockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6069,7 +7617,7 @@ func (m *QueryTraceTxResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTraceBlockRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryTraceCallRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6092,17 +7640,17 @@ func (m *QueryTraceBlockRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTraceBlockRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTraceCallRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTraceBlockRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTraceCallRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Txs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6112,26 +7660,45 @@ func (m *QueryTraceBlockRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Txs = append(m.Txs, &MsgEthereumTx{})
-			if err := m.Txs[len(m.Txs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Args = append(m.Args[:0], dAtA[iNdEx:postIndex]...)
+			if m.Args == nil {
+				m.Args = []byte{}
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasCap", wireType)
+			}
+			m.GasCap = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasCap |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field TraceConfig", wireType)
@@ -6168,7 +7735,7 @@ func (m *QueryTraceBlockRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field BlockNumber", wireType)
 			}
@@ -6187,7 +7754,7 @@ func (m *QueryTraceBlockRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 6:
+		case 5:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
 			}
@@ -6219,7 +7786,7 @@ func (m *QueryTraceBlockRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.BlockHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 6:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field BlockTime", wireType)
 			}
@@ -6252,7 +7819,7 @@ func (m *QueryTraceBlockRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 7:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ProposerAddress", wireType)
 			}
@@ -6286,7 +7853,7 @@ func (m *QueryTraceBlockRequest) Unmarshal(dAtA []byte) error {
 				m.ProposerAddress = []byte{}
 			}
 			iNdEx = postIndex
-		case 9:
+		case 8:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
@@ -6305,7 +7872,7 @@ func (m *QueryTraceBlockRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 10:
+		case 9:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field BlockMaxGas", wireType)
 			}
@@ -6324,6 +7891,74 @@ func (m *QueryTraceBlockRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StateOverrides", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StateOverrides = append(m.StateOverrides[:0], dAtA[iNdEx:postIndex]...)
+			if m.StateOverrides == nil {
+				m.StateOverrides = []byte{}
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockOverrides", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockOverrides = append(m.BlockOverrides[:0], dAtA[iNdEx:postIndex]...)
+			if m.BlockOverrides == nil {
+				m.BlockOverrides = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6345,7 +7980,7 @@ func (m *QueryTraceBlockRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTraceBlockResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTraceCallResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6368,10 +8003,10 @@ func (m *QueryTraceBlockResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTraceBlockResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTraceCallResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTraceBlockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTraceCallResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6699,6 +8334,140 @@ func (m *QueryGlobalMinGasPriceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryBurntFeesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBurntFeesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBurntFeesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBurntFeesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBurntFeesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBurntFeesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurntFees", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BurntFees.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
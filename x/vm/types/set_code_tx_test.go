@@ -0,0 +1,165 @@
+package types_test
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/holiman/uint256"
+
+	utiltx "github.com/cosmos/evm/testutil/tx"
+	"github.com/cosmos/evm/x/vm/types"
+)
+
+func (suite *TxDataTestSuite) signedAuthorization() ethtypes.SetCodeAuthorization {
+	_, privKey := utiltx.NewAddrKey()
+	key, err := privKey.ToECDSA()
+	suite.Require().NoError(err)
+	auth, err := ethtypes.SignSetCode(key, ethtypes.SetCodeAuthorization{
+		ChainID: *uint256.NewInt(9001),
+		Address: suite.addr,
+		Nonce:   1,
+	})
+	suite.Require().NoError(err)
+	return auth
+}
+
+func (suite *TxDataTestSuite) TestNewSetCodeTx() {
+	auth := suite.signedAuthorization()
+	ethTx := ethtypes.NewTx(&ethtypes.SetCodeTx{
+		ChainID:    uint256.NewInt(9001),
+		Nonce:      1,
+		Data:       []byte("data"),
+		Gas:        100,
+		GasTipCap:  uint256.NewInt(1),
+		GasFeeCap:  uint256.NewInt(1),
+		Value:      uint256.NewInt(1),
+		AccessList: ethtypes.AccessList{},
+		To:         suite.addr,
+		AuthList:   []ethtypes.SetCodeAuthorization{auth},
+		V:          uint256.NewInt(1),
+		R:          uint256.NewInt(1),
+		S:          uint256.NewInt(1),
+	})
+
+	tx, err := types.NewSetCodeTx(ethTx)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint8(ethtypes.SetCodeTxType), tx.TxType())
+	suite.Require().NoError(tx.Validate())
+
+	// the authorizations round trip, keeping their authority
+	res := ethtypes.NewTx(tx.AsEthereumData())
+	suite.Require().Equal(ethTx.Hash(), res.Hash())
+	suite.Require().Equal([]ethtypes.SetCodeAuthorization{auth}, res.SetCodeAuthorizations())
+	expAuthority, err := auth.Authority()
+	suite.Require().NoError(err)
+	authority, err := res.SetCodeAuthorizations()[0].Authority()
+	suite.Require().NoError(err)
+	suite.Require().Equal(expAuthority, authority)
+}
+
+func (suite *TxDataTestSuite) TestSetCodeTxValidate() {
+	authList := types.NewAuthorizationList([]ethtypes.SetCodeAuthorization{suite.signedAuthorization()})
+	invalidAuthList := types.NewAuthorizationList([]ethtypes.SetCodeAuthorization{suite.signedAuthorization()})
+	invalidAuthList[0].Address = suite.invalidAddr
+
+	testCases := []struct {
+		name     string
+		tx       types.SetCodeTx
+		expError bool
+	}{
+		{
+			"empty",
+			types.SetCodeTx{},
+			true,
+		},
+		{
+			"gas fee cap < gas tip cap",
+			types.SetCodeTx{
+				GasTipCap: &suite.sdkInt,
+				GasFeeCap: &suite.sdkZeroInt,
+			},
+			true,
+		},
+		{
+			"contract creation",
+			types.SetCodeTx{
+				GasTipCap:      &suite.sdkInt,
+				GasFeeCap:      &suite.sdkInt,
+				Amount:         &suite.sdkInt,
+				Authorizations: authList,
+				ChainID:        &suite.sdkInt,
+			},
+			true,
+		},
+		{
+			"no authorizations",
+			types.SetCodeTx{
+				GasTipCap: &suite.sdkInt,
+				GasFeeCap: &suite.sdkInt,
+				Amount:    &suite.sdkInt,
+				To:        suite.hexAddr,
+				ChainID:   &suite.sdkInt,
+			},
+			true,
+		},
+		{
+			"invalid authorization address",
+			types.SetCodeTx{
+				GasTipCap:      &suite.sdkInt,
+				GasFeeCap:      &suite.sdkInt,
+				Amount:         &suite.sdkInt,
+				To:             suite.hexAddr,
+				Authorizations: invalidAuthList,
+				ChainID:        &suite.sdkInt,
+			},
+			true,
+		},
+		{
+			"chain ID not present on SetCode txs",
+			types.SetCodeTx{
+				GasTipCap:      &suite.sdkInt,
+				GasFeeCap:      &suite.sdkInt,
+				Amount:         &suite.sdkInt,
+				To:             suite.hexAddr,
+				Authorizations: authList,
+			},
+			true,
+		},
+		{
+			"no errors",
+			types.SetCodeTx{
+				GasTipCap:      &suite.sdkInt,
+				GasFeeCap:      &suite.sdkInt,
+				Amount:         &suite.sdkInt,
+				To:             suite.hexAddr,
+				Authorizations: authList,
+				ChainID:        &suite.sdkInt,
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		err := tc.tx.Validate()
+
+		if tc.expError {
+			suite.Require().Error(err, tc.name)
+			continue
+		}
+
+		suite.Require().NoError(err, tc.name)
+	}
+}
+
+func (suite *TxDataTestSuite) TestSetCodeTxEffectiveCost() {
+	tx := types.SetCodeTx{
+		GasTipCap: &suite.sdkInt,
+		GasFeeCap: &suite.sdkInt,
+		GasLimit:  uint64(1),
+		Amount:    &suite.sdkZeroInt,
+	}
+	suite.Require().Equal(suite.sdkInt.BigInt(), tx.EffectiveCost(suite.sdkInt.BigInt()))
+	suite.Require().Equal(suite.sdkInt.BigInt(), tx.Cost())
+	suite.Require().Equal(common.Big1, new(big.Int).Div(tx.Fee(), suite.sdkInt.BigInt()))
+}
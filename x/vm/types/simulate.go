@@ -0,0 +1,109 @@
+package types
+
+import (
+	"errors"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+)
+
+const (
+	// MaxSimulateBlocks is the maximum number of blocks that can be simulated
+	// in a single eth_simulateV1 request.
+	MaxSimulateBlocks = 256
+	// MaxSimulateCalls is the maximum number of calls that can be simulated
+	// across all the blocks of a single eth_simulateV1 request.
+	MaxSimulateCalls = 1000
+	// SimulateTimestampIncrement is the default increment between the
+	// timestamps of consecutive simulated blocks.
+	SimulateTimestampIncrement = 12
+
+	// SimulateErrCodeReverted is the error code of simulated calls reverted by
+	// the EVM.
+	SimulateErrCodeReverted = -32000
+	// SimulateErrCodeVMError is the error code of simulated calls failed with
+	// any other EVM error.
+	SimulateErrCodeVMError = -32015
+)
+
+// SimOpts are the inputs to eth_simulateV1.
+// Duplicate struct definition since geth struct is in internal package
+// Ref: https://github.com/ethereum/go-ethereum/blob/v1.15.11/internal/ethapi/simulate.go
+type SimOpts struct {
+	BlockStateCalls []SimBlock `json:"blockStateCalls"`
+	TraceTransfers  bool       `json:"traceTransfers"`
+	Validation      bool       `json:"validation"`
+}
+
+// SimBlock is a batch of calls to be simulated sequentially on top of the
+// given block and state overrides.
+type SimBlock struct {
+	BlockOverrides *BlockOverrides   `json:"blockOverrides"`
+	StateOverrides *StateOverride    `json:"stateOverrides"`
+	Calls          []TransactionArgs `json:"calls"`
+}
+
+// SimBlockResult is the result of a simulated block.
+type SimBlockResult struct {
+	Number        hexutil.Uint64  `json:"number"`
+	Hash          common.Hash     `json:"hash"`
+	ParentHash    common.Hash     `json:"parentHash"`
+	Timestamp     hexutil.Uint64  `json:"timestamp"`
+	GasLimit      hexutil.Uint64  `json:"gasLimit"`
+	GasUsed       hexutil.Uint64  `json:"gasUsed"`
+	Miner         common.Address  `json:"miner"`
+	BaseFeePerGas *hexutil.Big    `json:"baseFeePerGas,omitempty"`
+	Transactions  []common.Hash   `json:"transactions"`
+	Calls         []SimCallResult `json:"calls"`
+}
+
+// SimCallResult is the result of a simulated call.
+type SimCallResult struct {
+	ReturnValue hexutil.Bytes   `json:"returnData"`
+	Logs        []*ethtypes.Log `json:"logs"`
+	GasUsed     hexutil.Uint64  `json:"gasUsed"`
+	Status      hexutil.Uint64  `json:"status"`
+	Error       *SimCallError   `json:"error,omitempty"`
+}
+
+// SimCallError is the error of a failed simulated call.
+type SimCallError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+	Data    string `json:"data,omitempty"`
+}
+
+// Validate performs a basic validation of the simulation options.
+func (opts SimOpts) Validate() error {
+	if len(opts.BlockStateCalls) == 0 {
+		return errors.New("empty input")
+	}
+	if len(opts.BlockStateCalls) > MaxSimulateBlocks {
+		return errors.New("too many blocks")
+	}
+	if opts.TraceTransfers {
+		return errors.New("traceTransfers is not supported")
+	}
+	if opts.Validation {
+		return errors.New("validation is not supported")
+	}
+	calls := 0
+	for _, block := range opts.BlockStateCalls {
+		calls += len(block.Calls)
+		if calls > MaxSimulateCalls {
+			return errors.New("too many calls")
+		}
+		if block.BlockOverrides != nil {
+			if err := block.BlockOverrides.Validate(); err != nil {
+				return err
+			}
+		}
+		if block.StateOverrides != nil {
+			if err := block.StateOverrides.Validate(); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,64 @@
+package types
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// StateOverride is the collection of overridden accounts.
+// Duplicate struct definition since geth struct is in internal package
+// Ref: https://github.com/ethereum/go-ethereum/blob/v1.15.11/internal/ethapi/override/override.go#L50
+type StateOverride map[common.Address]OverrideAccount
+
+// OverrideAccount indicates the overriding fields of account during the execution of
+// a message call.
+// Note, state and stateDiff can't be specified at the same time. If state is
+// set, message execution will only use the data in the given state. Otherwise
+// if stateDiff is set, all diff will be applied first and then execute the call
+// message.
+type OverrideAccount struct {
+	Nonce     *hexutil.Uint64              `json:"nonce"`
+	Code      *hexutil.Bytes               `json:"code"`
+	Balance   **hexutil.Big                `json:"balance"`
+	State     *map[common.Hash]common.Hash `json:"state"`
+	StateDiff *map[common.Hash]common.Hash `json:"stateDiff"`
+}
+
+// BlockOverrides is a set of header fields to override during the execution of
+// a message call.
+type BlockOverrides struct {
+	Number        *hexutil.Big    `json:"number"`
+	Time          *hexutil.Uint64 `json:"time"`
+	GasLimit      *hexutil.Uint64 `json:"gasLimit"`
+	FeeRecipient  *common.Address `json:"feeRecipient"`
+	BaseFeePerGas *hexutil.Big    `json:"baseFeePerGas"`
+}
+
+// Validate performs a basic validation of the overridden accounts.
+func (diff StateOverride) Validate() error {
+	for addr, account := range diff {
+		if account.State != nil && account.StateDiff != nil {
+			return fmt.Errorf("account %s has both 'state' and 'stateDiff'", addr.Hex())
+		}
+		if account.Balance != nil && *account.Balance != nil && (*account.Balance).ToInt().Sign() < 0 {
+			return fmt.Errorf("account %s has a negative balance override", addr.Hex())
+		}
+	}
+	return nil
+}
+
+// Validate performs a basic validation of the overridden header fields.
+func (o BlockOverrides) Validate() error {
+	if o.Number != nil {
+		if number := o.Number.ToInt(); !number.IsInt64() || number.Sign() <= 0 {
+			return fmt.Errorf("invalid block number override %s", number)
+		}
+	}
+	if o.Time != nil && uint64(*o.Time) > math.MaxInt64 {
+		return fmt.Errorf("invalid block time override %d", uint64(*o.Time))
+	}
+	return nil
+}
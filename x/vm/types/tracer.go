@@ -131,3 +131,11 @@ func (dt *NoOpTracer) OnLog(_ *types.Log) {
 
 func (dt *NoOpTracer) OnGasChange(_, _ uint64, _ tracing.GasChangeReason) {
 }
+
+// TraceCallConfig is the config for the debug_traceCall API. It holds extra
+// fields to override the state and the block context of the traced call.
+type TraceCallConfig struct {
+	TraceConfig
+	StateOverrides *StateOverride  `json:"stateOverrides"`
+	BlockOverrides *BlockOverrides `json:"blockOverrides"`
+}
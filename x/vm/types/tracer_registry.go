@@ -0,0 +1,76 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/eth/tracers"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+// TracerConstructor creates a native tracer for a single transaction trace,
+// from the config set on the tracer_json_config field of the TraceConfig.
+type TracerConstructor func(ctx *tracers.Context, cfg json.RawMessage, chainConfig *params.ChainConfig) (*tracers.Tracer, error)
+
+// TracerRegistry holds the custom native tracers registered by the application.
+// They are selected by name with the tracer field of the TraceConfig, and take
+// precedence over the go-ethereum built-in tracers of the same name.
+type TracerRegistry struct {
+	constructors map[string]TracerConstructor
+}
+
+// NewTracerRegistry returns an empty tracer registry.
+func NewTracerRegistry() *TracerRegistry {
+	return &TracerRegistry{
+		constructors: make(map[string]TracerConstructor),
+	}
+}
+
+// Register adds a named tracer to the registry. It fails if the name is empty
+// or already registered.
+func (r *TracerRegistry) Register(name string, constructor TracerConstructor) error {
+	if name == "" {
+		return fmt.Errorf("tracer name cannot be empty")
+	}
+	if constructor == nil {
+		return fmt.Errorf("tracer %s constructor cannot be nil", name)
+	}
+	if _, found := r.constructors[name]; found {
+		return fmt.Errorf("tracer %s already registered", name)
+	}
+
+	r.constructors[name] = constructor
+	return nil
+}
+
+// Get returns the constructor of the given tracer, if registered.
+func (r *TracerRegistry) Get(name string) (TracerConstructor, bool) {
+	if r == nil {
+		return nil, false
+	}
+	constructor, found := r.constructors[name]
+	return constructor, found
+}
+
+// Names returns the sorted names of the registered tracers.
+func (r *TracerRegistry) Names() []string {
+	if r == nil {
+		return nil
+	}
+	names := make([]string, 0, len(r.constructors))
+	for name := range r.constructors {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// New creates the named tracer, looking it up in the registry first and in
+// the go-ethereum tracers directory otherwise.
+func (r *TracerRegistry) New(name string, ctx *tracers.Context, cfg json.RawMessage, chainConfig *params.ChainConfig) (*tracers.Tracer, error) {
+	if constructor, found := r.Get(name); found {
+		return constructor(ctx, cfg, chainConfig)
+	}
+	return tracers.DefaultDirectory.New(name, ctx, cfg, chainConfig)
+}
@@ -0,0 +1,51 @@
+package types_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/eth/tracers"
+	_ "github.com/ethereum/go-ethereum/eth/tracers/native"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/evm/x/vm/types"
+)
+
+func TestTracerRegistry(t *testing.T) {
+	var receivedCfg json.RawMessage
+	constructor := func(_ *tracers.Context, cfg json.RawMessage, _ *params.ChainConfig) (*tracers.Tracer, error) {
+		receivedCfg = cfg
+		return &tracers.Tracer{}, nil
+	}
+
+	registry := types.NewTracerRegistry()
+	require.Error(t, registry.Register("", constructor))
+	require.Error(t, registry.Register("custom", nil))
+	require.NoError(t, registry.Register("custom", constructor))
+	require.Error(t, registry.Register("custom", constructor))
+	require.NoError(t, registry.Register("another", constructor))
+	require.Equal(t, []string{"another", "custom"}, registry.Names())
+
+	_, found := registry.Get("custom")
+	require.True(t, found)
+	_, found = registry.Get("callTracer")
+	require.False(t, found)
+
+	// registered tracers receive their config
+	tracer, err := registry.New("custom", &tracers.Context{}, json.RawMessage(`{"a":1}`), params.TestChainConfig)
+	require.NoError(t, err)
+	require.NotNil(t, tracer)
+	require.Equal(t, json.RawMessage(`{"a":1}`), receivedCfg)
+
+	// go-ethereum tracers are used as fallback
+	tracer, err = registry.New("callTracer", &tracers.Context{}, nil, params.TestChainConfig)
+	require.NoError(t, err)
+	require.NotNil(t, tracer.Hooks)
+
+	// a nil registry only has the go-ethereum tracers
+	var nilRegistry *types.TracerRegistry
+	require.Nil(t, nilRegistry.Names())
+	_, err = nilRegistry.New("callTracer", &tracers.Context{}, nil, params.TestChainConfig)
+	require.NoError(t, err)
+}
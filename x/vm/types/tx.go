@@ -22,6 +22,11 @@ type EvmTxArgs struct {
 	GasTipCap *big.Int
 	To        *common.Address
 	Accesses  *ethtypes.AccessList
+	// BlobFeeCap and BlobHashes are only set for EIP-4844 blob transactions
+	BlobFeeCap *big.Int
+	BlobHashes []common.Hash
+	// AuthorizationList is only set for EIP-7702 set code transactions
+	AuthorizationList []ethtypes.SetCodeAuthorization
 }
 
 // ToTxData converts the EvmTxArgs to TxData
@@ -111,6 +111,52 @@ func (args *TransactionArgs) ToTransaction() *MsgEthereumTx {
 
 	var data TxData
 	switch {
+	case args.AuthorizationList != nil:
+		al := AccessList{}
+		if args.AccessList != nil {
+			al = NewAccessList(args.AccessList)
+		}
+
+		data = &SetCodeTx{
+			To:             to,
+			ChainID:        &chainID,
+			Nonce:          nonce,
+			GasLimit:       gas,
+			GasFeeCap:      &maxFeePerGas,
+			GasTipCap:      &maxPriorityFeePerGas,
+			Amount:         &value,
+			Data:           args.GetData(),
+			Accesses:       al,
+			Authorizations: NewAuthorizationList(args.AuthorizationList),
+		}
+	case args.BlobHashes != nil:
+		al := AccessList{}
+		if args.AccessList != nil {
+			al = NewAccessList(args.AccessList)
+		}
+
+		var blobFeeCap sdkmath.Int
+		if args.BlobFeeCap != nil {
+			blobFeeCap = sdkmath.NewIntFromBigInt(args.BlobFeeCap.ToInt())
+		}
+		hashes := make([]string, len(args.BlobHashes))
+		for i, hash := range args.BlobHashes {
+			hashes[i] = hash.Hex()
+		}
+
+		data = &BlobTx{
+			To:         to,
+			ChainID:    &chainID,
+			Nonce:      nonce,
+			GasLimit:   gas,
+			GasFeeCap:  &maxFeePerGas,
+			GasTipCap:  &maxPriorityFeePerGas,
+			Amount:     &value,
+			Data:       args.GetData(),
+			Accesses:   al,
+			BlobFeeCap: &blobFeeCap,
+			BlobHashes: hashes,
+		}
 	case args.MaxFeePerGas != nil:
 		al := AccessList{}
 		if args.AccessList != nil {
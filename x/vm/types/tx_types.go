@@ -12,6 +12,10 @@ func GetTxTypeName(txType int) string {
 		return "LegacyTxType"
 	case gethtypes.AccessListTxType:
 		return "AccessListTxType"
+	case gethtypes.BlobTxType:
+		return "BlobTxType"
+	case gethtypes.SetCodeTxType:
+		return "SetCodeTxType"
 	default:
 		panic("unknown tx type")
 	}
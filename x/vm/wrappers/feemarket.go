@@ -47,6 +47,12 @@ func (w FeeMarketWrapper) CalculateBaseFee(ctx sdk.Context) *big.Int {
 	return types.ConvertAmountTo18DecimalsLegacy(baseFee).TruncateInt().BigInt()
 }
 
+// GetBlobBaseFee returns the blob base fee converted to 18 decimals.
+func (w FeeMarketWrapper) GetBlobBaseFee(ctx sdk.Context) *big.Int {
+	blobBaseFee := w.FeeMarketKeeper.GetBlobBaseFee(ctx)
+	return types.ConvertAmountTo18DecimalsLegacy(blobBaseFee).TruncateInt().BigInt()
+}
+
 // GetParams returns the params with associated fees values converted to 18 decimals.
 func (w FeeMarketWrapper) GetParams(ctx sdk.Context) feemarkettypes.Params {
 	params := w.FeeMarketKeeper.GetParams(ctx)
@@ -54,5 +60,8 @@ func (w FeeMarketWrapper) GetParams(ctx sdk.Context) feemarkettypes.Params {
 		params.BaseFee = types.ConvertAmountTo18DecimalsLegacy(params.BaseFee)
 	}
 	params.MinGasPrice = types.ConvertAmountTo18DecimalsLegacy(params.MinGasPrice)
+	if !params.MinBlobBaseFee.IsNil() {
+		params.MinBlobBaseFee = types.ConvertAmountTo18DecimalsLegacy(params.MinBlobBaseFee)
+	}
 	return params
 }